@@ -0,0 +1,41 @@
+#!/bin/bash -e
+
+# Captures the schemas served by a running Kong into kong/schemas/<major.minor>,
+# the snapshots used by BundledSchema, BundledPluginSchema and
+# BundledVaultSchema. Start the release to capture first, e.g. with
+# KONG_IMAGE_TAG=3.4 make setup-kong-postgres.
+
+readonly KONG_ADMIN_URL=${KONG_ADMIN_URL:-http://localhost:8001}
+readonly SCHEMAS_DIR=kong/schemas
+
+ENTITIES=(
+  acls basicauth_credentials ca_certificates certificates consumer_groups
+  consumers hmacauth_credentials jwt_secrets key_sets keyauth_credentials keys
+  plugins routes services snis targets upstreams vaults
+)
+PLUGINS=(acl cors jwt key-auth proxy-cache rate-limiting request-transformer)
+VAULTS=(env aws gcp hcv azure conjur)
+
+version=$(curl -sf "${KONG_ADMIN_URL}" | jq -r .version | cut -d. -f1,2)
+dir="${SCHEMAS_DIR}/${version}"
+mkdir -p "${dir}/plugins" "${dir}/vaults"
+
+capture() {
+  local path=$1 file=$2
+  if ! curl -sf "${KONG_ADMIN_URL}/schemas/${path}" | jq -S . > "${file}.tmp"; then
+    echo "skipping ${path}: not served by Kong ${version}"
+    rm -f "${file}.tmp"
+    return
+  fi
+  mv "${file}.tmp" "${file}"
+}
+
+for entity in "${ENTITIES[@]}"; do
+  capture "${entity}" "${dir}/${entity}.json"
+done
+for plugin in "${PLUGINS[@]}"; do
+  capture "plugins/${plugin}" "${dir}/plugins/${plugin}.json"
+done
+for vault in "${VAULTS[@]}"; do
+  capture "vaults/${vault}" "${dir}/vaults/${vault}.json"
+done
@@ -0,0 +1,1456 @@
+package kong
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// The builders in this file construct valid entities without spelling out
+// the pointer helpers, mostly for test fixtures:
+//
+//	content, err := NewServiceBuilder().
+//		Name("svc").
+//		URL("https://example.com/api").
+//		WithRoute(NewRouteBuilder().Name("route").Paths("/api")).
+//		WithPlugin(NewPluginBuilder("rate-limiting").ConfigValue("minute", 10)).
+//		FillID("default").
+//		BuildContent()
+//
+// Build returns the entity itself, while BuildContent returns it along with
+// the entities added to it, flattened and referencing their parent.
+// Builders which are given a workspace with FillID generate the IDs of the
+// entities they build with the FillID method of the entities, and so do
+// the builders added to them unless they have their own workspace.
+
+// defaultPorts holds the ports used by Kong for URLs without explicit port.
+var defaultPorts = map[string]int{
+	"http":  80,
+	"https": 443,
+	"grpc":  80,
+	"grpcs": 443,
+	"ws":    80,
+	"wss":   443,
+}
+
+// ServiceBuilder builds a Service, along with its routes and plugins.
+type ServiceBuilder struct {
+	service   Service
+	workspace *string
+	routes    []*RouteBuilder
+	plugins   []*PluginBuilder
+	errs      []error
+}
+
+// NewServiceBuilder returns a builder of Services.
+func NewServiceBuilder() *ServiceBuilder {
+	return &ServiceBuilder{}
+}
+
+// ID sets the ID of the service.
+func (b *ServiceBuilder) ID(id string) *ServiceBuilder {
+	b.service.ID = String(id)
+	return b
+}
+
+// Name sets the name of the service.
+func (b *ServiceBuilder) Name(name string) *ServiceBuilder {
+	b.service.Name = String(name)
+	return b
+}
+
+// URL sets the protocol, host, port and path of the service from rawURL.
+func (b *ServiceBuilder) URL(rawURL string) *ServiceBuilder {
+	fields, err := serviceURLFields(rawURL)
+	if err != nil || fields.Protocol == nil || fields.Host == nil {
+		b.errs = append(b.errs, fmt.Errorf("invalid service URL %q", rawURL))
+		return b
+	}
+	b.service.Protocol = fields.Protocol
+	b.service.Host = fields.Host
+	if fields.Port != nil {
+		b.service.Port = fields.Port
+	}
+	if fields.Path != nil {
+		b.service.Path = fields.Path
+	}
+	return b
+}
+
+// serviceURLFields returns a Service holding the protocol, host, port and
+// path set by rawURL, as Kong translates the url shorthand of services.
+// The fields missing from rawURL are nil, except the port which defaults to
+// the one of the protocol.
+func serviceURLFields(rawURL string) (*Service, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url")
+	}
+	fields := &Service{}
+	if u.Scheme != "" {
+		fields.Protocol = String(u.Scheme)
+	}
+	if host := u.Hostname(); host != "" {
+		fields.Host = String(host)
+	}
+	if port := u.Port(); port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port")
+		}
+		fields.Port = Int(p)
+	} else if p, ok := defaultPorts[u.Scheme]; ok {
+		fields.Port = Int(p)
+	}
+	if u.Path != "" {
+		fields.Path = String(u.Path)
+	}
+	return fields, nil
+}
+
+// Host sets the host of the service.
+func (b *ServiceBuilder) Host(host string) *ServiceBuilder {
+	b.service.Host = String(host)
+	return b
+}
+
+// Port sets the port of the service.
+func (b *ServiceBuilder) Port(port int) *ServiceBuilder {
+	b.service.Port = Int(port)
+	return b
+}
+
+// Protocol sets the protocol of the service.
+func (b *ServiceBuilder) Protocol(protocol string) *ServiceBuilder {
+	b.service.Protocol = String(protocol)
+	return b
+}
+
+// Path sets the path of the service.
+func (b *ServiceBuilder) Path(path string) *ServiceBuilder {
+	b.service.Path = String(path)
+	return b
+}
+
+// Retries sets the number of retries of the service.
+func (b *ServiceBuilder) Retries(retries int) *ServiceBuilder {
+	b.service.Retries = Int(retries)
+	return b
+}
+
+// Timeouts sets the connect, read and write timeouts of the service, in milliseconds.
+func (b *ServiceBuilder) Timeouts(connect, read, write int) *ServiceBuilder {
+	b.service.ConnectTimeout = Int(connect)
+	b.service.ReadTimeout = Int(read)
+	b.service.WriteTimeout = Int(write)
+	return b
+}
+
+// Enabled sets whether the service is enabled.
+func (b *ServiceBuilder) Enabled(enabled bool) *ServiceBuilder {
+	b.service.Enabled = Bool(enabled)
+	return b
+}
+
+// Tags sets the tags of the service.
+func (b *ServiceBuilder) Tags(tags ...string) *ServiceBuilder {
+	b.service.Tags = StringSlice(tags...)
+	return b
+}
+
+// WithRoute adds a route to the service.
+func (b *ServiceBuilder) WithRoute(route *RouteBuilder) *ServiceBuilder {
+	b.routes = append(b.routes, route)
+	return b
+}
+
+// WithPlugin adds a plugin applied to the service.
+func (b *ServiceBuilder) WithPlugin(plugin *PluginBuilder) *ServiceBuilder {
+	b.plugins = append(b.plugins, plugin)
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *ServiceBuilder) FillID(workspace string) *ServiceBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the service.
+func (b *ServiceBuilder) Build() (*Service, error) {
+	return b.build(nil)
+}
+
+// BuildContent returns the service along with its routes and plugins.
+func (b *ServiceBuilder) BuildContent() (*DeclarativeContent, error) {
+	content := &DeclarativeContent{}
+	if err := b.addTo(content, nil); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (b *ServiceBuilder) build(workspace *string) (*Service, error) {
+	if err := errors.Join(b.errs...); err != nil {
+		return nil, err
+	}
+	service := b.service.DeepCopy()
+	if service.Host == nil || *service.Host == "" {
+		return nil, fmt.Errorf("service host is required")
+	}
+	if err := fillBuiltID(service, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+func (b *ServiceBuilder) addTo(content *DeclarativeContent, workspace *string) error {
+	service, err := b.build(workspace)
+	if err != nil {
+		return err
+	}
+	workspace = inheritedWorkspace(b.workspace, workspace)
+	content.Services = append(content.Services, &DeclarativeService{Service: service})
+	for _, route := range b.routes {
+		if err := route.addTo(content, workspace, service); err != nil {
+			return err
+		}
+	}
+	for _, plugin := range b.plugins {
+		if err := plugin.addTo(content, workspace, &Plugin{Service: service}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RouteBuilder builds a Route, along with its plugins.
+type RouteBuilder struct {
+	route      Route
+	expression Expr
+	workspace  *string
+	plugins    []*PluginBuilder
+}
+
+// NewRouteBuilder returns a builder of Routes.
+func NewRouteBuilder() *RouteBuilder {
+	return &RouteBuilder{}
+}
+
+// ID sets the ID of the route.
+func (b *RouteBuilder) ID(id string) *RouteBuilder {
+	b.route.ID = String(id)
+	return b
+}
+
+// Name sets the name of the route.
+func (b *RouteBuilder) Name(name string) *RouteBuilder {
+	b.route.Name = String(name)
+	return b
+}
+
+// Paths sets the paths matched by the route.
+func (b *RouteBuilder) Paths(paths ...string) *RouteBuilder {
+	b.route.Paths = StringSlice(paths...)
+	return b
+}
+
+// Hosts sets the hosts matched by the route.
+func (b *RouteBuilder) Hosts(hosts ...string) *RouteBuilder {
+	b.route.Hosts = StringSlice(hosts...)
+	return b
+}
+
+// Methods sets the HTTP methods matched by the route.
+func (b *RouteBuilder) Methods(methods ...string) *RouteBuilder {
+	b.route.Methods = StringSlice(methods...)
+	return b
+}
+
+// Header adds a header matched by the route.
+func (b *RouteBuilder) Header(name string, values ...string) *RouteBuilder {
+	if b.route.Headers == nil {
+		b.route.Headers = map[string][]string{}
+	}
+	b.route.Headers[name] = append(b.route.Headers[name], values...)
+	return b
+}
+
+// SNIs sets the SNIs matched by the route.
+func (b *RouteBuilder) SNIs(snis ...string) *RouteBuilder {
+	b.route.SNIs = StringSlice(snis...)
+	return b
+}
+
+// Expression sets the expression matched by the route, with the expressions router.
+func (b *RouteBuilder) Expression(expression string) *RouteBuilder {
+	b.route.Expression = String(expression)
+	b.expression = nil
+	return b
+}
+
+// MatchExpression sets the expression matched by the route, with the
+// expressions router, from its typed form. Build fails if the expression is
+// invalid.
+func (b *RouteBuilder) MatchExpression(expression Expr) *RouteBuilder {
+	b.expression = expression
+	b.route.Expression = nil
+	return b
+}
+
+// Protocols sets the protocols of the route.
+func (b *RouteBuilder) Protocols(protocols ...string) *RouteBuilder {
+	b.route.Protocols = StringSlice(protocols...)
+	return b
+}
+
+// StripPath sets whether the route strips the matched path from the upstream request.
+func (b *RouteBuilder) StripPath(stripPath bool) *RouteBuilder {
+	b.route.StripPath = Bool(stripPath)
+	return b
+}
+
+// PreserveHost sets whether the route preserves the host header in the upstream request.
+func (b *RouteBuilder) PreserveHost(preserveHost bool) *RouteBuilder {
+	b.route.PreserveHost = Bool(preserveHost)
+	return b
+}
+
+// Tags sets the tags of the route.
+func (b *RouteBuilder) Tags(tags ...string) *RouteBuilder {
+	b.route.Tags = StringSlice(tags...)
+	return b
+}
+
+// Service sets the service of the route.
+// Routes added to a ServiceBuilder reference the built service instead.
+func (b *RouteBuilder) Service(service *Service) *RouteBuilder {
+	b.route.Service = service
+	return b
+}
+
+// WithPlugin adds a plugin applied to the route.
+func (b *RouteBuilder) WithPlugin(plugin *PluginBuilder) *RouteBuilder {
+	b.plugins = append(b.plugins, plugin)
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *RouteBuilder) FillID(workspace string) *RouteBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the route.
+func (b *RouteBuilder) Build() (*Route, error) {
+	return b.build(nil, nil)
+}
+
+// BuildContent returns the route along with its plugins.
+func (b *RouteBuilder) BuildContent() (*DeclarativeContent, error) {
+	content := &DeclarativeContent{}
+	if err := b.addTo(content, nil, nil); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (b *RouteBuilder) build(workspace *string, service *Service) (*Route, error) {
+	route := b.route.DeepCopy()
+	if service != nil {
+		route.Service = service
+	}
+	if b.expression != nil {
+		expression, err := FormatRouteExpression(b.expression)
+		if err != nil {
+			return nil, err
+		}
+		route.Expression = String(expression)
+	}
+	if route.Expression == nil && len(route.Paths) == 0 && len(route.Hosts) == 0 &&
+		len(route.Methods) == 0 && len(route.Headers) == 0 && len(route.SNIs) == 0 &&
+		len(route.Sources) == 0 && len(route.Destinations) == 0 {
+		return nil, fmt.Errorf("route must match on at least one of paths, hosts, methods, " +
+			"headers, snis, sources, destinations or expression")
+	}
+	if err := fillBuiltID(route, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if route.Service != nil {
+		route.Service = serviceReference(route.Service)
+	}
+	return route, nil
+}
+
+func (b *RouteBuilder) addTo(content *DeclarativeContent, workspace *string, service *Service) error {
+	route, err := b.build(workspace, service)
+	if err != nil {
+		return err
+	}
+	workspace = inheritedWorkspace(b.workspace, workspace)
+	content.Routes = append(content.Routes, &DeclarativeRoute{Route: route})
+	// Plugins are identified with the full service reference of the route.
+	scoped := route.DeepCopy()
+	if service != nil {
+		scoped.Service = service
+	}
+	for _, plugin := range b.plugins {
+		if err := plugin.addTo(content, workspace, &Plugin{Route: scoped}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PluginBuilder builds a Plugin.
+type PluginBuilder struct {
+	plugin    Plugin
+	condition Expr
+	workspace *string
+}
+
+// NewPluginBuilder returns a builder of Plugins named name.
+func NewPluginBuilder(name string) *PluginBuilder {
+	return &PluginBuilder{plugin: Plugin{Name: String(name)}}
+}
+
+// ID sets the ID of the plugin.
+func (b *PluginBuilder) ID(id string) *PluginBuilder {
+	b.plugin.ID = String(id)
+	return b
+}
+
+// InstanceName sets the instance name of the plugin.
+func (b *PluginBuilder) InstanceName(name string) *PluginBuilder {
+	b.plugin.InstanceName = String(name)
+	return b
+}
+
+// Config sets the configuration of the plugin.
+func (b *PluginBuilder) Config(config Configuration) *PluginBuilder {
+	b.plugin.Config = config.DeepCopy()
+	return b
+}
+
+// ConfigValue sets a field of the configuration of the plugin.
+// Built plugins hold the JSON representation of value, as decoded configurations do.
+func (b *PluginBuilder) ConfigValue(field string, value interface{}) *PluginBuilder {
+	if b.plugin.Config == nil {
+		b.plugin.Config = Configuration{}
+	}
+	b.plugin.Config[field] = value
+	return b
+}
+
+// Condition sets the condition under which the plugin runs. Build fails if
+// the condition is invalid.
+func (b *PluginBuilder) Condition(condition Expr) *PluginBuilder {
+	b.condition = condition
+	return b
+}
+
+// Enabled sets whether the plugin is enabled.
+func (b *PluginBuilder) Enabled(enabled bool) *PluginBuilder {
+	b.plugin.Enabled = Bool(enabled)
+	return b
+}
+
+// Protocols sets the protocols the plugin runs on.
+func (b *PluginBuilder) Protocols(protocols ...string) *PluginBuilder {
+	b.plugin.Protocols = StringSlice(protocols...)
+	return b
+}
+
+// Tags sets the tags of the plugin.
+func (b *PluginBuilder) Tags(tags ...string) *PluginBuilder {
+	b.plugin.Tags = StringSlice(tags...)
+	return b
+}
+
+// Service scopes the plugin to service.
+func (b *PluginBuilder) Service(service *Service) *PluginBuilder {
+	b.plugin.Service = service
+	return b
+}
+
+// Route scopes the plugin to route.
+func (b *PluginBuilder) Route(route *Route) *PluginBuilder {
+	b.plugin.Route = route
+	return b
+}
+
+// Consumer scopes the plugin to consumer.
+func (b *PluginBuilder) Consumer(consumer *Consumer) *PluginBuilder {
+	b.plugin.Consumer = consumer
+	return b
+}
+
+// ConsumerGroup scopes the plugin to consumerGroup.
+func (b *PluginBuilder) ConsumerGroup(consumerGroup *ConsumerGroup) *PluginBuilder {
+	b.plugin.ConsumerGroup = consumerGroup
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *PluginBuilder) FillID(workspace string) *PluginBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the plugin.
+func (b *PluginBuilder) Build() (*Plugin, error) {
+	return b.build(nil, nil)
+}
+
+// build builds the plugin, scoped to the entities referenced by scope, if
+// any, in addition to its own scope.
+func (b *PluginBuilder) build(workspace *string, scope *Plugin) (*Plugin, error) {
+	plugin := b.plugin.DeepCopy()
+	if plugin.Name == nil || *plugin.Name == "" {
+		return nil, fmt.Errorf("plugin name is required")
+	}
+	if b.condition != nil {
+		condition, err := FormatCondition(b.condition)
+		if err != nil {
+			return nil, err
+		}
+		plugin.Condition = String(condition)
+	}
+	if scope != nil {
+		if scope.Service != nil {
+			plugin.Service = scope.Service
+		}
+		if scope.Route != nil {
+			plugin.Route = scope.Route
+		}
+		if scope.Consumer != nil {
+			plugin.Consumer = scope.Consumer
+		}
+		if scope.ConsumerGroup != nil {
+			plugin.ConsumerGroup = scope.ConsumerGroup
+		}
+	}
+	if err := fillBuiltID(plugin, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if plugin.Service != nil {
+		plugin.Service = serviceReference(plugin.Service)
+	}
+	if plugin.Route != nil {
+		plugin.Route = routeReference(plugin.Route)
+	}
+	if plugin.Consumer != nil {
+		plugin.Consumer = consumerReference(plugin.Consumer)
+	}
+	if plugin.ConsumerGroup != nil {
+		plugin.ConsumerGroup = consumerGroupReference(plugin.ConsumerGroup)
+	}
+	return plugin, nil
+}
+
+func (b *PluginBuilder) addTo(content *DeclarativeContent, workspace *string, scope *Plugin) error {
+	plugin, err := b.build(workspace, scope)
+	if err != nil {
+		return err
+	}
+	content.Plugins = append(content.Plugins, plugin)
+	return nil
+}
+
+// UpstreamBuilder builds an Upstream, along with its targets.
+type UpstreamBuilder struct {
+	upstream  Upstream
+	workspace *string
+	targets   []*TargetBuilder
+}
+
+// NewUpstreamBuilder returns a builder of Upstreams.
+func NewUpstreamBuilder() *UpstreamBuilder {
+	return &UpstreamBuilder{}
+}
+
+// ID sets the ID of the upstream.
+func (b *UpstreamBuilder) ID(id string) *UpstreamBuilder {
+	b.upstream.ID = String(id)
+	return b
+}
+
+// Name sets the name of the upstream.
+func (b *UpstreamBuilder) Name(name string) *UpstreamBuilder {
+	b.upstream.Name = String(name)
+	return b
+}
+
+// Algorithm sets the load balancing algorithm of the upstream.
+func (b *UpstreamBuilder) Algorithm(algorithm string) *UpstreamBuilder {
+	b.upstream.Algorithm = String(algorithm)
+	return b
+}
+
+// Slots sets the number of slots of the upstream.
+func (b *UpstreamBuilder) Slots(slots int) *UpstreamBuilder {
+	b.upstream.Slots = Int(slots)
+	return b
+}
+
+// HashOn sets the hashing input type of the upstream.
+func (b *UpstreamBuilder) HashOn(hashOn string) *UpstreamBuilder {
+	b.upstream.HashOn = String(hashOn)
+	return b
+}
+
+// Tags sets the tags of the upstream.
+func (b *UpstreamBuilder) Tags(tags ...string) *UpstreamBuilder {
+	b.upstream.Tags = StringSlice(tags...)
+	return b
+}
+
+// WithTarget adds a target to the upstream.
+func (b *UpstreamBuilder) WithTarget(target *TargetBuilder) *UpstreamBuilder {
+	b.targets = append(b.targets, target)
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *UpstreamBuilder) FillID(workspace string) *UpstreamBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the upstream.
+func (b *UpstreamBuilder) Build() (*Upstream, error) {
+	return b.build(nil)
+}
+
+// BuildContent returns the upstream along with its targets.
+func (b *UpstreamBuilder) BuildContent() (*DeclarativeContent, error) {
+	content := &DeclarativeContent{}
+	if err := b.addTo(content, nil); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (b *UpstreamBuilder) build(workspace *string) (*Upstream, error) {
+	upstream := b.upstream.DeepCopy()
+	if upstream.Name == nil || *upstream.Name == "" {
+		return nil, fmt.Errorf("upstream name is required")
+	}
+	if err := fillBuiltID(upstream, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	return upstream, nil
+}
+
+func (b *UpstreamBuilder) addTo(content *DeclarativeContent, workspace *string) error {
+	upstream, err := b.build(workspace)
+	if err != nil {
+		return err
+	}
+	workspace = inheritedWorkspace(b.workspace, workspace)
+	content.Upstreams = append(content.Upstreams, &DeclarativeUpstream{Upstream: upstream})
+	for _, target := range b.targets {
+		t, err := target.build(workspace, upstream)
+		if err != nil {
+			return err
+		}
+		content.Targets = append(content.Targets, t)
+	}
+	return nil
+}
+
+// TargetBuilder builds a Target.
+type TargetBuilder struct {
+	target    Target
+	workspace *string
+}
+
+// NewTargetBuilder returns a builder of Targets pointing to target, a
+// host[:port] address.
+func NewTargetBuilder(target string) *TargetBuilder {
+	return &TargetBuilder{target: Target{Target: String(target)}}
+}
+
+// ID sets the ID of the target.
+func (b *TargetBuilder) ID(id string) *TargetBuilder {
+	b.target.ID = String(id)
+	return b
+}
+
+// Weight sets the weight of the target.
+func (b *TargetBuilder) Weight(weight int) *TargetBuilder {
+	b.target.Weight = Int(weight)
+	return b
+}
+
+// Tags sets the tags of the target.
+func (b *TargetBuilder) Tags(tags ...string) *TargetBuilder {
+	b.target.Tags = StringSlice(tags...)
+	return b
+}
+
+// Upstream sets the upstream of the target.
+// Targets added to an UpstreamBuilder reference the built upstream instead.
+func (b *TargetBuilder) Upstream(upstream *Upstream) *TargetBuilder {
+	b.target.Upstream = upstream
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *TargetBuilder) FillID(workspace string) *TargetBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the target.
+func (b *TargetBuilder) Build() (*Target, error) {
+	return b.build(nil, nil)
+}
+
+func (b *TargetBuilder) build(workspace *string, upstream *Upstream) (*Target, error) {
+	target := b.target.DeepCopy()
+	if target.Target == nil || *target.Target == "" {
+		return nil, fmt.Errorf("target target is required")
+	}
+	if upstream != nil {
+		target.Upstream = upstream
+	}
+	if err := fillBuiltID(target, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if target.Upstream != nil {
+		target.Upstream = upstreamReference(target.Upstream)
+	}
+	return target, nil
+}
+
+// ConsumerBuilder builds a Consumer, along with its credentials and plugins.
+type ConsumerBuilder struct {
+	consumer    Consumer
+	workspace   *string
+	plugins     []*PluginBuilder
+	credentials []credentialBuilder
+}
+
+// credentialBuilder is implemented by the builders of consumer credentials.
+type credentialBuilder interface {
+	addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error
+}
+
+// NewConsumerBuilder returns a builder of Consumers.
+func NewConsumerBuilder() *ConsumerBuilder {
+	return &ConsumerBuilder{}
+}
+
+// ID sets the ID of the consumer.
+func (b *ConsumerBuilder) ID(id string) *ConsumerBuilder {
+	b.consumer.ID = String(id)
+	return b
+}
+
+// Username sets the username of the consumer.
+func (b *ConsumerBuilder) Username(username string) *ConsumerBuilder {
+	b.consumer.Username = String(username)
+	return b
+}
+
+// CustomID sets the custom ID of the consumer.
+func (b *ConsumerBuilder) CustomID(customID string) *ConsumerBuilder {
+	b.consumer.CustomID = String(customID)
+	return b
+}
+
+// Tags sets the tags of the consumer.
+func (b *ConsumerBuilder) Tags(tags ...string) *ConsumerBuilder {
+	b.consumer.Tags = StringSlice(tags...)
+	return b
+}
+
+// WithPlugin adds a plugin applied to the consumer.
+func (b *ConsumerBuilder) WithPlugin(plugin *PluginBuilder) *ConsumerBuilder {
+	b.plugins = append(b.plugins, plugin)
+	return b
+}
+
+// WithKeyAuth adds a key-auth credential to the consumer.
+func (b *ConsumerBuilder) WithKeyAuth(credential *KeyAuthBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithBasicAuth adds a basic-auth credential to the consumer.
+func (b *ConsumerBuilder) WithBasicAuth(credential *BasicAuthBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithHMACAuth adds an hmac-auth credential to the consumer.
+func (b *ConsumerBuilder) WithHMACAuth(credential *HMACAuthBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithJWTAuth adds a jwt credential to the consumer.
+func (b *ConsumerBuilder) WithJWTAuth(credential *JWTAuthBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithACLGroup adds an ACL group to the consumer.
+func (b *ConsumerBuilder) WithACLGroup(credential *ACLGroupBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithMTLSAuth adds an mtls-auth credential to the consumer.
+func (b *ConsumerBuilder) WithMTLSAuth(credential *MTLSAuthBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// WithOauth2Credential adds an oauth2 credential to the consumer.
+func (b *ConsumerBuilder) WithOauth2Credential(credential *Oauth2CredentialBuilder) *ConsumerBuilder {
+	b.credentials = append(b.credentials, credential)
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *ConsumerBuilder) FillID(workspace string) *ConsumerBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the consumer.
+func (b *ConsumerBuilder) Build() (*Consumer, error) {
+	return b.build(nil)
+}
+
+// BuildContent returns the consumer along with its credentials and plugins.
+func (b *ConsumerBuilder) BuildContent() (*DeclarativeContent, error) {
+	content := &DeclarativeContent{}
+	consumer, err := b.build(nil)
+	if err != nil {
+		return nil, err
+	}
+	workspace := b.workspace
+	content.Consumers = append(content.Consumers, &DeclarativeConsumer{Consumer: consumer})
+	for _, credential := range b.credentials {
+		if err := credential.addTo(content, workspace, consumer); err != nil {
+			return nil, err
+		}
+	}
+	for _, plugin := range b.plugins {
+		if err := plugin.addTo(content, workspace, &Plugin{Consumer: consumer}); err != nil {
+			return nil, err
+		}
+	}
+	return content, nil
+}
+
+func (b *ConsumerBuilder) build(workspace *string) (*Consumer, error) {
+	consumer := b.consumer.DeepCopy()
+	if (consumer.Username == nil || *consumer.Username == "") &&
+		(consumer.CustomID == nil || *consumer.CustomID == "") {
+		return nil, fmt.Errorf("consumer username or custom_id is required")
+	}
+	if err := fillBuiltID(consumer, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	return consumer, nil
+}
+
+// KeyAuthBuilder builds a KeyAuth credential.
+type KeyAuthBuilder struct {
+	credential KeyAuth
+	workspace  *string
+}
+
+// NewKeyAuthBuilder returns a builder of KeyAuth credentials.
+// Kong generates the key of credentials created without one.
+func NewKeyAuthBuilder() *KeyAuthBuilder {
+	return &KeyAuthBuilder{}
+}
+
+// ID sets the ID of the credential.
+func (b *KeyAuthBuilder) ID(id string) *KeyAuthBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// Key sets the key of the credential.
+func (b *KeyAuthBuilder) Key(key string) *KeyAuthBuilder {
+	b.credential.Key = String(key)
+	return b
+}
+
+// TTL sets the time to live of the credential, in seconds.
+func (b *KeyAuthBuilder) TTL(ttl int) *KeyAuthBuilder {
+	b.credential.TTL = Int(ttl)
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *KeyAuthBuilder) Tags(tags ...string) *KeyAuthBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *KeyAuthBuilder) Consumer(consumer *Consumer) *KeyAuthBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *KeyAuthBuilder) FillID(workspace string) *KeyAuthBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *KeyAuthBuilder) Build() (*KeyAuth, error) {
+	return b.build(nil, nil)
+}
+
+func (b *KeyAuthBuilder) build(workspace *string, consumer *Consumer) (*KeyAuth, error) {
+	credential := b.credential.DeepCopy()
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if credential.Key != nil {
+		if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+			return nil, err
+		}
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *KeyAuthBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.KeyAuths = append(content.KeyAuths, credential)
+	return nil
+}
+
+// BasicAuthBuilder builds a BasicAuth credential.
+type BasicAuthBuilder struct {
+	credential BasicAuth
+	workspace  *string
+}
+
+// NewBasicAuthBuilder returns a builder of BasicAuth credentials.
+func NewBasicAuthBuilder(username, password string) *BasicAuthBuilder {
+	return &BasicAuthBuilder{credential: BasicAuth{
+		Username: String(username),
+		Password: String(password),
+	}}
+}
+
+// ID sets the ID of the credential.
+func (b *BasicAuthBuilder) ID(id string) *BasicAuthBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *BasicAuthBuilder) Tags(tags ...string) *BasicAuthBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *BasicAuthBuilder) Consumer(consumer *Consumer) *BasicAuthBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *BasicAuthBuilder) FillID(workspace string) *BasicAuthBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *BasicAuthBuilder) Build() (*BasicAuth, error) {
+	return b.build(nil, nil)
+}
+
+func (b *BasicAuthBuilder) build(workspace *string, consumer *Consumer) (*BasicAuth, error) {
+	credential := b.credential.DeepCopy()
+	if credential.Username == nil || *credential.Username == "" {
+		return nil, fmt.Errorf("basic-auth username is required")
+	}
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *BasicAuthBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.BasicAuths = append(content.BasicAuths, credential)
+	return nil
+}
+
+// HMACAuthBuilder builds an HMACAuth credential.
+type HMACAuthBuilder struct {
+	credential HMACAuth
+	workspace  *string
+}
+
+// NewHMACAuthBuilder returns a builder of HMACAuth credentials.
+// Kong generates the secret of credentials created without one.
+func NewHMACAuthBuilder(username string) *HMACAuthBuilder {
+	return &HMACAuthBuilder{credential: HMACAuth{Username: String(username)}}
+}
+
+// ID sets the ID of the credential.
+func (b *HMACAuthBuilder) ID(id string) *HMACAuthBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// Secret sets the secret of the credential.
+func (b *HMACAuthBuilder) Secret(secret string) *HMACAuthBuilder {
+	b.credential.Secret = String(secret)
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *HMACAuthBuilder) Tags(tags ...string) *HMACAuthBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *HMACAuthBuilder) Consumer(consumer *Consumer) *HMACAuthBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *HMACAuthBuilder) FillID(workspace string) *HMACAuthBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *HMACAuthBuilder) Build() (*HMACAuth, error) {
+	return b.build(nil, nil)
+}
+
+func (b *HMACAuthBuilder) build(workspace *string, consumer *Consumer) (*HMACAuth, error) {
+	credential := b.credential.DeepCopy()
+	if credential.Username == nil || *credential.Username == "" {
+		return nil, fmt.Errorf("hmac-auth username is required")
+	}
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *HMACAuthBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.HMACAuths = append(content.HMACAuths, credential)
+	return nil
+}
+
+// JWTAuthBuilder builds a JWTAuth credential.
+type JWTAuthBuilder struct {
+	credential JWTAuth
+	workspace  *string
+}
+
+// NewJWTAuthBuilder returns a builder of JWTAuth credentials.
+// Kong generates the key and secret of credentials created without them.
+func NewJWTAuthBuilder() *JWTAuthBuilder {
+	return &JWTAuthBuilder{}
+}
+
+// ID sets the ID of the credential.
+func (b *JWTAuthBuilder) ID(id string) *JWTAuthBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// Key sets the key of the credential, matched against the iss claim by default.
+func (b *JWTAuthBuilder) Key(key string) *JWTAuthBuilder {
+	b.credential.Key = String(key)
+	return b
+}
+
+// Secret sets the secret of the credential, for HMAC algorithms.
+func (b *JWTAuthBuilder) Secret(secret string) *JWTAuthBuilder {
+	b.credential.Secret = String(secret)
+	return b
+}
+
+// Algorithm sets the signing algorithm of the credential.
+func (b *JWTAuthBuilder) Algorithm(algorithm string) *JWTAuthBuilder {
+	b.credential.Algorithm = String(algorithm)
+	return b
+}
+
+// RSAPublicKey sets the public key of the credential, for RSA and ECDSA algorithms.
+func (b *JWTAuthBuilder) RSAPublicKey(key string) *JWTAuthBuilder {
+	b.credential.RSAPublicKey = String(key)
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *JWTAuthBuilder) Tags(tags ...string) *JWTAuthBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *JWTAuthBuilder) Consumer(consumer *Consumer) *JWTAuthBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *JWTAuthBuilder) FillID(workspace string) *JWTAuthBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *JWTAuthBuilder) Build() (*JWTAuth, error) {
+	return b.build(nil, nil)
+}
+
+func (b *JWTAuthBuilder) build(workspace *string, consumer *Consumer) (*JWTAuth, error) {
+	credential := b.credential.DeepCopy()
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if credential.Key != nil {
+		if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+			return nil, err
+		}
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *JWTAuthBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.JWTAuths = append(content.JWTAuths, credential)
+	return nil
+}
+
+// ACLGroupBuilder builds an ACLGroup.
+type ACLGroupBuilder struct {
+	credential ACLGroup
+	workspace  *string
+}
+
+// NewACLGroupBuilder returns a builder of ACLGroups for group.
+func NewACLGroupBuilder(group string) *ACLGroupBuilder {
+	return &ACLGroupBuilder{credential: ACLGroup{Group: String(group)}}
+}
+
+// ID sets the ID of the ACL group.
+func (b *ACLGroupBuilder) ID(id string) *ACLGroupBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// Tags sets the tags of the ACL group.
+func (b *ACLGroupBuilder) Tags(tags ...string) *ACLGroupBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the ACL group.
+func (b *ACLGroupBuilder) Consumer(consumer *Consumer) *ACLGroupBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+// ACL groups are identified along with their consumer, which is required.
+func (b *ACLGroupBuilder) FillID(workspace string) *ACLGroupBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the ACL group.
+func (b *ACLGroupBuilder) Build() (*ACLGroup, error) {
+	return b.build(nil, nil)
+}
+
+func (b *ACLGroupBuilder) build(workspace *string, consumer *Consumer) (*ACLGroup, error) {
+	credential := b.credential.DeepCopy()
+	if credential.Group == nil || *credential.Group == "" {
+		return nil, fmt.Errorf("acl group is required")
+	}
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *ACLGroupBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.ACLGroups = append(content.ACLGroups, credential)
+	return nil
+}
+
+// MTLSAuthBuilder builds an MTLSAuth credential.
+type MTLSAuthBuilder struct {
+	credential MTLSAuth
+	workspace  *string
+}
+
+// NewMTLSAuthBuilder returns a builder of MTLSAuth credentials matching
+// client certificates with subjectName.
+func NewMTLSAuthBuilder(subjectName string) *MTLSAuthBuilder {
+	return &MTLSAuthBuilder{credential: MTLSAuth{SubjectName: String(subjectName)}}
+}
+
+// ID sets the ID of the credential.
+func (b *MTLSAuthBuilder) ID(id string) *MTLSAuthBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// CACertificate sets the CA certificate the client certificates must be issued by.
+func (b *MTLSAuthBuilder) CACertificate(caCertificate *CACertificate) *MTLSAuthBuilder {
+	b.credential.CACertificate = caCertificate
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *MTLSAuthBuilder) Tags(tags ...string) *MTLSAuthBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *MTLSAuthBuilder) Consumer(consumer *Consumer) *MTLSAuthBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+// MTLS credentials are identified along with their consumer, which is required.
+func (b *MTLSAuthBuilder) FillID(workspace string) *MTLSAuthBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *MTLSAuthBuilder) Build() (*MTLSAuth, error) {
+	return b.build(nil, nil)
+}
+
+func (b *MTLSAuthBuilder) build(workspace *string, consumer *Consumer) (*MTLSAuth, error) {
+	credential := b.credential.DeepCopy()
+	if credential.SubjectName == nil || *credential.SubjectName == "" {
+		return nil, fmt.Errorf("mtls-auth subject name is required")
+	}
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+		return nil, err
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	if credential.CACertificate != nil && credential.CACertificate.ID != nil {
+		credential.CACertificate = &CACertificate{ID: credential.CACertificate.ID}
+	}
+	return credential, nil
+}
+
+func (b *MTLSAuthBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.MTLSAuths = append(content.MTLSAuths, credential)
+	return nil
+}
+
+// Oauth2CredentialBuilder builds an Oauth2Credential.
+type Oauth2CredentialBuilder struct {
+	credential Oauth2Credential
+	workspace  *string
+}
+
+// NewOauth2CredentialBuilder returns a builder of Oauth2Credentials for the
+// application named name.
+// Kong generates the client ID and secret of credentials created without them.
+func NewOauth2CredentialBuilder(name string) *Oauth2CredentialBuilder {
+	return &Oauth2CredentialBuilder{credential: Oauth2Credential{Name: String(name)}}
+}
+
+// ID sets the ID of the credential.
+func (b *Oauth2CredentialBuilder) ID(id string) *Oauth2CredentialBuilder {
+	b.credential.ID = String(id)
+	return b
+}
+
+// ClientID sets the client ID of the credential.
+func (b *Oauth2CredentialBuilder) ClientID(clientID string) *Oauth2CredentialBuilder {
+	b.credential.ClientID = String(clientID)
+	return b
+}
+
+// ClientSecret sets the client secret of the credential.
+func (b *Oauth2CredentialBuilder) ClientSecret(clientSecret string) *Oauth2CredentialBuilder {
+	b.credential.ClientSecret = String(clientSecret)
+	return b
+}
+
+// RedirectURIs sets the redirect URIs of the credential.
+func (b *Oauth2CredentialBuilder) RedirectURIs(uris ...string) *Oauth2CredentialBuilder {
+	b.credential.RedirectURIs = StringSlice(uris...)
+	return b
+}
+
+// Tags sets the tags of the credential.
+func (b *Oauth2CredentialBuilder) Tags(tags ...string) *Oauth2CredentialBuilder {
+	b.credential.Tags = StringSlice(tags...)
+	return b
+}
+
+// Consumer sets the consumer of the credential.
+func (b *Oauth2CredentialBuilder) Consumer(consumer *Consumer) *Oauth2CredentialBuilder {
+	b.credential.Consumer = consumer
+	return b
+}
+
+// FillID makes the builder generate deterministic IDs in workspace.
+func (b *Oauth2CredentialBuilder) FillID(workspace string) *Oauth2CredentialBuilder {
+	b.workspace = String(workspace)
+	return b
+}
+
+// Build returns the credential.
+func (b *Oauth2CredentialBuilder) Build() (*Oauth2Credential, error) {
+	return b.build(nil, nil)
+}
+
+func (b *Oauth2CredentialBuilder) build(workspace *string, consumer *Consumer) (*Oauth2Credential, error) {
+	credential := b.credential.DeepCopy()
+	if credential.Name == nil || *credential.Name == "" {
+		return nil, fmt.Errorf("oauth2 name is required")
+	}
+	if consumer != nil {
+		credential.Consumer = consumer
+	}
+	if credential.ClientID != nil {
+		if err := fillBuiltID(credential, b.workspace, workspace); err != nil {
+			return nil, err
+		}
+	}
+	if credential.Consumer != nil {
+		credential.Consumer = consumerReference(credential.Consumer)
+	}
+	return credential, nil
+}
+
+func (b *Oauth2CredentialBuilder) addTo(content *DeclarativeContent, workspace *string, consumer *Consumer) error {
+	credential, err := b.build(workspace, consumer)
+	if err != nil {
+		return err
+	}
+	content.Oauth2Credentials = append(content.Oauth2Credentials, credential)
+	return nil
+}
+
+// inheritedWorkspace returns the workspace in which a builder generates
+// IDs: its own if set, else the one of the builder it was added to.
+func inheritedWorkspace(own, parent *string) *string {
+	if own != nil {
+		return own
+	}
+	return parent
+}
+
+// fillBuiltID fills the ID of entity if a workspace is set.
+func fillBuiltID(entity IDFillable, own, parent *string) error {
+	workspace := inheritedWorkspace(own, parent)
+	if workspace == nil {
+		return nil
+	}
+	return entity.FillID(*workspace)
+}
+
+func serviceReference(s *Service) *Service {
+	if s.ID != nil {
+		return &Service{ID: s.ID}
+	}
+	return &Service{Name: s.Name}
+}
+
+func routeReference(r *Route) *Route {
+	if r.ID != nil {
+		return &Route{ID: r.ID}
+	}
+	return &Route{Name: r.Name}
+}
+
+func consumerReference(c *Consumer) *Consumer {
+	if c.ID != nil {
+		return &Consumer{ID: c.ID}
+	}
+	return &Consumer{Username: c.Username}
+}
+
+func consumerGroupReference(cg *ConsumerGroup) *ConsumerGroup {
+	if cg.ID != nil {
+		return &ConsumerGroup{ID: cg.ID}
+	}
+	return &ConsumerGroup{Name: cg.Name}
+}
+
+func upstreamReference(u *Upstream) *Upstream {
+	if u.ID != nil {
+		return &Upstream{ID: u.ID}
+	}
+	return &Upstream{Name: u.Name}
+}
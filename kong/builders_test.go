@@ -0,0 +1,238 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceBuilder(t *testing.T) {
+	service, err := NewServiceBuilder().
+		Name("svc").
+		URL("https://example.com:8443/api").
+		Retries(3).
+		Tags("a").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, &Service{
+		Name:     String("svc"),
+		Protocol: String("https"),
+		Host:     String("example.com"),
+		Port:     Int(8443),
+		Path:     String("/api"),
+		Retries:  Int(3),
+		Tags:     StringSlice("a"),
+	}, service)
+
+	service, err = NewServiceBuilder().URL("grpcs://example.com").Build()
+	require.NoError(t, err)
+	assert.Equal(t, 443, *service.Port)
+	assert.Nil(t, service.Path)
+
+	_, err = NewServiceBuilder().Name("svc").URL("example.com").Build()
+	assert.ErrorContains(t, err, "invalid service URL")
+	_, err = NewServiceBuilder().Name("svc").Build()
+	assert.ErrorContains(t, err, "service host is required")
+}
+
+func TestServiceBuilderContent(t *testing.T) {
+	content, err := NewServiceBuilder().
+		Name("svc").
+		URL("http://example.com").
+		WithRoute(NewRouteBuilder().Name("route").Paths("/").
+			WithPlugin(NewPluginBuilder("key-auth"))).
+		WithPlugin(NewPluginBuilder("rate-limiting").ConfigValue("minute", 10)).
+		FillID("default").
+		BuildContent()
+	require.NoError(t, err)
+	require.Len(t, content.Services, 1)
+	require.Len(t, content.Routes, 1)
+	require.Len(t, content.Plugins, 2)
+
+	service := &Service{Name: String("svc")}
+	require.NoError(t, service.FillID("default"))
+	route := &Route{Name: String("route")}
+	require.NoError(t, route.FillID("default"))
+	assert.Equal(t, service.ID, content.Services[0].ID)
+	assert.Equal(t, route.ID, content.Routes[0].ID)
+	assert.Equal(t, &Service{ID: service.ID}, content.Routes[0].Service)
+
+	routePlugin := &Plugin{Name: String("key-auth"), Route: route}
+	require.NoError(t, routePlugin.FillID("default"))
+	assert.Equal(t, routePlugin.ID, content.Plugins[0].ID)
+	assert.Equal(t, &Route{ID: route.ID}, content.Plugins[0].Route)
+	assert.Nil(t, content.Plugins[0].Service)
+
+	servicePlugin := &Plugin{Name: String("rate-limiting"), Service: service}
+	require.NoError(t, servicePlugin.FillID("default"))
+	assert.Equal(t, servicePlugin.ID, content.Plugins[1].ID)
+	assert.Equal(t, &Service{ID: service.ID}, content.Plugins[1].Service)
+	assert.Equal(t, Configuration{"minute": float64(10)}, content.Plugins[1].Config)
+}
+
+func TestBuildersWithoutFillID(t *testing.T) {
+	content, err := NewServiceBuilder().
+		Name("svc").
+		Host("example.com").
+		WithRoute(NewRouteBuilder().Name("route").Hosts("example.org")).
+		BuildContent()
+	require.NoError(t, err)
+	assert.Nil(t, content.Services[0].ID)
+	assert.Nil(t, content.Routes[0].ID)
+	assert.Equal(t, &Service{Name: String("svc")}, content.Routes[0].Service)
+
+	// Builders added to others can generate IDs on their own.
+	content, err = NewServiceBuilder().
+		Name("svc").
+		Host("example.com").
+		WithRoute(NewRouteBuilder().Name("route").Paths("/").FillID("ws")).
+		BuildContent()
+	require.NoError(t, err)
+	assert.Nil(t, content.Services[0].ID)
+	assert.NotNil(t, content.Routes[0].ID)
+}
+
+func TestRouteBuilder(t *testing.T) {
+	_, err := NewRouteBuilder().Name("route").Build()
+	assert.ErrorContains(t, err, "route must match")
+
+	route, err := NewRouteBuilder().
+		Header("x-version", "1", "2").
+		Service(&Service{ID: String("id"), Name: String("svc")}).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"x-version": {"1", "2"}}, route.Headers)
+	assert.Equal(t, &Service{ID: String("id")}, route.Service)
+}
+
+func TestPluginBuilderCondition(t *testing.T) {
+	plugin, err := NewPluginBuilder("key-auth").
+		Condition(ExprField("route.name").NotEquals("health")).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, `route.name != "health"`, *plugin.Condition)
+
+	_, err = NewPluginBuilder("key-auth").Condition(ExprField("http.paths").Equals("/")).Build()
+	assert.EqualError(t, err, `invalid condition: unknown field "http.paths"`)
+}
+
+func TestBuildersDoNotShareState(t *testing.T) {
+	builder := NewPluginBuilder("cors").ConfigValue("origins", []string{"*"})
+	first, err := builder.Build()
+	require.NoError(t, err)
+	first.Config["origins"] = []string{"example.com"}
+
+	second, err := builder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"*"}, second.Config["origins"])
+}
+
+func TestUpstreamBuilderContent(t *testing.T) {
+	content, err := NewUpstreamBuilder().
+		Name("upstream").
+		Algorithm("round-robin").
+		WithTarget(NewTargetBuilder("10.0.0.1:80").Weight(50)).
+		WithTarget(NewTargetBuilder("10.0.0.2:80")).
+		FillID("default").
+		BuildContent()
+	require.NoError(t, err)
+	require.Len(t, content.Upstreams, 1)
+	require.Len(t, content.Targets, 2)
+
+	upstreamID := content.Upstreams[0].ID
+	require.NotNil(t, upstreamID)
+	target := &Target{Target: String("10.0.0.1:80"), Upstream: &Upstream{Name: String("upstream")}}
+	require.NoError(t, target.FillID("default"))
+	assert.Equal(t, target.ID, content.Targets[0].ID)
+	assert.Equal(t, &Upstream{ID: upstreamID}, content.Targets[0].Upstream)
+	assert.Equal(t, 50, *content.Targets[0].Weight)
+
+	_, err = NewUpstreamBuilder().Build()
+	assert.ErrorContains(t, err, "upstream name is required")
+	_, err = NewTargetBuilder("10.0.0.1:80").FillID("default").Build()
+	assert.ErrorContains(t, err, "target upstream is required")
+}
+
+func TestConsumerBuilderContent(t *testing.T) {
+	content, err := NewConsumerBuilder().
+		Username("alice").
+		WithKeyAuth(NewKeyAuthBuilder().Key("key")).
+		WithKeyAuth(NewKeyAuthBuilder()).
+		WithBasicAuth(NewBasicAuthBuilder("alice", "password")).
+		WithHMACAuth(NewHMACAuthBuilder("alice").Secret("secret")).
+		WithJWTAuth(NewJWTAuthBuilder().Key("issuer").Algorithm("HS256").Secret("secret")).
+		WithACLGroup(NewACLGroupBuilder("admins")).
+		WithMTLSAuth(NewMTLSAuthBuilder("alice.example.com")).
+		WithOauth2Credential(NewOauth2CredentialBuilder("app").ClientID("client").
+			RedirectURIs("https://example.com/callback")).
+		WithPlugin(NewPluginBuilder("rate-limiting").ConfigValue("second", 1)).
+		FillID("default").
+		BuildContent()
+	require.NoError(t, err)
+
+	consumer := &Consumer{Username: String("alice")}
+	require.NoError(t, consumer.FillID("default"))
+	ref := &Consumer{ID: consumer.ID}
+	require.Len(t, content.Consumers, 1)
+	assert.Equal(t, consumer.ID, content.Consumers[0].ID)
+
+	require.Len(t, content.KeyAuths, 2)
+	assert.NotNil(t, content.KeyAuths[0].ID)
+	// Kong generates the key, and so the ID, of the second credential.
+	assert.Nil(t, content.KeyAuths[1].ID)
+	for _, k := range content.KeyAuths {
+		assert.Equal(t, ref, k.Consumer)
+	}
+	require.Len(t, content.BasicAuths, 1)
+	assert.Equal(t, ref, content.BasicAuths[0].Consumer)
+	require.Len(t, content.HMACAuths, 1)
+	assert.Equal(t, ref, content.HMACAuths[0].Consumer)
+	require.Len(t, content.JWTAuths, 1)
+	assert.Equal(t, ref, content.JWTAuths[0].Consumer)
+
+	require.Len(t, content.ACLGroups, 1)
+	acl := &ACLGroup{Group: String("admins"), Consumer: consumer}
+	require.NoError(t, acl.FillID("default"))
+	assert.Equal(t, acl.ID, content.ACLGroups[0].ID)
+	assert.Equal(t, ref, content.ACLGroups[0].Consumer)
+
+	require.Len(t, content.MTLSAuths, 1)
+	assert.NotNil(t, content.MTLSAuths[0].ID)
+	require.Len(t, content.Oauth2Credentials, 1)
+	assert.NotNil(t, content.Oauth2Credentials[0].ID)
+
+	require.Len(t, content.Plugins, 1)
+	plugin := &Plugin{Name: String("rate-limiting"), Consumer: consumer}
+	require.NoError(t, plugin.FillID("default"))
+	assert.Equal(t, plugin.ID, content.Plugins[0].ID)
+	assert.Equal(t, ref, content.Plugins[0].Consumer)
+
+	_, err = NewConsumerBuilder().Build()
+	assert.ErrorContains(t, err, "consumer username or custom_id is required")
+	consumerByCustomID, err := NewConsumerBuilder().CustomID("42").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "42", *consumerByCustomID.CustomID)
+}
+
+func TestRouteBuilderMatchExpression(t *testing.T) {
+	route, err := NewRouteBuilder().
+		Name("api").
+		MatchExpression(ExprAnd(
+			ExprField("http.path").HasPrefix("/api"),
+			ExprField("http.host").Equals("example.com"),
+		)).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, `http.path ^= "/api" && http.host == "example.com"`, *route.Expression)
+
+	_, err = NewRouteBuilder().MatchExpression(ExprField("http.paths").Equals("/")).Build()
+	assert.EqualError(t, err, `invalid route expression: unknown field "http.paths"`)
+
+	route, err = NewRouteBuilder().
+		MatchExpression(ExprField("http.paths").Equals("/")).
+		Expression(`http.path == "/"`).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, `http.path == "/"`, *route.Expression)
+}
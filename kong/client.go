@@ -1,6 +1,7 @@
 package kong
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -495,16 +497,135 @@ func (c *Client) ReloadDeclarativeRawConfig(
 	checkHash bool,
 	flattenErrors bool,
 ) error {
+	_, _, err := c.postDeclarativeConfig(ctx, config, DeclarativeConfigOpts{
+		CheckHash:     checkHash,
+		FlattenErrors: flattenErrors,
+	})
+	return err
+}
+
+// ReloadDeclarativeConfig marshals the specified declarative configuration
+// and sends it out to the configured Admin API endpoint.
+// FormatVersion defaults to DefaultDeclarativeFormatVersion when not set.
+// On success, it returns the entities loaded by Kong along with the hash of
+// the new configuration.
+// It returns APIError with a response body in case it receives a valid HTTP response with <200 or >=400 status codes.
+func (c *Client) ReloadDeclarativeConfig(
+	ctx context.Context,
+	content *DeclarativeContent,
+	opts DeclarativeConfigOpts,
+) (*DeclarativeConfigResult, error) {
+	if content == nil {
+		return nil, fmt.Errorf("declarative content cannot be nil")
+	}
+	if content.FormatVersion == "" {
+		content = content.DeepCopy()
+		content.FormatVersion = DefaultDeclarativeFormatVersion
+	}
+	b, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("marshal declarative content: %w", err)
+	}
+
+	statusCode, body, err := c.postDeclarativeConfig(ctx, bytes.NewReader(b), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeclarativeConfigResult{
+		NotModified:  statusCode == http.StatusNotModified,
+		EntityCounts: map[string]int{},
+		Entities:     map[string][]Configuration{},
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := parseDeclarativeConfigResponse(body, result); err != nil {
+			return nil, err
+		}
+	}
+	if result.ConfigurationHash == "" {
+		// Another reload may happen before the status is fetched, see
+		// DeclarativeConfigResult.ConfigurationHash.
+		status, err := c.Status(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetching configuration hash: %w", err)
+		}
+		if status.ConfigurationHash != emptyConfigurationHash {
+			result.ConfigurationHash = status.ConfigurationHash
+		}
+	}
+	return result, nil
+}
+
+// emptyConfigurationHash is reported by Kong when no configuration is loaded.
+const emptyConfigurationHash = "00000000000000000000000000000000"
+
+// parseDeclarativeConfigResponse reads the entities returned by POST /config.
+// Depending on the version, Kong returns every entity type either as a list
+// or as a map keyed by primary key.
+func parseDeclarativeConfigResponse(body []byte, result *DeclarativeConfigResult) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("decoding /config response body: %w", err)
+	}
+	for entityType, value := range raw {
+		value = bytes.TrimSpace(value)
+		if len(value) == 0 {
+			continue
+		}
+		switch value[0] {
+		case '[':
+			var entities []Configuration
+			if err := json.Unmarshal(value, &entities); err != nil {
+				return fmt.Errorf("decoding %s from /config response body: %w", entityType, err)
+			}
+			result.Entities[entityType] = entities
+		case '{':
+			var byKey map[string]Configuration
+			if err := json.Unmarshal(value, &byKey); err != nil {
+				return fmt.Errorf("decoding %s from /config response body: %w", entityType, err)
+			}
+			keys := make([]string, 0, len(byKey))
+			for k := range byKey {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			entities := make([]Configuration, 0, len(byKey))
+			for _, k := range keys {
+				entities = append(entities, byKey[k])
+			}
+			result.Entities[entityType] = entities
+		case '"':
+			if entityType == "configuration_hash" {
+				if err := json.Unmarshal(value, &result.ConfigurationHash); err != nil {
+					return fmt.Errorf("decoding configuration hash: %w", err)
+				}
+			}
+			continue
+		default:
+			continue
+		}
+		result.EntityCounts[entityType] = len(result.Entities[entityType])
+	}
+	return nil
+}
+
+// postDeclarativeConfig sends config to the /config endpoint and returns the
+// response status code and body.
+func (c *Client) postDeclarativeConfig(
+	ctx context.Context,
+	config io.Reader,
+	opts DeclarativeConfigOpts,
+) (int, []byte, error) {
 	type sendConfigParams struct {
 		CheckHash     int `url:"check_hash,omitempty"`
 		FlattenErrors int `url:"flatten_errors,omitempty"`
 	}
 	var checkHashI int
-	if checkHash {
+	if opts.CheckHash {
 		checkHashI = 1
 	}
 	var flattenErrorsI int
-	if flattenErrors {
+	if opts.FlattenErrors {
 		flattenErrorsI = 1
 	}
 	req, err := c.NewRequest(
@@ -514,22 +635,21 @@ func (c *Client) ReloadDeclarativeRawConfig(
 		config,
 	)
 	if err != nil {
-		return fmt.Errorf("creating new HTTP request for /config: %w", err)
+		return 0, nil, fmt.Errorf("creating new HTTP request for /config: %w", err)
 	}
-
 	resp, err := c.DoRAW(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed posting new config to /config: %w", err)
+		return 0, nil, fmt.Errorf("failed posting new config to /config: %w", err)
 	}
 	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("could not read /config %d status response body: %w", resp.StatusCode, err)
+		return 0, nil, fmt.Errorf("could not read /config %d status response body: %w", resp.StatusCode, err)
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		return NewAPIErrorWithRaw(resp.StatusCode, "failed posting new config to /config", b)
+		return 0, nil, NewAPIErrorWithRaw(resp.StatusCode, "failed posting new config to /config", b)
 	}
 
-	return nil
+	return resp.StatusCode, b, nil
 }
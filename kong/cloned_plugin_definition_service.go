@@ -3,7 +3,10 @@ package kong
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // AbstractClonedPluginService handles ClonedPluginDefintions in Kong.
@@ -20,6 +23,10 @@ type AbstractClonedPluginService interface {
 	List(ctx context.Context, opt *ListOpt) ([]*ClonedPluginDefinition, *ListOpt, error)
 	// ListAll fetches all ClonedPluginDefintions in Kong.
 	ListAll(ctx context.Context) ([]*ClonedPluginDefinition, error)
+	// Validate checks a ClonedPluginDefintion against the plugins installed in Kong.
+	Validate(ctx context.Context, plugin *ClonedPluginDefinition) error
+	// GetFullSchema retrieves the full schema of a ClonedPluginDefintion.
+	GetFullSchema(ctx context.Context, nameOrID *string) (Schema, error)
 }
 
 // ClonedPluginService handles ClonedPluginDefintions in Kong.
@@ -162,3 +169,94 @@ func (s *ClonedPluginService) ListAll(ctx context.Context) ([]*ClonedPluginDefin
 	}
 	return plugins, nil
 }
+
+// Validate checks that plugin can be created or updated in Kong: its Ref
+// must be a plugin installed in Kong, as reported by the
+// plugins.available_on_server of Client.Root, and not another cloned plugin,
+// its name must not be used by another plugin, and its Priority, when set,
+// must not be used by another plugin or cloned plugin, so that the execution
+// order stays deterministic.
+func (s *ClonedPluginService) Validate(ctx context.Context,
+	plugin *ClonedPluginDefinition,
+) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	if isEmptyString(plugin.Name) {
+		return fmt.Errorf("cloned plugin name cannot be nil")
+	}
+	if isEmptyString(plugin.Ref) {
+		return fmt.Errorf("cloned plugin ref cannot be nil")
+	}
+
+	available, err := availablePlugins(ctx, s.client)
+	if err != nil {
+		return fmt.Errorf("fetching available plugins: %w", err)
+	}
+	clones, err := s.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("listing cloned plugins: %w", err)
+	}
+	// isSelf returns true if clone is the definition being updated.
+	isSelf := func(clone *ClonedPluginDefinition) bool {
+		return plugin.ID != nil && clone.ID != nil && *plugin.ID == *clone.ID
+	}
+	// Kong reports clones as available plugins, the names of clones are
+	// checked against the other plugins only.
+	cloneNames := map[string]bool{}
+	var errs []error
+	for _, clone := range clones {
+		if clone.Name == nil {
+			continue
+		}
+		cloneNames[*clone.Name] = true
+		if *clone.Name == *plugin.Name && !isSelf(clone) {
+			errs = append(errs, fmt.Errorf("cloned plugin %q already exists", *plugin.Name))
+		}
+	}
+
+	if _, ok := available[*plugin.Ref]; !ok {
+		errs = append(errs, fmt.Errorf("referenced plugin %q is not installed", *plugin.Ref))
+	} else if cloneNames[*plugin.Ref] {
+		errs = append(errs, fmt.Errorf("referenced plugin %q is a cloned plugin", *plugin.Ref))
+	}
+	if _, ok := available[*plugin.Name]; ok && !cloneNames[*plugin.Name] {
+		errs = append(errs, fmt.Errorf("plugin %q already exists", *plugin.Name))
+	}
+
+	if plugin.Priority != nil {
+		var users []string
+		for name, priority := range available {
+			if priority != nil && *priority == *plugin.Priority && !cloneNames[name] {
+				users = append(users, fmt.Sprintf("plugin %q", name))
+			}
+		}
+		for _, clone := range clones {
+			if isSelf(clone) || clone.Priority == nil || *clone.Priority != *plugin.Priority {
+				continue
+			}
+			users = append(users, fmt.Sprintf("cloned plugin %q", clone.FriendlyName()))
+		}
+		if len(users) > 0 {
+			sort.Strings(users)
+			errs = append(errs, fmt.Errorf("priority %d is already used by %s",
+				*plugin.Priority, strings.Join(users, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetFullSchema retrieves the full schema of a cloned plugin, which is the
+// schema of the plugin it references.
+func (s *ClonedPluginService) GetFullSchema(ctx context.Context,
+	nameOrID *string,
+) (Schema, error) {
+	clone, err := s.Get(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	if isEmptyString(clone.Ref) {
+		return nil, fmt.Errorf("cloned plugin %q has no ref", clone.FriendlyName())
+	}
+	return s.client.Plugins.GetFullSchema(ctx, clone.Ref)
+}
@@ -1,6 +1,8 @@
 package kong
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
@@ -133,3 +135,120 @@ func TestClonedPluginServiceListAll(T *testing.T) {
 	assert.NotNil(allPlugins)
 	assert.GreaterOrEqual(len(allPlugins), 3)
 }
+
+func newClonedPluginServer(t *testing.T) *Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version": "3.15.0.0-enterprise-edition", "plugins": {"available_on_server": {
+			"file-log": {"version": "3.15.0", "priority": 9},
+			"http-log": {"version": "3.15.0", "priority": 12},
+			"audit-log": {"version": "3.15.0", "priority": 100}
+		}}}`))
+	})
+	mux.HandleFunc("/cloned-plugins", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "clone-id", "name": "audit-log", "ref": "file-log", "priority": 100}
+		], "next": null}`))
+	})
+	mux.HandleFunc("/cloned-plugins/audit-log", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "clone-id", "name": "audit-log", "ref": "file-log", "priority": 100}`))
+	})
+	mux.HandleFunc("/schemas/plugins/file-log", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"fields": [{"config": {"type": "record", "fields": []}}]}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClonedPluginServiceValidate(t *testing.T) {
+	client := newClonedPluginServer(t)
+
+	tests := []struct {
+		name    string
+		plugin  *ClonedPluginDefinition
+		wantErr string
+	}{
+		{
+			name:   "valid clone",
+			plugin: &ClonedPluginDefinition{Name: String("other-log"), Ref: String("file-log"), Priority: Int(10)},
+		},
+		{
+			name:   "priority inherited from the referenced plugin",
+			plugin: &ClonedPluginDefinition{Name: String("other-log"), Ref: String("file-log")},
+		},
+		{
+			name: "update of an existing clone",
+			plugin: &ClonedPluginDefinition{
+				ID:       String("clone-id"),
+				Name:     String("audit-log"),
+				Ref:      String("file-log"),
+				Priority: Int(100),
+			},
+		},
+		{
+			name:    "unknown reference",
+			plugin:  &ClonedPluginDefinition{Name: String("other-log"), Ref: String("unknown")},
+			wantErr: `referenced plugin "unknown" is not installed`,
+		},
+		{
+			name:    "reference to an existing clone",
+			plugin:  &ClonedPluginDefinition{Name: String("other-log"), Ref: String("audit-log")},
+			wantErr: `referenced plugin "audit-log" is a cloned plugin`,
+		},
+		{
+			name:    "name of an installed plugin",
+			plugin:  &ClonedPluginDefinition{Name: String("http-log"), Ref: String("file-log")},
+			wantErr: `plugin "http-log" already exists`,
+		},
+		{
+			name:    "name of an existing clone",
+			plugin:  &ClonedPluginDefinition{Name: String("audit-log"), Ref: String("file-log")},
+			wantErr: `cloned plugin "audit-log" already exists`,
+		},
+		{
+			name:    "priority of an installed plugin",
+			plugin:  &ClonedPluginDefinition{Name: String("other-log"), Ref: String("file-log"), Priority: Int(12)},
+			wantErr: `priority 12 is already used by plugin "http-log"`,
+		},
+		{
+			name:    "priority of an existing clone",
+			plugin:  &ClonedPluginDefinition{Name: String("other-log"), Ref: String("file-log"), Priority: Int(100)},
+			wantErr: `priority 100 is already used by cloned plugin "audit-log"`,
+		},
+		{
+			name:    "missing ref",
+			plugin:  &ClonedPluginDefinition{Name: String("other-log")},
+			wantErr: "cloned plugin ref cannot be nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.ClonedPlugins.Validate(defaultCtx, tt.plugin)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClonedPluginServiceGetFullSchema(t *testing.T) {
+	client := newClonedPluginServer(t)
+
+	schema, err := client.ClonedPlugins.GetFullSchema(defaultCtx, String("audit-log"))
+	require.NoError(t, err)
+	assert.Contains(t, schema, "fields")
+
+	_, err = client.ClonedPlugins.GetFullSchema(defaultCtx, String("unknown"))
+	assert.True(t, IsNotFoundErr(err))
+}
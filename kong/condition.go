@@ -0,0 +1,41 @@
+package kong
+
+import "fmt"
+
+// conditionFields holds the fields available to the conditions of plugins.
+var conditionFields = exprFields{
+	"http.method":       exprFieldString,
+	"http.host":         exprFieldString,
+	"http.path":         exprFieldString,
+	"http.headers.*":    exprFieldString,
+	"http.queries.*":    exprFieldString,
+	"net.protocol":      exprFieldString,
+	"net.src.ip":        exprFieldIPAddr,
+	"net.src.port":      exprFieldInt,
+	"net.dst.ip":        exprFieldIPAddr,
+	"net.dst.port":      exprFieldInt,
+	"tls.sni":           exprFieldString,
+	"route.id":          exprFieldString,
+	"route.name":        exprFieldString,
+	"service.id":        exprFieldString,
+	"service.name":      exprFieldString,
+	"consumer.id":       exprFieldString,
+	"consumer.username": exprFieldString,
+}
+
+// ParseCondition parses the condition of a plugin, e.g.
+// `route.name != "health" && http.path ^= "/api"`, and checks that it only
+// uses the fields, operators and values Kong supports.
+// Errors are *ExpressionError, giving the position of the error.
+func ParseCondition(condition string) (Expr, error) {
+	return parseExpr(condition, conditionFields)
+}
+
+// FormatCondition validates condition and returns it in Kong's syntax, as
+// expected by Plugin.Condition.
+func FormatCondition(condition Expr) (string, error) {
+	if err := validateExpr(condition, conditionFields); err != nil {
+		return "", fmt.Errorf("invalid condition: %w", err)
+	}
+	return condition.String(), nil
+}
@@ -0,0 +1,122 @@
+package kong
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCondition(t *testing.T) {
+	e, err := ParseCondition(`route.name != "health" && (http.path ^= "/api" || ` +
+		`lower(http.headers.x_debug) == "on") && net.src.ip in 10.0.0.0/8`)
+	require.NoError(t, err)
+	assert.Equal(t, &ExprLogical{Op: ExprAndOperator, Operands: []Expr{
+		ExprField("route.name").NotEquals("health"),
+		&ExprLogical{Op: ExprOrOperator, Operands: []Expr{
+			ExprField("http.path").HasPrefix("/api"),
+			ExprField("http.headers.x_debug").Lower().Equals("on"),
+		}},
+		ExprField("net.src.ip").In(netip.MustParsePrefix("10.0.0.0/8")),
+	}}, e)
+
+	tests := []struct {
+		condition string
+		formatted string
+	}{
+		{
+			condition: `http.path~r#"^/users/\d+$"#||net.dst.port>=8000`,
+			formatted: `http.path ~ r#"^/users/\d+$"# || net.dst.port >= 8000`,
+		},
+		{
+			condition: `!(http.method == "GET") && net.src.ip not in ::1/128 && net.dst.ip == 127.0.0.1`,
+			formatted: `!(http.method == "GET") && net.src.ip not in ::1/128 && net.dst.ip == 127.0.0.1`,
+		},
+		{
+			condition: `(http.host contains "a" && tls.sni =^ "\"b\\") || (http.path == "/" || http.path == "/x")`,
+			formatted: `http.host contains "a" && tls.sni =^ "\"b\\" || (http.path == "/" || http.path == "/x")`,
+		},
+		{
+			condition: `((http.queries.q == "1"))`,
+			formatted: `http.queries.q == "1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			e, err := ParseCondition(tt.condition)
+			require.NoError(t, err)
+			assert.Equal(t, tt.formatted, e.String())
+
+			reparsed, err := ParseCondition(e.String())
+			require.NoError(t, err)
+			assert.Equal(t, e, reparsed)
+		})
+	}
+}
+
+func TestParseConditionErrors(t *testing.T) {
+	tests := []struct {
+		condition string
+		wantErr   string
+		wantPos   int
+	}{
+		{"", "empty expression", 0},
+		{`http.path == "/" &&`, "expected a field, got end of expression", 19},
+		{`http.path = "/"`, `unexpected character '='`, 10},
+		{`http.path == "/`, "unterminated string", 13},
+		{`http.path == "\d"`, `invalid escape sequence \d`, 14},
+		{`http.path == r#"/`, "unterminated raw string", 13},
+		{`http.path "/"`, "expected an operator, got string", 10},
+		{`http.path == "/" http.host == "a"`, `unexpected "http.host"`, 17},
+		{`(http.path == "/"`, `expected ")", got end of expression`, 17},
+		{`!http.path == "/"`, `expected "(" after "!", got "http.path"`, 1},
+		{`http.path not == "/"`, `expected "in" after "not", got "=="`, 14},
+		{`http.path == foo`, `invalid value "foo"`, 13},
+		{`http.unknown == "a"`, `unknown field "http.unknown"`, 0},
+		{`http.headers.X_Foo == "a"`, `invalid field "http.headers.X_Foo": fields are lowercase and separated by dots`, 0},
+		{`lower(net.src.port) == 1`, `lower() cannot be applied to field "net.src.port" of type Int`, 0},
+		{`net.src.ip ^= "10."`, `operator "^=" cannot be applied to field "net.src.ip" of type IpAddr`, 0},
+		{`net.src.port == "80"`, "net.src.port == expects a value of type integer, got string", 16},
+		{`net.src.ip in 10.0.0.1`, "net.src.ip in expects a value of type CIDR, got IP address", 14},
+		{`http.path ~ "("`, "invalid regex \"(\": error parsing regexp: missing closing ): `(`", 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			_, err := ParseCondition(tt.condition)
+			var exprErr *ExpressionError
+			require.True(t, errors.As(err, &exprErr), err)
+			assert.Equal(t, tt.wantErr, exprErr.Msg)
+			assert.Equal(t, tt.wantPos, exprErr.Pos)
+			assert.Equal(t, tt.condition, exprErr.Expression)
+		})
+	}
+}
+
+func TestFormatCondition(t *testing.T) {
+	condition, err := FormatCondition(ExprAnd(
+		ExprNot(ExprOr(
+			ExprField("route.name").Equals("health"),
+			ExprField("http.path").Matches(`^/status/\d+$`),
+		)),
+		ExprField("net.src.port").Compare(ExprGreater, ExprInt(1024)),
+		ExprField("http.headers.x_tenant").HasSuffix(`"eu"`),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, `!(route.name == "health" || http.path ~ r#"^/status/\d+$"#) && `+
+		`net.src.port > 1024 && http.headers.x_tenant =^ "\"eu\""`, condition)
+
+	assert.Equal(t, `http.path == "/"`, ExprOr(ExprField("http.path").Equals("/")).String())
+	assert.Equal(t, `(http.path == "/a" && http.path == "/b") && http.path == "/c"`, ExprAnd(
+		ExprAnd(ExprField("http.path").Equals("/a"), ExprField("http.path").Equals("/b")),
+		ExprField("http.path").Equals("/c"),
+	).String())
+
+	_, err = FormatCondition(ExprAnd(ExprField("http.path").Equals("/"), ExprField("unknown").Equals("a")))
+	assert.EqualError(t, err, `invalid condition: unknown field "unknown"`)
+	_, err = FormatCondition(ExprAnd())
+	assert.EqualError(t, err, "invalid condition: && expression without operands")
+	_, err = FormatCondition(nil)
+	assert.EqualError(t, err, "invalid condition: expression cannot be nil")
+}
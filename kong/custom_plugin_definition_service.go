@@ -20,6 +20,8 @@ type AbstractCustomPluginService interface {
 	List(ctx context.Context, opt *ListOpt) ([]*CustomPluginDefinition, *ListOpt, error)
 	// ListAll fetches all CustomPluginDefintions in Kong.
 	ListAll(ctx context.Context) ([]*CustomPluginDefinition, error)
+	// Deploy creates or updates a CustomPluginDefintion in Kong from a CustomPluginPackage.
+	Deploy(ctx context.Context, pkg *CustomPluginPackage, tags ...string) (*CustomPluginDeployResult, error)
 }
 
 // CustomPluginService handles CustomPluginDefintions in Kong.
@@ -162,3 +164,60 @@ func (s *CustomPluginService) ListAll(ctx context.Context) ([]*CustomPluginDefin
 	}
 	return plugins, nil
 }
+
+// Deploy creates or updates the CustomPluginDefintion of pkg in Kong.
+// The definition is tagged with tags and with the HashTag of pkg, which is
+// used to skip the update of definitions which are up to date.
+// When the code in Kong differs from the code of pkg, the result holds a
+// unified diff of the changes.
+func (s *CustomPluginService) Deploy(ctx context.Context,
+	pkg *CustomPluginPackage, tags ...string,
+) (*CustomPluginDeployResult, error) {
+	if pkg == nil {
+		return nil, fmt.Errorf("pkg cannot be nil")
+	}
+	if err := pkg.Validate(); err != nil {
+		return nil, err
+	}
+
+	definition := pkg.Definition(tags...)
+	remote, err := s.Get(ctx, definition.Name)
+	if err != nil && !IsNotFoundErr(err) {
+		return nil, err
+	}
+	if remote == nil {
+		created, err := s.Create(ctx, definition)
+		if err != nil {
+			return nil, err
+		}
+		return &CustomPluginDeployResult{Action: CustomPluginCreated, Definition: created}, nil
+	}
+
+	hashTag := pkg.HashTag()
+	codeChanged := StringValue(remote.Handler) != pkg.Handler || StringValue(remote.Schema) != pkg.Schema
+	remoteTags := map[string]bool{}
+	for _, tag := range remote.Tags {
+		if tag != nil {
+			remoteTags[*tag] = true
+		}
+	}
+	tagsChanged := !remoteTags[hashTag]
+	for _, tag := range tags {
+		tagsChanged = tagsChanged || !remoteTags[tag]
+	}
+	if !codeChanged && !tagsChanged {
+		return &CustomPluginDeployResult{Action: CustomPluginUnchanged, Definition: remote}, nil
+	}
+
+	definition.ID = remote.ID
+	definition.Tags = withHashTag(hashTag, remote.Tags, StringSlice(tags...))
+	updated, err := s.Update(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	result := &CustomPluginDeployResult{Action: CustomPluginUpdated, Definition: updated}
+	if codeChanged {
+		result.Diff = customPluginDiff(remote, pkg)
+	}
+	return result, nil
+}
@@ -0,0 +1,302 @@
+package kong
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// CustomPluginHandlerFile is the file holding the handler of a plugin in
+	// a plugin directory.
+	CustomPluginHandlerFile = "handler.lua"
+	// CustomPluginSchemaFile is the file holding the schema of a plugin in a
+	// plugin directory.
+	CustomPluginSchemaFile = "schema.lua"
+	// CustomPluginHashTagPrefix prefixes the tag holding the content hash of
+	// the custom plugins deployed with CustomPluginService.Deploy.
+	CustomPluginHashTagPrefix = "content-hash:"
+	// MaxCustomPluginFileSize is the maximum size of the handler and of the
+	// schema of custom plugins read by ReadCustomPluginDir.
+	MaxCustomPluginFileSize = 1 << 20
+)
+
+// customPluginNameRegex matches the names Kong accepts for plugins.
+var customPluginNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// CustomPluginPackage holds the code of a custom plugin, as found in a Kong
+// plugin directory.
+type CustomPluginPackage struct {
+	Name    string
+	Handler string
+	Schema  string
+}
+
+// ReadCustomPluginDir reads the custom plugin in dir, which must contain the
+// handler.lua and schema.lua files of the plugin. The plugin is named after
+// dir, e.g. "kong/plugins/my-plugin" holds the plugin "my-plugin".
+func ReadCustomPluginDir(dir string) (*CustomPluginPackage, error) {
+	return ReadCustomPluginFS(os.DirFS(dir), filepath.Base(filepath.Clean(dir)))
+}
+
+// ReadCustomPluginFS reads the custom plugin name from the root of fsys, as
+// ReadCustomPluginDir does.
+func ReadCustomPluginFS(fsys fs.FS, name string) (*CustomPluginPackage, error) {
+	handler, err := readCustomPluginFile(fsys, CustomPluginHandlerFile)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := readCustomPluginFile(fsys, CustomPluginSchemaFile)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &CustomPluginPackage{Name: name, Handler: handler, Schema: schema}
+	if err := pkg.Validate(); err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+func readCustomPluginFile(fsys fs.FS, name string) (string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", name, err)
+	}
+	defer f.Close()
+	// Read one more byte than allowed to detect oversized files.
+	b, err := io.ReadAll(io.LimitReader(f, MaxCustomPluginFileSize+1))
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", name, err)
+	}
+	if len(b) > MaxCustomPluginFileSize {
+		return "", fmt.Errorf("%s exceeds %d bytes", name, MaxCustomPluginFileSize)
+	}
+	return string(b), nil
+}
+
+// Validate checks the name of the plugin and the size of its code.
+func (p *CustomPluginPackage) Validate() error {
+	var errs []error
+	if !customPluginNameRegex.MatchString(p.Name) {
+		errs = append(errs, fmt.Errorf("invalid plugin name %q: names must start with a lowercase letter "+
+			"followed by lowercase letters, digits, dashes or underscores", p.Name))
+	}
+	files := []struct{ name, code string }{
+		{CustomPluginHandlerFile, p.Handler},
+		{CustomPluginSchemaFile, p.Schema},
+	}
+	for _, file := range files {
+		switch {
+		case strings.TrimSpace(file.code) == "":
+			errs = append(errs, fmt.Errorf("%s is empty", file.name))
+		case len(file.code) > MaxCustomPluginFileSize:
+			errs = append(errs, fmt.Errorf("%s exceeds %d bytes", file.name, MaxCustomPluginFileSize))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the name and code of the
+// plugin.
+func (p *CustomPluginPackage) Hash() string {
+	h := sha256.New()
+	for _, part := range []string{p.Name, p.Handler, p.Schema} {
+		// Lengths prefix the parts so that moving code between the
+		// handler and the schema changes the hash.
+		fmt.Fprintf(h, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HashTag returns the tag identifying the content of the plugin.
+func (p *CustomPluginPackage) HashTag() string {
+	return CustomPluginHashTagPrefix + p.Hash()
+}
+
+// Definition returns the CustomPluginDefinition of the plugin, tagged with
+// its HashTag and tags.
+func (p *CustomPluginPackage) Definition(tags ...string) *CustomPluginDefinition {
+	// Appending to tags could overwrite the array of the caller.
+	definitionTags := make([]string, 0, len(tags)+1)
+	definitionTags = append(definitionTags, tags...)
+	return &CustomPluginDefinition{
+		Name:    String(p.Name),
+		Handler: String(p.Handler),
+		Schema:  String(p.Schema),
+		Tags:    StringSlice(append(definitionTags, p.HashTag())...),
+	}
+}
+
+// CustomPluginDeployAction is the action taken by CustomPluginService.Deploy.
+type CustomPluginDeployAction string
+
+const (
+	// CustomPluginCreated is reported when the plugin didn't exist in Kong.
+	CustomPluginCreated CustomPluginDeployAction = "created"
+	// CustomPluginUpdated is reported when the plugin existed in Kong with a
+	// different code or hash tag.
+	CustomPluginUpdated CustomPluginDeployAction = "updated"
+	// CustomPluginUnchanged is reported when the plugin was up to date.
+	CustomPluginUnchanged CustomPluginDeployAction = "unchanged"
+)
+
+// CustomPluginDeployResult is the result of CustomPluginService.Deploy.
+type CustomPluginDeployResult struct {
+	Action     CustomPluginDeployAction
+	Definition *CustomPluginDefinition
+	// Diff is a unified diff from the code in Kong to the deployed code,
+	// empty unless the code was updated.
+	Diff string
+}
+
+// withHashTag returns a new slice of the distinct tags of tagLists, with the
+// hash tags replaced by hashTag.
+func withHashTag(hashTag string, tagLists ...[]*string) []*string {
+	var res []*string
+	seen := map[string]bool{}
+	for _, tags := range tagLists {
+		for _, tag := range tags {
+			if tag == nil || seen[*tag] || strings.HasPrefix(*tag, CustomPluginHashTagPrefix) {
+				continue
+			}
+			seen[*tag] = true
+			res = append(res, tag)
+		}
+	}
+	return append(res, String(hashTag))
+}
+
+// customPluginDiff returns a unified diff of the handler and the schema of
+// remote and pkg.
+func customPluginDiff(remote *CustomPluginDefinition, pkg *CustomPluginPackage) string {
+	var b strings.Builder
+	b.WriteString(unifiedDiff("a/"+CustomPluginHandlerFile, "b/"+CustomPluginHandlerFile,
+		StringValue(remote.Handler), pkg.Handler))
+	b.WriteString(unifiedDiff("a/"+CustomPluginSchemaFile, "b/"+CustomPluginSchemaFile,
+		StringValue(remote.Schema), pkg.Schema))
+	return b.String()
+}
+
+// maxDiffCells bounds the size of the table computed by unifiedDiff.
+const maxDiffCells = 16 << 20
+
+// diffContext is the number of unchanged lines around changes in hunks.
+const diffContext = 3
+
+// unifiedDiff returns a unified diff from a to b, or an empty string if they
+// are equal.
+func unifiedDiff(nameA, nameB, a, b string) string {
+	if a == b {
+		return ""
+	}
+	linesA, linesB := splitLines(a), splitLines(b)
+	header := fmt.Sprintf("--- %s\n+++ %s\n", nameA, nameB)
+	if (len(linesA)+1)*(len(linesB)+1) > maxDiffCells {
+		return header + "files are too large to be compared line by line\n"
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// linesA[i:] and linesB[j:].
+	lcs := make([][]int, len(linesA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(linesB)+1)
+	}
+	for i := len(linesA) - 1; i >= 0; i-- {
+		for j := len(linesB) - 1; j >= 0; j-- {
+			if linesA[i] == linesB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	type edit struct {
+		op   byte
+		line string
+		// posA and posB are the line numbers before the edit, from 0.
+		posA, posB int
+	}
+	var edits []edit
+	i, j := 0, 0
+	for i < len(linesA) || j < len(linesB) {
+		switch {
+		case i < len(linesA) && j < len(linesB) && linesA[i] == linesB[j]:
+			edits = append(edits, edit{' ', linesA[i], i, j})
+			i++
+			j++
+		case i < len(linesA) && (j == len(linesB) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{'-', linesA[i], i, j})
+			i++
+		default:
+			edits = append(edits, edit{'+', linesB[j], i, j})
+			j++
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString(header)
+	for start := 0; start < len(edits); {
+		// Find the next change and the end of its hunk, merging changes
+		// separated by less than twice the context.
+		for start < len(edits) && edits[start].op == ' ' {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+		end := start
+		for k := start; k < len(edits); k++ {
+			if edits[k].op != ' ' {
+				end = k + 1
+			} else if k-end >= 2*diffContext {
+				break
+			}
+		}
+		from := max(start-diffContext, 0)
+		to := min(end+diffContext, len(edits))
+
+		var countA, countB int
+		for _, e := range edits[from:to] {
+			if e.op != '+' {
+				countA++
+			}
+			if e.op != '-' {
+				countB++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n",
+			hunkRange(edits[from].posA, countA), hunkRange(edits[from].posB, countB))
+		for _, e := range edits[from:to] {
+			out.WriteByte(e.op)
+			out.WriteString(e.line)
+			out.WriteByte('\n')
+		}
+		start = to
+	}
+	return out.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,211 @@
+package kong
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPluginHandler = `local MyPlugin = {
+  PRIORITY = 1000,
+  VERSION = "0.1.0",
+}
+
+function MyPlugin:access(conf)
+  kong.response.set_header("x-my-plugin", conf.value)
+end
+
+return MyPlugin
+`
+	testPluginSchema = `return {
+  name = "my-plugin",
+  fields = {
+    { config = { type = "record", fields = { { value = { type = "string" } } } } },
+  },
+}
+`
+)
+
+func TestReadCustomPluginDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-plugin")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, CustomPluginHandlerFile), []byte(testPluginHandler), 0o600))
+
+	_, err := ReadCustomPluginDir(dir)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, CustomPluginSchemaFile), []byte(testPluginSchema), 0o600))
+	pkg, err := ReadCustomPluginDir(dir + "/")
+	require.NoError(t, err)
+	assert.Equal(t, &CustomPluginPackage{
+		Name:    "my-plugin",
+		Handler: testPluginHandler,
+		Schema:  testPluginSchema,
+	}, pkg)
+}
+
+func TestReadCustomPluginFSValidation(t *testing.T) {
+	oversized := strings.Repeat("-", MaxCustomPluginFileSize+1)
+	_, err := ReadCustomPluginFS(fstest.MapFS{
+		CustomPluginHandlerFile: {Data: []byte(oversized)},
+		CustomPluginSchemaFile:  {Data: []byte(testPluginSchema)},
+	}, "my-plugin")
+	assert.EqualError(t, err, "handler.lua exceeds 1048576 bytes")
+
+	_, err = ReadCustomPluginFS(fstest.MapFS{
+		CustomPluginHandlerFile: {Data: []byte(testPluginHandler)},
+		CustomPluginSchemaFile:  {Data: []byte(" \n")},
+	}, "My Plugin")
+	assert.EqualError(t, err, `invalid plugin name "My Plugin": names must start with a lowercase letter `+
+		"followed by lowercase letters, digits, dashes or underscores\nschema.lua is empty")
+}
+
+func TestCustomPluginPackageHash(t *testing.T) {
+	pkg := &CustomPluginPackage{Name: "my-plugin", Handler: testPluginHandler, Schema: testPluginSchema}
+	hash := pkg.Hash()
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, pkg.Hash())
+	assert.Equal(t, CustomPluginHashTagPrefix+hash, pkg.HashTag())
+
+	moved := &CustomPluginPackage{Name: pkg.Name, Handler: pkg.Handler + pkg.Schema}
+	assert.NotEqual(t, hash, moved.Hash())
+	renamed := &CustomPluginPackage{Name: "other", Handler: pkg.Handler, Schema: pkg.Schema}
+	assert.NotEqual(t, hash, renamed.Hash())
+
+	assert.Equal(t, StringSlice("team:a", pkg.HashTag()), pkg.Definition("team:a").Tags)
+
+	// The tags of the caller are left untouched, even with spare capacity.
+	tags := make([]string, 1, 2)
+	tags[0] = "team:a"
+	pkg.Definition(tags...)
+	assert.Equal(t, []string{"team:a", ""}, tags[:2])
+}
+
+func TestWithHashTag(t *testing.T) {
+	remote := make([]*string, 2, 4)
+	remote[0], remote[1] = String("team:a"), String(CustomPluginHashTagPrefix+"old")
+	tags := withHashTag(CustomPluginHashTagPrefix+"new", remote, StringSlice("team:b", "team:a"))
+	assert.Equal(t, StringSlice("team:a", "team:b", CustomPluginHashTagPrefix+"new"), tags)
+	assert.Equal(t, []*string{String("team:a"), String(CustomPluginHashTagPrefix + "old"), nil, nil}, remote[:4])
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	assert.Empty(t, unifiedDiff("a", "b", "same\n", "same\n"))
+
+	from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n"
+	to := "1\n2\ntwo\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n"
+	assert.Equal(t, `--- a/file
++++ b/file
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++two
+ 4
+ 5
+ 6
+@@ -13,3 +13,4 @@
+ 13
+ 14
+ 15
++16
+`, unifiedDiff("a/file", "b/file", from, to))
+
+	assert.Equal(t, `--- a
++++ b
+@@ -0,0 +1 @@
++new
+`, unifiedDiff("a", "b", "", "new\n"))
+}
+
+// newCustomPluginServer returns a server storing custom plugins, along with
+// the methods of the requests it received.
+func newCustomPluginServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+	var (
+		lock    sync.Mutex
+		methods []string
+		stored  map[string]interface{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodGet:
+			if stored == nil {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not found"}`))
+				return
+			}
+		case http.MethodPost, http.MethodPatch:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if stored == nil {
+				stored = map[string]interface{}{"id": "plugin-id"}
+			}
+			for k, v := range body {
+				stored[k] = v
+			}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(stored))
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return methods
+	}
+}
+
+func TestCustomPluginServiceDeploy(t *testing.T) {
+	server, methods := newCustomPluginServer(t)
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	pkg := &CustomPluginPackage{Name: "my-plugin", Handler: testPluginHandler, Schema: testPluginSchema}
+	result, err := client.CustomPlugins.Deploy(defaultCtx, pkg, "team:a")
+	require.NoError(t, err)
+	assert.Equal(t, CustomPluginCreated, result.Action)
+	assert.Equal(t, StringSlice("team:a", pkg.HashTag()), result.Definition.Tags)
+	assert.Empty(t, result.Diff)
+
+	result, err = client.CustomPlugins.Deploy(defaultCtx, pkg, "team:a")
+	require.NoError(t, err)
+	assert.Equal(t, CustomPluginUnchanged, result.Action)
+	assert.Equal(t, []string{"GET", "POST", "GET"}, methods())
+
+	changed := &CustomPluginPackage{
+		Name:    pkg.Name,
+		Handler: strings.Replace(pkg.Handler, "PRIORITY = 1000", "PRIORITY = 1001", 1),
+		Schema:  pkg.Schema,
+	}
+	result, err = client.CustomPlugins.Deploy(defaultCtx, changed)
+	require.NoError(t, err)
+	assert.Equal(t, CustomPluginUpdated, result.Action)
+	assert.Equal(t, "plugin-id", *result.Definition.ID)
+	assert.Equal(t, StringSlice("team:a", changed.HashTag()), result.Definition.Tags)
+	assert.Equal(t, `--- a/handler.lua
++++ b/handler.lua
+@@ -1,5 +1,5 @@
+ local MyPlugin = {
+-  PRIORITY = 1000,
++  PRIORITY = 1001,
+   VERSION = "0.1.0",
+ }
+`+" \n", result.Diff)
+	assert.Equal(t, []string{"GET", "POST", "GET", "GET", "PATCH"}, methods())
+
+	_, err = client.CustomPlugins.Deploy(defaultCtx, &CustomPluginPackage{Name: "my-plugin"})
+	assert.EqualError(t, err, "handler.lua is empty\nschema.lua is empty")
+}
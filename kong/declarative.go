@@ -0,0 +1,491 @@
+package kong
+
+import "fmt"
+
+// DefaultDeclarativeFormatVersion is the declarative configuration format
+// version used when DeclarativeContent.FormatVersion is not set.
+const DefaultDeclarativeFormatVersion = "3.0"
+
+// DeclarativeContent represents a declarative configuration as accepted by
+// the POST /config endpoint of Kong running in DB-less mode.
+// Read https://docs.konghq.com/gateway/latest/production/deployment-topologies/db-less-and-declarative-config/
+// +k8s:deepcopy-gen=true
+type DeclarativeContent struct {
+	FormatVersion string `json:"_format_version" yaml:"_format_version"`
+	Transform     *bool  `json:"_transform,omitempty" yaml:"_transform,omitempty"`
+
+	Services       []*DeclarativeService       `json:"services,omitempty" yaml:"services,omitempty"`
+	Routes         []*DeclarativeRoute         `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Consumers      []*DeclarativeConsumer      `json:"consumers,omitempty" yaml:"consumers,omitempty"`
+	ConsumerGroups []*DeclarativeConsumerGroup `json:"consumer_groups,omitempty" yaml:"consumer_groups,omitempty"`
+	Plugins        []*Plugin                   `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	Upstreams      []*DeclarativeUpstream      `json:"upstreams,omitempty" yaml:"upstreams,omitempty"`
+	Targets        []*Target                   `json:"targets,omitempty" yaml:"targets,omitempty"`
+	Certificates   []*DeclarativeCertificate   `json:"certificates,omitempty" yaml:"certificates,omitempty"`
+	SNIs           []*SNI                      `json:"snis,omitempty" yaml:"snis,omitempty"`
+	CACertificates []*CACertificate            `json:"ca_certificates,omitempty" yaml:"ca_certificates,omitempty"`
+	Vaults         []*Vault                    `json:"vaults,omitempty" yaml:"vaults,omitempty"`
+	Keys           []*Key                      `json:"keys,omitempty" yaml:"keys,omitempty"`
+	KeySets        []*KeySet                   `json:"key_sets,omitempty" yaml:"key_sets,omitempty"`
+	Partials       []*Partial                  `json:"partials,omitempty" yaml:"partials,omitempty"`
+	FilterChains   []*FilterChain              `json:"filter_chains,omitempty" yaml:"filter_chains,omitempty"`
+
+	KeyAuths          []*KeyAuth          `json:"keyauth_credentials,omitempty" yaml:"keyauth_credentials,omitempty"`
+	BasicAuths        []*BasicAuth        `json:"basicauth_credentials,omitempty" yaml:"basicauth_credentials,omitempty"`
+	HMACAuths         []*HMACAuth         `json:"hmacauth_credentials,omitempty" yaml:"hmacauth_credentials,omitempty"`
+	JWTAuths          []*JWTAuth          `json:"jwt_secrets,omitempty" yaml:"jwt_secrets,omitempty"`
+	MTLSAuths         []*MTLSAuth         `json:"mtls_auth_credentials,omitempty" yaml:"mtls_auth_credentials,omitempty"`
+	ACLGroups         []*ACLGroup         `json:"acls,omitempty" yaml:"acls,omitempty"`
+	Oauth2Credentials []*Oauth2Credential `json:"oauth2_credentials,omitempty" yaml:"oauth2_credentials,omitempty"`
+}
+
+// DeclarativeService represents a Service in a declarative configuration,
+// along with the entities nested under it.
+// +k8s:deepcopy-gen=true
+type DeclarativeService struct {
+	*Service
+	Routes       []*DeclarativeRoute `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Plugins      []*Plugin           `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	FilterChains []*FilterChain      `json:"filter_chains,omitempty" yaml:"filter_chains,omitempty"`
+}
+
+// DeclarativeRoute represents a Route in a declarative configuration,
+// along with the entities nested under it.
+// +k8s:deepcopy-gen=true
+type DeclarativeRoute struct {
+	*Route
+	Plugins      []*Plugin      `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	FilterChains []*FilterChain `json:"filter_chains,omitempty" yaml:"filter_chains,omitempty"`
+}
+
+// DeclarativeConsumer represents a Consumer in a declarative configuration,
+// along with the entities nested under it.
+// +k8s:deepcopy-gen=true
+type DeclarativeConsumer struct {
+	*Consumer
+	Groups  []*ConsumerGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Plugins []*Plugin        `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+
+	KeyAuths          []*KeyAuth          `json:"keyauth_credentials,omitempty" yaml:"keyauth_credentials,omitempty"`
+	BasicAuths        []*BasicAuth        `json:"basicauth_credentials,omitempty" yaml:"basicauth_credentials,omitempty"`
+	HMACAuths         []*HMACAuth         `json:"hmacauth_credentials,omitempty" yaml:"hmacauth_credentials,omitempty"`
+	JWTAuths          []*JWTAuth          `json:"jwt_secrets,omitempty" yaml:"jwt_secrets,omitempty"`
+	MTLSAuths         []*MTLSAuth         `json:"mtls_auth_credentials,omitempty" yaml:"mtls_auth_credentials,omitempty"`
+	ACLGroups         []*ACLGroup         `json:"acls,omitempty" yaml:"acls,omitempty"`
+	Oauth2Credentials []*Oauth2Credential `json:"oauth2_credentials,omitempty" yaml:"oauth2_credentials,omitempty"`
+}
+
+// DeclarativeConsumerGroup represents a ConsumerGroup in a declarative
+// configuration, along with the plugins nested under it.
+// +k8s:deepcopy-gen=true
+type DeclarativeConsumerGroup struct {
+	*ConsumerGroup
+	Plugins []*Plugin `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+}
+
+// DeclarativeUpstream represents an Upstream in a declarative configuration,
+// along with the targets nested under it.
+// +k8s:deepcopy-gen=true
+type DeclarativeUpstream struct {
+	*Upstream
+	Targets []*Target `json:"targets,omitempty" yaml:"targets,omitempty"`
+}
+
+// DeclarativeCertificate represents a Certificate in a declarative
+// configuration, along with the SNIs nested under it.
+// SNIs shadows Certificate.SNIs, as nested SNIs are full entities in the
+// declarative format.
+// +k8s:deepcopy-gen=true
+type DeclarativeCertificate struct {
+	*Certificate
+	SNIs []*SNI `json:"snis,omitempty" yaml:"snis,omitempty"`
+}
+
+// DeclarativeConfigOpts holds the options of POST /config used when pushing a
+// declarative configuration with ReloadDeclarativeConfig.
+type DeclarativeConfigOpts struct {
+	// CheckHash makes Kong skip the reload when the pushed configuration
+	// matches the one currently loaded.
+	CheckHash bool
+	// FlattenErrors makes Kong report errors as a flat list of entity errors.
+	// It is supported by Kong 3.2 and later.
+	FlattenErrors bool
+}
+
+// DeclarativeConfigResult is the outcome of a successful ReloadDeclarativeConfig call.
+type DeclarativeConfigResult struct {
+	// NotModified is true when CheckHash was requested and Kong did not
+	// reload the configuration because it was already loaded.
+	NotModified bool
+	// ConfigurationHash is the hash of the configuration loaded by Kong. It
+	// is taken from the response of POST /config when Kong includes it there.
+	// Otherwise it is read from the /status endpoint after the reload, and is
+	// the hash of the configuration of another reload if one happened in
+	// between. It is empty when Kong doesn't report it.
+	ConfigurationHash string
+	// EntityCounts holds the number of entities loaded by Kong per entity type.
+	EntityCounts map[string]int
+	// Entities holds the entities loaded by Kong as returned by the Admin API,
+	// keyed by entity type.
+	Entities map[string][]Configuration
+}
+
+// FillIDs fills the IDs of all entities of content that don't have one yet,
+// including the entities nested under other entities, using the FillID method
+// of each entity.
+// Nested entities are identified as if they referenced their parent entity,
+// e.g. a plugin nested under a service gets the same ID as a top-level plugin
+// with the same name referencing that service. The parent reference itself is
+// not added to the nested entity.
+func FillIDs(content *DeclarativeContent, workspace string) error {
+	if content == nil {
+		return fmt.Errorf("declarative content is nil")
+	}
+
+	fill := func(kind string, i int, entity IDFillable) error {
+		if err := entity.FillID(workspace); err != nil {
+			return fmt.Errorf("%s[%d]: %w", kind, i, err)
+		}
+		return nil
+	}
+
+	for i, v := range content.Vaults {
+		if err := fill("vaults", i, v); err != nil {
+			return err
+		}
+	}
+	for i, ks := range content.KeySets {
+		if err := fill("key_sets", i, ks); err != nil {
+			return err
+		}
+	}
+	for i, k := range content.Keys {
+		if err := fill("keys", i, k); err != nil {
+			return err
+		}
+	}
+	for i, c := range content.CACertificates {
+		if err := fill("ca_certificates", i, c); err != nil {
+			return err
+		}
+	}
+	for i, c := range content.Certificates {
+		if c == nil {
+			return fmt.Errorf("certificates[%d]: certificate is nil", i)
+		}
+		if err := fill("certificates", i, c.Certificate); err != nil {
+			return err
+		}
+		for j, sni := range c.SNIs {
+			if err := fill(fmt.Sprintf("certificates[%d].snis", i), j, sni); err != nil {
+				return err
+			}
+		}
+	}
+	for i, sni := range content.SNIs {
+		if err := fill("snis", i, sni); err != nil {
+			return err
+		}
+	}
+	for i, u := range content.Upstreams {
+		if u == nil {
+			return fmt.Errorf("upstreams[%d]: upstream is nil", i)
+		}
+		if err := fill("upstreams", i, u.Upstream); err != nil {
+			return err
+		}
+		for j, t := range u.Targets {
+			if err := fill(fmt.Sprintf("upstreams[%d].targets", i), j, nestedTarget(t, u.Upstream)); err != nil {
+				return err
+			}
+		}
+	}
+	for i, t := range content.Targets {
+		if err := fill("targets", i, t); err != nil {
+			return err
+		}
+	}
+	for i, s := range content.Services {
+		if err := fillDeclarativeServiceIDs(s, fmt.Sprintf("services[%d]", i), workspace); err != nil {
+			return err
+		}
+	}
+	for i, r := range content.Routes {
+		if err := fillDeclarativeRouteIDs(r, fmt.Sprintf("routes[%d]", i), workspace); err != nil {
+			return err
+		}
+	}
+	for i, cg := range content.ConsumerGroups {
+		if cg == nil {
+			return fmt.Errorf("consumer_groups[%d]: consumer group is nil", i)
+		}
+		if err := fill("consumer_groups", i, cg.ConsumerGroup); err != nil {
+			return err
+		}
+		for j, p := range cg.Plugins {
+			err := fill(fmt.Sprintf("consumer_groups[%d].plugins", i), j, nestedPlugin(p, cg.ConsumerGroup))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, c := range content.Consumers {
+		if err := fillDeclarativeConsumerIDs(c, fmt.Sprintf("consumers[%d]", i), workspace); err != nil {
+			return err
+		}
+	}
+	for i, p := range content.Plugins {
+		if err := fill("plugins", i, p); err != nil {
+			return err
+		}
+	}
+	for i, p := range content.Partials {
+		if err := fill("partials", i, p); err != nil {
+			return err
+		}
+	}
+	for i, f := range content.FilterChains {
+		if err := fill("filter_chains", i, f); err != nil {
+			return err
+		}
+	}
+
+	credentials := []struct {
+		kind     string
+		entities []IDFillable
+	}{
+		{"keyauth_credentials", idFillables(content.KeyAuths)},
+		{"basicauth_credentials", idFillables(content.BasicAuths)},
+		{"hmacauth_credentials", idFillables(content.HMACAuths)},
+		{"jwt_secrets", idFillables(content.JWTAuths)},
+		{"mtls_auth_credentials", idFillables(content.MTLSAuths)},
+		{"acls", idFillables(content.ACLGroups)},
+		{"oauth2_credentials", idFillables(content.Oauth2Credentials)},
+	}
+	for _, c := range credentials {
+		for i, e := range c.entities {
+			if err := fill(c.kind, i, e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func fillDeclarativeServiceIDs(s *DeclarativeService, path, workspace string) error {
+	if s == nil {
+		return fmt.Errorf("%s: service is nil", path)
+	}
+	if err := s.Service.FillID(workspace); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for i, r := range s.Routes {
+		if err := fillDeclarativeRouteIDs(r, fmt.Sprintf("%s.routes[%d]", path, i), workspace); err != nil {
+			return err
+		}
+	}
+	for i, p := range s.Plugins {
+		err := nestedPlugin(p, s.Service).FillID(workspace)
+		if err != nil {
+			return fmt.Errorf("%s.plugins[%d]: %w", path, i, err)
+		}
+	}
+	for i, f := range s.FilterChains {
+		err := nestedFilterChain(f, s.Service).FillID(workspace)
+		if err != nil {
+			return fmt.Errorf("%s.filter_chains[%d]: %w", path, i, err)
+		}
+	}
+	return nil
+}
+
+func fillDeclarativeRouteIDs(r *DeclarativeRoute, path, workspace string) error {
+	if r == nil {
+		return fmt.Errorf("%s: route is nil", path)
+	}
+	if err := r.Route.FillID(workspace); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for i, p := range r.Plugins {
+		err := nestedPlugin(p, r.Route).FillID(workspace)
+		if err != nil {
+			return fmt.Errorf("%s.plugins[%d]: %w", path, i, err)
+		}
+	}
+	for i, f := range r.FilterChains {
+		err := nestedFilterChain(f, r.Route).FillID(workspace)
+		if err != nil {
+			return fmt.Errorf("%s.filter_chains[%d]: %w", path, i, err)
+		}
+	}
+	return nil
+}
+
+func fillDeclarativeConsumerIDs(c *DeclarativeConsumer, path, workspace string) error {
+	if c == nil {
+		return fmt.Errorf("%s: consumer is nil", path)
+	}
+	if err := c.Consumer.FillID(workspace); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for i, p := range c.Plugins {
+		err := nestedPlugin(p, c.Consumer).FillID(workspace)
+		if err != nil {
+			return fmt.Errorf("%s.plugins[%d]: %w", path, i, err)
+		}
+	}
+
+	credentials := []struct {
+		kind     string
+		entities []IDFillable
+	}{
+		{"keyauth_credentials", idFillables(c.KeyAuths)},
+		{"basicauth_credentials", idFillables(c.BasicAuths)},
+		{"hmacauth_credentials", idFillables(c.HMACAuths)},
+		{"jwt_secrets", idFillables(c.JWTAuths)},
+		{"mtls_auth_credentials", nestedMTLSAuths(c.MTLSAuths, c.Consumer)},
+		{"acls", nestedACLGroups(c.ACLGroups, c.Consumer)},
+		{"oauth2_credentials", idFillables(c.Oauth2Credentials)},
+	}
+	for _, creds := range credentials {
+		for i, e := range creds.entities {
+			if err := e.FillID(workspace); err != nil {
+				return fmt.Errorf("%s.%s[%d]: %w", path, creds.kind, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// idFillables converts a slice of entities to a slice of IDFillable.
+func idFillables[T any, PT interface {
+	*T
+	IDFillable
+}](entities []PT) []IDFillable {
+	out := make([]IDFillable, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, e)
+	}
+	return out
+}
+
+// nestedIDFiller fills the ID of a nested entity using a copy of it which
+// references its parent entity, leaving the nested entity itself untouched
+// apart from its ID.
+type nestedIDFiller struct {
+	withParent IDFillable
+	setID      func()
+}
+
+func (n nestedIDFiller) FillID(workspace string) error {
+	if err := n.withParent.FillID(workspace); err != nil {
+		return err
+	}
+	n.setID()
+	return nil
+}
+
+// nestedPlugin returns the IDFillable of a plugin nested in parent, which
+// references parent unless it already references another entity of the same
+// type.
+func nestedPlugin(p *Plugin, parent interface{}) IDFillable {
+	if p == nil {
+		return p
+	}
+	withParent := *p
+	switch parent := parent.(type) {
+	case *Service:
+		if p.Service == nil {
+			withParent.Service = parent
+		}
+	case *Route:
+		if p.Route == nil {
+			withParent.Route = parent
+		}
+	case *Consumer:
+		if p.Consumer == nil {
+			withParent.Consumer = parent
+		}
+	case *ConsumerGroup:
+		if p.ConsumerGroup == nil {
+			withParent.ConsumerGroup = parent
+		}
+	}
+	return nestedIDFiller{
+		withParent: &withParent,
+		setID:      func() { p.ID = withParent.ID },
+	}
+}
+
+// nestedFilterChain returns the IDFillable of a filter chain nested in
+// parent, as nestedPlugin does.
+func nestedFilterChain(f *FilterChain, parent interface{}) IDFillable {
+	if f == nil {
+		return f
+	}
+	withParent := *f
+	switch parent := parent.(type) {
+	case *Service:
+		if f.Service == nil {
+			withParent.Service = parent
+		}
+	case *Route:
+		if f.Route == nil {
+			withParent.Route = parent
+		}
+	}
+	return nestedIDFiller{
+		withParent: &withParent,
+		setID:      func() { f.ID = withParent.ID },
+	}
+}
+
+func nestedTarget(t *Target, upstream *Upstream) IDFillable {
+	if t == nil {
+		return t
+	}
+	withParent := *t
+	if t.Upstream == nil {
+		withParent.Upstream = upstream
+	}
+	return nestedIDFiller{
+		withParent: &withParent,
+		setID:      func() { t.ID = withParent.ID },
+	}
+}
+
+// nestedMTLSAuths and nestedACLGroups identify the nested credentials that are
+// unique per consumer using the consumer they are nested under.
+func nestedMTLSAuths(creds []*MTLSAuth, consumer *Consumer) []IDFillable {
+	out := make([]IDFillable, 0, len(creds))
+	for _, c := range creds {
+		if c == nil || c.Consumer != nil {
+			out = append(out, c)
+			continue
+		}
+		withParent := *c
+		withParent.Consumer = consumer
+		out = append(out, nestedIDFiller{
+			withParent: &withParent,
+			setID:      func() { c.ID = withParent.ID },
+		})
+	}
+	return out
+}
+
+func nestedACLGroups(creds []*ACLGroup, consumer *Consumer) []IDFillable {
+	out := make([]IDFillable, 0, len(creds))
+	for _, c := range creds {
+		if c == nil || c.Consumer != nil {
+			out = append(out, c)
+			continue
+		}
+		withParent := *c
+		withParent.Consumer = consumer
+		out = append(out, nestedIDFiller{
+			withParent: &withParent,
+			setID:      func() { c.ID = withParent.ID },
+		})
+	}
+	return out
+}
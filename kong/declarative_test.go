@@ -0,0 +1,275 @@
+package kong
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclarativeContentJSON(t *testing.T) {
+	content := &DeclarativeContent{
+		FormatVersion: "3.0",
+		Services: []*DeclarativeService{
+			{
+				Service: &Service{Name: String("svc"), Host: String("example.com")},
+				Routes: []*DeclarativeRoute{
+					{Route: &Route{Name: String("r"), Paths: StringSlice("/")}},
+				},
+			},
+		},
+		Certificates: []*DeclarativeCertificate{
+			{
+				Certificate: &Certificate{Cert: String("cert"), Key: String("key")},
+				SNIs:        []*SNI{{Name: String("example.com")}},
+			},
+		},
+	}
+
+	b, err := json.Marshal(content)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"_format_version": "3.0",
+		"services": [{
+			"name": "svc",
+			"host": "example.com",
+			"routes": [{"name": "r", "paths": ["/"]}]
+		}],
+		"certificates": [{
+			"cert": "cert",
+			"key": "key",
+			"snis": [{"name": "example.com"}]
+		}]
+	}`, string(b))
+
+	var decoded DeclarativeContent
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, content, &decoded)
+}
+
+func TestReloadDeclarativeConfig(t *testing.T) {
+	var (
+		gotQuery string
+		gotBody  map[string]interface{}
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"services": {
+				"b3a3e1c4-0000-4000-8000-000000000002": {"name": "svc2"},
+				"b3a3e1c4-0000-4000-8000-000000000001": {"name": "svc1"}
+			},
+			"routes": [{"name": "r"}],
+			"_format_version": "3.0"
+		}`))
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"configuration_hash": "a9a166c59873245db8f1a747ba9a80a7"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	content := &DeclarativeContent{
+		Services: []*DeclarativeService{{Service: &Service{Name: String("svc1")}}},
+	}
+	result, err := client.ReloadDeclarativeConfig(context.Background(), content, DeclarativeConfigOpts{
+		CheckHash:     true,
+		FlattenErrors: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "check_hash=1&flatten_errors=1", gotQuery)
+	assert.Equal(t, DefaultDeclarativeFormatVersion, gotBody["_format_version"])
+	assert.Empty(t, content.FormatVersion, "the provided content must not be mutated")
+
+	assert.False(t, result.NotModified)
+	assert.Equal(t, "a9a166c59873245db8f1a747ba9a80a7", result.ConfigurationHash)
+	assert.Equal(t, map[string]int{"services": 2, "routes": 1}, result.EntityCounts)
+	assert.Equal(t, []Configuration{{"name": "svc1"}, {"name": "svc2"}}, result.Entities["services"])
+}
+
+func TestReloadDeclarativeConfig_NotModified(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"configuration_hash": "00000000000000000000000000000000"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	result, err := client.ReloadDeclarativeConfig(context.Background(), &DeclarativeContent{FormatVersion: "3.0"},
+		DeclarativeConfigOpts{CheckHash: true})
+	require.NoError(t, err)
+	assert.True(t, result.NotModified)
+	assert.Empty(t, result.ConfigurationHash)
+	assert.Empty(t, result.EntityCounts)
+}
+
+func TestReloadDeclarativeConfig_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "declarative config is invalid"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	_, err = client.ReloadDeclarativeConfig(context.Background(), nil, DeclarativeConfigOpts{})
+	require.Error(t, err)
+
+	_, err = client.ReloadDeclarativeConfig(context.Background(), &DeclarativeContent{}, DeclarativeConfigOpts{})
+	require.Error(t, err)
+	apiErr := &APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code())
+	assert.NotEmpty(t, apiErr.Raw())
+}
+
+func TestFillIDs(t *testing.T) {
+	content := &DeclarativeContent{
+		Services: []*DeclarativeService{
+			{
+				Service: &Service{Name: String("svc")},
+				Routes: []*DeclarativeRoute{
+					{
+						Route:   &Route{Name: String("route")},
+						Plugins: []*Plugin{{Name: String("cors")}},
+					},
+				},
+				Plugins:      []*Plugin{{Name: String("rate-limiting")}},
+				FilterChains: []*FilterChain{{}},
+			},
+		},
+		Upstreams: []*DeclarativeUpstream{
+			{
+				Upstream: &Upstream{Name: String("upstream")},
+				Targets:  []*Target{{Target: String("10.0.0.1:80")}},
+			},
+		},
+		Certificates: []*DeclarativeCertificate{
+			{
+				Certificate: &Certificate{Cert: String("cert-pem")},
+				SNIs:        []*SNI{{Name: String("example.com")}},
+			},
+		},
+		Consumers: []*DeclarativeConsumer{
+			{
+				Consumer:  &Consumer{Username: String("consumer")},
+				KeyAuths:  []*KeyAuth{{Key: String("secret")}},
+				ACLGroups: []*ACLGroup{{Group: String("admins")}},
+			},
+		},
+		Plugins: []*Plugin{
+			{Name: String("rate-limiting"), Service: &Service{Name: String("svc")}},
+		},
+		Vaults: []*Vault{{Name: String("env"), Prefix: String("my-env")}},
+	}
+
+	require.NoError(t, FillIDs(content, "ws"))
+
+	svc := content.Services[0]
+	require.NotNil(t, svc.ID)
+	require.NotNil(t, svc.Routes[0].ID)
+	require.NotNil(t, svc.Routes[0].Plugins[0].ID)
+	require.NotNil(t, svc.Plugins[0].ID)
+	require.NotNil(t, svc.FilterChains[0].ID)
+	require.NotNil(t, content.Upstreams[0].ID)
+	require.NotNil(t, content.Upstreams[0].Targets[0].ID)
+	require.NotNil(t, content.Certificates[0].ID)
+	require.NotNil(t, content.Certificates[0].SNIs[0].ID)
+	require.NotNil(t, content.Consumers[0].ID)
+	require.NotNil(t, content.Consumers[0].KeyAuths[0].ID)
+	require.NotNil(t, content.Consumers[0].ACLGroups[0].ID)
+	require.NotNil(t, content.Vaults[0].ID)
+
+	// A plugin nested under a service is identified like a top-level plugin
+	// referencing that service, without adding the reference.
+	assert.Equal(t, *content.Plugins[0].ID, *svc.Plugins[0].ID)
+	assert.Nil(t, svc.Plugins[0].Service)
+	assert.Nil(t, svc.Routes[0].Plugins[0].Route)
+	assert.Nil(t, content.Upstreams[0].Targets[0].Upstream)
+	assert.Nil(t, content.Consumers[0].ACLGroups[0].Consumer)
+
+	expected := &Target{Target: String("10.0.0.1:80"), Upstream: &Upstream{Name: String("upstream")}}
+	require.NoError(t, expected.FillID("ws"))
+	assert.Equal(t, *expected.ID, *content.Upstreams[0].Targets[0].ID)
+
+	// Filling IDs again is a no-op.
+	before := content.DeepCopy()
+	require.NoError(t, FillIDs(content, "ws"))
+	assert.Equal(t, before, content)
+}
+
+func TestFillIDs_ScopedNestedPlugins(t *testing.T) {
+	consumerPlugin := func() *Plugin {
+		return &Plugin{Name: String("rate-limiting"), Consumer: &Consumer{Username: String("alice")}}
+	}
+	content := &DeclarativeContent{
+		Services: []*DeclarativeService{
+			{
+				Service:      &Service{Name: String("svc-a")},
+				Plugins:      []*Plugin{consumerPlugin()},
+				FilterChains: []*FilterChain{{Route: &Route{Name: String("route")}}},
+			},
+			{
+				Service:      &Service{Name: String("svc-b")},
+				Plugins:      []*Plugin{consumerPlugin()},
+				FilterChains: []*FilterChain{{Route: &Route{Name: String("route")}}},
+			},
+		},
+		Plugins: []*Plugin{consumerPlugin()},
+	}
+	require.NoError(t, FillIDs(content, "ws"))
+
+	// The consumer of the plugins doesn't prevent them from being scoped to
+	// the service they are nested in.
+	a, b := content.Services[0], content.Services[1]
+	assert.NotEqual(t, *a.Plugins[0].ID, *b.Plugins[0].ID)
+	assert.NotEqual(t, *content.Plugins[0].ID, *a.Plugins[0].ID)
+	assert.NotEqual(t, *content.Plugins[0].ID, *b.Plugins[0].ID)
+	expected := consumerPlugin()
+	expected.Service = &Service{Name: String("svc-b")}
+	require.NoError(t, expected.FillID("ws"))
+	assert.Equal(t, *expected.ID, *b.Plugins[0].ID)
+	assert.NotEqual(t, *a.FilterChains[0].ID, *b.FilterChains[0].ID)
+}
+
+func TestFillIDs_Errors(t *testing.T) {
+	require.Error(t, FillIDs(nil, ""))
+
+	err := FillIDs(&DeclarativeContent{
+		Services: []*DeclarativeService{
+			{
+				Service: &Service{Name: String("svc")},
+				Routes:  []*DeclarativeRoute{{Route: &Route{}}},
+			},
+		},
+	}, "")
+	require.EqualError(t, err, "services[0].routes[0]: route name is required")
+
+	err = FillIDs(&DeclarativeContent{
+		Consumers: []*DeclarativeConsumer{
+			{
+				Consumer:   &Consumer{Username: String("consumer")},
+				BasicAuths: []*BasicAuth{{}},
+			},
+		},
+	}, "")
+	require.EqualError(t, err, "consumers[0].basicauth_credentials[0]: basic-auth credential username is required")
+}
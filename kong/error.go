@@ -66,6 +66,16 @@ func IsNotFoundErr(e error) bool {
 	return false
 }
 
+// IsConflictErr returns true if the error or its cause is
+// a 409 response from Kong.
+func IsConflictErr(e error) bool {
+	var apiErr *APIError
+	if errors.As(e, &apiErr) {
+		return apiErr.httpCode == http.StatusConflict
+	}
+	return false
+}
+
 // IsForbiddenErr returns true if the error or its cause is
 // a 403 response from Kong.
 func IsForbiddenErr(e error) bool {
@@ -20,6 +20,15 @@ func TestIsNotFoundErr(T *testing.T) {
 	assert.False(IsNotFoundErr(err))
 }
 
+func TestIsConflictErr(T *testing.T) {
+	assert := assert.New(T)
+	var e error = NewAPIError(http.StatusConflict, "")
+	assert.True(IsConflictErr(e))
+	assert.True(IsConflictErr(fmt.Errorf("creating: %w", e)))
+	assert.False(IsConflictErr(NewAPIError(http.StatusNotFound, "")))
+	assert.False(IsConflictErr(nil))
+}
+
 func TestIsNotFoundErrE2E(T *testing.T) {
 	assert := assert.New(T)
 
@@ -0,0 +1,735 @@
+package kong
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The types in this file model Kong's expressions language, used by the
+// conditions of plugins and by the routes of the expressions router:
+//
+//	http.path ^= "/api" && !(http.headers.x_debug == "1" || lower(http.method) == "options")
+//
+// Expressions are made of predicates on fields of the request, combined with
+// && (which binds tighter), || and !(...).
+
+// Expr is an expression of Kong's expressions language.
+type Expr interface {
+	// String returns the expression in Kong's syntax.
+	String() string
+	isExpr()
+}
+
+// ExprOperator is the operator of a predicate.
+type ExprOperator string
+
+const (
+	ExprEquals         ExprOperator = "=="
+	ExprNotEquals      ExprOperator = "!="
+	ExprRegexMatch     ExprOperator = "~"
+	ExprPrefixMatch    ExprOperator = "^="
+	ExprPostfixMatch   ExprOperator = "=^"
+	ExprGreater        ExprOperator = ">"
+	ExprGreaterOrEqual ExprOperator = ">="
+	ExprLess           ExprOperator = "<"
+	ExprLessOrEqual    ExprOperator = "<="
+	ExprIn             ExprOperator = "in"
+	ExprNotIn          ExprOperator = "not in"
+	ExprContains       ExprOperator = "contains"
+)
+
+// ExprValueType is the type of a value of a predicate.
+type ExprValueType string
+
+const (
+	ExprValueString ExprValueType = "string"
+	ExprValueInt    ExprValueType = "integer"
+	ExprValueIP     ExprValueType = "IP address"
+	ExprValueCIDR   ExprValueType = "CIDR"
+)
+
+// ExprValue is the value a field is compared to by a predicate.
+type ExprValue struct {
+	Type ExprValueType
+	// Str is the value of strings.
+	Str string
+	// Raw is true if the string is written as a raw string, e.g. r#"\d+"#.
+	Raw  bool
+	Int  int64
+	Addr netip.Addr
+	CIDR netip.Prefix
+}
+
+// ExprString returns a string value.
+func ExprString(s string) ExprValue {
+	return ExprValue{Type: ExprValueString, Str: s}
+}
+
+// ExprRawString returns a string value written as a raw string, which saves
+// escaping the backslashes of regexes.
+func ExprRawString(s string) ExprValue {
+	return ExprValue{Type: ExprValueString, Str: s, Raw: true}
+}
+
+// ExprInt returns an integer value.
+func ExprInt(i int64) ExprValue {
+	return ExprValue{Type: ExprValueInt, Int: i}
+}
+
+// ExprIP returns an IP address value.
+func ExprIP(addr netip.Addr) ExprValue {
+	return ExprValue{Type: ExprValueIP, Addr: addr}
+}
+
+// ExprCIDR returns a CIDR value.
+func ExprCIDR(prefix netip.Prefix) ExprValue {
+	return ExprValue{Type: ExprValueCIDR, CIDR: prefix}
+}
+
+func (v ExprValue) String() string {
+	switch v.Type {
+	case ExprValueInt:
+		return strconv.FormatInt(v.Int, 10)
+	case ExprValueIP:
+		return v.Addr.String()
+	case ExprValueCIDR:
+		return v.CIDR.String()
+	}
+	if v.Raw && !strings.Contains(v.Str, `"#`) {
+		return `r#"` + v.Str + `"#`
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range v.Str {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// ExprPredicate compares a field of the request to a value, e.g.
+// http.path ^= "/api".
+type ExprPredicate struct {
+	Field string
+	// Lower is true if the field is lowercased before the comparison, with
+	// lower(field).
+	Lower bool
+	Op    ExprOperator
+	Value ExprValue
+}
+
+func (*ExprPredicate) isExpr() {}
+
+func (p *ExprPredicate) String() string {
+	field := p.Field
+	if p.Lower {
+		field = "lower(" + field + ")"
+	}
+	return fmt.Sprintf("%s %s %s", field, p.Op, p.Value)
+}
+
+// ExprLogicalOperator combines the operands of an ExprLogical.
+type ExprLogicalOperator string
+
+const (
+	ExprAndOperator ExprLogicalOperator = "&&"
+	ExprOrOperator  ExprLogicalOperator = "||"
+)
+
+// ExprLogical is true if all (&&) or any (||) of its operands are true.
+type ExprLogical struct {
+	Op       ExprLogicalOperator
+	Operands []Expr
+}
+
+func (*ExprLogical) isExpr() {}
+
+func (l *ExprLogical) String() string {
+	parts := make([]string, len(l.Operands))
+	for i, operand := range l.Operands {
+		parts[i] = operand.String()
+		// && binds tighter than ||, other nested operations keep their
+		// parentheses so that parsing the result gives back l.
+		if nested, ok := operand.(*ExprLogical); ok && (l.Op == ExprAndOperator || nested.Op == ExprOrOperator) {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+string(l.Op)+" ")
+}
+
+// ExprNegation is true if its operand is false.
+type ExprNegation struct {
+	Operand Expr
+}
+
+func (*ExprNegation) isExpr() {}
+
+func (n *ExprNegation) String() string {
+	return "!(" + n.Operand.String() + ")"
+}
+
+// ExprAnd returns an expression true if all operands are true.
+func ExprAnd(operands ...Expr) Expr {
+	return exprLogical(ExprAndOperator, operands)
+}
+
+// ExprOr returns an expression true if any of the operands is true.
+func ExprOr(operands ...Expr) Expr {
+	return exprLogical(ExprOrOperator, operands)
+}
+
+func exprLogical(op ExprLogicalOperator, operands []Expr) Expr {
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return &ExprLogical{Op: op, Operands: operands}
+}
+
+// ExprNot returns an expression true if operand is false.
+func ExprNot(operand Expr) Expr {
+	return &ExprNegation{Operand: operand}
+}
+
+// ExprFieldBuilder builds predicates on a field:
+//
+//	ExprAnd(
+//		ExprField("http.path").HasPrefix("/api"),
+//		ExprField("http.method").Lower().Equals("get"),
+//	)
+type ExprFieldBuilder struct {
+	field string
+	lower bool
+}
+
+// ExprField returns a builder of predicates on field.
+func ExprField(field string) ExprFieldBuilder {
+	return ExprFieldBuilder{field: field}
+}
+
+// Lower lowercases the field before comparing it.
+func (f ExprFieldBuilder) Lower() ExprFieldBuilder {
+	f.lower = true
+	return f
+}
+
+// Compare returns a predicate comparing the field to value with op.
+func (f ExprFieldBuilder) Compare(op ExprOperator, value ExprValue) *ExprPredicate {
+	return &ExprPredicate{Field: f.field, Lower: f.lower, Op: op, Value: value}
+}
+
+// Equals returns a predicate true if the field equals s.
+func (f ExprFieldBuilder) Equals(s string) *ExprPredicate {
+	return f.Compare(ExprEquals, ExprString(s))
+}
+
+// NotEquals returns a predicate true if the field differs from s.
+func (f ExprFieldBuilder) NotEquals(s string) *ExprPredicate {
+	return f.Compare(ExprNotEquals, ExprString(s))
+}
+
+// HasPrefix returns a predicate true if the field starts with prefix.
+func (f ExprFieldBuilder) HasPrefix(prefix string) *ExprPredicate {
+	return f.Compare(ExprPrefixMatch, ExprString(prefix))
+}
+
+// HasSuffix returns a predicate true if the field ends with suffix.
+func (f ExprFieldBuilder) HasSuffix(suffix string) *ExprPredicate {
+	return f.Compare(ExprPostfixMatch, ExprString(suffix))
+}
+
+// Contains returns a predicate true if the field contains s.
+func (f ExprFieldBuilder) Contains(s string) *ExprPredicate {
+	return f.Compare(ExprContains, ExprString(s))
+}
+
+// Matches returns a predicate true if the field matches regex, which is
+// written as a raw string.
+func (f ExprFieldBuilder) Matches(regex string) *ExprPredicate {
+	return f.Compare(ExprRegexMatch, ExprRawString(regex))
+}
+
+// In returns a predicate true if the field, an IP address, is in prefix.
+func (f ExprFieldBuilder) In(prefix netip.Prefix) *ExprPredicate {
+	return f.Compare(ExprIn, ExprCIDR(prefix))
+}
+
+// NotIn returns a predicate true if the field, an IP address, isn't in
+// prefix.
+func (f ExprFieldBuilder) NotIn(prefix netip.Prefix) *ExprPredicate {
+	return f.Compare(ExprNotIn, ExprCIDR(prefix))
+}
+
+// exprFieldType is the type of a field of the expressions language.
+type exprFieldType string
+
+const (
+	exprFieldString exprFieldType = "String"
+	exprFieldInt    exprFieldType = "Int"
+	exprFieldIPAddr exprFieldType = "IpAddr"
+)
+
+// exprFields holds the type of the fields available to expressions by name.
+// Names ending with ".*" stand for all the fields with that prefix, e.g.
+// "http.headers.*".
+type exprFields map[string]exprFieldType
+
+func (f exprFields) lookup(field string) (exprFieldType, bool) {
+	if t, ok := f[field]; ok {
+		return t, true
+	}
+	if i := strings.LastIndexByte(field, '.'); i > 0 {
+		t, ok := f[field[:i]+".*"]
+		return t, ok
+	}
+	return "", false
+}
+
+// exprFieldRegex matches the names of fields, which are lowercase.
+var exprFieldRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z0-9_]+)*$`)
+
+// exprOperators holds the operators supported by each type of field, along
+// with the type of the value they expect.
+var exprOperators = map[exprFieldType]map[ExprOperator]ExprValueType{
+	exprFieldString: {
+		ExprEquals:       ExprValueString,
+		ExprNotEquals:    ExprValueString,
+		ExprRegexMatch:   ExprValueString,
+		ExprPrefixMatch:  ExprValueString,
+		ExprPostfixMatch: ExprValueString,
+		ExprContains:     ExprValueString,
+	},
+	exprFieldInt: {
+		ExprEquals:         ExprValueInt,
+		ExprNotEquals:      ExprValueInt,
+		ExprGreater:        ExprValueInt,
+		ExprGreaterOrEqual: ExprValueInt,
+		ExprLess:           ExprValueInt,
+		ExprLessOrEqual:    ExprValueInt,
+	},
+	exprFieldIPAddr: {
+		ExprEquals:    ExprValueIP,
+		ExprNotEquals: ExprValueIP,
+		ExprIn:        ExprValueCIDR,
+		ExprNotIn:     ExprValueCIDR,
+	},
+}
+
+// validateExpr checks that e only uses fields, operators and values
+// supported by fields.
+func validateExpr(e Expr, fields exprFields) error {
+	switch e := e.(type) {
+	case *ExprPredicate:
+		return validateExprPredicate(e, fields)
+	case *ExprLogical:
+		if e.Op != ExprAndOperator && e.Op != ExprOrOperator {
+			return fmt.Errorf("unknown logical operator %q", e.Op)
+		}
+		if len(e.Operands) == 0 {
+			return fmt.Errorf("%s expression without operands", e.Op)
+		}
+		for _, operand := range e.Operands {
+			if err := validateExpr(operand, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *ExprNegation:
+		if e.Operand == nil {
+			return fmt.Errorf("negation without operand")
+		}
+		return validateExpr(e.Operand, fields)
+	case nil:
+		return fmt.Errorf("expression cannot be nil")
+	}
+	return fmt.Errorf("unsupported expression %T", e)
+}
+
+func validateExprPredicate(p *ExprPredicate, fields exprFields) error {
+	if err := validateExprOperator(p, fields); err != nil {
+		return err
+	}
+	return validateExprValue(p, fields)
+}
+
+// validateExprOperator checks the field of p and that its operator applies to
+// the field.
+func validateExprOperator(p *ExprPredicate, fields exprFields) error {
+	if !exprFieldRegex.MatchString(p.Field) {
+		return fmt.Errorf("invalid field %q: fields are lowercase and separated by dots", p.Field)
+	}
+	fieldType, ok := fields.lookup(p.Field)
+	if !ok {
+		return fmt.Errorf("unknown field %q", p.Field)
+	}
+	if p.Lower && fieldType != exprFieldString {
+		return fmt.Errorf("lower() cannot be applied to field %q of type %s", p.Field, fieldType)
+	}
+	if _, ok := exprOperators[fieldType][p.Op]; !ok {
+		return fmt.Errorf("operator %q cannot be applied to field %q of type %s", p.Op, p.Field, fieldType)
+	}
+	return nil
+}
+
+// validateExprValue checks the value of p, whose operator is valid.
+func validateExprValue(p *ExprPredicate, fields exprFields) error {
+	fieldType, _ := fields.lookup(p.Field)
+	if want := exprOperators[fieldType][p.Op]; p.Value.Type != want {
+		return fmt.Errorf("%s %s expects a value of type %s, got %s", p.Field, p.Op, want, p.Value.Type)
+	}
+	if p.Op == ExprRegexMatch {
+		if _, err := regexp.Compile(p.Value.Str); err != nil {
+			return fmt.Errorf("invalid regex %s: %w", p.Value, err)
+		}
+	}
+	return nil
+}
+
+// ExpressionError is returned when parsing an invalid expression.
+type ExpressionError struct {
+	Expression string
+	// Pos is the byte offset of the error in Expression.
+	Pos int
+	Msg string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("invalid expression: %s at offset %d", e.Msg, e.Pos)
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenWord
+	exprTokenString
+	exprTokenOperator
+	exprTokenAnd
+	exprTokenOr
+	exprTokenNot
+	exprTokenLeftParen
+	exprTokenRightParen
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	// text is the token as written, or the decoded value of strings.
+	text string
+	raw  bool
+	pos  int
+}
+
+func (t exprToken) String() string {
+	switch t.kind {
+	case exprTokenEOF:
+		return "end of expression"
+	case exprTokenString:
+		return "string"
+	}
+	return strconv.Quote(t.text)
+}
+
+// exprParser is a recursive descent parser of expressions:
+//
+//	or        = and { "||" and }
+//	and       = unary { "&&" unary }
+//	unary     = "!" "(" or ")" | "(" or ")" | predicate
+//	predicate = ( field | "lower" "(" field ")" ) operator value
+type exprParser struct {
+	input  string
+	pos    int
+	fields exprFields
+	token  exprToken
+}
+
+// parseExpr parses expression and validates it against fields.
+func parseExpr(expression string, fields exprFields) (Expr, error) {
+	p := &exprParser{input: expression, fields: fields}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.token.kind == exprTokenEOF {
+		return nil, p.errorf(p.token.pos, "empty expression")
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.token.kind != exprTokenEOF {
+		return nil, p.errorf(p.token.pos, "unexpected %s", p.token)
+	}
+	return e, nil
+}
+
+func (p *exprParser) errorf(pos int, format string, args ...interface{}) error {
+	return &ExpressionError{Expression: p.input, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *exprParser) parseOr() (Expr, error) {
+	return p.parseLogical(ExprOrOperator, exprTokenOr, p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (Expr, error) {
+	return p.parseLogical(ExprAndOperator, exprTokenAnd, p.parseUnary)
+}
+
+func (p *exprParser) parseLogical(op ExprLogicalOperator, kind exprTokenKind,
+	parseOperand func() (Expr, error),
+) (Expr, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []Expr{operand}
+	for p.token.kind == kind {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	return exprLogical(op, operands), nil
+}
+
+func (p *exprParser) parseUnary() (Expr, error) {
+	switch p.token.kind {
+	case exprTokenNot:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind != exprTokenLeftParen {
+			return nil, p.errorf(p.token.pos, "expected \"(\" after \"!\", got %s", p.token)
+		}
+		e, err := p.parseParenthesized()
+		if err != nil {
+			return nil, err
+		}
+		return &ExprNegation{Operand: e}, nil
+	case exprTokenLeftParen:
+		return p.parseParenthesized()
+	}
+	return p.parsePredicate()
+}
+
+func (p *exprParser) parseParenthesized() (Expr, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(exprTokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (p *exprParser) expect(kind exprTokenKind, text string) error {
+	if p.token.kind != kind {
+		return p.errorf(p.token.pos, "expected %q, got %s", text, p.token)
+	}
+	return p.next()
+}
+
+func (p *exprParser) parsePredicate() (Expr, error) {
+	start := p.token.pos
+	if p.token.kind != exprTokenWord {
+		return nil, p.errorf(p.token.pos, "expected a field, got %s", p.token)
+	}
+	predicate := &ExprPredicate{Field: p.token.text}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if predicate.Field == "lower" && p.token.kind == exprTokenLeftParen {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind != exprTokenWord {
+			return nil, p.errorf(p.token.pos, "expected a field, got %s", p.token)
+		}
+		predicate.Field = p.token.text
+		predicate.Lower = true
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(exprTokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case p.token.kind == exprTokenOperator:
+		predicate.Op = ExprOperator(p.token.text)
+	case p.token.kind == exprTokenWord && (p.token.text == "in" || p.token.text == "contains"):
+		predicate.Op = ExprOperator(p.token.text)
+	case p.token.kind == exprTokenWord && p.token.text == "not":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind != exprTokenWord || p.token.text != "in" {
+			return nil, p.errorf(p.token.pos, "expected \"in\" after \"not\", got %s", p.token)
+		}
+		predicate.Op = ExprNotIn
+	default:
+		return nil, p.errorf(p.token.pos, "expected an operator, got %s", p.token)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	valuePos := p.token.pos
+	switch p.token.kind {
+	case exprTokenString:
+		predicate.Value = ExprValue{Type: ExprValueString, Str: p.token.text, Raw: p.token.raw}
+	case exprTokenWord:
+		value, ok := parseExprValue(p.token.text)
+		if !ok {
+			return nil, p.errorf(valuePos, "invalid value %s", p.token)
+		}
+		predicate.Value = value
+	default:
+		return nil, p.errorf(valuePos, "expected a value, got %s", p.token)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if err := validateExprOperator(predicate, p.fields); err != nil {
+		return nil, p.errorf(start, "%v", err)
+	}
+	if err := validateExprValue(predicate, p.fields); err != nil {
+		return nil, p.errorf(valuePos, "%v", err)
+	}
+	return predicate, nil
+}
+
+// parseExprValue parses the unquoted values: integers, IP addresses and
+// CIDRs.
+func parseExprValue(s string) (ExprValue, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ExprInt(i), true
+	}
+	if strings.Contains(s, "/") {
+		if prefix, err := netip.ParsePrefix(s); err == nil {
+			return ExprCIDR(prefix), true
+		}
+		return ExprValue{}, false
+	}
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return ExprIP(addr), true
+	}
+	return ExprValue{}, false
+}
+
+func isExprWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '.' || c == ':' || c == '/' || c == '-' || c == '*'
+}
+
+// next reads the next token of the input.
+func (p *exprParser) next() error {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	token := func(kind exprTokenKind, length int) error {
+		p.token = exprToken{kind: kind, text: p.input[start : start+length], pos: start}
+		p.pos = start + length
+		return nil
+	}
+	if p.pos == len(p.input) {
+		return token(exprTokenEOF, 0)
+	}
+
+	rest := p.input[p.pos:]
+	for _, op := range []string{"&&", "||", "==", "!=", "=^", "^=", ">=", "<="} {
+		if strings.HasPrefix(rest, op) {
+			switch op {
+			case "&&":
+				return token(exprTokenAnd, 2)
+			case "||":
+				return token(exprTokenOr, 2)
+			}
+			return token(exprTokenOperator, 2)
+		}
+	}
+	switch c := rest[0]; {
+	case c == '(':
+		return token(exprTokenLeftParen, 1)
+	case c == ')':
+		return token(exprTokenRightParen, 1)
+	case c == '!':
+		return token(exprTokenNot, 1)
+	case c == '~' || c == '>' || c == '<':
+		return token(exprTokenOperator, 1)
+	case c == '"':
+		return p.readString()
+	case strings.HasPrefix(rest, `r#"`):
+		end := strings.Index(rest[3:], `"#`)
+		if end < 0 {
+			return p.errorf(start, "unterminated raw string")
+		}
+		p.token = exprToken{kind: exprTokenString, text: rest[3 : 3+end], raw: true, pos: start}
+		p.pos = start + 3 + end + 2
+		return nil
+	case isExprWordByte(c):
+		length := 1
+		for length < len(rest) && isExprWordByte(rest[length]) {
+			length++
+		}
+		return token(exprTokenWord, length)
+	}
+	return p.errorf(start, "unexpected character %q", rest[0])
+}
+
+// readString reads a double-quoted string.
+func (p *exprParser) readString() error {
+	start := p.pos
+	var b strings.Builder
+	for i := start + 1; i < len(p.input); i++ {
+		switch c := p.input[i]; c {
+		case '"':
+			p.token = exprToken{kind: exprTokenString, text: b.String(), pos: start}
+			p.pos = i + 1
+			return nil
+		case '\\':
+			i++
+			if i == len(p.input) {
+				return p.errorf(start, "unterminated string")
+			}
+			switch p.input[i] {
+			case '"', '\\':
+				b.WriteByte(p.input[i])
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				return p.errorf(i-1, "invalid escape sequence \\%c", p.input[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return p.errorf(start, "unterminated string")
+}
@@ -174,6 +174,437 @@ func (p *Plugin) FillID(workspace string) error {
 	return nil
 }
 
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for Upstream is Upstream.Name.
+func (u *Upstream) FillID(workspace string) error {
+	if u == nil {
+		return fmt.Errorf("upstream is nil")
+	}
+	if u.ID != nil && len(*u.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if u.Name == nil || len(*u.Name) == 0 {
+		return fmt.Errorf("upstream name is required")
+	}
+
+	gen, err := idGeneratorFor(u)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	u.ID = gen.buildIDFor(workspace, *u.Name)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for Target is the combination of Target.Target and the name of Target.Upstream,
+// as targets are unique per upstream.
+func (t *Target) FillID(workspace string) error {
+	if t == nil {
+		return fmt.Errorf("target is nil")
+	}
+	if t.ID != nil && len(*t.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if t.Target == nil || len(*t.Target) == 0 {
+		return fmt.Errorf("target target is required")
+	}
+	if t.Upstream == nil || len(t.Upstream.FriendlyName()) == 0 {
+		return fmt.Errorf("target upstream is required")
+	}
+
+	gen, err := idGeneratorFor(t)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	t.ID = gen.buildIDFor(workspace, *t.Target+":upstream/"+t.Upstream.FriendlyName())
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// Certificates have no endpoint key, the name used to generate the ID for Certificate is Certificate.Cert.
+func (c *Certificate) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("certificate is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Cert == nil || len(*c.Cert) == 0 {
+		return fmt.Errorf("certificate cert is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Cert)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for CACertificate is CACertificate.Cert.
+func (c *CACertificate) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("ca certificate is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Cert == nil || len(*c.Cert) == 0 {
+		return fmt.Errorf("ca certificate cert is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Cert)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for SNI is SNI.Name.
+func (s *SNI) FillID(workspace string) error {
+	if s == nil {
+		return fmt.Errorf("sni is nil")
+	}
+	if s.ID != nil && len(*s.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if s.Name == nil || len(*s.Name) == 0 {
+		return fmt.Errorf("sni name is required")
+	}
+
+	gen, err := idGeneratorFor(s)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	s.ID = gen.buildIDFor(workspace, *s.Name)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for Key is Key.KID, combined with the name of Key.Set when the key
+// belongs to a set, as key IDs are unique per key set.
+func (k *Key) FillID(workspace string) error {
+	if k == nil {
+		return fmt.Errorf("key is nil")
+	}
+	if k.ID != nil && len(*k.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if k.KID == nil || len(*k.KID) == 0 {
+		return fmt.Errorf("key kid is required")
+	}
+
+	key := *k.KID
+	if k.Set != nil {
+		setName := keySetFriendlyName(k.Set)
+		if len(setName) == 0 {
+			return fmt.Errorf("key set name or id is required")
+		}
+		key += ":set/" + setName
+	}
+
+	gen, err := idGeneratorFor(k)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	k.ID = gen.buildIDFor(workspace, key)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for KeySet is KeySet.Name.
+func (ks *KeySet) FillID(workspace string) error {
+	if ks == nil {
+		return fmt.Errorf("key set is nil")
+	}
+	if ks.ID != nil && len(*ks.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if ks.Name == nil || len(*ks.Name) == 0 {
+		return fmt.Errorf("key set name is required")
+	}
+
+	gen, err := idGeneratorFor(ks)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	ks.ID = gen.buildIDFor(workspace, *ks.Name)
+	return nil
+}
+
+func keySetFriendlyName(ks *KeySet) string {
+	if ks.Name != nil {
+		return *ks.Name
+	}
+	if ks.ID != nil {
+		return *ks.ID
+	}
+	return ""
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for Partial is Partial.Name.
+func (p *Partial) FillID(workspace string) error {
+	if p == nil {
+		return fmt.Errorf("partial is nil")
+	}
+	if p.ID != nil && len(*p.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if p.Name == nil || len(*p.Name) == 0 {
+		return fmt.Errorf("partial name is required")
+	}
+
+	gen, err := idGeneratorFor(p)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	p.ID = gen.buildIDFor(workspace, *p.Name)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for FilterChain is FilterChain.Name when set. Otherwise, as a service or
+// a route can have a single filter chain, the name of FilterChain.Service or FilterChain.Route is used.
+func (f *FilterChain) FillID(workspace string) error {
+	if f == nil {
+		return fmt.Errorf("filter chain is nil")
+	}
+	if f.ID != nil && len(*f.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+
+	var key string
+	switch {
+	case f.Name != nil && len(*f.Name) > 0:
+		key = *f.Name
+	case f.Service != nil && len(f.Service.FriendlyName()) > 0:
+		key = "service/" + f.Service.FriendlyName()
+	case f.Route != nil && len(f.Route.FriendlyName()) > 0:
+		key = "route/" + f.Route.FriendlyName()
+	default:
+		return fmt.Errorf("filter chain name, service or route is required")
+	}
+
+	gen, err := idGeneratorFor(f)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	f.ID = gen.buildIDFor(workspace, key)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for KeyAuth is KeyAuth.Key.
+func (c *KeyAuth) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("key-auth credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Key == nil || len(*c.Key) == 0 {
+		return fmt.Errorf("key-auth credential key is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Key)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for BasicAuth is BasicAuth.Username.
+func (c *BasicAuth) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("basic-auth credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Username == nil || len(*c.Username) == 0 {
+		return fmt.Errorf("basic-auth credential username is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Username)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for HMACAuth is HMACAuth.Username.
+func (c *HMACAuth) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("hmac-auth credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Username == nil || len(*c.Username) == 0 {
+		return fmt.Errorf("hmac-auth credential username is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Username)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for JWTAuth is JWTAuth.Key.
+func (c *JWTAuth) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("jwt credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Key == nil || len(*c.Key) == 0 {
+		return fmt.Errorf("jwt credential key is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Key)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for MTLSAuth is the combination of MTLSAuth.SubjectName, the name of
+// MTLSAuth.Consumer and, when set, the ID or cert of MTLSAuth.CACertificate.
+func (c *MTLSAuth) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("mtls-auth credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.SubjectName == nil || len(*c.SubjectName) == 0 {
+		return fmt.Errorf("mtls-auth credential subject name is required")
+	}
+	if c.Consumer == nil || len(c.Consumer.FriendlyName()) == 0 {
+		return fmt.Errorf("mtls-auth credential consumer is required")
+	}
+
+	key := *c.SubjectName + ":consumer/" + c.Consumer.FriendlyName()
+	if c.CACertificate != nil {
+		key += ":ca_certificate/" + c.CACertificate.FriendlyName()
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, key)
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for ACLGroup is the combination of ACLGroup.Group and the name of
+// ACLGroup.Consumer, as groups are unique per consumer.
+func (c *ACLGroup) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("acl group is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.Group == nil || len(*c.Group) == 0 {
+		return fmt.Errorf("acl group group is required")
+	}
+	if c.Consumer == nil || len(c.Consumer.FriendlyName()) == 0 {
+		return fmt.Errorf("acl group consumer is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.Group+":consumer/"+c.Consumer.FriendlyName())
+	return nil
+}
+
+// FillID fills the ID of an entity. It is a no-op if the entity already has an ID.
+// ID is generated in a deterministic way using UUIDv5. The UUIDv5 namespace is different for each entity type.
+// The name used to generate the ID for Oauth2Credential is Oauth2Credential.ClientID.
+func (c *Oauth2Credential) FillID(workspace string) error {
+	if c == nil {
+		return fmt.Errorf("oauth2 credential is nil")
+	}
+	if c.ID != nil && len(*c.ID) > 0 {
+		// ID already set, do nothing.
+		return nil
+	}
+	if c.ClientID == nil || len(*c.ClientID) == 0 {
+		return fmt.Errorf("oauth2 credential client id is required")
+	}
+
+	gen, err := idGeneratorFor(c)
+	if err != nil {
+		return fmt.Errorf("could not get id generator: %w", err)
+	}
+
+	c.ID = gen.buildIDFor(workspace, *c.ClientID)
+	return nil
+}
+
 var (
 	// _kongEntitiesNamespace is the UUIDv5 namespace used to generate IDs for Kong entities.
 	_kongEntitiesNamespace = uuid.MustParse("fd02801f-0957-4a15-a55a-c8d9606f30b5")
@@ -189,6 +620,23 @@ var (
 		reflect.TypeOf(ConsumerGroup{}): newIDGeneratorFor("consumergroups"),
 		reflect.TypeOf(Vault{}):         newIDGeneratorFor("vaults"),
 		reflect.TypeOf(Plugin{}):        newIDGeneratorFor("plugins"),
+		reflect.TypeOf(Upstream{}):      newIDGeneratorFor("upstreams"),
+		reflect.TypeOf(Target{}):        newIDGeneratorFor("targets"),
+		reflect.TypeOf(Certificate{}):   newIDGeneratorFor("certificates"),
+		reflect.TypeOf(CACertificate{}): newIDGeneratorFor("ca_certificates"),
+		reflect.TypeOf(SNI{}):           newIDGeneratorFor("snis"),
+		reflect.TypeOf(Key{}):           newIDGeneratorFor("keys"),
+		reflect.TypeOf(KeySet{}):        newIDGeneratorFor("key_sets"),
+		reflect.TypeOf(Partial{}):       newIDGeneratorFor("partials"),
+		reflect.TypeOf(FilterChain{}):   newIDGeneratorFor("filter_chains"),
+
+		reflect.TypeOf(KeyAuth{}):          newIDGeneratorFor("keyauth_credentials"),
+		reflect.TypeOf(BasicAuth{}):        newIDGeneratorFor("basicauth_credentials"),
+		reflect.TypeOf(HMACAuth{}):         newIDGeneratorFor("hmacauth_credentials"),
+		reflect.TypeOf(JWTAuth{}):          newIDGeneratorFor("jwt_secrets"),
+		reflect.TypeOf(MTLSAuth{}):         newIDGeneratorFor("mtls_auth_credentials"),
+		reflect.TypeOf(ACLGroup{}):         newIDGeneratorFor("acls"),
+		reflect.TypeOf(Oauth2Credential{}): newIDGeneratorFor("oauth2_credentials"),
 	}
 )
 
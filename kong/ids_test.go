@@ -271,6 +271,403 @@ func TestFillEntityID(t *testing.T) {
 				require.Equal(t, expectedID, *p.ID, "ID should be deterministic")
 			},
 		},
+		// Upstream
+		{
+			name:      "upstream nil pointer",
+			entity:    (*kong.Upstream)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "upstream with nil name",
+			entity:    &kong.Upstream{},
+			expectErr: true,
+		},
+		{
+			name: "upstream with name",
+			entity: &kong.Upstream{
+				Name: kong.String("upstream-1"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				u := e.(*kong.Upstream)
+				require.NotNil(t, u.ID)
+
+				const expectedID = "d0e602fd-0757-5159-b67c-b19e163375d1"
+				require.Equal(t, expectedID, *u.ID, "ID should be deterministic")
+			},
+		},
+		// Target
+		{
+			name:      "target nil pointer",
+			entity:    (*kong.Target)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "target without upstream",
+			entity:    &kong.Target{Target: kong.String("10.0.0.1:80")},
+			expectErr: true,
+		},
+		{
+			name: "target with target and upstream",
+			entity: &kong.Target{
+				Target: kong.String("10.0.0.1:80"),
+				Upstream: &kong.Upstream{
+					Name: kong.String("upstream-1"),
+				},
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				tg := e.(*kong.Target)
+				require.NotNil(t, tg.ID)
+
+				const expectedID = "0294c67c-6ccf-5923-8455-c04a413e5ade"
+				require.Equal(t, expectedID, *tg.ID, "ID should be deterministic")
+			},
+		},
+		// Certificate
+		{
+			name:      "certificate nil pointer",
+			entity:    (*kong.Certificate)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "certificate with nil cert",
+			entity:    &kong.Certificate{},
+			expectErr: true,
+		},
+		{
+			name: "certificate with cert",
+			entity: &kong.Certificate{
+				Cert: kong.String("cert-pem"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.Certificate)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "99d6154f-d835-5709-8f23-fe2c9c6fac56"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		// CA Certificate
+		{
+			name:      "ca certificate nil pointer",
+			entity:    (*kong.CACertificate)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "ca certificate with nil cert",
+			entity:    &kong.CACertificate{},
+			expectErr: true,
+		},
+		{
+			name: "ca certificate with cert",
+			entity: &kong.CACertificate{
+				Cert: kong.String("ca-cert-pem"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.CACertificate)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "1a6f0c3e-19f7-582a-af78-f01506ecdd14"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		// SNI
+		{
+			name:      "sni nil pointer",
+			entity:    (*kong.SNI)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "sni with nil name",
+			entity:    &kong.SNI{},
+			expectErr: true,
+		},
+		{
+			name: "sni with name",
+			entity: &kong.SNI{
+				Name: kong.String("example.com"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				s := e.(*kong.SNI)
+				require.NotNil(t, s.ID)
+
+				const expectedID = "1944977b-55fa-5e55-99c8-9160e78bbbb4"
+				require.Equal(t, expectedID, *s.ID, "ID should be deterministic")
+			},
+		},
+		// Key
+		{
+			name:      "key nil pointer",
+			entity:    (*kong.Key)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "key with nil kid",
+			entity:    &kong.Key{},
+			expectErr: true,
+		},
+		{
+			name:      "key with unnamed set",
+			entity:    &kong.Key{KID: kong.String("kid-1"), Set: &kong.KeySet{}},
+			expectErr: true,
+		},
+		{
+			name: "key with kid and set",
+			entity: &kong.Key{
+				KID: kong.String("kid-1"),
+				Set: &kong.KeySet{
+					Name: kong.String("set-1"),
+				},
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				k := e.(*kong.Key)
+				require.NotNil(t, k.ID)
+
+				const expectedID = "05b384f3-d279-53b0-a056-ab1b4df91635"
+				require.Equal(t, expectedID, *k.ID, "ID should be deterministic")
+			},
+		},
+		// Key Set
+		{
+			name:      "key set nil pointer",
+			entity:    (*kong.KeySet)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "key set with nil name",
+			entity:    &kong.KeySet{},
+			expectErr: true,
+		},
+		{
+			name: "key set with name",
+			entity: &kong.KeySet{
+				Name: kong.String("set-1"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				ks := e.(*kong.KeySet)
+				require.NotNil(t, ks.ID)
+
+				const expectedID = "1a291367-9aee-50bb-91e3-a7cd920ead8a"
+				require.Equal(t, expectedID, *ks.ID, "ID should be deterministic")
+			},
+		},
+		// Partial
+		{
+			name:      "partial nil pointer",
+			entity:    (*kong.Partial)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "partial with nil name",
+			entity:    &kong.Partial{},
+			expectErr: true,
+		},
+		{
+			name: "partial with name",
+			entity: &kong.Partial{
+				Name: kong.String("redis-ee"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				p := e.(*kong.Partial)
+				require.NotNil(t, p.ID)
+
+				const expectedID = "8f42f699-9bdf-5cc1-8ad6-727ea68bf803"
+				require.Equal(t, expectedID, *p.ID, "ID should be deterministic")
+			},
+		},
+		// Filter Chain
+		{
+			name:      "filter chain nil pointer",
+			entity:    (*kong.FilterChain)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "filter chain without name, service and route",
+			entity:    &kong.FilterChain{},
+			expectErr: true,
+		},
+		{
+			name: "filter chain with service",
+			entity: &kong.FilterChain{
+				Service: &kong.Service{
+					Name: kong.String("service-1"),
+				},
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				f := e.(*kong.FilterChain)
+				require.NotNil(t, f.ID)
+
+				const expectedID = "e983a2fa-af52-5663-bf70-de9d00761e99"
+				require.Equal(t, expectedID, *f.ID, "ID should be deterministic")
+			},
+		},
+		// Credentials
+		{
+			name:      "key-auth credential nil pointer",
+			entity:    (*kong.KeyAuth)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "key-auth credential with nil key",
+			entity:    &kong.KeyAuth{},
+			expectErr: true,
+		},
+		{
+			name: "key-auth credential with key",
+			entity: &kong.KeyAuth{
+				Key: kong.String("secret-key"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.KeyAuth)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "9e955a62-9ca2-57d3-aa61-64223fd765ca"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "basic-auth credential nil pointer",
+			entity:    (*kong.BasicAuth)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "basic-auth credential with nil username",
+			entity:    &kong.BasicAuth{},
+			expectErr: true,
+		},
+		{
+			name: "basic-auth credential with username",
+			entity: &kong.BasicAuth{
+				Username: kong.String("user-1"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.BasicAuth)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "0e3a417a-1049-50a2-b65c-432620f1c1f7"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "hmac-auth credential nil pointer",
+			entity:    (*kong.HMACAuth)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "hmac-auth credential with nil username",
+			entity:    &kong.HMACAuth{},
+			expectErr: true,
+		},
+		{
+			name: "hmac-auth credential with username",
+			entity: &kong.HMACAuth{
+				Username: kong.String("user-1"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.HMACAuth)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "641f118f-83e6-50d3-b8bc-1cddf1f7abe2"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "jwt credential nil pointer",
+			entity:    (*kong.JWTAuth)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "jwt credential with nil key",
+			entity:    &kong.JWTAuth{},
+			expectErr: true,
+		},
+		{
+			name: "jwt credential with key",
+			entity: &kong.JWTAuth{
+				Key: kong.String("jwt-key"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.JWTAuth)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "209edd9d-7a0a-5627-b2d7-5863cd33707a"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "mtls-auth credential nil pointer",
+			entity:    (*kong.MTLSAuth)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "mtls-auth credential without consumer",
+			entity:    &kong.MTLSAuth{SubjectName: kong.String("example.com")},
+			expectErr: true,
+		},
+		{
+			name: "mtls-auth credential with subject name and consumer",
+			entity: &kong.MTLSAuth{
+				SubjectName: kong.String("example.com"),
+				Consumer: &kong.Consumer{
+					Username: kong.String("consumer-1"),
+				},
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.MTLSAuth)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "521933b5-29de-5b2f-95a6-7c784d26e5ee"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "acl group nil pointer",
+			entity:    (*kong.ACLGroup)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "acl group without consumer",
+			entity:    &kong.ACLGroup{Group: kong.String("admins")},
+			expectErr: true,
+		},
+		{
+			name: "acl group with group and consumer",
+			entity: &kong.ACLGroup{
+				Group: kong.String("admins"),
+				Consumer: &kong.Consumer{
+					Username: kong.String("consumer-1"),
+				},
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.ACLGroup)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "ff3a8eff-92fc-5fc6-9456-ed5f873aea38"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
+		{
+			name:      "oauth2 credential nil pointer",
+			entity:    (*kong.Oauth2Credential)(nil),
+			expectErr: true,
+		},
+		{
+			name:      "oauth2 credential with nil client id",
+			entity:    &kong.Oauth2Credential{},
+			expectErr: true,
+		},
+		{
+			name: "oauth2 credential with client id",
+			entity: &kong.Oauth2Credential{
+				ClientID: kong.String("client-1"),
+			},
+			assertEntity: func(t *testing.T, e kong.IDFillable) {
+				c := e.(*kong.Oauth2Credential)
+				require.NotNil(t, c.ID)
+
+				const expectedID = "9062c4f1-3ae3-5728-b0ef-a35917d32840"
+				require.Equal(t, expectedID, *c.ID, "ID should be deterministic")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,27 @@
+package kongfake
+
+import (
+	"net/http"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+// backendURL is the base URL of the Clients built by NewClient. Their
+// requests never leave the process.
+const backendURL = "http://kongfake.invalid:8001"
+
+// NewClient returns a Client whose services are all fakes, and the fakes.
+// Calls which aren't programmed fall back to the regular services of the
+// Client, talking in-process to an in-memory fake of the Admin API
+// configured with opts. Set the Fallback of a fake to nil to make such
+// calls fail instead.
+func NewClient(opts ...kongtest.Option) (*kong.Client, *Fakes, error) {
+	client, err := kong.NewClient(kong.String(backendURL), &http.Client{
+		Transport: kongtest.NewHandler(opts...),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, wireFakes(client), nil
+}
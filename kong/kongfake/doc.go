@@ -0,0 +1,9 @@
+// Package kongfake provides fakes of the Abstract*Service interfaces of the
+// kong package, recording calls and returning programmed results.
+//
+// Calls which aren't programmed are served by a fallback service, which is
+// backed by an in-memory Admin API for the fakes of a Client built by
+// NewClient.
+package kongfake
+
+//go:generate go run ./internal/fakegen -source .. -output zz_generated.fakes.go
@@ -0,0 +1,513 @@
+// Command fakegen generates the fakes of the kongfake package from the
+// Abstract*Service interfaces of the kong package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const kongImportPath = "github.com/kong/go-kong/kong"
+
+var serviceInterfaceRe = regexp.MustCompile(`^Abstract\w+Service$`)
+
+// reservedNames are the identifiers used by the generated methods, which
+// can't be used as parameter names.
+var reservedNames = map[string]bool{
+	"f": true, "call": true,
+}
+
+func main() {
+	source := flag.String("source", "..", "directory of the kong package")
+	output := flag.String("output", "zz_generated.fakes.go", "file to write the fakes to")
+	flag.Parse()
+
+	src, err := generate(*source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name, typ string
+	variadic  bool
+}
+
+type fake struct {
+	iface   string
+	base    string
+	methods []method
+}
+
+type clientField struct {
+	name string
+	fake *fake
+}
+
+type generator struct {
+	imports map[string]string
+	used    map[string]bool
+}
+
+func generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{imports: map[string]string{}, used: map[string]bool{}}
+
+	var files []*ast.File
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if file.Name.Name != "kong" {
+			continue
+		}
+		files = append(files, file)
+		for _, imp := range file.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			name := filepath.Base(path)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			g.imports[name] = path
+		}
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	var client *ast.StructType
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.TypeSpec)
+				switch t := spec.Type.(type) {
+				case *ast.InterfaceType:
+					if serviceInterfaceRe.MatchString(spec.Name.Name) {
+						interfaces[spec.Name.Name] = t
+					}
+				case *ast.StructType:
+					if spec.Name.Name == "Client" {
+						client = t
+					}
+				}
+			}
+		}
+	}
+	if client == nil {
+		return nil, fmt.Errorf("type Client not found in %s", dir)
+	}
+
+	names := make([]string, 0, len(interfaces))
+	for name := range interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fakes := map[string]*fake{}
+	for _, name := range names {
+		f, err := g.fake(name, interfaces[name])
+		if err != nil {
+			return nil, err
+		}
+		fakes[name] = f
+	}
+
+	var fields []clientField
+	for _, field := range client.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || fakes[ident.Name] == nil {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.IsExported() {
+				fields = append(fields, clientField{name: name.Name, fake: fakes[ident.Name]})
+			}
+		}
+	}
+
+	var b bytes.Buffer
+	g.writeHeader(&b)
+	for _, name := range names {
+		g.writeFake(&b, fakes[name])
+	}
+	writeFakes(&b, fields)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.String())
+	}
+	return src, nil
+}
+
+func (g *generator) fake(name string, iface *ast.InterfaceType) (*fake, error) {
+	f := &fake{iface: name, base: strings.TrimPrefix(name, "Abstract")}
+	generated := map[string]bool{"Reset": true}
+	for _, m := range iface.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf("%s: embedded interfaces are not supported", name)
+		}
+		for _, n := range m.Names {
+			method := method{name: n.Name}
+			for i, p := range fn.Params.List {
+				typ, err := g.typeString(p.Type)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", name, n.Name, err)
+				}
+				_, variadic := p.Type.(*ast.Ellipsis)
+				if len(p.Names) == 0 {
+					method.params = append(method.params, param{fmt.Sprintf("arg%d", i), typ, variadic})
+				}
+				for _, pn := range p.Names {
+					if reservedNames[pn.Name] {
+						return nil, fmt.Errorf("%s.%s: parameter name %q is reserved", name, n.Name, pn.Name)
+					}
+					method.params = append(method.params, param{pn.Name, typ, variadic})
+				}
+			}
+			if fn.Results != nil {
+				for _, r := range fn.Results.List {
+					typ, err := g.typeString(r.Type)
+					if err != nil {
+						return nil, fmt.Errorf("%s.%s: %w", name, n.Name, err)
+					}
+					for range max(len(r.Names), 1) {
+						method.results = append(method.results, typ)
+					}
+				}
+			}
+			f.methods = append(f.methods, method)
+		}
+	}
+	for _, m := range f.methods {
+		generated[m.name+"CallCount"] = true
+		generated[m.name+"Calls"] = true
+		generated[m.name+"ArgsForCall"] = true
+		generated[m.name+"Stub"] = true
+		generated[m.name+"Returns"] = true
+		generated[m.name+"ReturnsOnCall"] = true
+	}
+	for _, m := range f.methods {
+		if generated[m.name] {
+			return nil, fmt.Errorf("%s.%s conflicts with a generated method", name, m.name)
+		}
+	}
+	return f, nil
+}
+
+// typeString returns the Go source of a type of the kong package, as seen
+// from another package.
+func (g *generator) typeString(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name, nil
+		}
+		if !t.IsExported() {
+			return "", fmt.Errorf("unexported type %s", t.Name)
+		}
+		g.used["kong"] = true
+		return "kong." + t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok || g.imports[pkg.Name] == "" {
+			return "", fmt.Errorf("unsupported type selector")
+		}
+		g.used[pkg.Name] = true
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := g.typeString(t.X)
+		return "*" + s, err
+	case *ast.Ellipsis:
+		s, err := g.typeString(t.Elt)
+		return "..." + s, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("arrays are not supported")
+		}
+		s, err := g.typeString(t.Elt)
+		return "[]" + s, err
+	case *ast.MapType:
+		k, err := g.typeString(t.Key)
+		if err != nil {
+			return "", err
+		}
+		v, err := g.typeString(t.Value)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+func (g *generator) writeHeader(b *bytes.Buffer) {
+	fmt.Fprintln(b, "// Code generated by fakegen. DO NOT EDIT.")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "package kongfake")
+	fmt.Fprintln(b)
+	var std, others []string
+	for pkg := range g.used {
+		path := g.imports[pkg]
+		if pkg == "kong" {
+			path = kongImportPath
+		}
+		if strings.Contains(path, ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	fmt.Fprintln(b, "import (")
+	for _, path := range std {
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	fmt.Fprintln(b)
+	for _, path := range others {
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	fmt.Fprintln(b, ")")
+}
+
+func (g *generator) writeFake(b *bytes.Buffer, f *fake) {
+	name := "Fake" + f.base
+
+	for _, m := range f.methods {
+		fmt.Fprintf(b, "\n// %s holds the arguments of a call to %s.%s.\n", callType(f, m), name, m.name)
+		fmt.Fprintf(b, "type %s struct {\n", callType(f, m))
+		for _, p := range m.params {
+			typ := p.typ
+			if p.variadic {
+				typ = "[]" + strings.TrimPrefix(typ, "...")
+			}
+			fmt.Fprintf(b, "%s %s\n", exported(p.name), typ)
+		}
+		fmt.Fprintln(b, "}")
+		fmt.Fprintf(b, "\ntype %s struct {\n", resultsType(f, m))
+		for i, r := range m.results {
+			fmt.Fprintf(b, "r%d %s\n", i, r)
+		}
+		fmt.Fprintln(b, "}")
+	}
+
+	fmt.Fprintf(b, "\n// %s is a fake implementation of kong.%s.\n", name, f.iface)
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	fmt.Fprintln(b, "// Fallback serves the calls which aren't programmed.")
+	fmt.Fprintln(b, "// Such calls fail with ErrNotProgrammed when it is nil.")
+	fmt.Fprintf(b, "Fallback kong.%s\n\n", f.iface)
+	for _, m := range f.methods {
+		fmt.Fprintf(b, "%s method[%s, %s, %s]\n", unexported(m.name), callType(f, m), resultsType(f, m), funcType(m))
+	}
+	fmt.Fprintln(b, "}")
+	fmt.Fprintf(b, "\nvar _ kong.%s = &%s{}\n", f.iface, name)
+
+	for _, m := range f.methods {
+		writeMethod(b, f, m)
+	}
+
+	fmt.Fprintf(b, "\n// Reset forgets the recorded calls and the programmed results.\n")
+	fmt.Fprintf(b, "func (f *%s) Reset() {\n", name)
+	for _, m := range f.methods {
+		fmt.Fprintf(b, "f.%s.reset()\n", unexported(m.name))
+	}
+	fmt.Fprintln(b, "}")
+}
+
+func writeMethod(b *bytes.Buffer, f *fake, m method) {
+	name := "Fake" + f.base
+	l := unexported(m.name)
+
+	var params, args, fields, resultParams, resultValues []string
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+		arg := p.name
+		if p.variadic {
+			arg += "..."
+		}
+		args = append(args, arg)
+		fields = append(fields, exported(p.name)+": "+p.name)
+	}
+	for i, r := range m.results {
+		resultParams = append(resultParams, fmt.Sprintf("r%d %s", i, r))
+		resultValues = append(resultValues, fmt.Sprintf("r%d: r%d", i, i))
+	}
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	programmedValues := make([]string, len(m.results))
+	notProgrammedValues := make([]string, len(m.results))
+	for i, r := range m.results {
+		programmedValues[i] = fmt.Sprintf("call.results.r%d", i)
+		notProgrammedValues[i] = programmedValues[i]
+		if r == "error" && i == len(m.results)-1 {
+			notProgrammedValues[i] = fmt.Sprintf("notProgrammed(%q)", name+"."+m.name)
+		}
+	}
+	ret := func(values []string) string {
+		if len(m.results) == 0 {
+			return "return"
+		}
+		return "return " + strings.Join(values, ", ")
+	}
+	delegate := func(target string) string {
+		c := fmt.Sprintf("%s(%s)", target, strings.Join(args, ", "))
+		if len(m.results) == 0 {
+			return c + "\nreturn"
+		}
+		return "return " + c
+	}
+
+	fmt.Fprintf(b, "\n// %s records the call and returns the programmed results.\n", m.name)
+	fmt.Fprintf(b, "func (f *%s) %s(%s) %s {\n", name, m.name, strings.Join(params, ", "), results)
+	fmt.Fprintf(b, "call := f.%s.record(%s{%s})\n", l, callType(f, m), strings.Join(fields, ", "))
+	fmt.Fprintln(b, "switch {")
+	fmt.Fprintln(b, "case call.programmed:")
+	fmt.Fprintln(b, ret(programmedValues))
+	fmt.Fprintln(b, "case call.stubbed:")
+	fmt.Fprintln(b, delegate("call.stub"))
+	fmt.Fprintln(b, "case f.Fallback != nil:")
+	fmt.Fprintln(b, delegate("f.Fallback."+m.name))
+	fmt.Fprintln(b, "}")
+	if len(m.results) > 0 {
+		fmt.Fprintln(b, ret(notProgrammedValues))
+	}
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sCallCount returns the number of calls to %s.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sCallCount() int {\n", name, m.name)
+	fmt.Fprintf(b, "return f.%s.callCount()\n", l)
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sCalls returns the arguments of the calls to %s.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sCalls() []%s {\n", name, m.name, callType(f, m))
+	fmt.Fprintf(b, "return f.%s.allCalls()\n", l)
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sArgsForCall returns the arguments of the i-th call to %s.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sArgsForCall(i int) %s {\n", name, m.name, callType(f, m))
+	fmt.Fprintf(b, "return f.%s.argsForCall(i)\n", l)
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sStub makes %s delegate to stub, or stop doing so if stub is nil.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sStub(stub %s) {\n", name, m.name, funcType(m))
+	fmt.Fprintf(b, "f.%s.setStub(stub, stub != nil)\n", l)
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sReturns makes %s return the given values.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sReturns(%s) {\n", name, m.name, strings.Join(resultParams, ", "))
+	fmt.Fprintf(b, "f.%s.setReturns(%s{%s})\n", l, resultsType(f, m), strings.Join(resultValues, ", "))
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintf(b, "\n// %sReturnsOnCall makes the i-th call to %s return the given values.\n", m.name, m.name)
+	fmt.Fprintf(b, "func (f *%s) %sReturnsOnCall(%s) {\n", name, m.name,
+		strings.Join(append([]string{"i int"}, resultParams...), ", "))
+	fmt.Fprintf(b, "f.%s.setReturnsOnCall(i, %s{%s})\n", l, resultsType(f, m), strings.Join(resultValues, ", "))
+	fmt.Fprintln(b, "}")
+}
+
+func writeFakes(b *bytes.Buffer, fields []clientField) {
+	fmt.Fprintln(b, "\n// Fakes holds the fakes wired in a Client built by NewClient.")
+	fmt.Fprintln(b, "type Fakes struct {")
+	for _, field := range fields {
+		fmt.Fprintf(b, "%s *Fake%s\n", field.name, field.fake.base)
+	}
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintln(b, "\n// wireFakes replaces the services of client with fakes falling back to them.")
+	fmt.Fprintln(b, "func wireFakes(client *kong.Client) *Fakes {")
+	fmt.Fprintln(b, "fakes := &Fakes{")
+	for _, field := range fields {
+		fmt.Fprintf(b, "%s: &Fake%s{Fallback: client.%s},\n", field.name, field.fake.base, field.name)
+	}
+	fmt.Fprintln(b, "}")
+	for _, field := range fields {
+		fmt.Fprintf(b, "client.%s = fakes.%s\n", field.name, field.name)
+	}
+	fmt.Fprintln(b, "return fakes")
+	fmt.Fprintln(b, "}")
+
+	fmt.Fprintln(b, "\n// Reset forgets the recorded calls and the programmed results of all fakes.")
+	fmt.Fprintln(b, "func (f *Fakes) Reset() {")
+	for _, field := range fields {
+		fmt.Fprintf(b, "f.%s.Reset()\n", field.name)
+	}
+	fmt.Fprintln(b, "}")
+}
+
+func callType(f *fake, m method) string {
+	return f.base + m.name + "Call"
+}
+
+func resultsType(f *fake, m method) string {
+	return unexported(f.base) + m.name + "Results"
+}
+
+func funcType(m method) string {
+	var params []string
+	for _, p := range m.params {
+		params = append(params, p.typ)
+	}
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	return fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), results)
+}
+
+func exported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// unexported lower-cases the leading upper-case letters of name, keeping
+// the last one of an initialism: "RBACRoleService" becomes "rbacRoleService".
+func unexported(name string) string {
+	r := []rune(name)
+	i := 0
+	for i < len(r) && unicode.IsUpper(r[i]) {
+		i++
+	}
+	if i > 1 && i < len(r) {
+		i--
+	}
+	for j := 0; j < i; j++ {
+		r[j] = unicode.ToLower(r[j])
+	}
+	return string(r)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFakesAreUpToDate(t *testing.T) {
+	src, err := generate("../../..")
+	require.NoError(t, err)
+	current, err := os.ReadFile("../../zz_generated.fakes.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(current), string(src),
+		"fakes are out of date, run go generate ./kong/kongfake")
+}
+
+func TestUnexported(t *testing.T) {
+	for name, want := range map[string]string{
+		"Create":          "create",
+		"GetByID":         "getByID",
+		"RBACRoleService": "rbacRoleService",
+		"ACLService":      "aclService",
+		"ID":              "id",
+	} {
+		assert.Equal(t, want, unexported(name), name)
+	}
+}
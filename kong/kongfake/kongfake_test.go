@@ -0,0 +1,88 @@
+package kongfake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongfake"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+func TestNewClient(t *testing.T) {
+	ctx := context.Background()
+	client, fakes, err := kongfake.NewClient(kongtest.WithVersion("3.4.0"))
+	require.NoError(t, err)
+
+	// Calls which aren't programmed are served by the in-memory Admin API.
+	service, err := client.Services.Create(ctx, &kong.Service{
+		Name: kong.String("svc"),
+		Host: kong.String("example.com"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, service.ID)
+	_, err = client.Routes.CreateInService(ctx, service.ID, &kong.Route{Paths: kong.StringSlice("/")})
+	require.NoError(t, err)
+
+	got, err := client.Services.Get(ctx, kong.String("svc"))
+	require.NoError(t, err)
+	assert.Equal(t, *service.ID, *got.ID)
+
+	require.Equal(t, 1, fakes.Services.CreateCallCount())
+	assert.Equal(t, "svc", *fakes.Services.CreateArgsForCall(0).Service.Name)
+	require.Len(t, fakes.Routes.CreateInServiceCalls(), 1)
+	assert.Equal(t, service.ID, fakes.Routes.CreateInServiceCalls()[0].ServiceID)
+
+	info, err := client.Root(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "3.4.0", kong.VersionFromInfo(info))
+}
+
+func TestFakeProgramming(t *testing.T) {
+	ctx := context.Background()
+	client, fakes, err := kongfake.NewClient()
+	require.NoError(t, err)
+
+	boom := errors.New("boom")
+	fakes.Consumers.GetReturns(&kong.Consumer{Username: kong.String("programmed")}, nil)
+	fakes.Consumers.GetReturnsOnCall(1, nil, boom)
+
+	consumer, err := client.Consumers.Get(ctx, kong.String("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, "programmed", *consumer.Username)
+	_, err = client.Consumers.Get(ctx, kong.String("alice"))
+	assert.ErrorIs(t, err, boom)
+
+	// A stub has precedence over the results programmed for every call.
+	fakes.Consumers.GetStub(func(_ context.Context, usernameOrID *string) (*kong.Consumer, error) {
+		return &kong.Consumer{Username: usernameOrID}, nil
+	})
+	consumer, err = client.Consumers.Get(ctx, kong.String("bob"))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", *consumer.Username)
+	assert.Equal(t, 3, fakes.Consumers.GetCallCount())
+
+	// Once reset, calls fall back to the in-memory Admin API again.
+	fakes.Reset()
+	_, err = client.Consumers.Get(ctx, kong.String("bob"))
+	assert.True(t, kong.IsNotFoundErr(err))
+	assert.Equal(t, 1, fakes.Consumers.GetCallCount())
+}
+
+func TestFakeWithoutFallback(t *testing.T) {
+	fake := &kongfake.FakePluginService{}
+
+	_, err := fake.ListAll(context.Background())
+	require.ErrorIs(t, err, kongfake.ErrNotProgrammed)
+	assert.EqualError(t, err, "FakePluginService.ListAll: fake method not programmed")
+
+	fake.ValidateReturns(false, "invalid config", nil)
+	ok, message, err := fake.Validate(context.Background(), &kong.Plugin{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "invalid config", message)
+}
@@ -0,0 +1,102 @@
+package kongfake
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotProgrammed is returned by the fakes when a method is called while
+// neither programmed nor backed by a fallback.
+var ErrNotProgrammed = errors.New("fake method not programmed")
+
+func notProgrammed(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotProgrammed)
+}
+
+// method records the calls of a fake method and holds its programmed
+// behavior. C holds the arguments of a call, R its results and S is the
+// signature of the method.
+type method[C, R, S any] struct {
+	mutex         sync.Mutex
+	calls         []C
+	stub          S
+	stubbed       bool
+	returns       *R
+	returnsOnCall map[int]R
+}
+
+// programmedCall is the behavior programmed for a call: either results,
+// when programmed is true, or a stub, when stubbed is true.
+type programmedCall[R, S any] struct {
+	results    R
+	programmed bool
+	stub       S
+	stubbed    bool
+}
+
+// record records call and returns the behavior programmed for it. Results
+// programmed for a specific call have precedence over a stub, which has
+// precedence over results programmed for every call.
+func (m *method[C, R, S]) record(call C) programmedCall[R, S] {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var p programmedCall[R, S]
+	p.results, p.programmed = m.returnsOnCall[len(m.calls)]
+	m.calls = append(m.calls, call)
+	switch {
+	case p.programmed:
+	case m.stubbed:
+		p.stub, p.stubbed = m.stub, true
+	case m.returns != nil:
+		p.results, p.programmed = *m.returns, true
+	}
+	return p
+}
+
+func (m *method[C, R, S]) callCount() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.calls)
+}
+
+func (m *method[C, R, S]) allCalls() []C {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]C{}, m.calls...)
+}
+
+func (m *method[C, R, S]) argsForCall(i int) C {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.calls[i]
+}
+
+func (m *method[C, R, S]) setStub(stub S, stubbed bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stub, m.stubbed = stub, stubbed
+}
+
+func (m *method[C, R, S]) setReturns(results R) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.returns = &results
+}
+
+func (m *method[C, R, S]) setReturnsOnCall(i int, results R) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.returnsOnCall == nil {
+		m.returnsOnCall = map[int]R{}
+	}
+	m.returnsOnCall[i] = results
+}
+
+func (m *method[C, R, S]) reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var stub S
+	m.calls, m.stub, m.stubbed, m.returns, m.returnsOnCall = nil, stub, false, nil, nil
+}
@@ -0,0 +1,18734 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package kongfake
+
+import (
+	"context"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/custom"
+)
+
+// ACLServiceCreateCall holds the arguments of a call to FakeACLService.Create.
+type ACLServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	AclGroup             *kong.ACLGroup
+}
+
+type aclServiceCreateResults struct {
+	r0 *kong.ACLGroup
+	r1 error
+}
+
+// ACLServiceGetCall holds the arguments of a call to FakeACLService.Get.
+type ACLServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	GroupOrID            *string
+}
+
+type aclServiceGetResults struct {
+	r0 *kong.ACLGroup
+	r1 error
+}
+
+// ACLServiceGetByIDCall holds the arguments of a call to FakeACLService.GetByID.
+type ACLServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type aclServiceGetByIDResults struct {
+	r0 *kong.ACLGroup
+	r1 error
+}
+
+// ACLServiceUpdateCall holds the arguments of a call to FakeACLService.Update.
+type ACLServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	AclGroup             *kong.ACLGroup
+}
+
+type aclServiceUpdateResults struct {
+	r0 *kong.ACLGroup
+	r1 error
+}
+
+// ACLServiceDeleteCall holds the arguments of a call to FakeACLService.Delete.
+type ACLServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	GroupOrID            *string
+}
+
+type aclServiceDeleteResults struct {
+	r0 error
+}
+
+// ACLServiceListCall holds the arguments of a call to FakeACLService.List.
+type ACLServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type aclServiceListResults struct {
+	r0 []*kong.ACLGroup
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// ACLServiceListAllCall holds the arguments of a call to FakeACLService.ListAll.
+type ACLServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type aclServiceListAllResults struct {
+	r0 []*kong.ACLGroup
+	r1 error
+}
+
+// ACLServiceListForConsumerCall holds the arguments of a call to FakeACLService.ListForConsumer.
+type ACLServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type aclServiceListForConsumerResults struct {
+	r0 []*kong.ACLGroup
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeACLService is a fake implementation of kong.AbstractACLService.
+type FakeACLService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractACLService
+
+	create          method[ACLServiceCreateCall, aclServiceCreateResults, func(context.Context, *string, *kong.ACLGroup) (*kong.ACLGroup, error)]
+	get             method[ACLServiceGetCall, aclServiceGetResults, func(context.Context, *string, *string) (*kong.ACLGroup, error)]
+	getByID         method[ACLServiceGetByIDCall, aclServiceGetByIDResults, func(context.Context, *string) (*kong.ACLGroup, error)]
+	update          method[ACLServiceUpdateCall, aclServiceUpdateResults, func(context.Context, *string, *kong.ACLGroup) (*kong.ACLGroup, error)]
+	delete          method[ACLServiceDeleteCall, aclServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[ACLServiceListCall, aclServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error)]
+	listAll         method[ACLServiceListAllCall, aclServiceListAllResults, func(context.Context) ([]*kong.ACLGroup, error)]
+	listForConsumer method[ACLServiceListForConsumerCall, aclServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractACLService = &FakeACLService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeACLService) Create(ctx context.Context, consumerUsernameOrID *string, aclGroup *kong.ACLGroup) (*kong.ACLGroup, error) {
+	call := f.create.record(ACLServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, AclGroup: aclGroup})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, aclGroup)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, aclGroup)
+	}
+	return call.results.r0, notProgrammed("FakeACLService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeACLService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeACLService) CreateCalls() []ACLServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeACLService) CreateArgsForCall(i int) ACLServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) CreateStub(stub func(context.Context, *string, *kong.ACLGroup) (*kong.ACLGroup, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeACLService) CreateReturns(r0 *kong.ACLGroup, r1 error) {
+	f.create.setReturns(aclServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeACLService) CreateReturnsOnCall(i int, r0 *kong.ACLGroup, r1 error) {
+	f.create.setReturnsOnCall(i, aclServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeACLService) Get(ctx context.Context, consumerUsernameOrID *string, groupOrID *string) (*kong.ACLGroup, error) {
+	call := f.get.record(ACLServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, GroupOrID: groupOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, groupOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, groupOrID)
+	}
+	return call.results.r0, notProgrammed("FakeACLService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeACLService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeACLService) GetCalls() []ACLServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeACLService) GetArgsForCall(i int) ACLServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) GetStub(stub func(context.Context, *string, *string) (*kong.ACLGroup, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeACLService) GetReturns(r0 *kong.ACLGroup, r1 error) {
+	f.get.setReturns(aclServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeACLService) GetReturnsOnCall(i int, r0 *kong.ACLGroup, r1 error) {
+	f.get.setReturnsOnCall(i, aclServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeACLService) GetByID(ctx context.Context, id *string) (*kong.ACLGroup, error) {
+	call := f.getByID.record(ACLServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeACLService.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeACLService) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeACLService) GetByIDCalls() []ACLServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeACLService) GetByIDArgsForCall(i int) ACLServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) GetByIDStub(stub func(context.Context, *string) (*kong.ACLGroup, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeACLService) GetByIDReturns(r0 *kong.ACLGroup, r1 error) {
+	f.getByID.setReturns(aclServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeACLService) GetByIDReturnsOnCall(i int, r0 *kong.ACLGroup, r1 error) {
+	f.getByID.setReturnsOnCall(i, aclServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeACLService) Update(ctx context.Context, consumerUsernameOrID *string, aclGroup *kong.ACLGroup) (*kong.ACLGroup, error) {
+	call := f.update.record(ACLServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, AclGroup: aclGroup})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, aclGroup)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, aclGroup)
+	}
+	return call.results.r0, notProgrammed("FakeACLService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeACLService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeACLService) UpdateCalls() []ACLServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeACLService) UpdateArgsForCall(i int) ACLServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) UpdateStub(stub func(context.Context, *string, *kong.ACLGroup) (*kong.ACLGroup, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeACLService) UpdateReturns(r0 *kong.ACLGroup, r1 error) {
+	f.update.setReturns(aclServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeACLService) UpdateReturnsOnCall(i int, r0 *kong.ACLGroup, r1 error) {
+	f.update.setReturnsOnCall(i, aclServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeACLService) Delete(ctx context.Context, consumerUsernameOrID *string, groupOrID *string) error {
+	call := f.delete.record(ACLServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, GroupOrID: groupOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, groupOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, groupOrID)
+	}
+	return notProgrammed("FakeACLService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeACLService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeACLService) DeleteCalls() []ACLServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeACLService) DeleteArgsForCall(i int) ACLServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeACLService) DeleteReturns(r0 error) {
+	f.delete.setReturns(aclServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeACLService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, aclServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeACLService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error) {
+	call := f.list.record(ACLServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeACLService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeACLService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeACLService) ListCalls() []ACLServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeACLService) ListArgsForCall(i int) ACLServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeACLService) ListReturns(r0 []*kong.ACLGroup, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(aclServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeACLService) ListReturnsOnCall(i int, r0 []*kong.ACLGroup, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, aclServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeACLService) ListAll(ctx context.Context) ([]*kong.ACLGroup, error) {
+	call := f.listAll.record(ACLServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeACLService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeACLService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeACLService) ListAllCalls() []ACLServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeACLService) ListAllArgsForCall(i int) ACLServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) ListAllStub(stub func(context.Context) ([]*kong.ACLGroup, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeACLService) ListAllReturns(r0 []*kong.ACLGroup, r1 error) {
+	f.listAll.setReturns(aclServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeACLService) ListAllReturnsOnCall(i int, r0 []*kong.ACLGroup, r1 error) {
+	f.listAll.setReturnsOnCall(i, aclServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeACLService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(ACLServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeACLService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeACLService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeACLService) ListForConsumerCalls() []ACLServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeACLService) ListForConsumerArgsForCall(i int) ACLServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeACLService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.ACLGroup, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeACLService) ListForConsumerReturns(r0 []*kong.ACLGroup, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(aclServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeACLService) ListForConsumerReturnsOnCall(i int, r0 []*kong.ACLGroup, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, aclServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeACLService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// AdminServiceInviteCall holds the arguments of a call to FakeAdminService.Invite.
+type AdminServiceInviteCall struct {
+	Ctx   context.Context
+	Admin *kong.Admin
+}
+
+type adminServiceInviteResults struct {
+	r0 *kong.Admin
+	r1 error
+}
+
+// AdminServiceCreateCall holds the arguments of a call to FakeAdminService.Create.
+type AdminServiceCreateCall struct {
+	Ctx   context.Context
+	Admin *kong.Admin
+}
+
+type adminServiceCreateResults struct {
+	r0 *kong.Admin
+	r1 error
+}
+
+// AdminServiceGetCall holds the arguments of a call to FakeAdminService.Get.
+type AdminServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type adminServiceGetResults struct {
+	r0 *kong.Admin
+	r1 error
+}
+
+// AdminServiceGenerateRegisterURLCall holds the arguments of a call to FakeAdminService.GenerateRegisterURL.
+type AdminServiceGenerateRegisterURLCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type adminServiceGenerateRegisterURLResults struct {
+	r0 *kong.Admin
+	r1 error
+}
+
+// AdminServiceUpdateCall holds the arguments of a call to FakeAdminService.Update.
+type AdminServiceUpdateCall struct {
+	Ctx   context.Context
+	Admin *kong.Admin
+}
+
+type adminServiceUpdateResults struct {
+	r0 *kong.Admin
+	r1 error
+}
+
+// AdminServiceDeleteCall holds the arguments of a call to FakeAdminService.Delete.
+type AdminServiceDeleteCall struct {
+	Ctx       context.Context
+	AdminOrID *string
+}
+
+type adminServiceDeleteResults struct {
+	r0 error
+}
+
+// AdminServiceListCall holds the arguments of a call to FakeAdminService.List.
+type AdminServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type adminServiceListResults struct {
+	r0 []*kong.Admin
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// AdminServiceRegisterCredentialsCall holds the arguments of a call to FakeAdminService.RegisterCredentials.
+type AdminServiceRegisterCredentialsCall struct {
+	Ctx   context.Context
+	Admin *kong.Admin
+}
+
+type adminServiceRegisterCredentialsResults struct {
+	r0 error
+}
+
+// AdminServiceListWorkspacesCall holds the arguments of a call to FakeAdminService.ListWorkspaces.
+type AdminServiceListWorkspacesCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+}
+
+type adminServiceListWorkspacesResults struct {
+	r0 []*kong.Workspace
+	r1 error
+}
+
+// AdminServiceListRolesCall holds the arguments of a call to FakeAdminService.ListRoles.
+type AdminServiceListRolesCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+	Opt       *kong.ListOpt
+}
+
+type adminServiceListRolesResults struct {
+	r0 []*kong.RBACRole
+	r1 error
+}
+
+// AdminServiceUpdateRolesCall holds the arguments of a call to FakeAdminService.UpdateRoles.
+type AdminServiceUpdateRolesCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+	Roles     []*kong.RBACRole
+}
+
+type adminServiceUpdateRolesResults struct {
+	r0 []*kong.RBACRole
+	r1 error
+}
+
+// AdminServiceDeleteRolesCall holds the arguments of a call to FakeAdminService.DeleteRoles.
+type AdminServiceDeleteRolesCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+	Roles     []*kong.RBACRole
+}
+
+type adminServiceDeleteRolesResults struct {
+	r0 error
+}
+
+// AdminServiceGetConsumerCall holds the arguments of a call to FakeAdminService.GetConsumer.
+type AdminServiceGetConsumerCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+}
+
+type adminServiceGetConsumerResults struct {
+	r0 *kong.Consumer
+	r1 error
+}
+
+// FakeAdminService is a fake implementation of kong.AbstractAdminService.
+type FakeAdminService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractAdminService
+
+	invite              method[AdminServiceInviteCall, adminServiceInviteResults, func(context.Context, *kong.Admin) (*kong.Admin, error)]
+	create              method[AdminServiceCreateCall, adminServiceCreateResults, func(context.Context, *kong.Admin) (*kong.Admin, error)]
+	get                 method[AdminServiceGetCall, adminServiceGetResults, func(context.Context, *string) (*kong.Admin, error)]
+	generateRegisterURL method[AdminServiceGenerateRegisterURLCall, adminServiceGenerateRegisterURLResults, func(context.Context, *string) (*kong.Admin, error)]
+	update              method[AdminServiceUpdateCall, adminServiceUpdateResults, func(context.Context, *kong.Admin) (*kong.Admin, error)]
+	delete              method[AdminServiceDeleteCall, adminServiceDeleteResults, func(context.Context, *string) error]
+	list                method[AdminServiceListCall, adminServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Admin, *kong.ListOpt, error)]
+	registerCredentials method[AdminServiceRegisterCredentialsCall, adminServiceRegisterCredentialsResults, func(context.Context, *kong.Admin) error]
+	listWorkspaces      method[AdminServiceListWorkspacesCall, adminServiceListWorkspacesResults, func(context.Context, *string) ([]*kong.Workspace, error)]
+	listRoles           method[AdminServiceListRolesCall, adminServiceListRolesResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.RBACRole, error)]
+	updateRoles         method[AdminServiceUpdateRolesCall, adminServiceUpdateRolesResults, func(context.Context, *string, []*kong.RBACRole) ([]*kong.RBACRole, error)]
+	deleteRoles         method[AdminServiceDeleteRolesCall, adminServiceDeleteRolesResults, func(context.Context, *string, []*kong.RBACRole) error]
+	getConsumer         method[AdminServiceGetConsumerCall, adminServiceGetConsumerResults, func(context.Context, *string) (*kong.Consumer, error)]
+}
+
+var _ kong.AbstractAdminService = &FakeAdminService{}
+
+// Invite records the call and returns the programmed results.
+func (f *FakeAdminService) Invite(ctx context.Context, admin *kong.Admin) (*kong.Admin, error) {
+	call := f.invite.record(AdminServiceInviteCall{Ctx: ctx, Admin: admin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, admin)
+	case f.Fallback != nil:
+		return f.Fallback.Invite(ctx, admin)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.Invite")
+}
+
+// InviteCallCount returns the number of calls to Invite.
+func (f *FakeAdminService) InviteCallCount() int {
+	return f.invite.callCount()
+}
+
+// InviteCalls returns the arguments of the calls to Invite.
+func (f *FakeAdminService) InviteCalls() []AdminServiceInviteCall {
+	return f.invite.allCalls()
+}
+
+// InviteArgsForCall returns the arguments of the i-th call to Invite.
+func (f *FakeAdminService) InviteArgsForCall(i int) AdminServiceInviteCall {
+	return f.invite.argsForCall(i)
+}
+
+// InviteStub makes Invite delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) InviteStub(stub func(context.Context, *kong.Admin) (*kong.Admin, error)) {
+	f.invite.setStub(stub, stub != nil)
+}
+
+// InviteReturns makes Invite return the given values.
+func (f *FakeAdminService) InviteReturns(r0 *kong.Admin, r1 error) {
+	f.invite.setReturns(adminServiceInviteResults{r0: r0, r1: r1})
+}
+
+// InviteReturnsOnCall makes the i-th call to Invite return the given values.
+func (f *FakeAdminService) InviteReturnsOnCall(i int, r0 *kong.Admin, r1 error) {
+	f.invite.setReturnsOnCall(i, adminServiceInviteResults{r0: r0, r1: r1})
+}
+
+// Create records the call and returns the programmed results.
+func (f *FakeAdminService) Create(ctx context.Context, admin *kong.Admin) (*kong.Admin, error) {
+	call := f.create.record(AdminServiceCreateCall{Ctx: ctx, Admin: admin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, admin)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, admin)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeAdminService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeAdminService) CreateCalls() []AdminServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeAdminService) CreateArgsForCall(i int) AdminServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) CreateStub(stub func(context.Context, *kong.Admin) (*kong.Admin, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeAdminService) CreateReturns(r0 *kong.Admin, r1 error) {
+	f.create.setReturns(adminServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeAdminService) CreateReturnsOnCall(i int, r0 *kong.Admin, r1 error) {
+	f.create.setReturnsOnCall(i, adminServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeAdminService) Get(ctx context.Context, nameOrID *string) (*kong.Admin, error) {
+	call := f.get.record(AdminServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeAdminService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeAdminService) GetCalls() []AdminServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeAdminService) GetArgsForCall(i int) AdminServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) GetStub(stub func(context.Context, *string) (*kong.Admin, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeAdminService) GetReturns(r0 *kong.Admin, r1 error) {
+	f.get.setReturns(adminServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeAdminService) GetReturnsOnCall(i int, r0 *kong.Admin, r1 error) {
+	f.get.setReturnsOnCall(i, adminServiceGetResults{r0: r0, r1: r1})
+}
+
+// GenerateRegisterURL records the call and returns the programmed results.
+func (f *FakeAdminService) GenerateRegisterURL(ctx context.Context, nameOrID *string) (*kong.Admin, error) {
+	call := f.generateRegisterURL.record(AdminServiceGenerateRegisterURLCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.GenerateRegisterURL(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.GenerateRegisterURL")
+}
+
+// GenerateRegisterURLCallCount returns the number of calls to GenerateRegisterURL.
+func (f *FakeAdminService) GenerateRegisterURLCallCount() int {
+	return f.generateRegisterURL.callCount()
+}
+
+// GenerateRegisterURLCalls returns the arguments of the calls to GenerateRegisterURL.
+func (f *FakeAdminService) GenerateRegisterURLCalls() []AdminServiceGenerateRegisterURLCall {
+	return f.generateRegisterURL.allCalls()
+}
+
+// GenerateRegisterURLArgsForCall returns the arguments of the i-th call to GenerateRegisterURL.
+func (f *FakeAdminService) GenerateRegisterURLArgsForCall(i int) AdminServiceGenerateRegisterURLCall {
+	return f.generateRegisterURL.argsForCall(i)
+}
+
+// GenerateRegisterURLStub makes GenerateRegisterURL delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) GenerateRegisterURLStub(stub func(context.Context, *string) (*kong.Admin, error)) {
+	f.generateRegisterURL.setStub(stub, stub != nil)
+}
+
+// GenerateRegisterURLReturns makes GenerateRegisterURL return the given values.
+func (f *FakeAdminService) GenerateRegisterURLReturns(r0 *kong.Admin, r1 error) {
+	f.generateRegisterURL.setReturns(adminServiceGenerateRegisterURLResults{r0: r0, r1: r1})
+}
+
+// GenerateRegisterURLReturnsOnCall makes the i-th call to GenerateRegisterURL return the given values.
+func (f *FakeAdminService) GenerateRegisterURLReturnsOnCall(i int, r0 *kong.Admin, r1 error) {
+	f.generateRegisterURL.setReturnsOnCall(i, adminServiceGenerateRegisterURLResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeAdminService) Update(ctx context.Context, admin *kong.Admin) (*kong.Admin, error) {
+	call := f.update.record(AdminServiceUpdateCall{Ctx: ctx, Admin: admin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, admin)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, admin)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeAdminService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeAdminService) UpdateCalls() []AdminServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeAdminService) UpdateArgsForCall(i int) AdminServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) UpdateStub(stub func(context.Context, *kong.Admin) (*kong.Admin, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeAdminService) UpdateReturns(r0 *kong.Admin, r1 error) {
+	f.update.setReturns(adminServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeAdminService) UpdateReturnsOnCall(i int, r0 *kong.Admin, r1 error) {
+	f.update.setReturnsOnCall(i, adminServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeAdminService) Delete(ctx context.Context, AdminOrID *string) error {
+	call := f.delete.record(AdminServiceDeleteCall{Ctx: ctx, AdminOrID: AdminOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, AdminOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, AdminOrID)
+	}
+	return notProgrammed("FakeAdminService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeAdminService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeAdminService) DeleteCalls() []AdminServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeAdminService) DeleteArgsForCall(i int) AdminServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeAdminService) DeleteReturns(r0 error) {
+	f.delete.setReturns(adminServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeAdminService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, adminServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeAdminService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Admin, *kong.ListOpt, error) {
+	call := f.list.record(AdminServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeAdminService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeAdminService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeAdminService) ListCalls() []AdminServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeAdminService) ListArgsForCall(i int) AdminServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Admin, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeAdminService) ListReturns(r0 []*kong.Admin, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(adminServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeAdminService) ListReturnsOnCall(i int, r0 []*kong.Admin, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, adminServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// RegisterCredentials records the call and returns the programmed results.
+func (f *FakeAdminService) RegisterCredentials(ctx context.Context, admin *kong.Admin) error {
+	call := f.registerCredentials.record(AdminServiceRegisterCredentialsCall{Ctx: ctx, Admin: admin})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, admin)
+	case f.Fallback != nil:
+		return f.Fallback.RegisterCredentials(ctx, admin)
+	}
+	return notProgrammed("FakeAdminService.RegisterCredentials")
+}
+
+// RegisterCredentialsCallCount returns the number of calls to RegisterCredentials.
+func (f *FakeAdminService) RegisterCredentialsCallCount() int {
+	return f.registerCredentials.callCount()
+}
+
+// RegisterCredentialsCalls returns the arguments of the calls to RegisterCredentials.
+func (f *FakeAdminService) RegisterCredentialsCalls() []AdminServiceRegisterCredentialsCall {
+	return f.registerCredentials.allCalls()
+}
+
+// RegisterCredentialsArgsForCall returns the arguments of the i-th call to RegisterCredentials.
+func (f *FakeAdminService) RegisterCredentialsArgsForCall(i int) AdminServiceRegisterCredentialsCall {
+	return f.registerCredentials.argsForCall(i)
+}
+
+// RegisterCredentialsStub makes RegisterCredentials delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) RegisterCredentialsStub(stub func(context.Context, *kong.Admin) error) {
+	f.registerCredentials.setStub(stub, stub != nil)
+}
+
+// RegisterCredentialsReturns makes RegisterCredentials return the given values.
+func (f *FakeAdminService) RegisterCredentialsReturns(r0 error) {
+	f.registerCredentials.setReturns(adminServiceRegisterCredentialsResults{r0: r0})
+}
+
+// RegisterCredentialsReturnsOnCall makes the i-th call to RegisterCredentials return the given values.
+func (f *FakeAdminService) RegisterCredentialsReturnsOnCall(i int, r0 error) {
+	f.registerCredentials.setReturnsOnCall(i, adminServiceRegisterCredentialsResults{r0: r0})
+}
+
+// ListWorkspaces records the call and returns the programmed results.
+func (f *FakeAdminService) ListWorkspaces(ctx context.Context, emailOrID *string) ([]*kong.Workspace, error) {
+	call := f.listWorkspaces.record(AdminServiceListWorkspacesCall{Ctx: ctx, EmailOrID: emailOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, emailOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListWorkspaces(ctx, emailOrID)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.ListWorkspaces")
+}
+
+// ListWorkspacesCallCount returns the number of calls to ListWorkspaces.
+func (f *FakeAdminService) ListWorkspacesCallCount() int {
+	return f.listWorkspaces.callCount()
+}
+
+// ListWorkspacesCalls returns the arguments of the calls to ListWorkspaces.
+func (f *FakeAdminService) ListWorkspacesCalls() []AdminServiceListWorkspacesCall {
+	return f.listWorkspaces.allCalls()
+}
+
+// ListWorkspacesArgsForCall returns the arguments of the i-th call to ListWorkspaces.
+func (f *FakeAdminService) ListWorkspacesArgsForCall(i int) AdminServiceListWorkspacesCall {
+	return f.listWorkspaces.argsForCall(i)
+}
+
+// ListWorkspacesStub makes ListWorkspaces delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) ListWorkspacesStub(stub func(context.Context, *string) ([]*kong.Workspace, error)) {
+	f.listWorkspaces.setStub(stub, stub != nil)
+}
+
+// ListWorkspacesReturns makes ListWorkspaces return the given values.
+func (f *FakeAdminService) ListWorkspacesReturns(r0 []*kong.Workspace, r1 error) {
+	f.listWorkspaces.setReturns(adminServiceListWorkspacesResults{r0: r0, r1: r1})
+}
+
+// ListWorkspacesReturnsOnCall makes the i-th call to ListWorkspaces return the given values.
+func (f *FakeAdminService) ListWorkspacesReturnsOnCall(i int, r0 []*kong.Workspace, r1 error) {
+	f.listWorkspaces.setReturnsOnCall(i, adminServiceListWorkspacesResults{r0: r0, r1: r1})
+}
+
+// ListRoles records the call and returns the programmed results.
+func (f *FakeAdminService) ListRoles(ctx context.Context, emailOrID *string, opt *kong.ListOpt) ([]*kong.RBACRole, error) {
+	call := f.listRoles.record(AdminServiceListRolesCall{Ctx: ctx, EmailOrID: emailOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, emailOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListRoles(ctx, emailOrID, opt)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.ListRoles")
+}
+
+// ListRolesCallCount returns the number of calls to ListRoles.
+func (f *FakeAdminService) ListRolesCallCount() int {
+	return f.listRoles.callCount()
+}
+
+// ListRolesCalls returns the arguments of the calls to ListRoles.
+func (f *FakeAdminService) ListRolesCalls() []AdminServiceListRolesCall {
+	return f.listRoles.allCalls()
+}
+
+// ListRolesArgsForCall returns the arguments of the i-th call to ListRoles.
+func (f *FakeAdminService) ListRolesArgsForCall(i int) AdminServiceListRolesCall {
+	return f.listRoles.argsForCall(i)
+}
+
+// ListRolesStub makes ListRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) ListRolesStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.RBACRole, error)) {
+	f.listRoles.setStub(stub, stub != nil)
+}
+
+// ListRolesReturns makes ListRoles return the given values.
+func (f *FakeAdminService) ListRolesReturns(r0 []*kong.RBACRole, r1 error) {
+	f.listRoles.setReturns(adminServiceListRolesResults{r0: r0, r1: r1})
+}
+
+// ListRolesReturnsOnCall makes the i-th call to ListRoles return the given values.
+func (f *FakeAdminService) ListRolesReturnsOnCall(i int, r0 []*kong.RBACRole, r1 error) {
+	f.listRoles.setReturnsOnCall(i, adminServiceListRolesResults{r0: r0, r1: r1})
+}
+
+// UpdateRoles records the call and returns the programmed results.
+func (f *FakeAdminService) UpdateRoles(ctx context.Context, emailOrID *string, roles []*kong.RBACRole) ([]*kong.RBACRole, error) {
+	call := f.updateRoles.record(AdminServiceUpdateRolesCall{Ctx: ctx, EmailOrID: emailOrID, Roles: roles})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, emailOrID, roles)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateRoles(ctx, emailOrID, roles)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.UpdateRoles")
+}
+
+// UpdateRolesCallCount returns the number of calls to UpdateRoles.
+func (f *FakeAdminService) UpdateRolesCallCount() int {
+	return f.updateRoles.callCount()
+}
+
+// UpdateRolesCalls returns the arguments of the calls to UpdateRoles.
+func (f *FakeAdminService) UpdateRolesCalls() []AdminServiceUpdateRolesCall {
+	return f.updateRoles.allCalls()
+}
+
+// UpdateRolesArgsForCall returns the arguments of the i-th call to UpdateRoles.
+func (f *FakeAdminService) UpdateRolesArgsForCall(i int) AdminServiceUpdateRolesCall {
+	return f.updateRoles.argsForCall(i)
+}
+
+// UpdateRolesStub makes UpdateRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) UpdateRolesStub(stub func(context.Context, *string, []*kong.RBACRole) ([]*kong.RBACRole, error)) {
+	f.updateRoles.setStub(stub, stub != nil)
+}
+
+// UpdateRolesReturns makes UpdateRoles return the given values.
+func (f *FakeAdminService) UpdateRolesReturns(r0 []*kong.RBACRole, r1 error) {
+	f.updateRoles.setReturns(adminServiceUpdateRolesResults{r0: r0, r1: r1})
+}
+
+// UpdateRolesReturnsOnCall makes the i-th call to UpdateRoles return the given values.
+func (f *FakeAdminService) UpdateRolesReturnsOnCall(i int, r0 []*kong.RBACRole, r1 error) {
+	f.updateRoles.setReturnsOnCall(i, adminServiceUpdateRolesResults{r0: r0, r1: r1})
+}
+
+// DeleteRoles records the call and returns the programmed results.
+func (f *FakeAdminService) DeleteRoles(ctx context.Context, emailOrID *string, roles []*kong.RBACRole) error {
+	call := f.deleteRoles.record(AdminServiceDeleteRolesCall{Ctx: ctx, EmailOrID: emailOrID, Roles: roles})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, emailOrID, roles)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteRoles(ctx, emailOrID, roles)
+	}
+	return notProgrammed("FakeAdminService.DeleteRoles")
+}
+
+// DeleteRolesCallCount returns the number of calls to DeleteRoles.
+func (f *FakeAdminService) DeleteRolesCallCount() int {
+	return f.deleteRoles.callCount()
+}
+
+// DeleteRolesCalls returns the arguments of the calls to DeleteRoles.
+func (f *FakeAdminService) DeleteRolesCalls() []AdminServiceDeleteRolesCall {
+	return f.deleteRoles.allCalls()
+}
+
+// DeleteRolesArgsForCall returns the arguments of the i-th call to DeleteRoles.
+func (f *FakeAdminService) DeleteRolesArgsForCall(i int) AdminServiceDeleteRolesCall {
+	return f.deleteRoles.argsForCall(i)
+}
+
+// DeleteRolesStub makes DeleteRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) DeleteRolesStub(stub func(context.Context, *string, []*kong.RBACRole) error) {
+	f.deleteRoles.setStub(stub, stub != nil)
+}
+
+// DeleteRolesReturns makes DeleteRoles return the given values.
+func (f *FakeAdminService) DeleteRolesReturns(r0 error) {
+	f.deleteRoles.setReturns(adminServiceDeleteRolesResults{r0: r0})
+}
+
+// DeleteRolesReturnsOnCall makes the i-th call to DeleteRoles return the given values.
+func (f *FakeAdminService) DeleteRolesReturnsOnCall(i int, r0 error) {
+	f.deleteRoles.setReturnsOnCall(i, adminServiceDeleteRolesResults{r0: r0})
+}
+
+// GetConsumer records the call and returns the programmed results.
+func (f *FakeAdminService) GetConsumer(ctx context.Context, emailOrID *string) (*kong.Consumer, error) {
+	call := f.getConsumer.record(AdminServiceGetConsumerCall{Ctx: ctx, EmailOrID: emailOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, emailOrID)
+	case f.Fallback != nil:
+		return f.Fallback.GetConsumer(ctx, emailOrID)
+	}
+	return call.results.r0, notProgrammed("FakeAdminService.GetConsumer")
+}
+
+// GetConsumerCallCount returns the number of calls to GetConsumer.
+func (f *FakeAdminService) GetConsumerCallCount() int {
+	return f.getConsumer.callCount()
+}
+
+// GetConsumerCalls returns the arguments of the calls to GetConsumer.
+func (f *FakeAdminService) GetConsumerCalls() []AdminServiceGetConsumerCall {
+	return f.getConsumer.allCalls()
+}
+
+// GetConsumerArgsForCall returns the arguments of the i-th call to GetConsumer.
+func (f *FakeAdminService) GetConsumerArgsForCall(i int) AdminServiceGetConsumerCall {
+	return f.getConsumer.argsForCall(i)
+}
+
+// GetConsumerStub makes GetConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeAdminService) GetConsumerStub(stub func(context.Context, *string) (*kong.Consumer, error)) {
+	f.getConsumer.setStub(stub, stub != nil)
+}
+
+// GetConsumerReturns makes GetConsumer return the given values.
+func (f *FakeAdminService) GetConsumerReturns(r0 *kong.Consumer, r1 error) {
+	f.getConsumer.setReturns(adminServiceGetConsumerResults{r0: r0, r1: r1})
+}
+
+// GetConsumerReturnsOnCall makes the i-th call to GetConsumer return the given values.
+func (f *FakeAdminService) GetConsumerReturnsOnCall(i int, r0 *kong.Consumer, r1 error) {
+	f.getConsumer.setReturnsOnCall(i, adminServiceGetConsumerResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeAdminService) Reset() {
+	f.invite.reset()
+	f.create.reset()
+	f.get.reset()
+	f.generateRegisterURL.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.registerCredentials.reset()
+	f.listWorkspaces.reset()
+	f.listRoles.reset()
+	f.updateRoles.reset()
+	f.deleteRoles.reset()
+	f.getConsumer.reset()
+}
+
+// BasicAuthServiceCreateCall holds the arguments of a call to FakeBasicAuthService.Create.
+type BasicAuthServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	BasicAuth            *kong.BasicAuth
+}
+
+type basicAuthServiceCreateResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceCreateWithOptionsCall holds the arguments of a call to FakeBasicAuthService.CreateWithOptions.
+type BasicAuthServiceCreateWithOptionsCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opts                 *kong.BasicAuthOptions
+}
+
+type basicAuthServiceCreateWithOptionsResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceGetCall holds the arguments of a call to FakeBasicAuthService.Get.
+type BasicAuthServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	UsernameOrID         *string
+}
+
+type basicAuthServiceGetResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceGetByIDCall holds the arguments of a call to FakeBasicAuthService.GetByID.
+type BasicAuthServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type basicAuthServiceGetByIDResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceUpdateCall holds the arguments of a call to FakeBasicAuthService.Update.
+type BasicAuthServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	BasicAuth            *kong.BasicAuth
+}
+
+type basicAuthServiceUpdateResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceUpdateWithOptionsCall holds the arguments of a call to FakeBasicAuthService.UpdateWithOptions.
+type BasicAuthServiceUpdateWithOptionsCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opts                 *kong.BasicAuthOptions
+}
+
+type basicAuthServiceUpdateWithOptionsResults struct {
+	r0 *kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceDeleteCall holds the arguments of a call to FakeBasicAuthService.Delete.
+type BasicAuthServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	UsernameOrID         *string
+}
+
+type basicAuthServiceDeleteResults struct {
+	r0 error
+}
+
+// BasicAuthServiceListCall holds the arguments of a call to FakeBasicAuthService.List.
+type BasicAuthServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type basicAuthServiceListResults struct {
+	r0 []*kong.BasicAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// BasicAuthServiceListAllCall holds the arguments of a call to FakeBasicAuthService.ListAll.
+type BasicAuthServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type basicAuthServiceListAllResults struct {
+	r0 []*kong.BasicAuth
+	r1 error
+}
+
+// BasicAuthServiceListForConsumerCall holds the arguments of a call to FakeBasicAuthService.ListForConsumer.
+type BasicAuthServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type basicAuthServiceListForConsumerResults struct {
+	r0 []*kong.BasicAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeBasicAuthService is a fake implementation of kong.AbstractBasicAuthService.
+type FakeBasicAuthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractBasicAuthService
+
+	create            method[BasicAuthServiceCreateCall, basicAuthServiceCreateResults, func(context.Context, *string, *kong.BasicAuth) (*kong.BasicAuth, error)]
+	createWithOptions method[BasicAuthServiceCreateWithOptionsCall, basicAuthServiceCreateWithOptionsResults, func(context.Context, *string, *kong.BasicAuthOptions) (*kong.BasicAuth, error)]
+	get               method[BasicAuthServiceGetCall, basicAuthServiceGetResults, func(context.Context, *string, *string) (*kong.BasicAuth, error)]
+	getByID           method[BasicAuthServiceGetByIDCall, basicAuthServiceGetByIDResults, func(context.Context, *string) (*kong.BasicAuth, error)]
+	update            method[BasicAuthServiceUpdateCall, basicAuthServiceUpdateResults, func(context.Context, *string, *kong.BasicAuth) (*kong.BasicAuth, error)]
+	updateWithOptions method[BasicAuthServiceUpdateWithOptionsCall, basicAuthServiceUpdateWithOptionsResults, func(context.Context, *string, *kong.BasicAuthOptions) (*kong.BasicAuth, error)]
+	delete            method[BasicAuthServiceDeleteCall, basicAuthServiceDeleteResults, func(context.Context, *string, *string) error]
+	list              method[BasicAuthServiceListCall, basicAuthServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error)]
+	listAll           method[BasicAuthServiceListAllCall, basicAuthServiceListAllResults, func(context.Context) ([]*kong.BasicAuth, error)]
+	listForConsumer   method[BasicAuthServiceListForConsumerCall, basicAuthServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractBasicAuthService = &FakeBasicAuthService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeBasicAuthService) Create(ctx context.Context, consumerUsernameOrID *string, basicAuth *kong.BasicAuth) (*kong.BasicAuth, error) {
+	call := f.create.record(BasicAuthServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, BasicAuth: basicAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, basicAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, basicAuth)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeBasicAuthService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeBasicAuthService) CreateCalls() []BasicAuthServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeBasicAuthService) CreateArgsForCall(i int) BasicAuthServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) CreateStub(stub func(context.Context, *string, *kong.BasicAuth) (*kong.BasicAuth, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeBasicAuthService) CreateReturns(r0 *kong.BasicAuth, r1 error) {
+	f.create.setReturns(basicAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeBasicAuthService) CreateReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.create.setReturnsOnCall(i, basicAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateWithOptions records the call and returns the programmed results.
+func (f *FakeBasicAuthService) CreateWithOptions(ctx context.Context, consumerUsernameOrID *string, opts *kong.BasicAuthOptions) (*kong.BasicAuth, error) {
+	call := f.createWithOptions.record(BasicAuthServiceCreateWithOptionsCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opts: opts})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opts)
+	case f.Fallback != nil:
+		return f.Fallback.CreateWithOptions(ctx, consumerUsernameOrID, opts)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.CreateWithOptions")
+}
+
+// CreateWithOptionsCallCount returns the number of calls to CreateWithOptions.
+func (f *FakeBasicAuthService) CreateWithOptionsCallCount() int {
+	return f.createWithOptions.callCount()
+}
+
+// CreateWithOptionsCalls returns the arguments of the calls to CreateWithOptions.
+func (f *FakeBasicAuthService) CreateWithOptionsCalls() []BasicAuthServiceCreateWithOptionsCall {
+	return f.createWithOptions.allCalls()
+}
+
+// CreateWithOptionsArgsForCall returns the arguments of the i-th call to CreateWithOptions.
+func (f *FakeBasicAuthService) CreateWithOptionsArgsForCall(i int) BasicAuthServiceCreateWithOptionsCall {
+	return f.createWithOptions.argsForCall(i)
+}
+
+// CreateWithOptionsStub makes CreateWithOptions delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) CreateWithOptionsStub(stub func(context.Context, *string, *kong.BasicAuthOptions) (*kong.BasicAuth, error)) {
+	f.createWithOptions.setStub(stub, stub != nil)
+}
+
+// CreateWithOptionsReturns makes CreateWithOptions return the given values.
+func (f *FakeBasicAuthService) CreateWithOptionsReturns(r0 *kong.BasicAuth, r1 error) {
+	f.createWithOptions.setReturns(basicAuthServiceCreateWithOptionsResults{r0: r0, r1: r1})
+}
+
+// CreateWithOptionsReturnsOnCall makes the i-th call to CreateWithOptions return the given values.
+func (f *FakeBasicAuthService) CreateWithOptionsReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.createWithOptions.setReturnsOnCall(i, basicAuthServiceCreateWithOptionsResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeBasicAuthService) Get(ctx context.Context, consumerUsernameOrID *string, usernameOrID *string) (*kong.BasicAuth, error) {
+	call := f.get.record(BasicAuthServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeBasicAuthService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeBasicAuthService) GetCalls() []BasicAuthServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeBasicAuthService) GetArgsForCall(i int) BasicAuthServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) GetStub(stub func(context.Context, *string, *string) (*kong.BasicAuth, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeBasicAuthService) GetReturns(r0 *kong.BasicAuth, r1 error) {
+	f.get.setReturns(basicAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeBasicAuthService) GetReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.get.setReturnsOnCall(i, basicAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeBasicAuthService) GetByID(ctx context.Context, id *string) (*kong.BasicAuth, error) {
+	call := f.getByID.record(BasicAuthServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeBasicAuthService) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeBasicAuthService) GetByIDCalls() []BasicAuthServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeBasicAuthService) GetByIDArgsForCall(i int) BasicAuthServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) GetByIDStub(stub func(context.Context, *string) (*kong.BasicAuth, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeBasicAuthService) GetByIDReturns(r0 *kong.BasicAuth, r1 error) {
+	f.getByID.setReturns(basicAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeBasicAuthService) GetByIDReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.getByID.setReturnsOnCall(i, basicAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeBasicAuthService) Update(ctx context.Context, consumerUsernameOrID *string, basicAuth *kong.BasicAuth) (*kong.BasicAuth, error) {
+	call := f.update.record(BasicAuthServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, BasicAuth: basicAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, basicAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, basicAuth)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeBasicAuthService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeBasicAuthService) UpdateCalls() []BasicAuthServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeBasicAuthService) UpdateArgsForCall(i int) BasicAuthServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) UpdateStub(stub func(context.Context, *string, *kong.BasicAuth) (*kong.BasicAuth, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeBasicAuthService) UpdateReturns(r0 *kong.BasicAuth, r1 error) {
+	f.update.setReturns(basicAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeBasicAuthService) UpdateReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.update.setReturnsOnCall(i, basicAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateWithOptions records the call and returns the programmed results.
+func (f *FakeBasicAuthService) UpdateWithOptions(ctx context.Context, consumerUsernameOrID *string, opts *kong.BasicAuthOptions) (*kong.BasicAuth, error) {
+	call := f.updateWithOptions.record(BasicAuthServiceUpdateWithOptionsCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opts: opts})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opts)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateWithOptions(ctx, consumerUsernameOrID, opts)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.UpdateWithOptions")
+}
+
+// UpdateWithOptionsCallCount returns the number of calls to UpdateWithOptions.
+func (f *FakeBasicAuthService) UpdateWithOptionsCallCount() int {
+	return f.updateWithOptions.callCount()
+}
+
+// UpdateWithOptionsCalls returns the arguments of the calls to UpdateWithOptions.
+func (f *FakeBasicAuthService) UpdateWithOptionsCalls() []BasicAuthServiceUpdateWithOptionsCall {
+	return f.updateWithOptions.allCalls()
+}
+
+// UpdateWithOptionsArgsForCall returns the arguments of the i-th call to UpdateWithOptions.
+func (f *FakeBasicAuthService) UpdateWithOptionsArgsForCall(i int) BasicAuthServiceUpdateWithOptionsCall {
+	return f.updateWithOptions.argsForCall(i)
+}
+
+// UpdateWithOptionsStub makes UpdateWithOptions delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) UpdateWithOptionsStub(stub func(context.Context, *string, *kong.BasicAuthOptions) (*kong.BasicAuth, error)) {
+	f.updateWithOptions.setStub(stub, stub != nil)
+}
+
+// UpdateWithOptionsReturns makes UpdateWithOptions return the given values.
+func (f *FakeBasicAuthService) UpdateWithOptionsReturns(r0 *kong.BasicAuth, r1 error) {
+	f.updateWithOptions.setReturns(basicAuthServiceUpdateWithOptionsResults{r0: r0, r1: r1})
+}
+
+// UpdateWithOptionsReturnsOnCall makes the i-th call to UpdateWithOptions return the given values.
+func (f *FakeBasicAuthService) UpdateWithOptionsReturnsOnCall(i int, r0 *kong.BasicAuth, r1 error) {
+	f.updateWithOptions.setReturnsOnCall(i, basicAuthServiceUpdateWithOptionsResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeBasicAuthService) Delete(ctx context.Context, consumerUsernameOrID *string, usernameOrID *string) error {
+	call := f.delete.record(BasicAuthServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, usernameOrID)
+	}
+	return notProgrammed("FakeBasicAuthService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeBasicAuthService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeBasicAuthService) DeleteCalls() []BasicAuthServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeBasicAuthService) DeleteArgsForCall(i int) BasicAuthServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeBasicAuthService) DeleteReturns(r0 error) {
+	f.delete.setReturns(basicAuthServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeBasicAuthService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, basicAuthServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeBasicAuthService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error) {
+	call := f.list.record(BasicAuthServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeBasicAuthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeBasicAuthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeBasicAuthService) ListCalls() []BasicAuthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeBasicAuthService) ListArgsForCall(i int) BasicAuthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeBasicAuthService) ListReturns(r0 []*kong.BasicAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(basicAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeBasicAuthService) ListReturnsOnCall(i int, r0 []*kong.BasicAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, basicAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeBasicAuthService) ListAll(ctx context.Context) ([]*kong.BasicAuth, error) {
+	call := f.listAll.record(BasicAuthServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeBasicAuthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeBasicAuthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeBasicAuthService) ListAllCalls() []BasicAuthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeBasicAuthService) ListAllArgsForCall(i int) BasicAuthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) ListAllStub(stub func(context.Context) ([]*kong.BasicAuth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeBasicAuthService) ListAllReturns(r0 []*kong.BasicAuth, r1 error) {
+	f.listAll.setReturns(basicAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeBasicAuthService) ListAllReturnsOnCall(i int, r0 []*kong.BasicAuth, r1 error) {
+	f.listAll.setReturnsOnCall(i, basicAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeBasicAuthService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(BasicAuthServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeBasicAuthService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeBasicAuthService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeBasicAuthService) ListForConsumerCalls() []BasicAuthServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeBasicAuthService) ListForConsumerArgsForCall(i int) BasicAuthServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeBasicAuthService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.BasicAuth, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeBasicAuthService) ListForConsumerReturns(r0 []*kong.BasicAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(basicAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeBasicAuthService) ListForConsumerReturnsOnCall(i int, r0 []*kong.BasicAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, basicAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeBasicAuthService) Reset() {
+	f.create.reset()
+	f.createWithOptions.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.updateWithOptions.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// CACertificateServiceCreateCall holds the arguments of a call to FakeCACertificateService.Create.
+type CACertificateServiceCreateCall struct {
+	Ctx         context.Context
+	Certificate *kong.CACertificate
+}
+
+type caCertificateServiceCreateResults struct {
+	r0 *kong.CACertificate
+	r1 error
+}
+
+// CACertificateServiceGetCall holds the arguments of a call to FakeCACertificateService.Get.
+type CACertificateServiceGetCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type caCertificateServiceGetResults struct {
+	r0 *kong.CACertificate
+	r1 error
+}
+
+// CACertificateServiceUpdateCall holds the arguments of a call to FakeCACertificateService.Update.
+type CACertificateServiceUpdateCall struct {
+	Ctx         context.Context
+	Certificate *kong.CACertificate
+}
+
+type caCertificateServiceUpdateResults struct {
+	r0 *kong.CACertificate
+	r1 error
+}
+
+// CACertificateServiceDeleteCall holds the arguments of a call to FakeCACertificateService.Delete.
+type CACertificateServiceDeleteCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type caCertificateServiceDeleteResults struct {
+	r0 error
+}
+
+// CACertificateServiceListCall holds the arguments of a call to FakeCACertificateService.List.
+type CACertificateServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type caCertificateServiceListResults struct {
+	r0 []*kong.CACertificate
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// CACertificateServiceListAllCall holds the arguments of a call to FakeCACertificateService.ListAll.
+type CACertificateServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type caCertificateServiceListAllResults struct {
+	r0 []*kong.CACertificate
+	r1 error
+}
+
+// FakeCACertificateService is a fake implementation of kong.AbstractCACertificateService.
+type FakeCACertificateService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractCACertificateService
+
+	create  method[CACertificateServiceCreateCall, caCertificateServiceCreateResults, func(context.Context, *kong.CACertificate) (*kong.CACertificate, error)]
+	get     method[CACertificateServiceGetCall, caCertificateServiceGetResults, func(context.Context, *string) (*kong.CACertificate, error)]
+	update  method[CACertificateServiceUpdateCall, caCertificateServiceUpdateResults, func(context.Context, *kong.CACertificate) (*kong.CACertificate, error)]
+	delete  method[CACertificateServiceDeleteCall, caCertificateServiceDeleteResults, func(context.Context, *string) error]
+	list    method[CACertificateServiceListCall, caCertificateServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.CACertificate, *kong.ListOpt, error)]
+	listAll method[CACertificateServiceListAllCall, caCertificateServiceListAllResults, func(context.Context) ([]*kong.CACertificate, error)]
+}
+
+var _ kong.AbstractCACertificateService = &FakeCACertificateService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeCACertificateService) Create(ctx context.Context, certificate *kong.CACertificate) (*kong.CACertificate, error) {
+	call := f.create.record(CACertificateServiceCreateCall{Ctx: ctx, Certificate: certificate})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, certificate)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, certificate)
+	}
+	return call.results.r0, notProgrammed("FakeCACertificateService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeCACertificateService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeCACertificateService) CreateCalls() []CACertificateServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeCACertificateService) CreateArgsForCall(i int) CACertificateServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) CreateStub(stub func(context.Context, *kong.CACertificate) (*kong.CACertificate, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeCACertificateService) CreateReturns(r0 *kong.CACertificate, r1 error) {
+	f.create.setReturns(caCertificateServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeCACertificateService) CreateReturnsOnCall(i int, r0 *kong.CACertificate, r1 error) {
+	f.create.setReturnsOnCall(i, caCertificateServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeCACertificateService) Get(ctx context.Context, ID *string) (*kong.CACertificate, error) {
+	call := f.get.record(CACertificateServiceGetCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, ID)
+	}
+	return call.results.r0, notProgrammed("FakeCACertificateService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeCACertificateService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeCACertificateService) GetCalls() []CACertificateServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeCACertificateService) GetArgsForCall(i int) CACertificateServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) GetStub(stub func(context.Context, *string) (*kong.CACertificate, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeCACertificateService) GetReturns(r0 *kong.CACertificate, r1 error) {
+	f.get.setReturns(caCertificateServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeCACertificateService) GetReturnsOnCall(i int, r0 *kong.CACertificate, r1 error) {
+	f.get.setReturnsOnCall(i, caCertificateServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeCACertificateService) Update(ctx context.Context, certificate *kong.CACertificate) (*kong.CACertificate, error) {
+	call := f.update.record(CACertificateServiceUpdateCall{Ctx: ctx, Certificate: certificate})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, certificate)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, certificate)
+	}
+	return call.results.r0, notProgrammed("FakeCACertificateService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeCACertificateService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeCACertificateService) UpdateCalls() []CACertificateServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeCACertificateService) UpdateArgsForCall(i int) CACertificateServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) UpdateStub(stub func(context.Context, *kong.CACertificate) (*kong.CACertificate, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeCACertificateService) UpdateReturns(r0 *kong.CACertificate, r1 error) {
+	f.update.setReturns(caCertificateServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeCACertificateService) UpdateReturnsOnCall(i int, r0 *kong.CACertificate, r1 error) {
+	f.update.setReturnsOnCall(i, caCertificateServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeCACertificateService) Delete(ctx context.Context, ID *string) error {
+	call := f.delete.record(CACertificateServiceDeleteCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, ID)
+	}
+	return notProgrammed("FakeCACertificateService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeCACertificateService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeCACertificateService) DeleteCalls() []CACertificateServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeCACertificateService) DeleteArgsForCall(i int) CACertificateServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeCACertificateService) DeleteReturns(r0 error) {
+	f.delete.setReturns(caCertificateServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeCACertificateService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, caCertificateServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeCACertificateService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.CACertificate, *kong.ListOpt, error) {
+	call := f.list.record(CACertificateServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeCACertificateService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeCACertificateService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeCACertificateService) ListCalls() []CACertificateServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeCACertificateService) ListArgsForCall(i int) CACertificateServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.CACertificate, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeCACertificateService) ListReturns(r0 []*kong.CACertificate, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(caCertificateServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeCACertificateService) ListReturnsOnCall(i int, r0 []*kong.CACertificate, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, caCertificateServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeCACertificateService) ListAll(ctx context.Context) ([]*kong.CACertificate, error) {
+	call := f.listAll.record(CACertificateServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeCACertificateService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeCACertificateService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeCACertificateService) ListAllCalls() []CACertificateServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeCACertificateService) ListAllArgsForCall(i int) CACertificateServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCACertificateService) ListAllStub(stub func(context.Context) ([]*kong.CACertificate, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeCACertificateService) ListAllReturns(r0 []*kong.CACertificate, r1 error) {
+	f.listAll.setReturns(caCertificateServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeCACertificateService) ListAllReturnsOnCall(i int, r0 []*kong.CACertificate, r1 error) {
+	f.listAll.setReturnsOnCall(i, caCertificateServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeCACertificateService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// CertificateServiceCreateCall holds the arguments of a call to FakeCertificateService.Create.
+type CertificateServiceCreateCall struct {
+	Ctx         context.Context
+	Certificate *kong.Certificate
+}
+
+type certificateServiceCreateResults struct {
+	r0 *kong.Certificate
+	r1 error
+}
+
+// CertificateServiceGetCall holds the arguments of a call to FakeCertificateService.Get.
+type CertificateServiceGetCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type certificateServiceGetResults struct {
+	r0 *kong.Certificate
+	r1 error
+}
+
+// CertificateServiceUpdateCall holds the arguments of a call to FakeCertificateService.Update.
+type CertificateServiceUpdateCall struct {
+	Ctx         context.Context
+	Certificate *kong.Certificate
+}
+
+type certificateServiceUpdateResults struct {
+	r0 *kong.Certificate
+	r1 error
+}
+
+// CertificateServiceDeleteCall holds the arguments of a call to FakeCertificateService.Delete.
+type CertificateServiceDeleteCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type certificateServiceDeleteResults struct {
+	r0 error
+}
+
+// CertificateServiceListCall holds the arguments of a call to FakeCertificateService.List.
+type CertificateServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type certificateServiceListResults struct {
+	r0 []*kong.Certificate
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// CertificateServiceListAllCall holds the arguments of a call to FakeCertificateService.ListAll.
+type CertificateServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type certificateServiceListAllResults struct {
+	r0 []*kong.Certificate
+	r1 error
+}
+
+// FakeCertificateService is a fake implementation of kong.AbstractCertificateService.
+type FakeCertificateService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractCertificateService
+
+	create  method[CertificateServiceCreateCall, certificateServiceCreateResults, func(context.Context, *kong.Certificate) (*kong.Certificate, error)]
+	get     method[CertificateServiceGetCall, certificateServiceGetResults, func(context.Context, *string) (*kong.Certificate, error)]
+	update  method[CertificateServiceUpdateCall, certificateServiceUpdateResults, func(context.Context, *kong.Certificate) (*kong.Certificate, error)]
+	delete  method[CertificateServiceDeleteCall, certificateServiceDeleteResults, func(context.Context, *string) error]
+	list    method[CertificateServiceListCall, certificateServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Certificate, *kong.ListOpt, error)]
+	listAll method[CertificateServiceListAllCall, certificateServiceListAllResults, func(context.Context) ([]*kong.Certificate, error)]
+}
+
+var _ kong.AbstractCertificateService = &FakeCertificateService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeCertificateService) Create(ctx context.Context, certificate *kong.Certificate) (*kong.Certificate, error) {
+	call := f.create.record(CertificateServiceCreateCall{Ctx: ctx, Certificate: certificate})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, certificate)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, certificate)
+	}
+	return call.results.r0, notProgrammed("FakeCertificateService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeCertificateService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeCertificateService) CreateCalls() []CertificateServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeCertificateService) CreateArgsForCall(i int) CertificateServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) CreateStub(stub func(context.Context, *kong.Certificate) (*kong.Certificate, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeCertificateService) CreateReturns(r0 *kong.Certificate, r1 error) {
+	f.create.setReturns(certificateServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeCertificateService) CreateReturnsOnCall(i int, r0 *kong.Certificate, r1 error) {
+	f.create.setReturnsOnCall(i, certificateServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeCertificateService) Get(ctx context.Context, usernameOrID *string) (*kong.Certificate, error) {
+	call := f.get.record(CertificateServiceGetCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeCertificateService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeCertificateService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeCertificateService) GetCalls() []CertificateServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeCertificateService) GetArgsForCall(i int) CertificateServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) GetStub(stub func(context.Context, *string) (*kong.Certificate, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeCertificateService) GetReturns(r0 *kong.Certificate, r1 error) {
+	f.get.setReturns(certificateServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeCertificateService) GetReturnsOnCall(i int, r0 *kong.Certificate, r1 error) {
+	f.get.setReturnsOnCall(i, certificateServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeCertificateService) Update(ctx context.Context, certificate *kong.Certificate) (*kong.Certificate, error) {
+	call := f.update.record(CertificateServiceUpdateCall{Ctx: ctx, Certificate: certificate})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, certificate)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, certificate)
+	}
+	return call.results.r0, notProgrammed("FakeCertificateService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeCertificateService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeCertificateService) UpdateCalls() []CertificateServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeCertificateService) UpdateArgsForCall(i int) CertificateServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) UpdateStub(stub func(context.Context, *kong.Certificate) (*kong.Certificate, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeCertificateService) UpdateReturns(r0 *kong.Certificate, r1 error) {
+	f.update.setReturns(certificateServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeCertificateService) UpdateReturnsOnCall(i int, r0 *kong.Certificate, r1 error) {
+	f.update.setReturnsOnCall(i, certificateServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeCertificateService) Delete(ctx context.Context, usernameOrID *string) error {
+	call := f.delete.record(CertificateServiceDeleteCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, usernameOrID)
+	}
+	return notProgrammed("FakeCertificateService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeCertificateService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeCertificateService) DeleteCalls() []CertificateServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeCertificateService) DeleteArgsForCall(i int) CertificateServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeCertificateService) DeleteReturns(r0 error) {
+	f.delete.setReturns(certificateServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeCertificateService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, certificateServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeCertificateService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Certificate, *kong.ListOpt, error) {
+	call := f.list.record(CertificateServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeCertificateService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeCertificateService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeCertificateService) ListCalls() []CertificateServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeCertificateService) ListArgsForCall(i int) CertificateServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Certificate, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeCertificateService) ListReturns(r0 []*kong.Certificate, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(certificateServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeCertificateService) ListReturnsOnCall(i int, r0 []*kong.Certificate, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, certificateServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeCertificateService) ListAll(ctx context.Context) ([]*kong.Certificate, error) {
+	call := f.listAll.record(CertificateServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeCertificateService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeCertificateService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeCertificateService) ListAllCalls() []CertificateServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeCertificateService) ListAllArgsForCall(i int) CertificateServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCertificateService) ListAllStub(stub func(context.Context) ([]*kong.Certificate, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeCertificateService) ListAllReturns(r0 []*kong.Certificate, r1 error) {
+	f.listAll.setReturns(certificateServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeCertificateService) ListAllReturnsOnCall(i int, r0 []*kong.Certificate, r1 error) {
+	f.listAll.setReturnsOnCall(i, certificateServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeCertificateService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// ClonedPluginServiceCreateCall holds the arguments of a call to FakeClonedPluginService.Create.
+type ClonedPluginServiceCreateCall struct {
+	Ctx    context.Context
+	Plugin *kong.ClonedPluginDefinition
+}
+
+type clonedPluginServiceCreateResults struct {
+	r0 *kong.ClonedPluginDefinition
+	r1 error
+}
+
+// ClonedPluginServiceGetCall holds the arguments of a call to FakeClonedPluginService.Get.
+type ClonedPluginServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type clonedPluginServiceGetResults struct {
+	r0 *kong.ClonedPluginDefinition
+	r1 error
+}
+
+// ClonedPluginServiceUpdateCall holds the arguments of a call to FakeClonedPluginService.Update.
+type ClonedPluginServiceUpdateCall struct {
+	Ctx    context.Context
+	Plugin *kong.ClonedPluginDefinition
+}
+
+type clonedPluginServiceUpdateResults struct {
+	r0 *kong.ClonedPluginDefinition
+	r1 error
+}
+
+// ClonedPluginServiceDeleteCall holds the arguments of a call to FakeClonedPluginService.Delete.
+type ClonedPluginServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type clonedPluginServiceDeleteResults struct {
+	r0 error
+}
+
+// ClonedPluginServiceListCall holds the arguments of a call to FakeClonedPluginService.List.
+type ClonedPluginServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type clonedPluginServiceListResults struct {
+	r0 []*kong.ClonedPluginDefinition
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// ClonedPluginServiceListAllCall holds the arguments of a call to FakeClonedPluginService.ListAll.
+type ClonedPluginServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type clonedPluginServiceListAllResults struct {
+	r0 []*kong.ClonedPluginDefinition
+	r1 error
+}
+
+// ClonedPluginServiceValidateCall holds the arguments of a call to FakeClonedPluginService.Validate.
+type ClonedPluginServiceValidateCall struct {
+	Ctx    context.Context
+	Plugin *kong.ClonedPluginDefinition
+}
+
+type clonedPluginServiceValidateResults struct {
+	r0 error
+}
+
+// ClonedPluginServiceGetFullSchemaCall holds the arguments of a call to FakeClonedPluginService.GetFullSchema.
+type ClonedPluginServiceGetFullSchemaCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type clonedPluginServiceGetFullSchemaResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// FakeClonedPluginService is a fake implementation of kong.AbstractClonedPluginService.
+type FakeClonedPluginService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractClonedPluginService
+
+	create        method[ClonedPluginServiceCreateCall, clonedPluginServiceCreateResults, func(context.Context, *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error)]
+	get           method[ClonedPluginServiceGetCall, clonedPluginServiceGetResults, func(context.Context, *string) (*kong.ClonedPluginDefinition, error)]
+	update        method[ClonedPluginServiceUpdateCall, clonedPluginServiceUpdateResults, func(context.Context, *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error)]
+	delete        method[ClonedPluginServiceDeleteCall, clonedPluginServiceDeleteResults, func(context.Context, *string) error]
+	list          method[ClonedPluginServiceListCall, clonedPluginServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.ClonedPluginDefinition, *kong.ListOpt, error)]
+	listAll       method[ClonedPluginServiceListAllCall, clonedPluginServiceListAllResults, func(context.Context) ([]*kong.ClonedPluginDefinition, error)]
+	validate      method[ClonedPluginServiceValidateCall, clonedPluginServiceValidateResults, func(context.Context, *kong.ClonedPluginDefinition) error]
+	getFullSchema method[ClonedPluginServiceGetFullSchemaCall, clonedPluginServiceGetFullSchemaResults, func(context.Context, *string) (kong.Schema, error)]
+}
+
+var _ kong.AbstractClonedPluginService = &FakeClonedPluginService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeClonedPluginService) Create(ctx context.Context, plugin *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error) {
+	call := f.create.record(ClonedPluginServiceCreateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakeClonedPluginService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeClonedPluginService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeClonedPluginService) CreateCalls() []ClonedPluginServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeClonedPluginService) CreateArgsForCall(i int) ClonedPluginServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) CreateStub(stub func(context.Context, *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeClonedPluginService) CreateReturns(r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.create.setReturns(clonedPluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeClonedPluginService) CreateReturnsOnCall(i int, r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.create.setReturnsOnCall(i, clonedPluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeClonedPluginService) Get(ctx context.Context, nameOrID *string) (*kong.ClonedPluginDefinition, error) {
+	call := f.get.record(ClonedPluginServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeClonedPluginService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeClonedPluginService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeClonedPluginService) GetCalls() []ClonedPluginServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeClonedPluginService) GetArgsForCall(i int) ClonedPluginServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) GetStub(stub func(context.Context, *string) (*kong.ClonedPluginDefinition, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeClonedPluginService) GetReturns(r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.get.setReturns(clonedPluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeClonedPluginService) GetReturnsOnCall(i int, r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.get.setReturnsOnCall(i, clonedPluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeClonedPluginService) Update(ctx context.Context, plugin *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error) {
+	call := f.update.record(ClonedPluginServiceUpdateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakeClonedPluginService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeClonedPluginService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeClonedPluginService) UpdateCalls() []ClonedPluginServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeClonedPluginService) UpdateArgsForCall(i int) ClonedPluginServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) UpdateStub(stub func(context.Context, *kong.ClonedPluginDefinition) (*kong.ClonedPluginDefinition, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeClonedPluginService) UpdateReturns(r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.update.setReturns(clonedPluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeClonedPluginService) UpdateReturnsOnCall(i int, r0 *kong.ClonedPluginDefinition, r1 error) {
+	f.update.setReturnsOnCall(i, clonedPluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeClonedPluginService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(ClonedPluginServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeClonedPluginService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeClonedPluginService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeClonedPluginService) DeleteCalls() []ClonedPluginServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeClonedPluginService) DeleteArgsForCall(i int) ClonedPluginServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeClonedPluginService) DeleteReturns(r0 error) {
+	f.delete.setReturns(clonedPluginServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeClonedPluginService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, clonedPluginServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeClonedPluginService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.ClonedPluginDefinition, *kong.ListOpt, error) {
+	call := f.list.record(ClonedPluginServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeClonedPluginService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeClonedPluginService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeClonedPluginService) ListCalls() []ClonedPluginServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeClonedPluginService) ListArgsForCall(i int) ClonedPluginServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.ClonedPluginDefinition, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeClonedPluginService) ListReturns(r0 []*kong.ClonedPluginDefinition, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(clonedPluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeClonedPluginService) ListReturnsOnCall(i int, r0 []*kong.ClonedPluginDefinition, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, clonedPluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeClonedPluginService) ListAll(ctx context.Context) ([]*kong.ClonedPluginDefinition, error) {
+	call := f.listAll.record(ClonedPluginServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeClonedPluginService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeClonedPluginService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeClonedPluginService) ListAllCalls() []ClonedPluginServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeClonedPluginService) ListAllArgsForCall(i int) ClonedPluginServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) ListAllStub(stub func(context.Context) ([]*kong.ClonedPluginDefinition, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeClonedPluginService) ListAllReturns(r0 []*kong.ClonedPluginDefinition, r1 error) {
+	f.listAll.setReturns(clonedPluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeClonedPluginService) ListAllReturnsOnCall(i int, r0 []*kong.ClonedPluginDefinition, r1 error) {
+	f.listAll.setReturnsOnCall(i, clonedPluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Validate records the call and returns the programmed results.
+func (f *FakeClonedPluginService) Validate(ctx context.Context, plugin *kong.ClonedPluginDefinition) error {
+	call := f.validate.record(ClonedPluginServiceValidateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Validate(ctx, plugin)
+	}
+	return notProgrammed("FakeClonedPluginService.Validate")
+}
+
+// ValidateCallCount returns the number of calls to Validate.
+func (f *FakeClonedPluginService) ValidateCallCount() int {
+	return f.validate.callCount()
+}
+
+// ValidateCalls returns the arguments of the calls to Validate.
+func (f *FakeClonedPluginService) ValidateCalls() []ClonedPluginServiceValidateCall {
+	return f.validate.allCalls()
+}
+
+// ValidateArgsForCall returns the arguments of the i-th call to Validate.
+func (f *FakeClonedPluginService) ValidateArgsForCall(i int) ClonedPluginServiceValidateCall {
+	return f.validate.argsForCall(i)
+}
+
+// ValidateStub makes Validate delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) ValidateStub(stub func(context.Context, *kong.ClonedPluginDefinition) error) {
+	f.validate.setStub(stub, stub != nil)
+}
+
+// ValidateReturns makes Validate return the given values.
+func (f *FakeClonedPluginService) ValidateReturns(r0 error) {
+	f.validate.setReturns(clonedPluginServiceValidateResults{r0: r0})
+}
+
+// ValidateReturnsOnCall makes the i-th call to Validate return the given values.
+func (f *FakeClonedPluginService) ValidateReturnsOnCall(i int, r0 error) {
+	f.validate.setReturnsOnCall(i, clonedPluginServiceValidateResults{r0: r0})
+}
+
+// GetFullSchema records the call and returns the programmed results.
+func (f *FakeClonedPluginService) GetFullSchema(ctx context.Context, nameOrID *string) (kong.Schema, error) {
+	call := f.getFullSchema.record(ClonedPluginServiceGetFullSchemaCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.GetFullSchema(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeClonedPluginService.GetFullSchema")
+}
+
+// GetFullSchemaCallCount returns the number of calls to GetFullSchema.
+func (f *FakeClonedPluginService) GetFullSchemaCallCount() int {
+	return f.getFullSchema.callCount()
+}
+
+// GetFullSchemaCalls returns the arguments of the calls to GetFullSchema.
+func (f *FakeClonedPluginService) GetFullSchemaCalls() []ClonedPluginServiceGetFullSchemaCall {
+	return f.getFullSchema.allCalls()
+}
+
+// GetFullSchemaArgsForCall returns the arguments of the i-th call to GetFullSchema.
+func (f *FakeClonedPluginService) GetFullSchemaArgsForCall(i int) ClonedPluginServiceGetFullSchemaCall {
+	return f.getFullSchema.argsForCall(i)
+}
+
+// GetFullSchemaStub makes GetFullSchema delegate to stub, or stop doing so if stub is nil.
+func (f *FakeClonedPluginService) GetFullSchemaStub(stub func(context.Context, *string) (kong.Schema, error)) {
+	f.getFullSchema.setStub(stub, stub != nil)
+}
+
+// GetFullSchemaReturns makes GetFullSchema return the given values.
+func (f *FakeClonedPluginService) GetFullSchemaReturns(r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturns(clonedPluginServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// GetFullSchemaReturnsOnCall makes the i-th call to GetFullSchema return the given values.
+func (f *FakeClonedPluginService) GetFullSchemaReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturnsOnCall(i, clonedPluginServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeClonedPluginService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.validate.reset()
+	f.getFullSchema.reset()
+}
+
+// ConsumerGroupConsumerServiceCreateCall holds the arguments of a call to FakeConsumerGroupConsumerService.Create.
+type ConsumerGroupConsumerServiceCreateCall struct {
+	Ctx                   context.Context
+	ConsumerGroupNameOrID *string
+	ConsumerNameOrID      *string
+}
+
+type consumerGroupConsumerServiceCreateResults struct {
+	r0 *kong.ConsumerGroupObject
+	r1 error
+}
+
+// ConsumerGroupConsumerServiceDeleteCall holds the arguments of a call to FakeConsumerGroupConsumerService.Delete.
+type ConsumerGroupConsumerServiceDeleteCall struct {
+	Ctx                   context.Context
+	ConsumerGroupNameOrID *string
+	ConsumerNameOrID      *string
+}
+
+type consumerGroupConsumerServiceDeleteResults struct {
+	r0 error
+}
+
+// ConsumerGroupConsumerServiceListAllCall holds the arguments of a call to FakeConsumerGroupConsumerService.ListAll.
+type ConsumerGroupConsumerServiceListAllCall struct {
+	Ctx                   context.Context
+	ConsumerGroupNameOrID *string
+}
+
+type consumerGroupConsumerServiceListAllResults struct {
+	r0 []*kong.Consumer
+	r1 error
+}
+
+// FakeConsumerGroupConsumerService is a fake implementation of kong.AbstractConsumerGroupConsumerService.
+type FakeConsumerGroupConsumerService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractConsumerGroupConsumerService
+
+	create  method[ConsumerGroupConsumerServiceCreateCall, consumerGroupConsumerServiceCreateResults, func(context.Context, *string, *string) (*kong.ConsumerGroupObject, error)]
+	delete  method[ConsumerGroupConsumerServiceDeleteCall, consumerGroupConsumerServiceDeleteResults, func(context.Context, *string, *string) error]
+	listAll method[ConsumerGroupConsumerServiceListAllCall, consumerGroupConsumerServiceListAllResults, func(context.Context, *string) ([]*kong.Consumer, error)]
+}
+
+var _ kong.AbstractConsumerGroupConsumerService = &FakeConsumerGroupConsumerService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeConsumerGroupConsumerService) Create(ctx context.Context, consumerGroupNameOrID *string, consumerNameOrID *string) (*kong.ConsumerGroupObject, error) {
+	call := f.create.record(ConsumerGroupConsumerServiceCreateCall{Ctx: ctx, ConsumerGroupNameOrID: consumerGroupNameOrID, ConsumerNameOrID: consumerNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerGroupNameOrID, consumerNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerGroupNameOrID, consumerNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupConsumerService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeConsumerGroupConsumerService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeConsumerGroupConsumerService) CreateCalls() []ConsumerGroupConsumerServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeConsumerGroupConsumerService) CreateArgsForCall(i int) ConsumerGroupConsumerServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupConsumerService) CreateStub(stub func(context.Context, *string, *string) (*kong.ConsumerGroupObject, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeConsumerGroupConsumerService) CreateReturns(r0 *kong.ConsumerGroupObject, r1 error) {
+	f.create.setReturns(consumerGroupConsumerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeConsumerGroupConsumerService) CreateReturnsOnCall(i int, r0 *kong.ConsumerGroupObject, r1 error) {
+	f.create.setReturnsOnCall(i, consumerGroupConsumerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeConsumerGroupConsumerService) Delete(ctx context.Context, consumerGroupNameOrID *string, consumerNameOrID *string) error {
+	call := f.delete.record(ConsumerGroupConsumerServiceDeleteCall{Ctx: ctx, ConsumerGroupNameOrID: consumerGroupNameOrID, ConsumerNameOrID: consumerNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerGroupNameOrID, consumerNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerGroupNameOrID, consumerNameOrID)
+	}
+	return notProgrammed("FakeConsumerGroupConsumerService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeConsumerGroupConsumerService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeConsumerGroupConsumerService) DeleteCalls() []ConsumerGroupConsumerServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeConsumerGroupConsumerService) DeleteArgsForCall(i int) ConsumerGroupConsumerServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupConsumerService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeConsumerGroupConsumerService) DeleteReturns(r0 error) {
+	f.delete.setReturns(consumerGroupConsumerServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeConsumerGroupConsumerService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, consumerGroupConsumerServiceDeleteResults{r0: r0})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeConsumerGroupConsumerService) ListAll(ctx context.Context, consumerGroupNameOrID *string) ([]*kong.Consumer, error) {
+	call := f.listAll.record(ConsumerGroupConsumerServiceListAllCall{Ctx: ctx, ConsumerGroupNameOrID: consumerGroupNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerGroupNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx, consumerGroupNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupConsumerService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeConsumerGroupConsumerService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeConsumerGroupConsumerService) ListAllCalls() []ConsumerGroupConsumerServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeConsumerGroupConsumerService) ListAllArgsForCall(i int) ConsumerGroupConsumerServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupConsumerService) ListAllStub(stub func(context.Context, *string) ([]*kong.Consumer, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeConsumerGroupConsumerService) ListAllReturns(r0 []*kong.Consumer, r1 error) {
+	f.listAll.setReturns(consumerGroupConsumerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeConsumerGroupConsumerService) ListAllReturnsOnCall(i int, r0 []*kong.Consumer, r1 error) {
+	f.listAll.setReturnsOnCall(i, consumerGroupConsumerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeConsumerGroupConsumerService) Reset() {
+	f.create.reset()
+	f.delete.reset()
+	f.listAll.reset()
+}
+
+// ConsumerGroupServiceCreateCall holds the arguments of a call to FakeConsumerGroupService.Create.
+type ConsumerGroupServiceCreateCall struct {
+	Ctx           context.Context
+	ConsumerGroup *kong.ConsumerGroup
+}
+
+type consumerGroupServiceCreateResults struct {
+	r0 *kong.ConsumerGroup
+	r1 error
+}
+
+// ConsumerGroupServiceGetCall holds the arguments of a call to FakeConsumerGroupService.Get.
+type ConsumerGroupServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type consumerGroupServiceGetResults struct {
+	r0 *kong.ConsumerGroupObject
+	r1 error
+}
+
+// ConsumerGroupServiceGetWithNoConsumersCall holds the arguments of a call to FakeConsumerGroupService.GetWithNoConsumers.
+type ConsumerGroupServiceGetWithNoConsumersCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type consumerGroupServiceGetWithNoConsumersResults struct {
+	r0 *kong.ConsumerGroupObject
+	r1 error
+}
+
+// ConsumerGroupServiceUpdateCall holds the arguments of a call to FakeConsumerGroupService.Update.
+type ConsumerGroupServiceUpdateCall struct {
+	Ctx           context.Context
+	ConsumerGroup *kong.ConsumerGroup
+}
+
+type consumerGroupServiceUpdateResults struct {
+	r0 *kong.ConsumerGroup
+	r1 error
+}
+
+// ConsumerGroupServiceDeleteCall holds the arguments of a call to FakeConsumerGroupService.Delete.
+type ConsumerGroupServiceDeleteCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type consumerGroupServiceDeleteResults struct {
+	r0 error
+}
+
+// ConsumerGroupServiceListCall holds the arguments of a call to FakeConsumerGroupService.List.
+type ConsumerGroupServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type consumerGroupServiceListResults struct {
+	r0 []*kong.ConsumerGroup
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// ConsumerGroupServiceListAllCall holds the arguments of a call to FakeConsumerGroupService.ListAll.
+type ConsumerGroupServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type consumerGroupServiceListAllResults struct {
+	r0 []*kong.ConsumerGroup
+	r1 error
+}
+
+// ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall holds the arguments of a call to FakeConsumerGroupService.UpdateRateLimitingAdvancedPlugin.
+type ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall struct {
+	Ctx      context.Context
+	NameOrID *string
+	Config   map[string]kong.Configuration
+}
+
+type consumerGroupServiceUpdateRateLimitingAdvancedPluginResults struct {
+	r0 *kong.ConsumerGroupRLA
+	r1 error
+}
+
+// FakeConsumerGroupService is a fake implementation of kong.AbstractConsumerGroupService.
+type FakeConsumerGroupService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractConsumerGroupService
+
+	create                           method[ConsumerGroupServiceCreateCall, consumerGroupServiceCreateResults, func(context.Context, *kong.ConsumerGroup) (*kong.ConsumerGroup, error)]
+	get                              method[ConsumerGroupServiceGetCall, consumerGroupServiceGetResults, func(context.Context, *string) (*kong.ConsumerGroupObject, error)]
+	getWithNoConsumers               method[ConsumerGroupServiceGetWithNoConsumersCall, consumerGroupServiceGetWithNoConsumersResults, func(context.Context, *string) (*kong.ConsumerGroupObject, error)]
+	update                           method[ConsumerGroupServiceUpdateCall, consumerGroupServiceUpdateResults, func(context.Context, *kong.ConsumerGroup) (*kong.ConsumerGroup, error)]
+	delete                           method[ConsumerGroupServiceDeleteCall, consumerGroupServiceDeleteResults, func(context.Context, *string) error]
+	list                             method[ConsumerGroupServiceListCall, consumerGroupServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.ConsumerGroup, *kong.ListOpt, error)]
+	listAll                          method[ConsumerGroupServiceListAllCall, consumerGroupServiceListAllResults, func(context.Context) ([]*kong.ConsumerGroup, error)]
+	updateRateLimitingAdvancedPlugin method[ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall, consumerGroupServiceUpdateRateLimitingAdvancedPluginResults, func(context.Context, *string, map[string]kong.Configuration) (*kong.ConsumerGroupRLA, error)]
+}
+
+var _ kong.AbstractConsumerGroupService = &FakeConsumerGroupService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) Create(ctx context.Context, consumerGroup *kong.ConsumerGroup) (*kong.ConsumerGroup, error) {
+	call := f.create.record(ConsumerGroupServiceCreateCall{Ctx: ctx, ConsumerGroup: consumerGroup})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerGroup)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerGroup)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeConsumerGroupService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeConsumerGroupService) CreateCalls() []ConsumerGroupServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeConsumerGroupService) CreateArgsForCall(i int) ConsumerGroupServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) CreateStub(stub func(context.Context, *kong.ConsumerGroup) (*kong.ConsumerGroup, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeConsumerGroupService) CreateReturns(r0 *kong.ConsumerGroup, r1 error) {
+	f.create.setReturns(consumerGroupServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeConsumerGroupService) CreateReturnsOnCall(i int, r0 *kong.ConsumerGroup, r1 error) {
+	f.create.setReturnsOnCall(i, consumerGroupServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) Get(ctx context.Context, nameOrID *string) (*kong.ConsumerGroupObject, error) {
+	call := f.get.record(ConsumerGroupServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeConsumerGroupService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeConsumerGroupService) GetCalls() []ConsumerGroupServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeConsumerGroupService) GetArgsForCall(i int) ConsumerGroupServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) GetStub(stub func(context.Context, *string) (*kong.ConsumerGroupObject, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeConsumerGroupService) GetReturns(r0 *kong.ConsumerGroupObject, r1 error) {
+	f.get.setReturns(consumerGroupServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeConsumerGroupService) GetReturnsOnCall(i int, r0 *kong.ConsumerGroupObject, r1 error) {
+	f.get.setReturnsOnCall(i, consumerGroupServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetWithNoConsumers records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) GetWithNoConsumers(ctx context.Context, nameOrID *string) (*kong.ConsumerGroupObject, error) {
+	call := f.getWithNoConsumers.record(ConsumerGroupServiceGetWithNoConsumersCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.GetWithNoConsumers(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.GetWithNoConsumers")
+}
+
+// GetWithNoConsumersCallCount returns the number of calls to GetWithNoConsumers.
+func (f *FakeConsumerGroupService) GetWithNoConsumersCallCount() int {
+	return f.getWithNoConsumers.callCount()
+}
+
+// GetWithNoConsumersCalls returns the arguments of the calls to GetWithNoConsumers.
+func (f *FakeConsumerGroupService) GetWithNoConsumersCalls() []ConsumerGroupServiceGetWithNoConsumersCall {
+	return f.getWithNoConsumers.allCalls()
+}
+
+// GetWithNoConsumersArgsForCall returns the arguments of the i-th call to GetWithNoConsumers.
+func (f *FakeConsumerGroupService) GetWithNoConsumersArgsForCall(i int) ConsumerGroupServiceGetWithNoConsumersCall {
+	return f.getWithNoConsumers.argsForCall(i)
+}
+
+// GetWithNoConsumersStub makes GetWithNoConsumers delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) GetWithNoConsumersStub(stub func(context.Context, *string) (*kong.ConsumerGroupObject, error)) {
+	f.getWithNoConsumers.setStub(stub, stub != nil)
+}
+
+// GetWithNoConsumersReturns makes GetWithNoConsumers return the given values.
+func (f *FakeConsumerGroupService) GetWithNoConsumersReturns(r0 *kong.ConsumerGroupObject, r1 error) {
+	f.getWithNoConsumers.setReturns(consumerGroupServiceGetWithNoConsumersResults{r0: r0, r1: r1})
+}
+
+// GetWithNoConsumersReturnsOnCall makes the i-th call to GetWithNoConsumers return the given values.
+func (f *FakeConsumerGroupService) GetWithNoConsumersReturnsOnCall(i int, r0 *kong.ConsumerGroupObject, r1 error) {
+	f.getWithNoConsumers.setReturnsOnCall(i, consumerGroupServiceGetWithNoConsumersResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) Update(ctx context.Context, consumerGroup *kong.ConsumerGroup) (*kong.ConsumerGroup, error) {
+	call := f.update.record(ConsumerGroupServiceUpdateCall{Ctx: ctx, ConsumerGroup: consumerGroup})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerGroup)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerGroup)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeConsumerGroupService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeConsumerGroupService) UpdateCalls() []ConsumerGroupServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeConsumerGroupService) UpdateArgsForCall(i int) ConsumerGroupServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) UpdateStub(stub func(context.Context, *kong.ConsumerGroup) (*kong.ConsumerGroup, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeConsumerGroupService) UpdateReturns(r0 *kong.ConsumerGroup, r1 error) {
+	f.update.setReturns(consumerGroupServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeConsumerGroupService) UpdateReturnsOnCall(i int, r0 *kong.ConsumerGroup, r1 error) {
+	f.update.setReturnsOnCall(i, consumerGroupServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) Delete(ctx context.Context, usernameOrID *string) error {
+	call := f.delete.record(ConsumerGroupServiceDeleteCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, usernameOrID)
+	}
+	return notProgrammed("FakeConsumerGroupService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeConsumerGroupService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeConsumerGroupService) DeleteCalls() []ConsumerGroupServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeConsumerGroupService) DeleteArgsForCall(i int) ConsumerGroupServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeConsumerGroupService) DeleteReturns(r0 error) {
+	f.delete.setReturns(consumerGroupServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeConsumerGroupService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, consumerGroupServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.ConsumerGroup, *kong.ListOpt, error) {
+	call := f.list.record(ConsumerGroupServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeConsumerGroupService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeConsumerGroupService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeConsumerGroupService) ListCalls() []ConsumerGroupServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeConsumerGroupService) ListArgsForCall(i int) ConsumerGroupServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.ConsumerGroup, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeConsumerGroupService) ListReturns(r0 []*kong.ConsumerGroup, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(consumerGroupServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeConsumerGroupService) ListReturnsOnCall(i int, r0 []*kong.ConsumerGroup, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, consumerGroupServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) ListAll(ctx context.Context) ([]*kong.ConsumerGroup, error) {
+	call := f.listAll.record(ConsumerGroupServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeConsumerGroupService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeConsumerGroupService) ListAllCalls() []ConsumerGroupServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeConsumerGroupService) ListAllArgsForCall(i int) ConsumerGroupServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) ListAllStub(stub func(context.Context) ([]*kong.ConsumerGroup, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeConsumerGroupService) ListAllReturns(r0 []*kong.ConsumerGroup, r1 error) {
+	f.listAll.setReturns(consumerGroupServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeConsumerGroupService) ListAllReturnsOnCall(i int, r0 []*kong.ConsumerGroup, r1 error) {
+	f.listAll.setReturnsOnCall(i, consumerGroupServiceListAllResults{r0: r0, r1: r1})
+}
+
+// UpdateRateLimitingAdvancedPlugin records the call and returns the programmed results.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPlugin(ctx context.Context, nameOrID *string, config map[string]kong.Configuration) (*kong.ConsumerGroupRLA, error) {
+	call := f.updateRateLimitingAdvancedPlugin.record(ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall{Ctx: ctx, NameOrID: nameOrID, Config: config})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID, config)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateRateLimitingAdvancedPlugin(ctx, nameOrID, config)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerGroupService.UpdateRateLimitingAdvancedPlugin")
+}
+
+// UpdateRateLimitingAdvancedPluginCallCount returns the number of calls to UpdateRateLimitingAdvancedPlugin.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginCallCount() int {
+	return f.updateRateLimitingAdvancedPlugin.callCount()
+}
+
+// UpdateRateLimitingAdvancedPluginCalls returns the arguments of the calls to UpdateRateLimitingAdvancedPlugin.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginCalls() []ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall {
+	return f.updateRateLimitingAdvancedPlugin.allCalls()
+}
+
+// UpdateRateLimitingAdvancedPluginArgsForCall returns the arguments of the i-th call to UpdateRateLimitingAdvancedPlugin.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginArgsForCall(i int) ConsumerGroupServiceUpdateRateLimitingAdvancedPluginCall {
+	return f.updateRateLimitingAdvancedPlugin.argsForCall(i)
+}
+
+// UpdateRateLimitingAdvancedPluginStub makes UpdateRateLimitingAdvancedPlugin delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginStub(stub func(context.Context, *string, map[string]kong.Configuration) (*kong.ConsumerGroupRLA, error)) {
+	f.updateRateLimitingAdvancedPlugin.setStub(stub, stub != nil)
+}
+
+// UpdateRateLimitingAdvancedPluginReturns makes UpdateRateLimitingAdvancedPlugin return the given values.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginReturns(r0 *kong.ConsumerGroupRLA, r1 error) {
+	f.updateRateLimitingAdvancedPlugin.setReturns(consumerGroupServiceUpdateRateLimitingAdvancedPluginResults{r0: r0, r1: r1})
+}
+
+// UpdateRateLimitingAdvancedPluginReturnsOnCall makes the i-th call to UpdateRateLimitingAdvancedPlugin return the given values.
+func (f *FakeConsumerGroupService) UpdateRateLimitingAdvancedPluginReturnsOnCall(i int, r0 *kong.ConsumerGroupRLA, r1 error) {
+	f.updateRateLimitingAdvancedPlugin.setReturnsOnCall(i, consumerGroupServiceUpdateRateLimitingAdvancedPluginResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeConsumerGroupService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getWithNoConsumers.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.updateRateLimitingAdvancedPlugin.reset()
+}
+
+// ConsumerServiceCreateCall holds the arguments of a call to FakeConsumerService.Create.
+type ConsumerServiceCreateCall struct {
+	Ctx      context.Context
+	Consumer *kong.Consumer
+}
+
+type consumerServiceCreateResults struct {
+	r0 *kong.Consumer
+	r1 error
+}
+
+// ConsumerServiceGetCall holds the arguments of a call to FakeConsumerService.Get.
+type ConsumerServiceGetCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type consumerServiceGetResults struct {
+	r0 *kong.Consumer
+	r1 error
+}
+
+// ConsumerServiceGetByCustomIDCall holds the arguments of a call to FakeConsumerService.GetByCustomID.
+type ConsumerServiceGetByCustomIDCall struct {
+	Ctx      context.Context
+	CustomID *string
+}
+
+type consumerServiceGetByCustomIDResults struct {
+	r0 *kong.Consumer
+	r1 error
+}
+
+// ConsumerServiceUpdateCall holds the arguments of a call to FakeConsumerService.Update.
+type ConsumerServiceUpdateCall struct {
+	Ctx      context.Context
+	Consumer *kong.Consumer
+}
+
+type consumerServiceUpdateResults struct {
+	r0 *kong.Consumer
+	r1 error
+}
+
+// ConsumerServiceDeleteCall holds the arguments of a call to FakeConsumerService.Delete.
+type ConsumerServiceDeleteCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type consumerServiceDeleteResults struct {
+	r0 error
+}
+
+// ConsumerServiceListCall holds the arguments of a call to FakeConsumerService.List.
+type ConsumerServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type consumerServiceListResults struct {
+	r0 []*kong.Consumer
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// ConsumerServiceListAllCall holds the arguments of a call to FakeConsumerService.ListAll.
+type ConsumerServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type consumerServiceListAllResults struct {
+	r0 []*kong.Consumer
+	r1 error
+}
+
+// FakeConsumerService is a fake implementation of kong.AbstractConsumerService.
+type FakeConsumerService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractConsumerService
+
+	create        method[ConsumerServiceCreateCall, consumerServiceCreateResults, func(context.Context, *kong.Consumer) (*kong.Consumer, error)]
+	get           method[ConsumerServiceGetCall, consumerServiceGetResults, func(context.Context, *string) (*kong.Consumer, error)]
+	getByCustomID method[ConsumerServiceGetByCustomIDCall, consumerServiceGetByCustomIDResults, func(context.Context, *string) (*kong.Consumer, error)]
+	update        method[ConsumerServiceUpdateCall, consumerServiceUpdateResults, func(context.Context, *kong.Consumer) (*kong.Consumer, error)]
+	delete        method[ConsumerServiceDeleteCall, consumerServiceDeleteResults, func(context.Context, *string) error]
+	list          method[ConsumerServiceListCall, consumerServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Consumer, *kong.ListOpt, error)]
+	listAll       method[ConsumerServiceListAllCall, consumerServiceListAllResults, func(context.Context) ([]*kong.Consumer, error)]
+}
+
+var _ kong.AbstractConsumerService = &FakeConsumerService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeConsumerService) Create(ctx context.Context, consumer *kong.Consumer) (*kong.Consumer, error) {
+	call := f.create.record(ConsumerServiceCreateCall{Ctx: ctx, Consumer: consumer})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumer)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumer)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeConsumerService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeConsumerService) CreateCalls() []ConsumerServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeConsumerService) CreateArgsForCall(i int) ConsumerServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) CreateStub(stub func(context.Context, *kong.Consumer) (*kong.Consumer, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeConsumerService) CreateReturns(r0 *kong.Consumer, r1 error) {
+	f.create.setReturns(consumerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeConsumerService) CreateReturnsOnCall(i int, r0 *kong.Consumer, r1 error) {
+	f.create.setReturnsOnCall(i, consumerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeConsumerService) Get(ctx context.Context, usernameOrID *string) (*kong.Consumer, error) {
+	call := f.get.record(ConsumerServiceGetCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeConsumerService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeConsumerService) GetCalls() []ConsumerServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeConsumerService) GetArgsForCall(i int) ConsumerServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) GetStub(stub func(context.Context, *string) (*kong.Consumer, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeConsumerService) GetReturns(r0 *kong.Consumer, r1 error) {
+	f.get.setReturns(consumerServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeConsumerService) GetReturnsOnCall(i int, r0 *kong.Consumer, r1 error) {
+	f.get.setReturnsOnCall(i, consumerServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByCustomID records the call and returns the programmed results.
+func (f *FakeConsumerService) GetByCustomID(ctx context.Context, customID *string) (*kong.Consumer, error) {
+	call := f.getByCustomID.record(ConsumerServiceGetByCustomIDCall{Ctx: ctx, CustomID: customID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, customID)
+	case f.Fallback != nil:
+		return f.Fallback.GetByCustomID(ctx, customID)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerService.GetByCustomID")
+}
+
+// GetByCustomIDCallCount returns the number of calls to GetByCustomID.
+func (f *FakeConsumerService) GetByCustomIDCallCount() int {
+	return f.getByCustomID.callCount()
+}
+
+// GetByCustomIDCalls returns the arguments of the calls to GetByCustomID.
+func (f *FakeConsumerService) GetByCustomIDCalls() []ConsumerServiceGetByCustomIDCall {
+	return f.getByCustomID.allCalls()
+}
+
+// GetByCustomIDArgsForCall returns the arguments of the i-th call to GetByCustomID.
+func (f *FakeConsumerService) GetByCustomIDArgsForCall(i int) ConsumerServiceGetByCustomIDCall {
+	return f.getByCustomID.argsForCall(i)
+}
+
+// GetByCustomIDStub makes GetByCustomID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) GetByCustomIDStub(stub func(context.Context, *string) (*kong.Consumer, error)) {
+	f.getByCustomID.setStub(stub, stub != nil)
+}
+
+// GetByCustomIDReturns makes GetByCustomID return the given values.
+func (f *FakeConsumerService) GetByCustomIDReturns(r0 *kong.Consumer, r1 error) {
+	f.getByCustomID.setReturns(consumerServiceGetByCustomIDResults{r0: r0, r1: r1})
+}
+
+// GetByCustomIDReturnsOnCall makes the i-th call to GetByCustomID return the given values.
+func (f *FakeConsumerService) GetByCustomIDReturnsOnCall(i int, r0 *kong.Consumer, r1 error) {
+	f.getByCustomID.setReturnsOnCall(i, consumerServiceGetByCustomIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeConsumerService) Update(ctx context.Context, consumer *kong.Consumer) (*kong.Consumer, error) {
+	call := f.update.record(ConsumerServiceUpdateCall{Ctx: ctx, Consumer: consumer})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumer)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumer)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeConsumerService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeConsumerService) UpdateCalls() []ConsumerServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeConsumerService) UpdateArgsForCall(i int) ConsumerServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) UpdateStub(stub func(context.Context, *kong.Consumer) (*kong.Consumer, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeConsumerService) UpdateReturns(r0 *kong.Consumer, r1 error) {
+	f.update.setReturns(consumerServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeConsumerService) UpdateReturnsOnCall(i int, r0 *kong.Consumer, r1 error) {
+	f.update.setReturnsOnCall(i, consumerServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeConsumerService) Delete(ctx context.Context, usernameOrID *string) error {
+	call := f.delete.record(ConsumerServiceDeleteCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, usernameOrID)
+	}
+	return notProgrammed("FakeConsumerService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeConsumerService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeConsumerService) DeleteCalls() []ConsumerServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeConsumerService) DeleteArgsForCall(i int) ConsumerServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeConsumerService) DeleteReturns(r0 error) {
+	f.delete.setReturns(consumerServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeConsumerService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, consumerServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeConsumerService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Consumer, *kong.ListOpt, error) {
+	call := f.list.record(ConsumerServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeConsumerService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeConsumerService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeConsumerService) ListCalls() []ConsumerServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeConsumerService) ListArgsForCall(i int) ConsumerServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Consumer, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeConsumerService) ListReturns(r0 []*kong.Consumer, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(consumerServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeConsumerService) ListReturnsOnCall(i int, r0 []*kong.Consumer, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, consumerServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeConsumerService) ListAll(ctx context.Context) ([]*kong.Consumer, error) {
+	call := f.listAll.record(ConsumerServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeConsumerService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeConsumerService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeConsumerService) ListAllCalls() []ConsumerServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeConsumerService) ListAllArgsForCall(i int) ConsumerServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeConsumerService) ListAllStub(stub func(context.Context) ([]*kong.Consumer, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeConsumerService) ListAllReturns(r0 []*kong.Consumer, r1 error) {
+	f.listAll.setReturns(consumerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeConsumerService) ListAllReturnsOnCall(i int, r0 []*kong.Consumer, r1 error) {
+	f.listAll.setReturnsOnCall(i, consumerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeConsumerService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByCustomID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// CustomEntityServiceGetCall holds the arguments of a call to FakeCustomEntityService.Get.
+type CustomEntityServiceGetCall struct {
+	Ctx    context.Context
+	Entity custom.Entity
+}
+
+type customEntityServiceGetResults struct {
+	r0 custom.Entity
+	r1 error
+}
+
+// CustomEntityServiceCreateCall holds the arguments of a call to FakeCustomEntityService.Create.
+type CustomEntityServiceCreateCall struct {
+	Ctx    context.Context
+	Entity custom.Entity
+}
+
+type customEntityServiceCreateResults struct {
+	r0 custom.Entity
+	r1 error
+}
+
+// CustomEntityServiceUpdateCall holds the arguments of a call to FakeCustomEntityService.Update.
+type CustomEntityServiceUpdateCall struct {
+	Ctx    context.Context
+	Entity custom.Entity
+}
+
+type customEntityServiceUpdateResults struct {
+	r0 custom.Entity
+	r1 error
+}
+
+// CustomEntityServiceDeleteCall holds the arguments of a call to FakeCustomEntityService.Delete.
+type CustomEntityServiceDeleteCall struct {
+	Ctx    context.Context
+	Entity custom.Entity
+}
+
+type customEntityServiceDeleteResults struct {
+	r0 error
+}
+
+// CustomEntityServiceListCall holds the arguments of a call to FakeCustomEntityService.List.
+type CustomEntityServiceListCall struct {
+	Ctx    context.Context
+	Opt    *kong.ListOpt
+	Entity custom.Entity
+}
+
+type customEntityServiceListResults struct {
+	r0 []custom.Entity
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// CustomEntityServiceListAllCall holds the arguments of a call to FakeCustomEntityService.ListAll.
+type CustomEntityServiceListAllCall struct {
+	Ctx    context.Context
+	Entity custom.Entity
+}
+
+type customEntityServiceListAllResults struct {
+	r0 []custom.Entity
+	r1 error
+}
+
+// FakeCustomEntityService is a fake implementation of kong.AbstractCustomEntityService.
+type FakeCustomEntityService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractCustomEntityService
+
+	get     method[CustomEntityServiceGetCall, customEntityServiceGetResults, func(context.Context, custom.Entity) (custom.Entity, error)]
+	create  method[CustomEntityServiceCreateCall, customEntityServiceCreateResults, func(context.Context, custom.Entity) (custom.Entity, error)]
+	update  method[CustomEntityServiceUpdateCall, customEntityServiceUpdateResults, func(context.Context, custom.Entity) (custom.Entity, error)]
+	delete  method[CustomEntityServiceDeleteCall, customEntityServiceDeleteResults, func(context.Context, custom.Entity) error]
+	list    method[CustomEntityServiceListCall, customEntityServiceListResults, func(context.Context, *kong.ListOpt, custom.Entity) ([]custom.Entity, *kong.ListOpt, error)]
+	listAll method[CustomEntityServiceListAllCall, customEntityServiceListAllResults, func(context.Context, custom.Entity) ([]custom.Entity, error)]
+}
+
+var _ kong.AbstractCustomEntityService = &FakeCustomEntityService{}
+
+// Get records the call and returns the programmed results.
+func (f *FakeCustomEntityService) Get(ctx context.Context, entity custom.Entity) (custom.Entity, error) {
+	call := f.get.record(CustomEntityServiceGetCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, entity)
+	}
+	return call.results.r0, notProgrammed("FakeCustomEntityService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeCustomEntityService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeCustomEntityService) GetCalls() []CustomEntityServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeCustomEntityService) GetArgsForCall(i int) CustomEntityServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) GetStub(stub func(context.Context, custom.Entity) (custom.Entity, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeCustomEntityService) GetReturns(r0 custom.Entity, r1 error) {
+	f.get.setReturns(customEntityServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeCustomEntityService) GetReturnsOnCall(i int, r0 custom.Entity, r1 error) {
+	f.get.setReturnsOnCall(i, customEntityServiceGetResults{r0: r0, r1: r1})
+}
+
+// Create records the call and returns the programmed results.
+func (f *FakeCustomEntityService) Create(ctx context.Context, entity custom.Entity) (custom.Entity, error) {
+	call := f.create.record(CustomEntityServiceCreateCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, entity)
+	}
+	return call.results.r0, notProgrammed("FakeCustomEntityService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeCustomEntityService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeCustomEntityService) CreateCalls() []CustomEntityServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeCustomEntityService) CreateArgsForCall(i int) CustomEntityServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) CreateStub(stub func(context.Context, custom.Entity) (custom.Entity, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeCustomEntityService) CreateReturns(r0 custom.Entity, r1 error) {
+	f.create.setReturns(customEntityServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeCustomEntityService) CreateReturnsOnCall(i int, r0 custom.Entity, r1 error) {
+	f.create.setReturnsOnCall(i, customEntityServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeCustomEntityService) Update(ctx context.Context, entity custom.Entity) (custom.Entity, error) {
+	call := f.update.record(CustomEntityServiceUpdateCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, entity)
+	}
+	return call.results.r0, notProgrammed("FakeCustomEntityService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeCustomEntityService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeCustomEntityService) UpdateCalls() []CustomEntityServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeCustomEntityService) UpdateArgsForCall(i int) CustomEntityServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) UpdateStub(stub func(context.Context, custom.Entity) (custom.Entity, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeCustomEntityService) UpdateReturns(r0 custom.Entity, r1 error) {
+	f.update.setReturns(customEntityServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeCustomEntityService) UpdateReturnsOnCall(i int, r0 custom.Entity, r1 error) {
+	f.update.setReturnsOnCall(i, customEntityServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeCustomEntityService) Delete(ctx context.Context, entity custom.Entity) error {
+	call := f.delete.record(CustomEntityServiceDeleteCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, entity)
+	}
+	return notProgrammed("FakeCustomEntityService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeCustomEntityService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeCustomEntityService) DeleteCalls() []CustomEntityServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeCustomEntityService) DeleteArgsForCall(i int) CustomEntityServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) DeleteStub(stub func(context.Context, custom.Entity) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeCustomEntityService) DeleteReturns(r0 error) {
+	f.delete.setReturns(customEntityServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeCustomEntityService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, customEntityServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeCustomEntityService) List(ctx context.Context, opt *kong.ListOpt, entity custom.Entity) ([]custom.Entity, *kong.ListOpt, error) {
+	call := f.list.record(CustomEntityServiceListCall{Ctx: ctx, Opt: opt, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt, entity)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt, entity)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeCustomEntityService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeCustomEntityService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeCustomEntityService) ListCalls() []CustomEntityServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeCustomEntityService) ListArgsForCall(i int) CustomEntityServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) ListStub(stub func(context.Context, *kong.ListOpt, custom.Entity) ([]custom.Entity, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeCustomEntityService) ListReturns(r0 []custom.Entity, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(customEntityServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeCustomEntityService) ListReturnsOnCall(i int, r0 []custom.Entity, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, customEntityServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeCustomEntityService) ListAll(ctx context.Context, entity custom.Entity) ([]custom.Entity, error) {
+	call := f.listAll.record(CustomEntityServiceListAllCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx, entity)
+	}
+	return call.results.r0, notProgrammed("FakeCustomEntityService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeCustomEntityService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeCustomEntityService) ListAllCalls() []CustomEntityServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeCustomEntityService) ListAllArgsForCall(i int) CustomEntityServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomEntityService) ListAllStub(stub func(context.Context, custom.Entity) ([]custom.Entity, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeCustomEntityService) ListAllReturns(r0 []custom.Entity, r1 error) {
+	f.listAll.setReturns(customEntityServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeCustomEntityService) ListAllReturnsOnCall(i int, r0 []custom.Entity, r1 error) {
+	f.listAll.setReturnsOnCall(i, customEntityServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeCustomEntityService) Reset() {
+	f.get.reset()
+	f.create.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// CustomPluginServiceCreateCall holds the arguments of a call to FakeCustomPluginService.Create.
+type CustomPluginServiceCreateCall struct {
+	Ctx    context.Context
+	Plugin *kong.CustomPluginDefinition
+}
+
+type customPluginServiceCreateResults struct {
+	r0 *kong.CustomPluginDefinition
+	r1 error
+}
+
+// CustomPluginServiceGetCall holds the arguments of a call to FakeCustomPluginService.Get.
+type CustomPluginServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type customPluginServiceGetResults struct {
+	r0 *kong.CustomPluginDefinition
+	r1 error
+}
+
+// CustomPluginServiceUpdateCall holds the arguments of a call to FakeCustomPluginService.Update.
+type CustomPluginServiceUpdateCall struct {
+	Ctx    context.Context
+	Plugin *kong.CustomPluginDefinition
+}
+
+type customPluginServiceUpdateResults struct {
+	r0 *kong.CustomPluginDefinition
+	r1 error
+}
+
+// CustomPluginServiceDeleteCall holds the arguments of a call to FakeCustomPluginService.Delete.
+type CustomPluginServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type customPluginServiceDeleteResults struct {
+	r0 error
+}
+
+// CustomPluginServiceListCall holds the arguments of a call to FakeCustomPluginService.List.
+type CustomPluginServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type customPluginServiceListResults struct {
+	r0 []*kong.CustomPluginDefinition
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// CustomPluginServiceListAllCall holds the arguments of a call to FakeCustomPluginService.ListAll.
+type CustomPluginServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type customPluginServiceListAllResults struct {
+	r0 []*kong.CustomPluginDefinition
+	r1 error
+}
+
+// CustomPluginServiceDeployCall holds the arguments of a call to FakeCustomPluginService.Deploy.
+type CustomPluginServiceDeployCall struct {
+	Ctx  context.Context
+	Pkg  *kong.CustomPluginPackage
+	Tags []string
+}
+
+type customPluginServiceDeployResults struct {
+	r0 *kong.CustomPluginDeployResult
+	r1 error
+}
+
+// FakeCustomPluginService is a fake implementation of kong.AbstractCustomPluginService.
+type FakeCustomPluginService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractCustomPluginService
+
+	create  method[CustomPluginServiceCreateCall, customPluginServiceCreateResults, func(context.Context, *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error)]
+	get     method[CustomPluginServiceGetCall, customPluginServiceGetResults, func(context.Context, *string) (*kong.CustomPluginDefinition, error)]
+	update  method[CustomPluginServiceUpdateCall, customPluginServiceUpdateResults, func(context.Context, *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error)]
+	delete  method[CustomPluginServiceDeleteCall, customPluginServiceDeleteResults, func(context.Context, *string) error]
+	list    method[CustomPluginServiceListCall, customPluginServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.CustomPluginDefinition, *kong.ListOpt, error)]
+	listAll method[CustomPluginServiceListAllCall, customPluginServiceListAllResults, func(context.Context) ([]*kong.CustomPluginDefinition, error)]
+	deploy  method[CustomPluginServiceDeployCall, customPluginServiceDeployResults, func(context.Context, *kong.CustomPluginPackage, ...string) (*kong.CustomPluginDeployResult, error)]
+}
+
+var _ kong.AbstractCustomPluginService = &FakeCustomPluginService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeCustomPluginService) Create(ctx context.Context, plugin *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error) {
+	call := f.create.record(CustomPluginServiceCreateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakeCustomPluginService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeCustomPluginService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeCustomPluginService) CreateCalls() []CustomPluginServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeCustomPluginService) CreateArgsForCall(i int) CustomPluginServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) CreateStub(stub func(context.Context, *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeCustomPluginService) CreateReturns(r0 *kong.CustomPluginDefinition, r1 error) {
+	f.create.setReturns(customPluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeCustomPluginService) CreateReturnsOnCall(i int, r0 *kong.CustomPluginDefinition, r1 error) {
+	f.create.setReturnsOnCall(i, customPluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeCustomPluginService) Get(ctx context.Context, nameOrID *string) (*kong.CustomPluginDefinition, error) {
+	call := f.get.record(CustomPluginServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeCustomPluginService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeCustomPluginService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeCustomPluginService) GetCalls() []CustomPluginServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeCustomPluginService) GetArgsForCall(i int) CustomPluginServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) GetStub(stub func(context.Context, *string) (*kong.CustomPluginDefinition, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeCustomPluginService) GetReturns(r0 *kong.CustomPluginDefinition, r1 error) {
+	f.get.setReturns(customPluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeCustomPluginService) GetReturnsOnCall(i int, r0 *kong.CustomPluginDefinition, r1 error) {
+	f.get.setReturnsOnCall(i, customPluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeCustomPluginService) Update(ctx context.Context, plugin *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error) {
+	call := f.update.record(CustomPluginServiceUpdateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakeCustomPluginService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeCustomPluginService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeCustomPluginService) UpdateCalls() []CustomPluginServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeCustomPluginService) UpdateArgsForCall(i int) CustomPluginServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) UpdateStub(stub func(context.Context, *kong.CustomPluginDefinition) (*kong.CustomPluginDefinition, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeCustomPluginService) UpdateReturns(r0 *kong.CustomPluginDefinition, r1 error) {
+	f.update.setReturns(customPluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeCustomPluginService) UpdateReturnsOnCall(i int, r0 *kong.CustomPluginDefinition, r1 error) {
+	f.update.setReturnsOnCall(i, customPluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeCustomPluginService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(CustomPluginServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeCustomPluginService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeCustomPluginService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeCustomPluginService) DeleteCalls() []CustomPluginServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeCustomPluginService) DeleteArgsForCall(i int) CustomPluginServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeCustomPluginService) DeleteReturns(r0 error) {
+	f.delete.setReturns(customPluginServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeCustomPluginService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, customPluginServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeCustomPluginService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.CustomPluginDefinition, *kong.ListOpt, error) {
+	call := f.list.record(CustomPluginServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeCustomPluginService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeCustomPluginService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeCustomPluginService) ListCalls() []CustomPluginServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeCustomPluginService) ListArgsForCall(i int) CustomPluginServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.CustomPluginDefinition, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeCustomPluginService) ListReturns(r0 []*kong.CustomPluginDefinition, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(customPluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeCustomPluginService) ListReturnsOnCall(i int, r0 []*kong.CustomPluginDefinition, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, customPluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeCustomPluginService) ListAll(ctx context.Context) ([]*kong.CustomPluginDefinition, error) {
+	call := f.listAll.record(CustomPluginServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeCustomPluginService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeCustomPluginService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeCustomPluginService) ListAllCalls() []CustomPluginServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeCustomPluginService) ListAllArgsForCall(i int) CustomPluginServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) ListAllStub(stub func(context.Context) ([]*kong.CustomPluginDefinition, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeCustomPluginService) ListAllReturns(r0 []*kong.CustomPluginDefinition, r1 error) {
+	f.listAll.setReturns(customPluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeCustomPluginService) ListAllReturnsOnCall(i int, r0 []*kong.CustomPluginDefinition, r1 error) {
+	f.listAll.setReturnsOnCall(i, customPluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Deploy records the call and returns the programmed results.
+func (f *FakeCustomPluginService) Deploy(ctx context.Context, pkg *kong.CustomPluginPackage, tags ...string) (*kong.CustomPluginDeployResult, error) {
+	call := f.deploy.record(CustomPluginServiceDeployCall{Ctx: ctx, Pkg: pkg, Tags: tags})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, pkg, tags...)
+	case f.Fallback != nil:
+		return f.Fallback.Deploy(ctx, pkg, tags...)
+	}
+	return call.results.r0, notProgrammed("FakeCustomPluginService.Deploy")
+}
+
+// DeployCallCount returns the number of calls to Deploy.
+func (f *FakeCustomPluginService) DeployCallCount() int {
+	return f.deploy.callCount()
+}
+
+// DeployCalls returns the arguments of the calls to Deploy.
+func (f *FakeCustomPluginService) DeployCalls() []CustomPluginServiceDeployCall {
+	return f.deploy.allCalls()
+}
+
+// DeployArgsForCall returns the arguments of the i-th call to Deploy.
+func (f *FakeCustomPluginService) DeployArgsForCall(i int) CustomPluginServiceDeployCall {
+	return f.deploy.argsForCall(i)
+}
+
+// DeployStub makes Deploy delegate to stub, or stop doing so if stub is nil.
+func (f *FakeCustomPluginService) DeployStub(stub func(context.Context, *kong.CustomPluginPackage, ...string) (*kong.CustomPluginDeployResult, error)) {
+	f.deploy.setStub(stub, stub != nil)
+}
+
+// DeployReturns makes Deploy return the given values.
+func (f *FakeCustomPluginService) DeployReturns(r0 *kong.CustomPluginDeployResult, r1 error) {
+	f.deploy.setReturns(customPluginServiceDeployResults{r0: r0, r1: r1})
+}
+
+// DeployReturnsOnCall makes the i-th call to Deploy return the given values.
+func (f *FakeCustomPluginService) DeployReturnsOnCall(i int, r0 *kong.CustomPluginDeployResult, r1 error) {
+	f.deploy.setReturnsOnCall(i, customPluginServiceDeployResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeCustomPluginService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.deploy.reset()
+}
+
+// DegraphqlRouteServiceCreateCall holds the arguments of a call to FakeDegraphqlRouteService.Create.
+type DegraphqlRouteServiceCreateCall struct {
+	Ctx   context.Context
+	Route *kong.DegraphqlRoute
+}
+
+type degraphqlRouteServiceCreateResults struct {
+	r0 *kong.DegraphqlRoute
+	r1 error
+}
+
+// DegraphqlRouteServiceGetCall holds the arguments of a call to FakeDegraphqlRouteService.Get.
+type DegraphqlRouteServiceGetCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+	ID              *string
+}
+
+type degraphqlRouteServiceGetResults struct {
+	r0 *kong.DegraphqlRoute
+	r1 error
+}
+
+// DegraphqlRouteServiceUpdateCall holds the arguments of a call to FakeDegraphqlRouteService.Update.
+type DegraphqlRouteServiceUpdateCall struct {
+	Ctx   context.Context
+	Route *kong.DegraphqlRoute
+}
+
+type degraphqlRouteServiceUpdateResults struct {
+	r0 *kong.DegraphqlRoute
+	r1 error
+}
+
+// DegraphqlRouteServiceDeleteCall holds the arguments of a call to FakeDegraphqlRouteService.Delete.
+type DegraphqlRouteServiceDeleteCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+	Id              *string
+}
+
+type degraphqlRouteServiceDeleteResults struct {
+	r0 error
+}
+
+// DegraphqlRouteServiceListCall holds the arguments of a call to FakeDegraphqlRouteService.List.
+type DegraphqlRouteServiceListCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+	Listopt         *kong.ListOpt
+}
+
+type degraphqlRouteServiceListResults struct {
+	r0 []*kong.DegraphqlRoute
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// DegraphqlRouteServiceListAllCall holds the arguments of a call to FakeDegraphqlRouteService.ListAll.
+type DegraphqlRouteServiceListAllCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+}
+
+type degraphqlRouteServiceListAllResults struct {
+	r0 []*kong.DegraphqlRoute
+	r1 error
+}
+
+// FakeDegraphqlRouteService is a fake implementation of kong.AbstractDegraphqlRouteService.
+type FakeDegraphqlRouteService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractDegraphqlRouteService
+
+	create  method[DegraphqlRouteServiceCreateCall, degraphqlRouteServiceCreateResults, func(context.Context, *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error)]
+	get     method[DegraphqlRouteServiceGetCall, degraphqlRouteServiceGetResults, func(context.Context, *string, *string) (*kong.DegraphqlRoute, error)]
+	update  method[DegraphqlRouteServiceUpdateCall, degraphqlRouteServiceUpdateResults, func(context.Context, *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error)]
+	delete  method[DegraphqlRouteServiceDeleteCall, degraphqlRouteServiceDeleteResults, func(context.Context, *string, *string) error]
+	list    method[DegraphqlRouteServiceListCall, degraphqlRouteServiceListResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.DegraphqlRoute, *kong.ListOpt, error)]
+	listAll method[DegraphqlRouteServiceListAllCall, degraphqlRouteServiceListAllResults, func(context.Context, *string) ([]*kong.DegraphqlRoute, error)]
+}
+
+var _ kong.AbstractDegraphqlRouteService = &FakeDegraphqlRouteService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) Create(ctx context.Context, route *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error) {
+	call := f.create.record(DegraphqlRouteServiceCreateCall{Ctx: ctx, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, route)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, route)
+	}
+	return call.results.r0, notProgrammed("FakeDegraphqlRouteService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeDegraphqlRouteService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeDegraphqlRouteService) CreateCalls() []DegraphqlRouteServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeDegraphqlRouteService) CreateArgsForCall(i int) DegraphqlRouteServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) CreateStub(stub func(context.Context, *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeDegraphqlRouteService) CreateReturns(r0 *kong.DegraphqlRoute, r1 error) {
+	f.create.setReturns(degraphqlRouteServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeDegraphqlRouteService) CreateReturnsOnCall(i int, r0 *kong.DegraphqlRoute, r1 error) {
+	f.create.setReturnsOnCall(i, degraphqlRouteServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) Get(ctx context.Context, serviceNameOrID *string, ID *string) (*kong.DegraphqlRoute, error) {
+	call := f.get.record(DegraphqlRouteServiceGetCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, serviceNameOrID, ID)
+	}
+	return call.results.r0, notProgrammed("FakeDegraphqlRouteService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeDegraphqlRouteService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeDegraphqlRouteService) GetCalls() []DegraphqlRouteServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeDegraphqlRouteService) GetArgsForCall(i int) DegraphqlRouteServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) GetStub(stub func(context.Context, *string, *string) (*kong.DegraphqlRoute, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeDegraphqlRouteService) GetReturns(r0 *kong.DegraphqlRoute, r1 error) {
+	f.get.setReturns(degraphqlRouteServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeDegraphqlRouteService) GetReturnsOnCall(i int, r0 *kong.DegraphqlRoute, r1 error) {
+	f.get.setReturnsOnCall(i, degraphqlRouteServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) Update(ctx context.Context, route *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error) {
+	call := f.update.record(DegraphqlRouteServiceUpdateCall{Ctx: ctx, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, route)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, route)
+	}
+	return call.results.r0, notProgrammed("FakeDegraphqlRouteService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeDegraphqlRouteService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeDegraphqlRouteService) UpdateCalls() []DegraphqlRouteServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeDegraphqlRouteService) UpdateArgsForCall(i int) DegraphqlRouteServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) UpdateStub(stub func(context.Context, *kong.DegraphqlRoute) (*kong.DegraphqlRoute, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeDegraphqlRouteService) UpdateReturns(r0 *kong.DegraphqlRoute, r1 error) {
+	f.update.setReturns(degraphqlRouteServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeDegraphqlRouteService) UpdateReturnsOnCall(i int, r0 *kong.DegraphqlRoute, r1 error) {
+	f.update.setReturnsOnCall(i, degraphqlRouteServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) Delete(ctx context.Context, serviceNameOrID *string, id *string) error {
+	call := f.delete.record(DegraphqlRouteServiceDeleteCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID, id)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, serviceNameOrID, id)
+	}
+	return notProgrammed("FakeDegraphqlRouteService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeDegraphqlRouteService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeDegraphqlRouteService) DeleteCalls() []DegraphqlRouteServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeDegraphqlRouteService) DeleteArgsForCall(i int) DegraphqlRouteServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeDegraphqlRouteService) DeleteReturns(r0 error) {
+	f.delete.setReturns(degraphqlRouteServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeDegraphqlRouteService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, degraphqlRouteServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) List(ctx context.Context, serviceNameOrID *string, listopt *kong.ListOpt) ([]*kong.DegraphqlRoute, *kong.ListOpt, error) {
+	call := f.list.record(DegraphqlRouteServiceListCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID, Listopt: listopt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID, listopt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, serviceNameOrID, listopt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeDegraphqlRouteService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeDegraphqlRouteService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeDegraphqlRouteService) ListCalls() []DegraphqlRouteServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeDegraphqlRouteService) ListArgsForCall(i int) DegraphqlRouteServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) ListStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.DegraphqlRoute, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeDegraphqlRouteService) ListReturns(r0 []*kong.DegraphqlRoute, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(degraphqlRouteServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeDegraphqlRouteService) ListReturnsOnCall(i int, r0 []*kong.DegraphqlRoute, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, degraphqlRouteServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeDegraphqlRouteService) ListAll(ctx context.Context, serviceNameOrID *string) ([]*kong.DegraphqlRoute, error) {
+	call := f.listAll.record(DegraphqlRouteServiceListAllCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx, serviceNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeDegraphqlRouteService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeDegraphqlRouteService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeDegraphqlRouteService) ListAllCalls() []DegraphqlRouteServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeDegraphqlRouteService) ListAllArgsForCall(i int) DegraphqlRouteServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDegraphqlRouteService) ListAllStub(stub func(context.Context, *string) ([]*kong.DegraphqlRoute, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeDegraphqlRouteService) ListAllReturns(r0 []*kong.DegraphqlRoute, r1 error) {
+	f.listAll.setReturns(degraphqlRouteServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeDegraphqlRouteService) ListAllReturnsOnCall(i int, r0 []*kong.DegraphqlRoute, r1 error) {
+	f.listAll.setReturnsOnCall(i, degraphqlRouteServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeDegraphqlRouteService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// DeveloperRoleServiceCreateCall holds the arguments of a call to FakeDeveloperRoleService.Create.
+type DeveloperRoleServiceCreateCall struct {
+	Ctx  context.Context
+	Role *kong.DeveloperRole
+}
+
+type developerRoleServiceCreateResults struct {
+	r0 *kong.DeveloperRole
+	r1 error
+}
+
+// DeveloperRoleServiceGetCall holds the arguments of a call to FakeDeveloperRoleService.Get.
+type DeveloperRoleServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type developerRoleServiceGetResults struct {
+	r0 *kong.DeveloperRole
+	r1 error
+}
+
+// DeveloperRoleServiceUpdateCall holds the arguments of a call to FakeDeveloperRoleService.Update.
+type DeveloperRoleServiceUpdateCall struct {
+	Ctx  context.Context
+	Role *kong.DeveloperRole
+}
+
+type developerRoleServiceUpdateResults struct {
+	r0 *kong.DeveloperRole
+	r1 error
+}
+
+// DeveloperRoleServiceDeleteCall holds the arguments of a call to FakeDeveloperRoleService.Delete.
+type DeveloperRoleServiceDeleteCall struct {
+	Ctx      context.Context
+	RoleOrID *string
+}
+
+type developerRoleServiceDeleteResults struct {
+	r0 error
+}
+
+// DeveloperRoleServiceListCall holds the arguments of a call to FakeDeveloperRoleService.List.
+type DeveloperRoleServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type developerRoleServiceListResults struct {
+	r0 []*kong.DeveloperRole
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// DeveloperRoleServiceListAllCall holds the arguments of a call to FakeDeveloperRoleService.ListAll.
+type DeveloperRoleServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type developerRoleServiceListAllResults struct {
+	r0 []*kong.DeveloperRole
+	r1 error
+}
+
+// FakeDeveloperRoleService is a fake implementation of kong.AbstractDeveloperRoleService.
+type FakeDeveloperRoleService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractDeveloperRoleService
+
+	create  method[DeveloperRoleServiceCreateCall, developerRoleServiceCreateResults, func(context.Context, *kong.DeveloperRole) (*kong.DeveloperRole, error)]
+	get     method[DeveloperRoleServiceGetCall, developerRoleServiceGetResults, func(context.Context, *string) (*kong.DeveloperRole, error)]
+	update  method[DeveloperRoleServiceUpdateCall, developerRoleServiceUpdateResults, func(context.Context, *kong.DeveloperRole) (*kong.DeveloperRole, error)]
+	delete  method[DeveloperRoleServiceDeleteCall, developerRoleServiceDeleteResults, func(context.Context, *string) error]
+	list    method[DeveloperRoleServiceListCall, developerRoleServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.DeveloperRole, *kong.ListOpt, error)]
+	listAll method[DeveloperRoleServiceListAllCall, developerRoleServiceListAllResults, func(context.Context) ([]*kong.DeveloperRole, error)]
+}
+
+var _ kong.AbstractDeveloperRoleService = &FakeDeveloperRoleService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) Create(ctx context.Context, role *kong.DeveloperRole) (*kong.DeveloperRole, error) {
+	call := f.create.record(DeveloperRoleServiceCreateCall{Ctx: ctx, Role: role})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, role)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, role)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperRoleService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeDeveloperRoleService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeDeveloperRoleService) CreateCalls() []DeveloperRoleServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeDeveloperRoleService) CreateArgsForCall(i int) DeveloperRoleServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) CreateStub(stub func(context.Context, *kong.DeveloperRole) (*kong.DeveloperRole, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeDeveloperRoleService) CreateReturns(r0 *kong.DeveloperRole, r1 error) {
+	f.create.setReturns(developerRoleServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeDeveloperRoleService) CreateReturnsOnCall(i int, r0 *kong.DeveloperRole, r1 error) {
+	f.create.setReturnsOnCall(i, developerRoleServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) Get(ctx context.Context, nameOrID *string) (*kong.DeveloperRole, error) {
+	call := f.get.record(DeveloperRoleServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperRoleService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeDeveloperRoleService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeDeveloperRoleService) GetCalls() []DeveloperRoleServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeDeveloperRoleService) GetArgsForCall(i int) DeveloperRoleServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) GetStub(stub func(context.Context, *string) (*kong.DeveloperRole, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeDeveloperRoleService) GetReturns(r0 *kong.DeveloperRole, r1 error) {
+	f.get.setReturns(developerRoleServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeDeveloperRoleService) GetReturnsOnCall(i int, r0 *kong.DeveloperRole, r1 error) {
+	f.get.setReturnsOnCall(i, developerRoleServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) Update(ctx context.Context, role *kong.DeveloperRole) (*kong.DeveloperRole, error) {
+	call := f.update.record(DeveloperRoleServiceUpdateCall{Ctx: ctx, Role: role})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, role)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, role)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperRoleService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeDeveloperRoleService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeDeveloperRoleService) UpdateCalls() []DeveloperRoleServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeDeveloperRoleService) UpdateArgsForCall(i int) DeveloperRoleServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) UpdateStub(stub func(context.Context, *kong.DeveloperRole) (*kong.DeveloperRole, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeDeveloperRoleService) UpdateReturns(r0 *kong.DeveloperRole, r1 error) {
+	f.update.setReturns(developerRoleServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeDeveloperRoleService) UpdateReturnsOnCall(i int, r0 *kong.DeveloperRole, r1 error) {
+	f.update.setReturnsOnCall(i, developerRoleServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) Delete(ctx context.Context, RoleOrID *string) error {
+	call := f.delete.record(DeveloperRoleServiceDeleteCall{Ctx: ctx, RoleOrID: RoleOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, RoleOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, RoleOrID)
+	}
+	return notProgrammed("FakeDeveloperRoleService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeDeveloperRoleService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeDeveloperRoleService) DeleteCalls() []DeveloperRoleServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeDeveloperRoleService) DeleteArgsForCall(i int) DeveloperRoleServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeDeveloperRoleService) DeleteReturns(r0 error) {
+	f.delete.setReturns(developerRoleServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeDeveloperRoleService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, developerRoleServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.DeveloperRole, *kong.ListOpt, error) {
+	call := f.list.record(DeveloperRoleServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeDeveloperRoleService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeDeveloperRoleService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeDeveloperRoleService) ListCalls() []DeveloperRoleServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeDeveloperRoleService) ListArgsForCall(i int) DeveloperRoleServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.DeveloperRole, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeDeveloperRoleService) ListReturns(r0 []*kong.DeveloperRole, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(developerRoleServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeDeveloperRoleService) ListReturnsOnCall(i int, r0 []*kong.DeveloperRole, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, developerRoleServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeDeveloperRoleService) ListAll(ctx context.Context) ([]*kong.DeveloperRole, error) {
+	call := f.listAll.record(DeveloperRoleServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperRoleService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeDeveloperRoleService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeDeveloperRoleService) ListAllCalls() []DeveloperRoleServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeDeveloperRoleService) ListAllArgsForCall(i int) DeveloperRoleServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperRoleService) ListAllStub(stub func(context.Context) ([]*kong.DeveloperRole, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeDeveloperRoleService) ListAllReturns(r0 []*kong.DeveloperRole, r1 error) {
+	f.listAll.setReturns(developerRoleServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeDeveloperRoleService) ListAllReturnsOnCall(i int, r0 []*kong.DeveloperRole, r1 error) {
+	f.listAll.setReturnsOnCall(i, developerRoleServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeDeveloperRoleService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// DeveloperServiceCreateCall holds the arguments of a call to FakeDeveloperService.Create.
+type DeveloperServiceCreateCall struct {
+	Ctx       context.Context
+	Developer *kong.Developer
+}
+
+type developerServiceCreateResults struct {
+	r0 *kong.Developer
+	r1 error
+}
+
+// DeveloperServiceGetCall holds the arguments of a call to FakeDeveloperService.Get.
+type DeveloperServiceGetCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+}
+
+type developerServiceGetResults struct {
+	r0 *kong.Developer
+	r1 error
+}
+
+// DeveloperServiceGetByCustomIDCall holds the arguments of a call to FakeDeveloperService.GetByCustomID.
+type DeveloperServiceGetByCustomIDCall struct {
+	Ctx      context.Context
+	CustomID *string
+}
+
+type developerServiceGetByCustomIDResults struct {
+	r0 *kong.Developer
+	r1 error
+}
+
+// DeveloperServiceUpdateCall holds the arguments of a call to FakeDeveloperService.Update.
+type DeveloperServiceUpdateCall struct {
+	Ctx       context.Context
+	Developer *kong.Developer
+}
+
+type developerServiceUpdateResults struct {
+	r0 *kong.Developer
+	r1 error
+}
+
+// DeveloperServiceDeleteCall holds the arguments of a call to FakeDeveloperService.Delete.
+type DeveloperServiceDeleteCall struct {
+	Ctx       context.Context
+	EmailOrID *string
+}
+
+type developerServiceDeleteResults struct {
+	r0 error
+}
+
+// DeveloperServiceListCall holds the arguments of a call to FakeDeveloperService.List.
+type DeveloperServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type developerServiceListResults struct {
+	r0 []*kong.Developer
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// DeveloperServiceListAllCall holds the arguments of a call to FakeDeveloperService.ListAll.
+type DeveloperServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type developerServiceListAllResults struct {
+	r0 []*kong.Developer
+	r1 error
+}
+
+// FakeDeveloperService is a fake implementation of kong.AbstractDeveloperService.
+type FakeDeveloperService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractDeveloperService
+
+	create        method[DeveloperServiceCreateCall, developerServiceCreateResults, func(context.Context, *kong.Developer) (*kong.Developer, error)]
+	get           method[DeveloperServiceGetCall, developerServiceGetResults, func(context.Context, *string) (*kong.Developer, error)]
+	getByCustomID method[DeveloperServiceGetByCustomIDCall, developerServiceGetByCustomIDResults, func(context.Context, *string) (*kong.Developer, error)]
+	update        method[DeveloperServiceUpdateCall, developerServiceUpdateResults, func(context.Context, *kong.Developer) (*kong.Developer, error)]
+	delete        method[DeveloperServiceDeleteCall, developerServiceDeleteResults, func(context.Context, *string) error]
+	list          method[DeveloperServiceListCall, developerServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Developer, *kong.ListOpt, error)]
+	listAll       method[DeveloperServiceListAllCall, developerServiceListAllResults, func(context.Context) ([]*kong.Developer, error)]
+}
+
+var _ kong.AbstractDeveloperService = &FakeDeveloperService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeDeveloperService) Create(ctx context.Context, developer *kong.Developer) (*kong.Developer, error) {
+	call := f.create.record(DeveloperServiceCreateCall{Ctx: ctx, Developer: developer})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, developer)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, developer)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeDeveloperService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeDeveloperService) CreateCalls() []DeveloperServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeDeveloperService) CreateArgsForCall(i int) DeveloperServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) CreateStub(stub func(context.Context, *kong.Developer) (*kong.Developer, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeDeveloperService) CreateReturns(r0 *kong.Developer, r1 error) {
+	f.create.setReturns(developerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeDeveloperService) CreateReturnsOnCall(i int, r0 *kong.Developer, r1 error) {
+	f.create.setReturnsOnCall(i, developerServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeDeveloperService) Get(ctx context.Context, emailOrID *string) (*kong.Developer, error) {
+	call := f.get.record(DeveloperServiceGetCall{Ctx: ctx, EmailOrID: emailOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, emailOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, emailOrID)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeDeveloperService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeDeveloperService) GetCalls() []DeveloperServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeDeveloperService) GetArgsForCall(i int) DeveloperServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) GetStub(stub func(context.Context, *string) (*kong.Developer, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeDeveloperService) GetReturns(r0 *kong.Developer, r1 error) {
+	f.get.setReturns(developerServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeDeveloperService) GetReturnsOnCall(i int, r0 *kong.Developer, r1 error) {
+	f.get.setReturnsOnCall(i, developerServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByCustomID records the call and returns the programmed results.
+func (f *FakeDeveloperService) GetByCustomID(ctx context.Context, customID *string) (*kong.Developer, error) {
+	call := f.getByCustomID.record(DeveloperServiceGetByCustomIDCall{Ctx: ctx, CustomID: customID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, customID)
+	case f.Fallback != nil:
+		return f.Fallback.GetByCustomID(ctx, customID)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperService.GetByCustomID")
+}
+
+// GetByCustomIDCallCount returns the number of calls to GetByCustomID.
+func (f *FakeDeveloperService) GetByCustomIDCallCount() int {
+	return f.getByCustomID.callCount()
+}
+
+// GetByCustomIDCalls returns the arguments of the calls to GetByCustomID.
+func (f *FakeDeveloperService) GetByCustomIDCalls() []DeveloperServiceGetByCustomIDCall {
+	return f.getByCustomID.allCalls()
+}
+
+// GetByCustomIDArgsForCall returns the arguments of the i-th call to GetByCustomID.
+func (f *FakeDeveloperService) GetByCustomIDArgsForCall(i int) DeveloperServiceGetByCustomIDCall {
+	return f.getByCustomID.argsForCall(i)
+}
+
+// GetByCustomIDStub makes GetByCustomID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) GetByCustomIDStub(stub func(context.Context, *string) (*kong.Developer, error)) {
+	f.getByCustomID.setStub(stub, stub != nil)
+}
+
+// GetByCustomIDReturns makes GetByCustomID return the given values.
+func (f *FakeDeveloperService) GetByCustomIDReturns(r0 *kong.Developer, r1 error) {
+	f.getByCustomID.setReturns(developerServiceGetByCustomIDResults{r0: r0, r1: r1})
+}
+
+// GetByCustomIDReturnsOnCall makes the i-th call to GetByCustomID return the given values.
+func (f *FakeDeveloperService) GetByCustomIDReturnsOnCall(i int, r0 *kong.Developer, r1 error) {
+	f.getByCustomID.setReturnsOnCall(i, developerServiceGetByCustomIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeDeveloperService) Update(ctx context.Context, developer *kong.Developer) (*kong.Developer, error) {
+	call := f.update.record(DeveloperServiceUpdateCall{Ctx: ctx, Developer: developer})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, developer)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, developer)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeDeveloperService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeDeveloperService) UpdateCalls() []DeveloperServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeDeveloperService) UpdateArgsForCall(i int) DeveloperServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) UpdateStub(stub func(context.Context, *kong.Developer) (*kong.Developer, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeDeveloperService) UpdateReturns(r0 *kong.Developer, r1 error) {
+	f.update.setReturns(developerServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeDeveloperService) UpdateReturnsOnCall(i int, r0 *kong.Developer, r1 error) {
+	f.update.setReturnsOnCall(i, developerServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeDeveloperService) Delete(ctx context.Context, emailOrID *string) error {
+	call := f.delete.record(DeveloperServiceDeleteCall{Ctx: ctx, EmailOrID: emailOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, emailOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, emailOrID)
+	}
+	return notProgrammed("FakeDeveloperService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeDeveloperService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeDeveloperService) DeleteCalls() []DeveloperServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeDeveloperService) DeleteArgsForCall(i int) DeveloperServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeDeveloperService) DeleteReturns(r0 error) {
+	f.delete.setReturns(developerServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeDeveloperService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, developerServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeDeveloperService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Developer, *kong.ListOpt, error) {
+	call := f.list.record(DeveloperServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeDeveloperService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeDeveloperService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeDeveloperService) ListCalls() []DeveloperServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeDeveloperService) ListArgsForCall(i int) DeveloperServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Developer, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeDeveloperService) ListReturns(r0 []*kong.Developer, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(developerServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeDeveloperService) ListReturnsOnCall(i int, r0 []*kong.Developer, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, developerServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeDeveloperService) ListAll(ctx context.Context) ([]*kong.Developer, error) {
+	call := f.listAll.record(DeveloperServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeDeveloperService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeDeveloperService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeDeveloperService) ListAllCalls() []DeveloperServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeDeveloperService) ListAllArgsForCall(i int) DeveloperServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeDeveloperService) ListAllStub(stub func(context.Context) ([]*kong.Developer, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeDeveloperService) ListAllReturns(r0 []*kong.Developer, r1 error) {
+	f.listAll.setReturns(developerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeDeveloperService) ListAllReturnsOnCall(i int, r0 []*kong.Developer, r1 error) {
+	f.listAll.setReturnsOnCall(i, developerServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeDeveloperService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByCustomID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// FilterChainServiceCreateCall holds the arguments of a call to FakeFilterChainService.Create.
+type FilterChainServiceCreateCall struct {
+	Ctx         context.Context
+	FilterChain *kong.FilterChain
+}
+
+type filterChainServiceCreateResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceCreateForServiceCall holds the arguments of a call to FakeFilterChainService.CreateForService.
+type FilterChainServiceCreateForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	FilterChain     *kong.FilterChain
+}
+
+type filterChainServiceCreateForServiceResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceCreateForRouteCall holds the arguments of a call to FakeFilterChainService.CreateForRoute.
+type FilterChainServiceCreateForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	FilterChain   *kong.FilterChain
+}
+
+type filterChainServiceCreateForRouteResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceGetCall holds the arguments of a call to FakeFilterChainService.Get.
+type FilterChainServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type filterChainServiceGetResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceUpdateCall holds the arguments of a call to FakeFilterChainService.Update.
+type FilterChainServiceUpdateCall struct {
+	Ctx         context.Context
+	FilterChain *kong.FilterChain
+}
+
+type filterChainServiceUpdateResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceUpdateForServiceCall holds the arguments of a call to FakeFilterChainService.UpdateForService.
+type FilterChainServiceUpdateForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	FilterChain     *kong.FilterChain
+}
+
+type filterChainServiceUpdateForServiceResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceUpdateForRouteCall holds the arguments of a call to FakeFilterChainService.UpdateForRoute.
+type FilterChainServiceUpdateForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	FilterChain   *kong.FilterChain
+}
+
+type filterChainServiceUpdateForRouteResults struct {
+	r0 *kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceDeleteCall holds the arguments of a call to FakeFilterChainService.Delete.
+type FilterChainServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type filterChainServiceDeleteResults struct {
+	r0 error
+}
+
+// FilterChainServiceDeleteForServiceCall holds the arguments of a call to FakeFilterChainService.DeleteForService.
+type FilterChainServiceDeleteForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	FilterChainID   *string
+}
+
+type filterChainServiceDeleteForServiceResults struct {
+	r0 error
+}
+
+// FilterChainServiceDeleteForRouteCall holds the arguments of a call to FakeFilterChainService.DeleteForRoute.
+type FilterChainServiceDeleteForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	FilterChainID *string
+}
+
+type filterChainServiceDeleteForRouteResults struct {
+	r0 error
+}
+
+// FilterChainServiceListCall holds the arguments of a call to FakeFilterChainService.List.
+type FilterChainServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type filterChainServiceListResults struct {
+	r0 []*kong.FilterChain
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FilterChainServiceListAllCall holds the arguments of a call to FakeFilterChainService.ListAll.
+type FilterChainServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type filterChainServiceListAllResults struct {
+	r0 []*kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceListAllForServiceCall holds the arguments of a call to FakeFilterChainService.ListAllForService.
+type FilterChainServiceListAllForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+}
+
+type filterChainServiceListAllForServiceResults struct {
+	r0 []*kong.FilterChain
+	r1 error
+}
+
+// FilterChainServiceListAllForRouteCall holds the arguments of a call to FakeFilterChainService.ListAllForRoute.
+type FilterChainServiceListAllForRouteCall struct {
+	Ctx     context.Context
+	RouteID *string
+}
+
+type filterChainServiceListAllForRouteResults struct {
+	r0 []*kong.FilterChain
+	r1 error
+}
+
+// FakeFilterChainService is a fake implementation of kong.AbstractFilterChainService.
+type FakeFilterChainService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractFilterChainService
+
+	create            method[FilterChainServiceCreateCall, filterChainServiceCreateResults, func(context.Context, *kong.FilterChain) (*kong.FilterChain, error)]
+	createForService  method[FilterChainServiceCreateForServiceCall, filterChainServiceCreateForServiceResults, func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)]
+	createForRoute    method[FilterChainServiceCreateForRouteCall, filterChainServiceCreateForRouteResults, func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)]
+	get               method[FilterChainServiceGetCall, filterChainServiceGetResults, func(context.Context, *string) (*kong.FilterChain, error)]
+	update            method[FilterChainServiceUpdateCall, filterChainServiceUpdateResults, func(context.Context, *kong.FilterChain) (*kong.FilterChain, error)]
+	updateForService  method[FilterChainServiceUpdateForServiceCall, filterChainServiceUpdateForServiceResults, func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)]
+	updateForRoute    method[FilterChainServiceUpdateForRouteCall, filterChainServiceUpdateForRouteResults, func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)]
+	delete            method[FilterChainServiceDeleteCall, filterChainServiceDeleteResults, func(context.Context, *string) error]
+	deleteForService  method[FilterChainServiceDeleteForServiceCall, filterChainServiceDeleteForServiceResults, func(context.Context, *string, *string) error]
+	deleteForRoute    method[FilterChainServiceDeleteForRouteCall, filterChainServiceDeleteForRouteResults, func(context.Context, *string, *string) error]
+	list              method[FilterChainServiceListCall, filterChainServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.FilterChain, *kong.ListOpt, error)]
+	listAll           method[FilterChainServiceListAllCall, filterChainServiceListAllResults, func(context.Context) ([]*kong.FilterChain, error)]
+	listAllForService method[FilterChainServiceListAllForServiceCall, filterChainServiceListAllForServiceResults, func(context.Context, *string) ([]*kong.FilterChain, error)]
+	listAllForRoute   method[FilterChainServiceListAllForRouteCall, filterChainServiceListAllForRouteResults, func(context.Context, *string) ([]*kong.FilterChain, error)]
+}
+
+var _ kong.AbstractFilterChainService = &FakeFilterChainService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeFilterChainService) Create(ctx context.Context, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.create.record(FilterChainServiceCreateCall{Ctx: ctx, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeFilterChainService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeFilterChainService) CreateCalls() []FilterChainServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeFilterChainService) CreateArgsForCall(i int) FilterChainServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) CreateStub(stub func(context.Context, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeFilterChainService) CreateReturns(r0 *kong.FilterChain, r1 error) {
+	f.create.setReturns(filterChainServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeFilterChainService) CreateReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.create.setReturnsOnCall(i, filterChainServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateForService records the call and returns the programmed results.
+func (f *FakeFilterChainService) CreateForService(ctx context.Context, serviceIDorName *string, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.createForService.record(FilterChainServiceCreateForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForService(ctx, serviceIDorName, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.CreateForService")
+}
+
+// CreateForServiceCallCount returns the number of calls to CreateForService.
+func (f *FakeFilterChainService) CreateForServiceCallCount() int {
+	return f.createForService.callCount()
+}
+
+// CreateForServiceCalls returns the arguments of the calls to CreateForService.
+func (f *FakeFilterChainService) CreateForServiceCalls() []FilterChainServiceCreateForServiceCall {
+	return f.createForService.allCalls()
+}
+
+// CreateForServiceArgsForCall returns the arguments of the i-th call to CreateForService.
+func (f *FakeFilterChainService) CreateForServiceArgsForCall(i int) FilterChainServiceCreateForServiceCall {
+	return f.createForService.argsForCall(i)
+}
+
+// CreateForServiceStub makes CreateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) CreateForServiceStub(stub func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.createForService.setStub(stub, stub != nil)
+}
+
+// CreateForServiceReturns makes CreateForService return the given values.
+func (f *FakeFilterChainService) CreateForServiceReturns(r0 *kong.FilterChain, r1 error) {
+	f.createForService.setReturns(filterChainServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// CreateForServiceReturnsOnCall makes the i-th call to CreateForService return the given values.
+func (f *FakeFilterChainService) CreateForServiceReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.createForService.setReturnsOnCall(i, filterChainServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// CreateForRoute records the call and returns the programmed results.
+func (f *FakeFilterChainService) CreateForRoute(ctx context.Context, routeIDorName *string, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.createForRoute.record(FilterChainServiceCreateForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForRoute(ctx, routeIDorName, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.CreateForRoute")
+}
+
+// CreateForRouteCallCount returns the number of calls to CreateForRoute.
+func (f *FakeFilterChainService) CreateForRouteCallCount() int {
+	return f.createForRoute.callCount()
+}
+
+// CreateForRouteCalls returns the arguments of the calls to CreateForRoute.
+func (f *FakeFilterChainService) CreateForRouteCalls() []FilterChainServiceCreateForRouteCall {
+	return f.createForRoute.allCalls()
+}
+
+// CreateForRouteArgsForCall returns the arguments of the i-th call to CreateForRoute.
+func (f *FakeFilterChainService) CreateForRouteArgsForCall(i int) FilterChainServiceCreateForRouteCall {
+	return f.createForRoute.argsForCall(i)
+}
+
+// CreateForRouteStub makes CreateForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) CreateForRouteStub(stub func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.createForRoute.setStub(stub, stub != nil)
+}
+
+// CreateForRouteReturns makes CreateForRoute return the given values.
+func (f *FakeFilterChainService) CreateForRouteReturns(r0 *kong.FilterChain, r1 error) {
+	f.createForRoute.setReturns(filterChainServiceCreateForRouteResults{r0: r0, r1: r1})
+}
+
+// CreateForRouteReturnsOnCall makes the i-th call to CreateForRoute return the given values.
+func (f *FakeFilterChainService) CreateForRouteReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.createForRoute.setReturnsOnCall(i, filterChainServiceCreateForRouteResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeFilterChainService) Get(ctx context.Context, nameOrID *string) (*kong.FilterChain, error) {
+	call := f.get.record(FilterChainServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeFilterChainService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeFilterChainService) GetCalls() []FilterChainServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeFilterChainService) GetArgsForCall(i int) FilterChainServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) GetStub(stub func(context.Context, *string) (*kong.FilterChain, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeFilterChainService) GetReturns(r0 *kong.FilterChain, r1 error) {
+	f.get.setReturns(filterChainServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeFilterChainService) GetReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.get.setReturnsOnCall(i, filterChainServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeFilterChainService) Update(ctx context.Context, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.update.record(FilterChainServiceUpdateCall{Ctx: ctx, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeFilterChainService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeFilterChainService) UpdateCalls() []FilterChainServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeFilterChainService) UpdateArgsForCall(i int) FilterChainServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) UpdateStub(stub func(context.Context, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeFilterChainService) UpdateReturns(r0 *kong.FilterChain, r1 error) {
+	f.update.setReturns(filterChainServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeFilterChainService) UpdateReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.update.setReturnsOnCall(i, filterChainServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateForService records the call and returns the programmed results.
+func (f *FakeFilterChainService) UpdateForService(ctx context.Context, serviceIDorName *string, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.updateForService.record(FilterChainServiceUpdateForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForService(ctx, serviceIDorName, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.UpdateForService")
+}
+
+// UpdateForServiceCallCount returns the number of calls to UpdateForService.
+func (f *FakeFilterChainService) UpdateForServiceCallCount() int {
+	return f.updateForService.callCount()
+}
+
+// UpdateForServiceCalls returns the arguments of the calls to UpdateForService.
+func (f *FakeFilterChainService) UpdateForServiceCalls() []FilterChainServiceUpdateForServiceCall {
+	return f.updateForService.allCalls()
+}
+
+// UpdateForServiceArgsForCall returns the arguments of the i-th call to UpdateForService.
+func (f *FakeFilterChainService) UpdateForServiceArgsForCall(i int) FilterChainServiceUpdateForServiceCall {
+	return f.updateForService.argsForCall(i)
+}
+
+// UpdateForServiceStub makes UpdateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) UpdateForServiceStub(stub func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.updateForService.setStub(stub, stub != nil)
+}
+
+// UpdateForServiceReturns makes UpdateForService return the given values.
+func (f *FakeFilterChainService) UpdateForServiceReturns(r0 *kong.FilterChain, r1 error) {
+	f.updateForService.setReturns(filterChainServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForServiceReturnsOnCall makes the i-th call to UpdateForService return the given values.
+func (f *FakeFilterChainService) UpdateForServiceReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.updateForService.setReturnsOnCall(i, filterChainServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForRoute records the call and returns the programmed results.
+func (f *FakeFilterChainService) UpdateForRoute(ctx context.Context, routeIDorName *string, filterChain *kong.FilterChain) (*kong.FilterChain, error) {
+	call := f.updateForRoute.record(FilterChainServiceUpdateForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, FilterChain: filterChain})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, filterChain)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForRoute(ctx, routeIDorName, filterChain)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.UpdateForRoute")
+}
+
+// UpdateForRouteCallCount returns the number of calls to UpdateForRoute.
+func (f *FakeFilterChainService) UpdateForRouteCallCount() int {
+	return f.updateForRoute.callCount()
+}
+
+// UpdateForRouteCalls returns the arguments of the calls to UpdateForRoute.
+func (f *FakeFilterChainService) UpdateForRouteCalls() []FilterChainServiceUpdateForRouteCall {
+	return f.updateForRoute.allCalls()
+}
+
+// UpdateForRouteArgsForCall returns the arguments of the i-th call to UpdateForRoute.
+func (f *FakeFilterChainService) UpdateForRouteArgsForCall(i int) FilterChainServiceUpdateForRouteCall {
+	return f.updateForRoute.argsForCall(i)
+}
+
+// UpdateForRouteStub makes UpdateForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) UpdateForRouteStub(stub func(context.Context, *string, *kong.FilterChain) (*kong.FilterChain, error)) {
+	f.updateForRoute.setStub(stub, stub != nil)
+}
+
+// UpdateForRouteReturns makes UpdateForRoute return the given values.
+func (f *FakeFilterChainService) UpdateForRouteReturns(r0 *kong.FilterChain, r1 error) {
+	f.updateForRoute.setReturns(filterChainServiceUpdateForRouteResults{r0: r0, r1: r1})
+}
+
+// UpdateForRouteReturnsOnCall makes the i-th call to UpdateForRoute return the given values.
+func (f *FakeFilterChainService) UpdateForRouteReturnsOnCall(i int, r0 *kong.FilterChain, r1 error) {
+	f.updateForRoute.setReturnsOnCall(i, filterChainServiceUpdateForRouteResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeFilterChainService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(FilterChainServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeFilterChainService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeFilterChainService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeFilterChainService) DeleteCalls() []FilterChainServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeFilterChainService) DeleteArgsForCall(i int) FilterChainServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeFilterChainService) DeleteReturns(r0 error) {
+	f.delete.setReturns(filterChainServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeFilterChainService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, filterChainServiceDeleteResults{r0: r0})
+}
+
+// DeleteForService records the call and returns the programmed results.
+func (f *FakeFilterChainService) DeleteForService(ctx context.Context, serviceIDorName *string, filterChainID *string) error {
+	call := f.deleteForService.record(FilterChainServiceDeleteForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, FilterChainID: filterChainID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, filterChainID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForService(ctx, serviceIDorName, filterChainID)
+	}
+	return notProgrammed("FakeFilterChainService.DeleteForService")
+}
+
+// DeleteForServiceCallCount returns the number of calls to DeleteForService.
+func (f *FakeFilterChainService) DeleteForServiceCallCount() int {
+	return f.deleteForService.callCount()
+}
+
+// DeleteForServiceCalls returns the arguments of the calls to DeleteForService.
+func (f *FakeFilterChainService) DeleteForServiceCalls() []FilterChainServiceDeleteForServiceCall {
+	return f.deleteForService.allCalls()
+}
+
+// DeleteForServiceArgsForCall returns the arguments of the i-th call to DeleteForService.
+func (f *FakeFilterChainService) DeleteForServiceArgsForCall(i int) FilterChainServiceDeleteForServiceCall {
+	return f.deleteForService.argsForCall(i)
+}
+
+// DeleteForServiceStub makes DeleteForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) DeleteForServiceStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForService.setStub(stub, stub != nil)
+}
+
+// DeleteForServiceReturns makes DeleteForService return the given values.
+func (f *FakeFilterChainService) DeleteForServiceReturns(r0 error) {
+	f.deleteForService.setReturns(filterChainServiceDeleteForServiceResults{r0: r0})
+}
+
+// DeleteForServiceReturnsOnCall makes the i-th call to DeleteForService return the given values.
+func (f *FakeFilterChainService) DeleteForServiceReturnsOnCall(i int, r0 error) {
+	f.deleteForService.setReturnsOnCall(i, filterChainServiceDeleteForServiceResults{r0: r0})
+}
+
+// DeleteForRoute records the call and returns the programmed results.
+func (f *FakeFilterChainService) DeleteForRoute(ctx context.Context, routeIDorName *string, filterChainID *string) error {
+	call := f.deleteForRoute.record(FilterChainServiceDeleteForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, FilterChainID: filterChainID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, filterChainID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForRoute(ctx, routeIDorName, filterChainID)
+	}
+	return notProgrammed("FakeFilterChainService.DeleteForRoute")
+}
+
+// DeleteForRouteCallCount returns the number of calls to DeleteForRoute.
+func (f *FakeFilterChainService) DeleteForRouteCallCount() int {
+	return f.deleteForRoute.callCount()
+}
+
+// DeleteForRouteCalls returns the arguments of the calls to DeleteForRoute.
+func (f *FakeFilterChainService) DeleteForRouteCalls() []FilterChainServiceDeleteForRouteCall {
+	return f.deleteForRoute.allCalls()
+}
+
+// DeleteForRouteArgsForCall returns the arguments of the i-th call to DeleteForRoute.
+func (f *FakeFilterChainService) DeleteForRouteArgsForCall(i int) FilterChainServiceDeleteForRouteCall {
+	return f.deleteForRoute.argsForCall(i)
+}
+
+// DeleteForRouteStub makes DeleteForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) DeleteForRouteStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForRoute.setStub(stub, stub != nil)
+}
+
+// DeleteForRouteReturns makes DeleteForRoute return the given values.
+func (f *FakeFilterChainService) DeleteForRouteReturns(r0 error) {
+	f.deleteForRoute.setReturns(filterChainServiceDeleteForRouteResults{r0: r0})
+}
+
+// DeleteForRouteReturnsOnCall makes the i-th call to DeleteForRoute return the given values.
+func (f *FakeFilterChainService) DeleteForRouteReturnsOnCall(i int, r0 error) {
+	f.deleteForRoute.setReturnsOnCall(i, filterChainServiceDeleteForRouteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeFilterChainService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.FilterChain, *kong.ListOpt, error) {
+	call := f.list.record(FilterChainServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeFilterChainService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeFilterChainService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeFilterChainService) ListCalls() []FilterChainServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeFilterChainService) ListArgsForCall(i int) FilterChainServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.FilterChain, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeFilterChainService) ListReturns(r0 []*kong.FilterChain, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(filterChainServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeFilterChainService) ListReturnsOnCall(i int, r0 []*kong.FilterChain, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, filterChainServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeFilterChainService) ListAll(ctx context.Context) ([]*kong.FilterChain, error) {
+	call := f.listAll.record(FilterChainServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeFilterChainService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeFilterChainService) ListAllCalls() []FilterChainServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeFilterChainService) ListAllArgsForCall(i int) FilterChainServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) ListAllStub(stub func(context.Context) ([]*kong.FilterChain, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeFilterChainService) ListAllReturns(r0 []*kong.FilterChain, r1 error) {
+	f.listAll.setReturns(filterChainServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeFilterChainService) ListAllReturnsOnCall(i int, r0 []*kong.FilterChain, r1 error) {
+	f.listAll.setReturnsOnCall(i, filterChainServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllForService records the call and returns the programmed results.
+func (f *FakeFilterChainService) ListAllForService(ctx context.Context, serviceIDorName *string) ([]*kong.FilterChain, error) {
+	call := f.listAllForService.record(FilterChainServiceListAllForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForService(ctx, serviceIDorName)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.ListAllForService")
+}
+
+// ListAllForServiceCallCount returns the number of calls to ListAllForService.
+func (f *FakeFilterChainService) ListAllForServiceCallCount() int {
+	return f.listAllForService.callCount()
+}
+
+// ListAllForServiceCalls returns the arguments of the calls to ListAllForService.
+func (f *FakeFilterChainService) ListAllForServiceCalls() []FilterChainServiceListAllForServiceCall {
+	return f.listAllForService.allCalls()
+}
+
+// ListAllForServiceArgsForCall returns the arguments of the i-th call to ListAllForService.
+func (f *FakeFilterChainService) ListAllForServiceArgsForCall(i int) FilterChainServiceListAllForServiceCall {
+	return f.listAllForService.argsForCall(i)
+}
+
+// ListAllForServiceStub makes ListAllForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) ListAllForServiceStub(stub func(context.Context, *string) ([]*kong.FilterChain, error)) {
+	f.listAllForService.setStub(stub, stub != nil)
+}
+
+// ListAllForServiceReturns makes ListAllForService return the given values.
+func (f *FakeFilterChainService) ListAllForServiceReturns(r0 []*kong.FilterChain, r1 error) {
+	f.listAllForService.setReturns(filterChainServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// ListAllForServiceReturnsOnCall makes the i-th call to ListAllForService return the given values.
+func (f *FakeFilterChainService) ListAllForServiceReturnsOnCall(i int, r0 []*kong.FilterChain, r1 error) {
+	f.listAllForService.setReturnsOnCall(i, filterChainServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// ListAllForRoute records the call and returns the programmed results.
+func (f *FakeFilterChainService) ListAllForRoute(ctx context.Context, routeID *string) ([]*kong.FilterChain, error) {
+	call := f.listAllForRoute.record(FilterChainServiceListAllForRouteCall{Ctx: ctx, RouteID: routeID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForRoute(ctx, routeID)
+	}
+	return call.results.r0, notProgrammed("FakeFilterChainService.ListAllForRoute")
+}
+
+// ListAllForRouteCallCount returns the number of calls to ListAllForRoute.
+func (f *FakeFilterChainService) ListAllForRouteCallCount() int {
+	return f.listAllForRoute.callCount()
+}
+
+// ListAllForRouteCalls returns the arguments of the calls to ListAllForRoute.
+func (f *FakeFilterChainService) ListAllForRouteCalls() []FilterChainServiceListAllForRouteCall {
+	return f.listAllForRoute.allCalls()
+}
+
+// ListAllForRouteArgsForCall returns the arguments of the i-th call to ListAllForRoute.
+func (f *FakeFilterChainService) ListAllForRouteArgsForCall(i int) FilterChainServiceListAllForRouteCall {
+	return f.listAllForRoute.argsForCall(i)
+}
+
+// ListAllForRouteStub makes ListAllForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakeFilterChainService) ListAllForRouteStub(stub func(context.Context, *string) ([]*kong.FilterChain, error)) {
+	f.listAllForRoute.setStub(stub, stub != nil)
+}
+
+// ListAllForRouteReturns makes ListAllForRoute return the given values.
+func (f *FakeFilterChainService) ListAllForRouteReturns(r0 []*kong.FilterChain, r1 error) {
+	f.listAllForRoute.setReturns(filterChainServiceListAllForRouteResults{r0: r0, r1: r1})
+}
+
+// ListAllForRouteReturnsOnCall makes the i-th call to ListAllForRoute return the given values.
+func (f *FakeFilterChainService) ListAllForRouteReturnsOnCall(i int, r0 []*kong.FilterChain, r1 error) {
+	f.listAllForRoute.setReturnsOnCall(i, filterChainServiceListAllForRouteResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeFilterChainService) Reset() {
+	f.create.reset()
+	f.createForService.reset()
+	f.createForRoute.reset()
+	f.get.reset()
+	f.update.reset()
+	f.updateForService.reset()
+	f.updateForRoute.reset()
+	f.delete.reset()
+	f.deleteForService.reset()
+	f.deleteForRoute.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listAllForService.reset()
+	f.listAllForRoute.reset()
+}
+
+// GraphqlRateLimitingCostDecorationServiceCreateCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.Create.
+type GraphqlRateLimitingCostDecorationServiceCreateCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceCreateResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceCreateWithIDCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.CreateWithID.
+type GraphqlRateLimitingCostDecorationServiceCreateWithIDCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceCreateWithIDResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceGetCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.Get.
+type GraphqlRateLimitingCostDecorationServiceGetCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type graphqlRateLimitingCostDecorationServiceGetResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceUpdateCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.Update.
+type GraphqlRateLimitingCostDecorationServiceUpdateCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceUpdateResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceDeleteCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.Delete.
+type GraphqlRateLimitingCostDecorationServiceDeleteCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type graphqlRateLimitingCostDecorationServiceDeleteResults struct {
+	r0 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceListCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.List.
+type GraphqlRateLimitingCostDecorationServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type graphqlRateLimitingCostDecorationServiceListResults struct {
+	r0 []*kong.GraphqlRateLimitingCostDecoration
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceListAllCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.ListAll.
+type GraphqlRateLimitingCostDecorationServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type graphqlRateLimitingCostDecorationServiceListAllResults struct {
+	r0 []*kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceCreateForServiceCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.CreateForService.
+type GraphqlRateLimitingCostDecorationServiceCreateForServiceCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceCreateForServiceResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.UpdateForService.
+type GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceUpdateForServiceResults struct {
+	r0 *kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.DeleteForService.
+type GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall struct {
+	Ctx      context.Context
+	CostDeco *kong.GraphqlRateLimitingCostDecoration
+}
+
+type graphqlRateLimitingCostDecorationServiceDeleteForServiceResults struct {
+	r0 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceListForServiceCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.ListForService.
+type GraphqlRateLimitingCostDecorationServiceListForServiceCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+	Opt             *kong.ListOpt
+}
+
+type graphqlRateLimitingCostDecorationServiceListForServiceResults struct {
+	r0 []*kong.GraphqlRateLimitingCostDecoration
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// GraphqlRateLimitingCostDecorationServiceListAllForServiceCall holds the arguments of a call to FakeGraphqlRateLimitingCostDecorationService.ListAllForService.
+type GraphqlRateLimitingCostDecorationServiceListAllForServiceCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+}
+
+type graphqlRateLimitingCostDecorationServiceListAllForServiceResults struct {
+	r0 []*kong.GraphqlRateLimitingCostDecoration
+	r1 error
+}
+
+// FakeGraphqlRateLimitingCostDecorationService is a fake implementation of kong.AbstractGraphqlRateLimitingCostDecorationService.
+type FakeGraphqlRateLimitingCostDecorationService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractGraphqlRateLimitingCostDecorationService
+
+	create            method[GraphqlRateLimitingCostDecorationServiceCreateCall, graphqlRateLimitingCostDecorationServiceCreateResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	createWithID      method[GraphqlRateLimitingCostDecorationServiceCreateWithIDCall, graphqlRateLimitingCostDecorationServiceCreateWithIDResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	get               method[GraphqlRateLimitingCostDecorationServiceGetCall, graphqlRateLimitingCostDecorationServiceGetResults, func(context.Context, *string) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	update            method[GraphqlRateLimitingCostDecorationServiceUpdateCall, graphqlRateLimitingCostDecorationServiceUpdateResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	delete            method[GraphqlRateLimitingCostDecorationServiceDeleteCall, graphqlRateLimitingCostDecorationServiceDeleteResults, func(context.Context, *string) error]
+	list              method[GraphqlRateLimitingCostDecorationServiceListCall, graphqlRateLimitingCostDecorationServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error)]
+	listAll           method[GraphqlRateLimitingCostDecorationServiceListAllCall, graphqlRateLimitingCostDecorationServiceListAllResults, func(context.Context) ([]*kong.GraphqlRateLimitingCostDecoration, error)]
+	createForService  method[GraphqlRateLimitingCostDecorationServiceCreateForServiceCall, graphqlRateLimitingCostDecorationServiceCreateForServiceResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	updateForService  method[GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall, graphqlRateLimitingCostDecorationServiceUpdateForServiceResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)]
+	deleteForService  method[GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall, graphqlRateLimitingCostDecorationServiceDeleteForServiceResults, func(context.Context, *kong.GraphqlRateLimitingCostDecoration) error]
+	listForService    method[GraphqlRateLimitingCostDecorationServiceListForServiceCall, graphqlRateLimitingCostDecorationServiceListForServiceResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error)]
+	listAllForService method[GraphqlRateLimitingCostDecorationServiceListAllForServiceCall, graphqlRateLimitingCostDecorationServiceListAllForServiceResults, func(context.Context, *string) ([]*kong.GraphqlRateLimitingCostDecoration, error)]
+}
+
+var _ kong.AbstractGraphqlRateLimitingCostDecorationService = &FakeGraphqlRateLimitingCostDecorationService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) Create(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.create.record(GraphqlRateLimitingCostDecorationServiceCreateCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, costDeco)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateCalls() []GraphqlRateLimitingCostDecorationServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.create.setReturns(graphqlRateLimitingCostDecorationServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.create.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateWithID records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithID(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.createWithID.record(GraphqlRateLimitingCostDecorationServiceCreateWithIDCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.CreateWithID(ctx, costDeco)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.CreateWithID")
+}
+
+// CreateWithIDCallCount returns the number of calls to CreateWithID.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDCallCount() int {
+	return f.createWithID.callCount()
+}
+
+// CreateWithIDCalls returns the arguments of the calls to CreateWithID.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDCalls() []GraphqlRateLimitingCostDecorationServiceCreateWithIDCall {
+	return f.createWithID.allCalls()
+}
+
+// CreateWithIDArgsForCall returns the arguments of the i-th call to CreateWithID.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceCreateWithIDCall {
+	return f.createWithID.argsForCall(i)
+}
+
+// CreateWithIDStub makes CreateWithID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.createWithID.setStub(stub, stub != nil)
+}
+
+// CreateWithIDReturns makes CreateWithID return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.createWithID.setReturns(graphqlRateLimitingCostDecorationServiceCreateWithIDResults{r0: r0, r1: r1})
+}
+
+// CreateWithIDReturnsOnCall makes the i-th call to CreateWithID return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateWithIDReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.createWithID.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceCreateWithIDResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) Get(ctx context.Context, ID *string) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.get.record(GraphqlRateLimitingCostDecorationServiceGetCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, ID)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetCalls() []GraphqlRateLimitingCostDecorationServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetStub(stub func(context.Context, *string) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.get.setReturns(graphqlRateLimitingCostDecorationServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) GetReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.get.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) Update(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.update.record(GraphqlRateLimitingCostDecorationServiceUpdateCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, costDeco)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateCalls() []GraphqlRateLimitingCostDecorationServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.update.setReturns(graphqlRateLimitingCostDecorationServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.update.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) Delete(ctx context.Context, ID *string) error {
+	call := f.delete.record(GraphqlRateLimitingCostDecorationServiceDeleteCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, ID)
+	}
+	return notProgrammed("FakeGraphqlRateLimitingCostDecorationService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteCalls() []GraphqlRateLimitingCostDecorationServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteReturns(r0 error) {
+	f.delete.setReturns(graphqlRateLimitingCostDecorationServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error) {
+	call := f.list.record(GraphqlRateLimitingCostDecorationServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListCalls() []GraphqlRateLimitingCostDecorationServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListReturns(r0 []*kong.GraphqlRateLimitingCostDecoration, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(graphqlRateLimitingCostDecorationServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListReturnsOnCall(i int, r0 []*kong.GraphqlRateLimitingCostDecoration, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAll(ctx context.Context) ([]*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.listAll.record(GraphqlRateLimitingCostDecorationServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllCalls() []GraphqlRateLimitingCostDecorationServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllStub(stub func(context.Context) ([]*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllReturns(r0 []*kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.listAll.setReturns(graphqlRateLimitingCostDecorationServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllReturnsOnCall(i int, r0 []*kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.listAll.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceListAllResults{r0: r0, r1: r1})
+}
+
+// CreateForService records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForService(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.createForService.record(GraphqlRateLimitingCostDecorationServiceCreateForServiceCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForService(ctx, costDeco)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.CreateForService")
+}
+
+// CreateForServiceCallCount returns the number of calls to CreateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceCallCount() int {
+	return f.createForService.callCount()
+}
+
+// CreateForServiceCalls returns the arguments of the calls to CreateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceCalls() []GraphqlRateLimitingCostDecorationServiceCreateForServiceCall {
+	return f.createForService.allCalls()
+}
+
+// CreateForServiceArgsForCall returns the arguments of the i-th call to CreateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceCreateForServiceCall {
+	return f.createForService.argsForCall(i)
+}
+
+// CreateForServiceStub makes CreateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.createForService.setStub(stub, stub != nil)
+}
+
+// CreateForServiceReturns makes CreateForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.createForService.setReturns(graphqlRateLimitingCostDecorationServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// CreateForServiceReturnsOnCall makes the i-th call to CreateForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) CreateForServiceReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.createForService.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForService records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForService(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.updateForService.record(GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForService(ctx, costDeco)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.UpdateForService")
+}
+
+// UpdateForServiceCallCount returns the number of calls to UpdateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceCallCount() int {
+	return f.updateForService.callCount()
+}
+
+// UpdateForServiceCalls returns the arguments of the calls to UpdateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceCalls() []GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall {
+	return f.updateForService.allCalls()
+}
+
+// UpdateForServiceArgsForCall returns the arguments of the i-th call to UpdateForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceUpdateForServiceCall {
+	return f.updateForService.argsForCall(i)
+}
+
+// UpdateForServiceStub makes UpdateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) (*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.updateForService.setStub(stub, stub != nil)
+}
+
+// UpdateForServiceReturns makes UpdateForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceReturns(r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.updateForService.setReturns(graphqlRateLimitingCostDecorationServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForServiceReturnsOnCall makes the i-th call to UpdateForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) UpdateForServiceReturnsOnCall(i int, r0 *kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.updateForService.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// DeleteForService records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForService(ctx context.Context, costDeco *kong.GraphqlRateLimitingCostDecoration) error {
+	call := f.deleteForService.record(GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall{Ctx: ctx, CostDeco: costDeco})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, costDeco)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForService(ctx, costDeco)
+	}
+	return notProgrammed("FakeGraphqlRateLimitingCostDecorationService.DeleteForService")
+}
+
+// DeleteForServiceCallCount returns the number of calls to DeleteForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceCallCount() int {
+	return f.deleteForService.callCount()
+}
+
+// DeleteForServiceCalls returns the arguments of the calls to DeleteForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceCalls() []GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall {
+	return f.deleteForService.allCalls()
+}
+
+// DeleteForServiceArgsForCall returns the arguments of the i-th call to DeleteForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceDeleteForServiceCall {
+	return f.deleteForService.argsForCall(i)
+}
+
+// DeleteForServiceStub makes DeleteForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceStub(stub func(context.Context, *kong.GraphqlRateLimitingCostDecoration) error) {
+	f.deleteForService.setStub(stub, stub != nil)
+}
+
+// DeleteForServiceReturns makes DeleteForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceReturns(r0 error) {
+	f.deleteForService.setReturns(graphqlRateLimitingCostDecorationServiceDeleteForServiceResults{r0: r0})
+}
+
+// DeleteForServiceReturnsOnCall makes the i-th call to DeleteForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) DeleteForServiceReturnsOnCall(i int, r0 error) {
+	f.deleteForService.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceDeleteForServiceResults{r0: r0})
+}
+
+// ListForService records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForService(ctx context.Context, serviceNameOrID *string, opt *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error) {
+	call := f.listForService.record(GraphqlRateLimitingCostDecorationServiceListForServiceCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForService(ctx, serviceNameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.ListForService")
+}
+
+// ListForServiceCallCount returns the number of calls to ListForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceCallCount() int {
+	return f.listForService.callCount()
+}
+
+// ListForServiceCalls returns the arguments of the calls to ListForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceCalls() []GraphqlRateLimitingCostDecorationServiceListForServiceCall {
+	return f.listForService.allCalls()
+}
+
+// ListForServiceArgsForCall returns the arguments of the i-th call to ListForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceListForServiceCall {
+	return f.listForService.argsForCall(i)
+}
+
+// ListForServiceStub makes ListForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.GraphqlRateLimitingCostDecoration, *kong.ListOpt, error)) {
+	f.listForService.setStub(stub, stub != nil)
+}
+
+// ListForServiceReturns makes ListForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceReturns(r0 []*kong.GraphqlRateLimitingCostDecoration, r1 *kong.ListOpt, r2 error) {
+	f.listForService.setReturns(graphqlRateLimitingCostDecorationServiceListForServiceResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForServiceReturnsOnCall makes the i-th call to ListForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListForServiceReturnsOnCall(i int, r0 []*kong.GraphqlRateLimitingCostDecoration, r1 *kong.ListOpt, r2 error) {
+	f.listForService.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceListForServiceResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAllForService records the call and returns the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForService(ctx context.Context, serviceNameOrID *string) ([]*kong.GraphqlRateLimitingCostDecoration, error) {
+	call := f.listAllForService.record(GraphqlRateLimitingCostDecorationServiceListAllForServiceCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForService(ctx, serviceNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeGraphqlRateLimitingCostDecorationService.ListAllForService")
+}
+
+// ListAllForServiceCallCount returns the number of calls to ListAllForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceCallCount() int {
+	return f.listAllForService.callCount()
+}
+
+// ListAllForServiceCalls returns the arguments of the calls to ListAllForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceCalls() []GraphqlRateLimitingCostDecorationServiceListAllForServiceCall {
+	return f.listAllForService.allCalls()
+}
+
+// ListAllForServiceArgsForCall returns the arguments of the i-th call to ListAllForService.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceArgsForCall(i int) GraphqlRateLimitingCostDecorationServiceListAllForServiceCall {
+	return f.listAllForService.argsForCall(i)
+}
+
+// ListAllForServiceStub makes ListAllForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceStub(stub func(context.Context, *string) ([]*kong.GraphqlRateLimitingCostDecoration, error)) {
+	f.listAllForService.setStub(stub, stub != nil)
+}
+
+// ListAllForServiceReturns makes ListAllForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceReturns(r0 []*kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.listAllForService.setReturns(graphqlRateLimitingCostDecorationServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// ListAllForServiceReturnsOnCall makes the i-th call to ListAllForService return the given values.
+func (f *FakeGraphqlRateLimitingCostDecorationService) ListAllForServiceReturnsOnCall(i int, r0 []*kong.GraphqlRateLimitingCostDecoration, r1 error) {
+	f.listAllForService.setReturnsOnCall(i, graphqlRateLimitingCostDecorationServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeGraphqlRateLimitingCostDecorationService) Reset() {
+	f.create.reset()
+	f.createWithID.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.createForService.reset()
+	f.updateForService.reset()
+	f.deleteForService.reset()
+	f.listForService.reset()
+	f.listAllForService.reset()
+}
+
+// HMACAuthServiceCreateCall holds the arguments of a call to FakeHMACAuthService.Create.
+type HMACAuthServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	HmacAuth             *kong.HMACAuth
+}
+
+type hmacAuthServiceCreateResults struct {
+	r0 *kong.HMACAuth
+	r1 error
+}
+
+// HMACAuthServiceGetCall holds the arguments of a call to FakeHMACAuthService.Get.
+type HMACAuthServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	UsernameOrID         *string
+}
+
+type hmacAuthServiceGetResults struct {
+	r0 *kong.HMACAuth
+	r1 error
+}
+
+// HMACAuthServiceGetByIDCall holds the arguments of a call to FakeHMACAuthService.GetByID.
+type HMACAuthServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type hmacAuthServiceGetByIDResults struct {
+	r0 *kong.HMACAuth
+	r1 error
+}
+
+// HMACAuthServiceUpdateCall holds the arguments of a call to FakeHMACAuthService.Update.
+type HMACAuthServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	HmacAuth             *kong.HMACAuth
+}
+
+type hmacAuthServiceUpdateResults struct {
+	r0 *kong.HMACAuth
+	r1 error
+}
+
+// HMACAuthServiceDeleteCall holds the arguments of a call to FakeHMACAuthService.Delete.
+type HMACAuthServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	UsernameOrID         *string
+}
+
+type hmacAuthServiceDeleteResults struct {
+	r0 error
+}
+
+// HMACAuthServiceListCall holds the arguments of a call to FakeHMACAuthService.List.
+type HMACAuthServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type hmacAuthServiceListResults struct {
+	r0 []*kong.HMACAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// HMACAuthServiceListAllCall holds the arguments of a call to FakeHMACAuthService.ListAll.
+type HMACAuthServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type hmacAuthServiceListAllResults struct {
+	r0 []*kong.HMACAuth
+	r1 error
+}
+
+// HMACAuthServiceListForConsumerCall holds the arguments of a call to FakeHMACAuthService.ListForConsumer.
+type HMACAuthServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type hmacAuthServiceListForConsumerResults struct {
+	r0 []*kong.HMACAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeHMACAuthService is a fake implementation of kong.AbstractHMACAuthService.
+type FakeHMACAuthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractHMACAuthService
+
+	create          method[HMACAuthServiceCreateCall, hmacAuthServiceCreateResults, func(context.Context, *string, *kong.HMACAuth) (*kong.HMACAuth, error)]
+	get             method[HMACAuthServiceGetCall, hmacAuthServiceGetResults, func(context.Context, *string, *string) (*kong.HMACAuth, error)]
+	getByID         method[HMACAuthServiceGetByIDCall, hmacAuthServiceGetByIDResults, func(context.Context, *string) (*kong.HMACAuth, error)]
+	update          method[HMACAuthServiceUpdateCall, hmacAuthServiceUpdateResults, func(context.Context, *string, *kong.HMACAuth) (*kong.HMACAuth, error)]
+	delete          method[HMACAuthServiceDeleteCall, hmacAuthServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[HMACAuthServiceListCall, hmacAuthServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error)]
+	listAll         method[HMACAuthServiceListAllCall, hmacAuthServiceListAllResults, func(context.Context) ([]*kong.HMACAuth, error)]
+	listForConsumer method[HMACAuthServiceListForConsumerCall, hmacAuthServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractHMACAuthService = &FakeHMACAuthService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeHMACAuthService) Create(ctx context.Context, consumerUsernameOrID *string, hmacAuth *kong.HMACAuth) (*kong.HMACAuth, error) {
+	call := f.create.record(HMACAuthServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, HmacAuth: hmacAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, hmacAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, hmacAuth)
+	}
+	return call.results.r0, notProgrammed("FakeHMACAuthService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeHMACAuthService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeHMACAuthService) CreateCalls() []HMACAuthServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeHMACAuthService) CreateArgsForCall(i int) HMACAuthServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) CreateStub(stub func(context.Context, *string, *kong.HMACAuth) (*kong.HMACAuth, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeHMACAuthService) CreateReturns(r0 *kong.HMACAuth, r1 error) {
+	f.create.setReturns(hmacAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeHMACAuthService) CreateReturnsOnCall(i int, r0 *kong.HMACAuth, r1 error) {
+	f.create.setReturnsOnCall(i, hmacAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeHMACAuthService) Get(ctx context.Context, consumerUsernameOrID *string, usernameOrID *string) (*kong.HMACAuth, error) {
+	call := f.get.record(HMACAuthServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeHMACAuthService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeHMACAuthService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeHMACAuthService) GetCalls() []HMACAuthServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeHMACAuthService) GetArgsForCall(i int) HMACAuthServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) GetStub(stub func(context.Context, *string, *string) (*kong.HMACAuth, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeHMACAuthService) GetReturns(r0 *kong.HMACAuth, r1 error) {
+	f.get.setReturns(hmacAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeHMACAuthService) GetReturnsOnCall(i int, r0 *kong.HMACAuth, r1 error) {
+	f.get.setReturnsOnCall(i, hmacAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeHMACAuthService) GetByID(ctx context.Context, id *string) (*kong.HMACAuth, error) {
+	call := f.getByID.record(HMACAuthServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeHMACAuthService.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeHMACAuthService) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeHMACAuthService) GetByIDCalls() []HMACAuthServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeHMACAuthService) GetByIDArgsForCall(i int) HMACAuthServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) GetByIDStub(stub func(context.Context, *string) (*kong.HMACAuth, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeHMACAuthService) GetByIDReturns(r0 *kong.HMACAuth, r1 error) {
+	f.getByID.setReturns(hmacAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeHMACAuthService) GetByIDReturnsOnCall(i int, r0 *kong.HMACAuth, r1 error) {
+	f.getByID.setReturnsOnCall(i, hmacAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeHMACAuthService) Update(ctx context.Context, consumerUsernameOrID *string, hmacAuth *kong.HMACAuth) (*kong.HMACAuth, error) {
+	call := f.update.record(HMACAuthServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, HmacAuth: hmacAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, hmacAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, hmacAuth)
+	}
+	return call.results.r0, notProgrammed("FakeHMACAuthService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeHMACAuthService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeHMACAuthService) UpdateCalls() []HMACAuthServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeHMACAuthService) UpdateArgsForCall(i int) HMACAuthServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) UpdateStub(stub func(context.Context, *string, *kong.HMACAuth) (*kong.HMACAuth, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeHMACAuthService) UpdateReturns(r0 *kong.HMACAuth, r1 error) {
+	f.update.setReturns(hmacAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeHMACAuthService) UpdateReturnsOnCall(i int, r0 *kong.HMACAuth, r1 error) {
+	f.update.setReturnsOnCall(i, hmacAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeHMACAuthService) Delete(ctx context.Context, consumerUsernameOrID *string, usernameOrID *string) error {
+	call := f.delete.record(HMACAuthServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, usernameOrID)
+	}
+	return notProgrammed("FakeHMACAuthService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeHMACAuthService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeHMACAuthService) DeleteCalls() []HMACAuthServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeHMACAuthService) DeleteArgsForCall(i int) HMACAuthServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeHMACAuthService) DeleteReturns(r0 error) {
+	f.delete.setReturns(hmacAuthServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeHMACAuthService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, hmacAuthServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeHMACAuthService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error) {
+	call := f.list.record(HMACAuthServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeHMACAuthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeHMACAuthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeHMACAuthService) ListCalls() []HMACAuthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeHMACAuthService) ListArgsForCall(i int) HMACAuthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeHMACAuthService) ListReturns(r0 []*kong.HMACAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(hmacAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeHMACAuthService) ListReturnsOnCall(i int, r0 []*kong.HMACAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, hmacAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeHMACAuthService) ListAll(ctx context.Context) ([]*kong.HMACAuth, error) {
+	call := f.listAll.record(HMACAuthServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeHMACAuthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeHMACAuthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeHMACAuthService) ListAllCalls() []HMACAuthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeHMACAuthService) ListAllArgsForCall(i int) HMACAuthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) ListAllStub(stub func(context.Context) ([]*kong.HMACAuth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeHMACAuthService) ListAllReturns(r0 []*kong.HMACAuth, r1 error) {
+	f.listAll.setReturns(hmacAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeHMACAuthService) ListAllReturnsOnCall(i int, r0 []*kong.HMACAuth, r1 error) {
+	f.listAll.setReturnsOnCall(i, hmacAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeHMACAuthService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(HMACAuthServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeHMACAuthService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeHMACAuthService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeHMACAuthService) ListForConsumerCalls() []HMACAuthServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeHMACAuthService) ListForConsumerArgsForCall(i int) HMACAuthServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeHMACAuthService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.HMACAuth, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeHMACAuthService) ListForConsumerReturns(r0 []*kong.HMACAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(hmacAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeHMACAuthService) ListForConsumerReturnsOnCall(i int, r0 []*kong.HMACAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, hmacAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeHMACAuthService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// InfoServiceGetCall holds the arguments of a call to FakeInfoService.Get.
+type InfoServiceGetCall struct {
+	Ctx context.Context
+}
+
+type infoServiceGetResults struct {
+	r0 *kong.Info
+	r1 error
+}
+
+// FakeInfoService is a fake implementation of kong.AbstractInfoService.
+type FakeInfoService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractInfoService
+
+	get method[InfoServiceGetCall, infoServiceGetResults, func(context.Context) (*kong.Info, error)]
+}
+
+var _ kong.AbstractInfoService = &FakeInfoService{}
+
+// Get records the call and returns the programmed results.
+func (f *FakeInfoService) Get(ctx context.Context) (*kong.Info, error) {
+	call := f.get.record(InfoServiceGetCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeInfoService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeInfoService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeInfoService) GetCalls() []InfoServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeInfoService) GetArgsForCall(i int) InfoServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeInfoService) GetStub(stub func(context.Context) (*kong.Info, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeInfoService) GetReturns(r0 *kong.Info, r1 error) {
+	f.get.setReturns(infoServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeInfoService) GetReturnsOnCall(i int, r0 *kong.Info, r1 error) {
+	f.get.setReturnsOnCall(i, infoServiceGetResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeInfoService) Reset() {
+	f.get.reset()
+}
+
+// JWTAuthServiceCreateCall holds the arguments of a call to FakeJWTAuthService.Create.
+type JWTAuthServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	JwtAuth              *kong.JWTAuth
+}
+
+type jwtAuthServiceCreateResults struct {
+	r0 *kong.JWTAuth
+	r1 error
+}
+
+// JWTAuthServiceGetCall holds the arguments of a call to FakeJWTAuthService.Get.
+type JWTAuthServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type jwtAuthServiceGetResults struct {
+	r0 *kong.JWTAuth
+	r1 error
+}
+
+// JWTAuthServiceGetByIDCall holds the arguments of a call to FakeJWTAuthService.GetByID.
+type JWTAuthServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type jwtAuthServiceGetByIDResults struct {
+	r0 *kong.JWTAuth
+	r1 error
+}
+
+// JWTAuthServiceUpdateCall holds the arguments of a call to FakeJWTAuthService.Update.
+type JWTAuthServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	JwtAuth              *kong.JWTAuth
+}
+
+type jwtAuthServiceUpdateResults struct {
+	r0 *kong.JWTAuth
+	r1 error
+}
+
+// JWTAuthServiceDeleteCall holds the arguments of a call to FakeJWTAuthService.Delete.
+type JWTAuthServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type jwtAuthServiceDeleteResults struct {
+	r0 error
+}
+
+// JWTAuthServiceListCall holds the arguments of a call to FakeJWTAuthService.List.
+type JWTAuthServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type jwtAuthServiceListResults struct {
+	r0 []*kong.JWTAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// JWTAuthServiceListAllCall holds the arguments of a call to FakeJWTAuthService.ListAll.
+type JWTAuthServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type jwtAuthServiceListAllResults struct {
+	r0 []*kong.JWTAuth
+	r1 error
+}
+
+// JWTAuthServiceListForConsumerCall holds the arguments of a call to FakeJWTAuthService.ListForConsumer.
+type JWTAuthServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type jwtAuthServiceListForConsumerResults struct {
+	r0 []*kong.JWTAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeJWTAuthService is a fake implementation of kong.AbstractJWTAuthService.
+type FakeJWTAuthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractJWTAuthService
+
+	create          method[JWTAuthServiceCreateCall, jwtAuthServiceCreateResults, func(context.Context, *string, *kong.JWTAuth) (*kong.JWTAuth, error)]
+	get             method[JWTAuthServiceGetCall, jwtAuthServiceGetResults, func(context.Context, *string, *string) (*kong.JWTAuth, error)]
+	getByID         method[JWTAuthServiceGetByIDCall, jwtAuthServiceGetByIDResults, func(context.Context, *string) (*kong.JWTAuth, error)]
+	update          method[JWTAuthServiceUpdateCall, jwtAuthServiceUpdateResults, func(context.Context, *string, *kong.JWTAuth) (*kong.JWTAuth, error)]
+	delete          method[JWTAuthServiceDeleteCall, jwtAuthServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[JWTAuthServiceListCall, jwtAuthServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error)]
+	listAll         method[JWTAuthServiceListAllCall, jwtAuthServiceListAllResults, func(context.Context) ([]*kong.JWTAuth, error)]
+	listForConsumer method[JWTAuthServiceListForConsumerCall, jwtAuthServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractJWTAuthService = &FakeJWTAuthService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeJWTAuthService) Create(ctx context.Context, consumerUsernameOrID *string, jwtAuth *kong.JWTAuth) (*kong.JWTAuth, error) {
+	call := f.create.record(JWTAuthServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, JwtAuth: jwtAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, jwtAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, jwtAuth)
+	}
+	return call.results.r0, notProgrammed("FakeJWTAuthService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeJWTAuthService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeJWTAuthService) CreateCalls() []JWTAuthServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeJWTAuthService) CreateArgsForCall(i int) JWTAuthServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) CreateStub(stub func(context.Context, *string, *kong.JWTAuth) (*kong.JWTAuth, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeJWTAuthService) CreateReturns(r0 *kong.JWTAuth, r1 error) {
+	f.create.setReturns(jwtAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeJWTAuthService) CreateReturnsOnCall(i int, r0 *kong.JWTAuth, r1 error) {
+	f.create.setReturnsOnCall(i, jwtAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeJWTAuthService) Get(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) (*kong.JWTAuth, error) {
+	call := f.get.record(JWTAuthServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return call.results.r0, notProgrammed("FakeJWTAuthService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeJWTAuthService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeJWTAuthService) GetCalls() []JWTAuthServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeJWTAuthService) GetArgsForCall(i int) JWTAuthServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) GetStub(stub func(context.Context, *string, *string) (*kong.JWTAuth, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeJWTAuthService) GetReturns(r0 *kong.JWTAuth, r1 error) {
+	f.get.setReturns(jwtAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeJWTAuthService) GetReturnsOnCall(i int, r0 *kong.JWTAuth, r1 error) {
+	f.get.setReturnsOnCall(i, jwtAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeJWTAuthService) GetByID(ctx context.Context, id *string) (*kong.JWTAuth, error) {
+	call := f.getByID.record(JWTAuthServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeJWTAuthService.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeJWTAuthService) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeJWTAuthService) GetByIDCalls() []JWTAuthServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeJWTAuthService) GetByIDArgsForCall(i int) JWTAuthServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) GetByIDStub(stub func(context.Context, *string) (*kong.JWTAuth, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeJWTAuthService) GetByIDReturns(r0 *kong.JWTAuth, r1 error) {
+	f.getByID.setReturns(jwtAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeJWTAuthService) GetByIDReturnsOnCall(i int, r0 *kong.JWTAuth, r1 error) {
+	f.getByID.setReturnsOnCall(i, jwtAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeJWTAuthService) Update(ctx context.Context, consumerUsernameOrID *string, jwtAuth *kong.JWTAuth) (*kong.JWTAuth, error) {
+	call := f.update.record(JWTAuthServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, JwtAuth: jwtAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, jwtAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, jwtAuth)
+	}
+	return call.results.r0, notProgrammed("FakeJWTAuthService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeJWTAuthService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeJWTAuthService) UpdateCalls() []JWTAuthServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeJWTAuthService) UpdateArgsForCall(i int) JWTAuthServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) UpdateStub(stub func(context.Context, *string, *kong.JWTAuth) (*kong.JWTAuth, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeJWTAuthService) UpdateReturns(r0 *kong.JWTAuth, r1 error) {
+	f.update.setReturns(jwtAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeJWTAuthService) UpdateReturnsOnCall(i int, r0 *kong.JWTAuth, r1 error) {
+	f.update.setReturnsOnCall(i, jwtAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeJWTAuthService) Delete(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) error {
+	call := f.delete.record(JWTAuthServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return notProgrammed("FakeJWTAuthService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeJWTAuthService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeJWTAuthService) DeleteCalls() []JWTAuthServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeJWTAuthService) DeleteArgsForCall(i int) JWTAuthServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeJWTAuthService) DeleteReturns(r0 error) {
+	f.delete.setReturns(jwtAuthServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeJWTAuthService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, jwtAuthServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeJWTAuthService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error) {
+	call := f.list.record(JWTAuthServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeJWTAuthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeJWTAuthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeJWTAuthService) ListCalls() []JWTAuthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeJWTAuthService) ListArgsForCall(i int) JWTAuthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeJWTAuthService) ListReturns(r0 []*kong.JWTAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(jwtAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeJWTAuthService) ListReturnsOnCall(i int, r0 []*kong.JWTAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, jwtAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeJWTAuthService) ListAll(ctx context.Context) ([]*kong.JWTAuth, error) {
+	call := f.listAll.record(JWTAuthServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeJWTAuthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeJWTAuthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeJWTAuthService) ListAllCalls() []JWTAuthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeJWTAuthService) ListAllArgsForCall(i int) JWTAuthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) ListAllStub(stub func(context.Context) ([]*kong.JWTAuth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeJWTAuthService) ListAllReturns(r0 []*kong.JWTAuth, r1 error) {
+	f.listAll.setReturns(jwtAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeJWTAuthService) ListAllReturnsOnCall(i int, r0 []*kong.JWTAuth, r1 error) {
+	f.listAll.setReturnsOnCall(i, jwtAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeJWTAuthService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(JWTAuthServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeJWTAuthService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeJWTAuthService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeJWTAuthService) ListForConsumerCalls() []JWTAuthServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeJWTAuthService) ListForConsumerArgsForCall(i int) JWTAuthServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeJWTAuthService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.JWTAuth, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeJWTAuthService) ListForConsumerReturns(r0 []*kong.JWTAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(jwtAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeJWTAuthService) ListForConsumerReturnsOnCall(i int, r0 []*kong.JWTAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, jwtAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeJWTAuthService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// KeyAuthServiceCreateCall holds the arguments of a call to FakeKeyAuthService.Create.
+type KeyAuthServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyAuth              *kong.KeyAuth
+}
+
+type keyAuthServiceCreateResults struct {
+	r0 *kong.KeyAuth
+	r1 error
+}
+
+// KeyAuthServiceGetCall holds the arguments of a call to FakeKeyAuthService.Get.
+type KeyAuthServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type keyAuthServiceGetResults struct {
+	r0 *kong.KeyAuth
+	r1 error
+}
+
+// KeyAuthServiceGetByIDCall holds the arguments of a call to FakeKeyAuthService.GetByID.
+type KeyAuthServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type keyAuthServiceGetByIDResults struct {
+	r0 *kong.KeyAuth
+	r1 error
+}
+
+// KeyAuthServiceUpdateCall holds the arguments of a call to FakeKeyAuthService.Update.
+type KeyAuthServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyAuth              *kong.KeyAuth
+}
+
+type keyAuthServiceUpdateResults struct {
+	r0 *kong.KeyAuth
+	r1 error
+}
+
+// KeyAuthServiceDeleteCall holds the arguments of a call to FakeKeyAuthService.Delete.
+type KeyAuthServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type keyAuthServiceDeleteResults struct {
+	r0 error
+}
+
+// KeyAuthServiceListCall holds the arguments of a call to FakeKeyAuthService.List.
+type KeyAuthServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type keyAuthServiceListResults struct {
+	r0 []*kong.KeyAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// KeyAuthServiceListAllCall holds the arguments of a call to FakeKeyAuthService.ListAll.
+type KeyAuthServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type keyAuthServiceListAllResults struct {
+	r0 []*kong.KeyAuth
+	r1 error
+}
+
+// KeyAuthServiceListForConsumerCall holds the arguments of a call to FakeKeyAuthService.ListForConsumer.
+type KeyAuthServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type keyAuthServiceListForConsumerResults struct {
+	r0 []*kong.KeyAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeKeyAuthService is a fake implementation of kong.AbstractKeyAuthService.
+type FakeKeyAuthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractKeyAuthService
+
+	create          method[KeyAuthServiceCreateCall, keyAuthServiceCreateResults, func(context.Context, *string, *kong.KeyAuth) (*kong.KeyAuth, error)]
+	get             method[KeyAuthServiceGetCall, keyAuthServiceGetResults, func(context.Context, *string, *string) (*kong.KeyAuth, error)]
+	getByID         method[KeyAuthServiceGetByIDCall, keyAuthServiceGetByIDResults, func(context.Context, *string) (*kong.KeyAuth, error)]
+	update          method[KeyAuthServiceUpdateCall, keyAuthServiceUpdateResults, func(context.Context, *string, *kong.KeyAuth) (*kong.KeyAuth, error)]
+	delete          method[KeyAuthServiceDeleteCall, keyAuthServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[KeyAuthServiceListCall, keyAuthServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error)]
+	listAll         method[KeyAuthServiceListAllCall, keyAuthServiceListAllResults, func(context.Context) ([]*kong.KeyAuth, error)]
+	listForConsumer method[KeyAuthServiceListForConsumerCall, keyAuthServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractKeyAuthService = &FakeKeyAuthService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeKeyAuthService) Create(ctx context.Context, consumerUsernameOrID *string, keyAuth *kong.KeyAuth) (*kong.KeyAuth, error) {
+	call := f.create.record(KeyAuthServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyAuth: keyAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, keyAuth)
+	}
+	return call.results.r0, notProgrammed("FakeKeyAuthService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeKeyAuthService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeKeyAuthService) CreateCalls() []KeyAuthServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeKeyAuthService) CreateArgsForCall(i int) KeyAuthServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) CreateStub(stub func(context.Context, *string, *kong.KeyAuth) (*kong.KeyAuth, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeKeyAuthService) CreateReturns(r0 *kong.KeyAuth, r1 error) {
+	f.create.setReturns(keyAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeKeyAuthService) CreateReturnsOnCall(i int, r0 *kong.KeyAuth, r1 error) {
+	f.create.setReturnsOnCall(i, keyAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeKeyAuthService) Get(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) (*kong.KeyAuth, error) {
+	call := f.get.record(KeyAuthServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return call.results.r0, notProgrammed("FakeKeyAuthService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeKeyAuthService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeKeyAuthService) GetCalls() []KeyAuthServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeKeyAuthService) GetArgsForCall(i int) KeyAuthServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) GetStub(stub func(context.Context, *string, *string) (*kong.KeyAuth, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeKeyAuthService) GetReturns(r0 *kong.KeyAuth, r1 error) {
+	f.get.setReturns(keyAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeKeyAuthService) GetReturnsOnCall(i int, r0 *kong.KeyAuth, r1 error) {
+	f.get.setReturnsOnCall(i, keyAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeKeyAuthService) GetByID(ctx context.Context, id *string) (*kong.KeyAuth, error) {
+	call := f.getByID.record(KeyAuthServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeKeyAuthService.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeKeyAuthService) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeKeyAuthService) GetByIDCalls() []KeyAuthServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeKeyAuthService) GetByIDArgsForCall(i int) KeyAuthServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) GetByIDStub(stub func(context.Context, *string) (*kong.KeyAuth, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeKeyAuthService) GetByIDReturns(r0 *kong.KeyAuth, r1 error) {
+	f.getByID.setReturns(keyAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeKeyAuthService) GetByIDReturnsOnCall(i int, r0 *kong.KeyAuth, r1 error) {
+	f.getByID.setReturnsOnCall(i, keyAuthServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeKeyAuthService) Update(ctx context.Context, consumerUsernameOrID *string, keyAuth *kong.KeyAuth) (*kong.KeyAuth, error) {
+	call := f.update.record(KeyAuthServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyAuth: keyAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, keyAuth)
+	}
+	return call.results.r0, notProgrammed("FakeKeyAuthService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeKeyAuthService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeKeyAuthService) UpdateCalls() []KeyAuthServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeKeyAuthService) UpdateArgsForCall(i int) KeyAuthServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) UpdateStub(stub func(context.Context, *string, *kong.KeyAuth) (*kong.KeyAuth, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeKeyAuthService) UpdateReturns(r0 *kong.KeyAuth, r1 error) {
+	f.update.setReturns(keyAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeKeyAuthService) UpdateReturnsOnCall(i int, r0 *kong.KeyAuth, r1 error) {
+	f.update.setReturnsOnCall(i, keyAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeKeyAuthService) Delete(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) error {
+	call := f.delete.record(KeyAuthServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return notProgrammed("FakeKeyAuthService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeKeyAuthService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeKeyAuthService) DeleteCalls() []KeyAuthServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeKeyAuthService) DeleteArgsForCall(i int) KeyAuthServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeKeyAuthService) DeleteReturns(r0 error) {
+	f.delete.setReturns(keyAuthServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeKeyAuthService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, keyAuthServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeKeyAuthService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error) {
+	call := f.list.record(KeyAuthServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeKeyAuthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeKeyAuthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeKeyAuthService) ListCalls() []KeyAuthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeKeyAuthService) ListArgsForCall(i int) KeyAuthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeKeyAuthService) ListReturns(r0 []*kong.KeyAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(keyAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeKeyAuthService) ListReturnsOnCall(i int, r0 []*kong.KeyAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, keyAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeKeyAuthService) ListAll(ctx context.Context) ([]*kong.KeyAuth, error) {
+	call := f.listAll.record(KeyAuthServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeKeyAuthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeKeyAuthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeKeyAuthService) ListAllCalls() []KeyAuthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeKeyAuthService) ListAllArgsForCall(i int) KeyAuthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) ListAllStub(stub func(context.Context) ([]*kong.KeyAuth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeKeyAuthService) ListAllReturns(r0 []*kong.KeyAuth, r1 error) {
+	f.listAll.setReturns(keyAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeKeyAuthService) ListAllReturnsOnCall(i int, r0 []*kong.KeyAuth, r1 error) {
+	f.listAll.setReturnsOnCall(i, keyAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeKeyAuthService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(KeyAuthServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeKeyAuthService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeKeyAuthService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeKeyAuthService) ListForConsumerCalls() []KeyAuthServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeKeyAuthService) ListForConsumerArgsForCall(i int) KeyAuthServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyAuthService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.KeyAuth, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeKeyAuthService) ListForConsumerReturns(r0 []*kong.KeyAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(keyAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeKeyAuthService) ListForConsumerReturnsOnCall(i int, r0 []*kong.KeyAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, keyAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeKeyAuthService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// KeyServiceCreateCall holds the arguments of a call to FakeKeyService.Create.
+type KeyServiceCreateCall struct {
+	Ctx context.Context
+	Key *kong.Key
+}
+
+type keyServiceCreateResults struct {
+	r0 *kong.Key
+	r1 error
+}
+
+// KeyServiceGetCall holds the arguments of a call to FakeKeyService.Get.
+type KeyServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type keyServiceGetResults struct {
+	r0 *kong.Key
+	r1 error
+}
+
+// KeyServiceUpdateCall holds the arguments of a call to FakeKeyService.Update.
+type KeyServiceUpdateCall struct {
+	Ctx context.Context
+	Key *kong.Key
+}
+
+type keyServiceUpdateResults struct {
+	r0 *kong.Key
+	r1 error
+}
+
+// KeyServiceDeleteCall holds the arguments of a call to FakeKeyService.Delete.
+type KeyServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type keyServiceDeleteResults struct {
+	r0 error
+}
+
+// KeyServiceListCall holds the arguments of a call to FakeKeyService.List.
+type KeyServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type keyServiceListResults struct {
+	r0 []*kong.Key
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// KeyServiceListAllCall holds the arguments of a call to FakeKeyService.ListAll.
+type KeyServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type keyServiceListAllResults struct {
+	r0 []*kong.Key
+	r1 error
+}
+
+// FakeKeyService is a fake implementation of kong.AbstractKeyService.
+type FakeKeyService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractKeyService
+
+	create  method[KeyServiceCreateCall, keyServiceCreateResults, func(context.Context, *kong.Key) (*kong.Key, error)]
+	get     method[KeyServiceGetCall, keyServiceGetResults, func(context.Context, *string) (*kong.Key, error)]
+	update  method[KeyServiceUpdateCall, keyServiceUpdateResults, func(context.Context, *kong.Key) (*kong.Key, error)]
+	delete  method[KeyServiceDeleteCall, keyServiceDeleteResults, func(context.Context, *string) error]
+	list    method[KeyServiceListCall, keyServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Key, *kong.ListOpt, error)]
+	listAll method[KeyServiceListAllCall, keyServiceListAllResults, func(context.Context) ([]*kong.Key, error)]
+}
+
+var _ kong.AbstractKeyService = &FakeKeyService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeKeyService) Create(ctx context.Context, key *kong.Key) (*kong.Key, error) {
+	call := f.create.record(KeyServiceCreateCall{Ctx: ctx, Key: key})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, key)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, key)
+	}
+	return call.results.r0, notProgrammed("FakeKeyService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeKeyService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeKeyService) CreateCalls() []KeyServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeKeyService) CreateArgsForCall(i int) KeyServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) CreateStub(stub func(context.Context, *kong.Key) (*kong.Key, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeKeyService) CreateReturns(r0 *kong.Key, r1 error) {
+	f.create.setReturns(keyServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeKeyService) CreateReturnsOnCall(i int, r0 *kong.Key, r1 error) {
+	f.create.setReturnsOnCall(i, keyServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeKeyService) Get(ctx context.Context, nameOrID *string) (*kong.Key, error) {
+	call := f.get.record(KeyServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeKeyService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeKeyService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeKeyService) GetCalls() []KeyServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeKeyService) GetArgsForCall(i int) KeyServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) GetStub(stub func(context.Context, *string) (*kong.Key, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeKeyService) GetReturns(r0 *kong.Key, r1 error) {
+	f.get.setReturns(keyServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeKeyService) GetReturnsOnCall(i int, r0 *kong.Key, r1 error) {
+	f.get.setReturnsOnCall(i, keyServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeKeyService) Update(ctx context.Context, key *kong.Key) (*kong.Key, error) {
+	call := f.update.record(KeyServiceUpdateCall{Ctx: ctx, Key: key})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, key)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, key)
+	}
+	return call.results.r0, notProgrammed("FakeKeyService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeKeyService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeKeyService) UpdateCalls() []KeyServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeKeyService) UpdateArgsForCall(i int) KeyServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) UpdateStub(stub func(context.Context, *kong.Key) (*kong.Key, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeKeyService) UpdateReturns(r0 *kong.Key, r1 error) {
+	f.update.setReturns(keyServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeKeyService) UpdateReturnsOnCall(i int, r0 *kong.Key, r1 error) {
+	f.update.setReturnsOnCall(i, keyServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeKeyService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(KeyServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeKeyService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeKeyService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeKeyService) DeleteCalls() []KeyServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeKeyService) DeleteArgsForCall(i int) KeyServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeKeyService) DeleteReturns(r0 error) {
+	f.delete.setReturns(keyServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeKeyService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, keyServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeKeyService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Key, *kong.ListOpt, error) {
+	call := f.list.record(KeyServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeKeyService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeKeyService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeKeyService) ListCalls() []KeyServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeKeyService) ListArgsForCall(i int) KeyServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Key, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeKeyService) ListReturns(r0 []*kong.Key, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(keyServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeKeyService) ListReturnsOnCall(i int, r0 []*kong.Key, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, keyServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeKeyService) ListAll(ctx context.Context) ([]*kong.Key, error) {
+	call := f.listAll.record(KeyServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeKeyService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeKeyService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeKeyService) ListAllCalls() []KeyServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeKeyService) ListAllArgsForCall(i int) KeyServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeyService) ListAllStub(stub func(context.Context) ([]*kong.Key, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeKeyService) ListAllReturns(r0 []*kong.Key, r1 error) {
+	f.listAll.setReturns(keyServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeKeyService) ListAllReturnsOnCall(i int, r0 []*kong.Key, r1 error) {
+	f.listAll.setReturnsOnCall(i, keyServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeKeyService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// KeySetServiceCreateCall holds the arguments of a call to FakeKeySetService.Create.
+type KeySetServiceCreateCall struct {
+	Ctx    context.Context
+	KeySet *kong.KeySet
+}
+
+type keySetServiceCreateResults struct {
+	r0 *kong.KeySet
+	r1 error
+}
+
+// KeySetServiceGetCall holds the arguments of a call to FakeKeySetService.Get.
+type KeySetServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type keySetServiceGetResults struct {
+	r0 *kong.KeySet
+	r1 error
+}
+
+// KeySetServiceUpdateCall holds the arguments of a call to FakeKeySetService.Update.
+type KeySetServiceUpdateCall struct {
+	Ctx    context.Context
+	KeySet *kong.KeySet
+}
+
+type keySetServiceUpdateResults struct {
+	r0 *kong.KeySet
+	r1 error
+}
+
+// KeySetServiceDeleteCall holds the arguments of a call to FakeKeySetService.Delete.
+type KeySetServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type keySetServiceDeleteResults struct {
+	r0 error
+}
+
+// KeySetServiceListCall holds the arguments of a call to FakeKeySetService.List.
+type KeySetServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type keySetServiceListResults struct {
+	r0 []*kong.KeySet
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// KeySetServiceListAllCall holds the arguments of a call to FakeKeySetService.ListAll.
+type KeySetServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type keySetServiceListAllResults struct {
+	r0 []*kong.KeySet
+	r1 error
+}
+
+// FakeKeySetService is a fake implementation of kong.AbstractKeySetService.
+type FakeKeySetService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractKeySetService
+
+	create  method[KeySetServiceCreateCall, keySetServiceCreateResults, func(context.Context, *kong.KeySet) (*kong.KeySet, error)]
+	get     method[KeySetServiceGetCall, keySetServiceGetResults, func(context.Context, *string) (*kong.KeySet, error)]
+	update  method[KeySetServiceUpdateCall, keySetServiceUpdateResults, func(context.Context, *kong.KeySet) (*kong.KeySet, error)]
+	delete  method[KeySetServiceDeleteCall, keySetServiceDeleteResults, func(context.Context, *string) error]
+	list    method[KeySetServiceListCall, keySetServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.KeySet, *kong.ListOpt, error)]
+	listAll method[KeySetServiceListAllCall, keySetServiceListAllResults, func(context.Context) ([]*kong.KeySet, error)]
+}
+
+var _ kong.AbstractKeySetService = &FakeKeySetService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeKeySetService) Create(ctx context.Context, keySet *kong.KeySet) (*kong.KeySet, error) {
+	call := f.create.record(KeySetServiceCreateCall{Ctx: ctx, KeySet: keySet})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, keySet)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, keySet)
+	}
+	return call.results.r0, notProgrammed("FakeKeySetService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeKeySetService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeKeySetService) CreateCalls() []KeySetServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeKeySetService) CreateArgsForCall(i int) KeySetServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) CreateStub(stub func(context.Context, *kong.KeySet) (*kong.KeySet, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeKeySetService) CreateReturns(r0 *kong.KeySet, r1 error) {
+	f.create.setReturns(keySetServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeKeySetService) CreateReturnsOnCall(i int, r0 *kong.KeySet, r1 error) {
+	f.create.setReturnsOnCall(i, keySetServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeKeySetService) Get(ctx context.Context, nameOrID *string) (*kong.KeySet, error) {
+	call := f.get.record(KeySetServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeKeySetService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeKeySetService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeKeySetService) GetCalls() []KeySetServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeKeySetService) GetArgsForCall(i int) KeySetServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) GetStub(stub func(context.Context, *string) (*kong.KeySet, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeKeySetService) GetReturns(r0 *kong.KeySet, r1 error) {
+	f.get.setReturns(keySetServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeKeySetService) GetReturnsOnCall(i int, r0 *kong.KeySet, r1 error) {
+	f.get.setReturnsOnCall(i, keySetServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeKeySetService) Update(ctx context.Context, keySet *kong.KeySet) (*kong.KeySet, error) {
+	call := f.update.record(KeySetServiceUpdateCall{Ctx: ctx, KeySet: keySet})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, keySet)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, keySet)
+	}
+	return call.results.r0, notProgrammed("FakeKeySetService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeKeySetService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeKeySetService) UpdateCalls() []KeySetServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeKeySetService) UpdateArgsForCall(i int) KeySetServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) UpdateStub(stub func(context.Context, *kong.KeySet) (*kong.KeySet, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeKeySetService) UpdateReturns(r0 *kong.KeySet, r1 error) {
+	f.update.setReturns(keySetServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeKeySetService) UpdateReturnsOnCall(i int, r0 *kong.KeySet, r1 error) {
+	f.update.setReturnsOnCall(i, keySetServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeKeySetService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(KeySetServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeKeySetService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeKeySetService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeKeySetService) DeleteCalls() []KeySetServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeKeySetService) DeleteArgsForCall(i int) KeySetServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeKeySetService) DeleteReturns(r0 error) {
+	f.delete.setReturns(keySetServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeKeySetService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, keySetServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeKeySetService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.KeySet, *kong.ListOpt, error) {
+	call := f.list.record(KeySetServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeKeySetService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeKeySetService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeKeySetService) ListCalls() []KeySetServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeKeySetService) ListArgsForCall(i int) KeySetServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.KeySet, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeKeySetService) ListReturns(r0 []*kong.KeySet, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(keySetServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeKeySetService) ListReturnsOnCall(i int, r0 []*kong.KeySet, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, keySetServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeKeySetService) ListAll(ctx context.Context) ([]*kong.KeySet, error) {
+	call := f.listAll.record(KeySetServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeKeySetService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeKeySetService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeKeySetService) ListAllCalls() []KeySetServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeKeySetService) ListAllArgsForCall(i int) KeySetServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKeySetService) ListAllStub(stub func(context.Context) ([]*kong.KeySet, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeKeySetService) ListAllReturns(r0 []*kong.KeySet, r1 error) {
+	f.listAll.setReturns(keySetServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeKeySetService) ListAllReturnsOnCall(i int, r0 []*kong.KeySet, r1 error) {
+	f.listAll.setReturnsOnCall(i, keySetServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeKeySetService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// KonnectApplicationServiceCreateCall holds the arguments of a call to FakeKonnectApplicationService.Create.
+type KonnectApplicationServiceCreateCall struct {
+	Ctx context.Context
+	Key *kong.KonnectApplication
+}
+
+type konnectApplicationServiceCreateResults struct {
+	r0 *kong.KonnectApplication
+	r1 error
+}
+
+// KonnectApplicationServiceListCall holds the arguments of a call to FakeKonnectApplicationService.List.
+type KonnectApplicationServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type konnectApplicationServiceListResults struct {
+	r0 []*kong.KonnectApplication
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// KonnectApplicationServiceListAllCall holds the arguments of a call to FakeKonnectApplicationService.ListAll.
+type KonnectApplicationServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type konnectApplicationServiceListAllResults struct {
+	r0 []*kong.KonnectApplication
+	r1 error
+}
+
+// KonnectApplicationServiceDeleteCall holds the arguments of a call to FakeKonnectApplicationService.Delete.
+type KonnectApplicationServiceDeleteCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type konnectApplicationServiceDeleteResults struct {
+	r0 error
+}
+
+// FakeKonnectApplicationService is a fake implementation of kong.AbstractKonnectApplicationService.
+type FakeKonnectApplicationService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractKonnectApplicationService
+
+	create  method[KonnectApplicationServiceCreateCall, konnectApplicationServiceCreateResults, func(context.Context, *kong.KonnectApplication) (*kong.KonnectApplication, error)]
+	list    method[KonnectApplicationServiceListCall, konnectApplicationServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.KonnectApplication, *kong.ListOpt, error)]
+	listAll method[KonnectApplicationServiceListAllCall, konnectApplicationServiceListAllResults, func(context.Context) ([]*kong.KonnectApplication, error)]
+	delete  method[KonnectApplicationServiceDeleteCall, konnectApplicationServiceDeleteResults, func(context.Context, *string) error]
+}
+
+var _ kong.AbstractKonnectApplicationService = &FakeKonnectApplicationService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeKonnectApplicationService) Create(ctx context.Context, key *kong.KonnectApplication) (*kong.KonnectApplication, error) {
+	call := f.create.record(KonnectApplicationServiceCreateCall{Ctx: ctx, Key: key})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, key)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, key)
+	}
+	return call.results.r0, notProgrammed("FakeKonnectApplicationService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeKonnectApplicationService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeKonnectApplicationService) CreateCalls() []KonnectApplicationServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeKonnectApplicationService) CreateArgsForCall(i int) KonnectApplicationServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKonnectApplicationService) CreateStub(stub func(context.Context, *kong.KonnectApplication) (*kong.KonnectApplication, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeKonnectApplicationService) CreateReturns(r0 *kong.KonnectApplication, r1 error) {
+	f.create.setReturns(konnectApplicationServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeKonnectApplicationService) CreateReturnsOnCall(i int, r0 *kong.KonnectApplication, r1 error) {
+	f.create.setReturnsOnCall(i, konnectApplicationServiceCreateResults{r0: r0, r1: r1})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeKonnectApplicationService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.KonnectApplication, *kong.ListOpt, error) {
+	call := f.list.record(KonnectApplicationServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeKonnectApplicationService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeKonnectApplicationService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeKonnectApplicationService) ListCalls() []KonnectApplicationServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeKonnectApplicationService) ListArgsForCall(i int) KonnectApplicationServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKonnectApplicationService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.KonnectApplication, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeKonnectApplicationService) ListReturns(r0 []*kong.KonnectApplication, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(konnectApplicationServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeKonnectApplicationService) ListReturnsOnCall(i int, r0 []*kong.KonnectApplication, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, konnectApplicationServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeKonnectApplicationService) ListAll(ctx context.Context) ([]*kong.KonnectApplication, error) {
+	call := f.listAll.record(KonnectApplicationServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeKonnectApplicationService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeKonnectApplicationService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeKonnectApplicationService) ListAllCalls() []KonnectApplicationServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeKonnectApplicationService) ListAllArgsForCall(i int) KonnectApplicationServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKonnectApplicationService) ListAllStub(stub func(context.Context) ([]*kong.KonnectApplication, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeKonnectApplicationService) ListAllReturns(r0 []*kong.KonnectApplication, r1 error) {
+	f.listAll.setReturns(konnectApplicationServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeKonnectApplicationService) ListAllReturnsOnCall(i int, r0 []*kong.KonnectApplication, r1 error) {
+	f.listAll.setReturnsOnCall(i, konnectApplicationServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeKonnectApplicationService) Delete(ctx context.Context, ID *string) error {
+	call := f.delete.record(KonnectApplicationServiceDeleteCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, ID)
+	}
+	return notProgrammed("FakeKonnectApplicationService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeKonnectApplicationService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeKonnectApplicationService) DeleteCalls() []KonnectApplicationServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeKonnectApplicationService) DeleteArgsForCall(i int) KonnectApplicationServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeKonnectApplicationService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeKonnectApplicationService) DeleteReturns(r0 error) {
+	f.delete.setReturns(konnectApplicationServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeKonnectApplicationService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, konnectApplicationServiceDeleteResults{r0: r0})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeKonnectApplicationService) Reset() {
+	f.create.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.delete.reset()
+}
+
+// LicenseServiceCreateCall holds the arguments of a call to FakeLicenseService.Create.
+type LicenseServiceCreateCall struct {
+	Ctx     context.Context
+	License *kong.License
+}
+
+type licenseServiceCreateResults struct {
+	r0 *kong.License
+	r1 error
+}
+
+// LicenseServiceGetCall holds the arguments of a call to FakeLicenseService.Get.
+type LicenseServiceGetCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type licenseServiceGetResults struct {
+	r0 *kong.License
+	r1 error
+}
+
+// LicenseServiceUpdateCall holds the arguments of a call to FakeLicenseService.Update.
+type LicenseServiceUpdateCall struct {
+	Ctx     context.Context
+	License *kong.License
+}
+
+type licenseServiceUpdateResults struct {
+	r0 *kong.License
+	r1 error
+}
+
+// LicenseServiceDeleteCall holds the arguments of a call to FakeLicenseService.Delete.
+type LicenseServiceDeleteCall struct {
+	Ctx context.Context
+	ID  *string
+}
+
+type licenseServiceDeleteResults struct {
+	r0 error
+}
+
+// LicenseServiceListCall holds the arguments of a call to FakeLicenseService.List.
+type LicenseServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type licenseServiceListResults struct {
+	r0 []*kong.License
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// LicenseServiceListAllCall holds the arguments of a call to FakeLicenseService.ListAll.
+type LicenseServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type licenseServiceListAllResults struct {
+	r0 []*kong.License
+	r1 error
+}
+
+// FakeLicenseService is a fake implementation of kong.AbstractLicenseService.
+type FakeLicenseService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractLicenseService
+
+	create  method[LicenseServiceCreateCall, licenseServiceCreateResults, func(context.Context, *kong.License) (*kong.License, error)]
+	get     method[LicenseServiceGetCall, licenseServiceGetResults, func(context.Context, *string) (*kong.License, error)]
+	update  method[LicenseServiceUpdateCall, licenseServiceUpdateResults, func(context.Context, *kong.License) (*kong.License, error)]
+	delete  method[LicenseServiceDeleteCall, licenseServiceDeleteResults, func(context.Context, *string) error]
+	list    method[LicenseServiceListCall, licenseServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.License, *kong.ListOpt, error)]
+	listAll method[LicenseServiceListAllCall, licenseServiceListAllResults, func(context.Context) ([]*kong.License, error)]
+}
+
+var _ kong.AbstractLicenseService = &FakeLicenseService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeLicenseService) Create(ctx context.Context, license *kong.License) (*kong.License, error) {
+	call := f.create.record(LicenseServiceCreateCall{Ctx: ctx, License: license})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, license)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, license)
+	}
+	return call.results.r0, notProgrammed("FakeLicenseService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeLicenseService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeLicenseService) CreateCalls() []LicenseServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeLicenseService) CreateArgsForCall(i int) LicenseServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) CreateStub(stub func(context.Context, *kong.License) (*kong.License, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeLicenseService) CreateReturns(r0 *kong.License, r1 error) {
+	f.create.setReturns(licenseServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeLicenseService) CreateReturnsOnCall(i int, r0 *kong.License, r1 error) {
+	f.create.setReturnsOnCall(i, licenseServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeLicenseService) Get(ctx context.Context, ID *string) (*kong.License, error) {
+	call := f.get.record(LicenseServiceGetCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, ID)
+	}
+	return call.results.r0, notProgrammed("FakeLicenseService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeLicenseService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeLicenseService) GetCalls() []LicenseServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeLicenseService) GetArgsForCall(i int) LicenseServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) GetStub(stub func(context.Context, *string) (*kong.License, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeLicenseService) GetReturns(r0 *kong.License, r1 error) {
+	f.get.setReturns(licenseServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeLicenseService) GetReturnsOnCall(i int, r0 *kong.License, r1 error) {
+	f.get.setReturnsOnCall(i, licenseServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeLicenseService) Update(ctx context.Context, license *kong.License) (*kong.License, error) {
+	call := f.update.record(LicenseServiceUpdateCall{Ctx: ctx, License: license})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, license)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, license)
+	}
+	return call.results.r0, notProgrammed("FakeLicenseService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeLicenseService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeLicenseService) UpdateCalls() []LicenseServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeLicenseService) UpdateArgsForCall(i int) LicenseServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) UpdateStub(stub func(context.Context, *kong.License) (*kong.License, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeLicenseService) UpdateReturns(r0 *kong.License, r1 error) {
+	f.update.setReturns(licenseServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeLicenseService) UpdateReturnsOnCall(i int, r0 *kong.License, r1 error) {
+	f.update.setReturnsOnCall(i, licenseServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeLicenseService) Delete(ctx context.Context, ID *string) error {
+	call := f.delete.record(LicenseServiceDeleteCall{Ctx: ctx, ID: ID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, ID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, ID)
+	}
+	return notProgrammed("FakeLicenseService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeLicenseService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeLicenseService) DeleteCalls() []LicenseServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeLicenseService) DeleteArgsForCall(i int) LicenseServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeLicenseService) DeleteReturns(r0 error) {
+	f.delete.setReturns(licenseServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeLicenseService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, licenseServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeLicenseService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.License, *kong.ListOpt, error) {
+	call := f.list.record(LicenseServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeLicenseService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeLicenseService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeLicenseService) ListCalls() []LicenseServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeLicenseService) ListArgsForCall(i int) LicenseServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.License, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeLicenseService) ListReturns(r0 []*kong.License, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(licenseServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeLicenseService) ListReturnsOnCall(i int, r0 []*kong.License, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, licenseServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeLicenseService) ListAll(ctx context.Context) ([]*kong.License, error) {
+	call := f.listAll.record(LicenseServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeLicenseService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeLicenseService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeLicenseService) ListAllCalls() []LicenseServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeLicenseService) ListAllArgsForCall(i int) LicenseServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeLicenseService) ListAllStub(stub func(context.Context) ([]*kong.License, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeLicenseService) ListAllReturns(r0 []*kong.License, r1 error) {
+	f.listAll.setReturns(licenseServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeLicenseService) ListAllReturnsOnCall(i int, r0 []*kong.License, r1 error) {
+	f.listAll.setReturnsOnCall(i, licenseServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeLicenseService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// MTLSAuthServiceCreateCall holds the arguments of a call to FakeMTLSAuthService.Create.
+type MTLSAuthServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	MtlsAuth             *kong.MTLSAuth
+}
+
+type mtlsAuthServiceCreateResults struct {
+	r0 *kong.MTLSAuth
+	r1 error
+}
+
+// MTLSAuthServiceGetCall holds the arguments of a call to FakeMTLSAuthService.Get.
+type MTLSAuthServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type mtlsAuthServiceGetResults struct {
+	r0 *kong.MTLSAuth
+	r1 error
+}
+
+// MTLSAuthServiceUpdateCall holds the arguments of a call to FakeMTLSAuthService.Update.
+type MTLSAuthServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	MtlsAuth             *kong.MTLSAuth
+}
+
+type mtlsAuthServiceUpdateResults struct {
+	r0 *kong.MTLSAuth
+	r1 error
+}
+
+// MTLSAuthServiceDeleteCall holds the arguments of a call to FakeMTLSAuthService.Delete.
+type MTLSAuthServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	KeyOrID              *string
+}
+
+type mtlsAuthServiceDeleteResults struct {
+	r0 error
+}
+
+// MTLSAuthServiceListCall holds the arguments of a call to FakeMTLSAuthService.List.
+type MTLSAuthServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type mtlsAuthServiceListResults struct {
+	r0 []*kong.MTLSAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// MTLSAuthServiceListAllCall holds the arguments of a call to FakeMTLSAuthService.ListAll.
+type MTLSAuthServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type mtlsAuthServiceListAllResults struct {
+	r0 []*kong.MTLSAuth
+	r1 error
+}
+
+// MTLSAuthServiceListForConsumerCall holds the arguments of a call to FakeMTLSAuthService.ListForConsumer.
+type MTLSAuthServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type mtlsAuthServiceListForConsumerResults struct {
+	r0 []*kong.MTLSAuth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeMTLSAuthService is a fake implementation of kong.AbstractMTLSAuthService.
+type FakeMTLSAuthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractMTLSAuthService
+
+	create          method[MTLSAuthServiceCreateCall, mtlsAuthServiceCreateResults, func(context.Context, *string, *kong.MTLSAuth) (*kong.MTLSAuth, error)]
+	get             method[MTLSAuthServiceGetCall, mtlsAuthServiceGetResults, func(context.Context, *string, *string) (*kong.MTLSAuth, error)]
+	update          method[MTLSAuthServiceUpdateCall, mtlsAuthServiceUpdateResults, func(context.Context, *string, *kong.MTLSAuth) (*kong.MTLSAuth, error)]
+	delete          method[MTLSAuthServiceDeleteCall, mtlsAuthServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[MTLSAuthServiceListCall, mtlsAuthServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error)]
+	listAll         method[MTLSAuthServiceListAllCall, mtlsAuthServiceListAllResults, func(context.Context) ([]*kong.MTLSAuth, error)]
+	listForConsumer method[MTLSAuthServiceListForConsumerCall, mtlsAuthServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractMTLSAuthService = &FakeMTLSAuthService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) Create(ctx context.Context, consumerUsernameOrID *string, mtlsAuth *kong.MTLSAuth) (*kong.MTLSAuth, error) {
+	call := f.create.record(MTLSAuthServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, MtlsAuth: mtlsAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, mtlsAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, mtlsAuth)
+	}
+	return call.results.r0, notProgrammed("FakeMTLSAuthService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeMTLSAuthService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeMTLSAuthService) CreateCalls() []MTLSAuthServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeMTLSAuthService) CreateArgsForCall(i int) MTLSAuthServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) CreateStub(stub func(context.Context, *string, *kong.MTLSAuth) (*kong.MTLSAuth, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeMTLSAuthService) CreateReturns(r0 *kong.MTLSAuth, r1 error) {
+	f.create.setReturns(mtlsAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeMTLSAuthService) CreateReturnsOnCall(i int, r0 *kong.MTLSAuth, r1 error) {
+	f.create.setReturnsOnCall(i, mtlsAuthServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) Get(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) (*kong.MTLSAuth, error) {
+	call := f.get.record(MTLSAuthServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return call.results.r0, notProgrammed("FakeMTLSAuthService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeMTLSAuthService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeMTLSAuthService) GetCalls() []MTLSAuthServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeMTLSAuthService) GetArgsForCall(i int) MTLSAuthServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) GetStub(stub func(context.Context, *string, *string) (*kong.MTLSAuth, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeMTLSAuthService) GetReturns(r0 *kong.MTLSAuth, r1 error) {
+	f.get.setReturns(mtlsAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeMTLSAuthService) GetReturnsOnCall(i int, r0 *kong.MTLSAuth, r1 error) {
+	f.get.setReturnsOnCall(i, mtlsAuthServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) Update(ctx context.Context, consumerUsernameOrID *string, mtlsAuth *kong.MTLSAuth) (*kong.MTLSAuth, error) {
+	call := f.update.record(MTLSAuthServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, MtlsAuth: mtlsAuth})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, mtlsAuth)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, mtlsAuth)
+	}
+	return call.results.r0, notProgrammed("FakeMTLSAuthService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeMTLSAuthService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeMTLSAuthService) UpdateCalls() []MTLSAuthServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeMTLSAuthService) UpdateArgsForCall(i int) MTLSAuthServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) UpdateStub(stub func(context.Context, *string, *kong.MTLSAuth) (*kong.MTLSAuth, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeMTLSAuthService) UpdateReturns(r0 *kong.MTLSAuth, r1 error) {
+	f.update.setReturns(mtlsAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeMTLSAuthService) UpdateReturnsOnCall(i int, r0 *kong.MTLSAuth, r1 error) {
+	f.update.setReturnsOnCall(i, mtlsAuthServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) Delete(ctx context.Context, consumerUsernameOrID *string, keyOrID *string) error {
+	call := f.delete.record(MTLSAuthServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, KeyOrID: keyOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, keyOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, keyOrID)
+	}
+	return notProgrammed("FakeMTLSAuthService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeMTLSAuthService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeMTLSAuthService) DeleteCalls() []MTLSAuthServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeMTLSAuthService) DeleteArgsForCall(i int) MTLSAuthServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeMTLSAuthService) DeleteReturns(r0 error) {
+	f.delete.setReturns(mtlsAuthServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeMTLSAuthService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, mtlsAuthServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error) {
+	call := f.list.record(MTLSAuthServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeMTLSAuthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeMTLSAuthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeMTLSAuthService) ListCalls() []MTLSAuthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeMTLSAuthService) ListArgsForCall(i int) MTLSAuthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeMTLSAuthService) ListReturns(r0 []*kong.MTLSAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(mtlsAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeMTLSAuthService) ListReturnsOnCall(i int, r0 []*kong.MTLSAuth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, mtlsAuthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) ListAll(ctx context.Context) ([]*kong.MTLSAuth, error) {
+	call := f.listAll.record(MTLSAuthServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeMTLSAuthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeMTLSAuthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeMTLSAuthService) ListAllCalls() []MTLSAuthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeMTLSAuthService) ListAllArgsForCall(i int) MTLSAuthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) ListAllStub(stub func(context.Context) ([]*kong.MTLSAuth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeMTLSAuthService) ListAllReturns(r0 []*kong.MTLSAuth, r1 error) {
+	f.listAll.setReturns(mtlsAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeMTLSAuthService) ListAllReturnsOnCall(i int, r0 []*kong.MTLSAuth, r1 error) {
+	f.listAll.setReturnsOnCall(i, mtlsAuthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeMTLSAuthService) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(MTLSAuthServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeMTLSAuthService.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeMTLSAuthService) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeMTLSAuthService) ListForConsumerCalls() []MTLSAuthServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeMTLSAuthService) ListForConsumerArgsForCall(i int) MTLSAuthServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeMTLSAuthService) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.MTLSAuth, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeMTLSAuthService) ListForConsumerReturns(r0 []*kong.MTLSAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(mtlsAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeMTLSAuthService) ListForConsumerReturnsOnCall(i int, r0 []*kong.MTLSAuth, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, mtlsAuthServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeMTLSAuthService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// Oauth2ServiceCreateCall holds the arguments of a call to FakeOauth2Service.Create.
+type Oauth2ServiceCreateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Oauth2Cred           *kong.Oauth2Credential
+}
+
+type oauth2ServiceCreateResults struct {
+	r0 *kong.Oauth2Credential
+	r1 error
+}
+
+// Oauth2ServiceGetCall holds the arguments of a call to FakeOauth2Service.Get.
+type Oauth2ServiceGetCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	ClientIDorID         *string
+}
+
+type oauth2ServiceGetResults struct {
+	r0 *kong.Oauth2Credential
+	r1 error
+}
+
+// Oauth2ServiceGetByIDCall holds the arguments of a call to FakeOauth2Service.GetByID.
+type Oauth2ServiceGetByIDCall struct {
+	Ctx context.Context
+	Id  *string
+}
+
+type oauth2ServiceGetByIDResults struct {
+	r0 *kong.Oauth2Credential
+	r1 error
+}
+
+// Oauth2ServiceUpdateCall holds the arguments of a call to FakeOauth2Service.Update.
+type Oauth2ServiceUpdateCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Oauth2Cred           *kong.Oauth2Credential
+}
+
+type oauth2ServiceUpdateResults struct {
+	r0 *kong.Oauth2Credential
+	r1 error
+}
+
+// Oauth2ServiceDeleteCall holds the arguments of a call to FakeOauth2Service.Delete.
+type Oauth2ServiceDeleteCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	ClientIDorID         *string
+}
+
+type oauth2ServiceDeleteResults struct {
+	r0 error
+}
+
+// Oauth2ServiceListCall holds the arguments of a call to FakeOauth2Service.List.
+type Oauth2ServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type oauth2ServiceListResults struct {
+	r0 []*kong.Oauth2Credential
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// Oauth2ServiceListAllCall holds the arguments of a call to FakeOauth2Service.ListAll.
+type Oauth2ServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type oauth2ServiceListAllResults struct {
+	r0 []*kong.Oauth2Credential
+	r1 error
+}
+
+// Oauth2ServiceListForConsumerCall holds the arguments of a call to FakeOauth2Service.ListForConsumer.
+type Oauth2ServiceListForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Opt                  *kong.ListOpt
+}
+
+type oauth2ServiceListForConsumerResults struct {
+	r0 []*kong.Oauth2Credential
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// FakeOauth2Service is a fake implementation of kong.AbstractOauth2Service.
+type FakeOauth2Service struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractOauth2Service
+
+	create          method[Oauth2ServiceCreateCall, oauth2ServiceCreateResults, func(context.Context, *string, *kong.Oauth2Credential) (*kong.Oauth2Credential, error)]
+	get             method[Oauth2ServiceGetCall, oauth2ServiceGetResults, func(context.Context, *string, *string) (*kong.Oauth2Credential, error)]
+	getByID         method[Oauth2ServiceGetByIDCall, oauth2ServiceGetByIDResults, func(context.Context, *string) (*kong.Oauth2Credential, error)]
+	update          method[Oauth2ServiceUpdateCall, oauth2ServiceUpdateResults, func(context.Context, *string, *kong.Oauth2Credential) (*kong.Oauth2Credential, error)]
+	delete          method[Oauth2ServiceDeleteCall, oauth2ServiceDeleteResults, func(context.Context, *string, *string) error]
+	list            method[Oauth2ServiceListCall, oauth2ServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error)]
+	listAll         method[Oauth2ServiceListAllCall, oauth2ServiceListAllResults, func(context.Context) ([]*kong.Oauth2Credential, error)]
+	listForConsumer method[Oauth2ServiceListForConsumerCall, oauth2ServiceListForConsumerResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error)]
+}
+
+var _ kong.AbstractOauth2Service = &FakeOauth2Service{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeOauth2Service) Create(ctx context.Context, consumerUsernameOrID *string, oauth2Cred *kong.Oauth2Credential) (*kong.Oauth2Credential, error) {
+	call := f.create.record(Oauth2ServiceCreateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Oauth2Cred: oauth2Cred})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, oauth2Cred)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, consumerUsernameOrID, oauth2Cred)
+	}
+	return call.results.r0, notProgrammed("FakeOauth2Service.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeOauth2Service) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeOauth2Service) CreateCalls() []Oauth2ServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeOauth2Service) CreateArgsForCall(i int) Oauth2ServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) CreateStub(stub func(context.Context, *string, *kong.Oauth2Credential) (*kong.Oauth2Credential, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeOauth2Service) CreateReturns(r0 *kong.Oauth2Credential, r1 error) {
+	f.create.setReturns(oauth2ServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeOauth2Service) CreateReturnsOnCall(i int, r0 *kong.Oauth2Credential, r1 error) {
+	f.create.setReturnsOnCall(i, oauth2ServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeOauth2Service) Get(ctx context.Context, consumerUsernameOrID *string, clientIDorID *string) (*kong.Oauth2Credential, error) {
+	call := f.get.record(Oauth2ServiceGetCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, ClientIDorID: clientIDorID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, clientIDorID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, consumerUsernameOrID, clientIDorID)
+	}
+	return call.results.r0, notProgrammed("FakeOauth2Service.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeOauth2Service) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeOauth2Service) GetCalls() []Oauth2ServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeOauth2Service) GetArgsForCall(i int) Oauth2ServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) GetStub(stub func(context.Context, *string, *string) (*kong.Oauth2Credential, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeOauth2Service) GetReturns(r0 *kong.Oauth2Credential, r1 error) {
+	f.get.setReturns(oauth2ServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeOauth2Service) GetReturnsOnCall(i int, r0 *kong.Oauth2Credential, r1 error) {
+	f.get.setReturnsOnCall(i, oauth2ServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetByID records the call and returns the programmed results.
+func (f *FakeOauth2Service) GetByID(ctx context.Context, id *string) (*kong.Oauth2Credential, error) {
+	call := f.getByID.record(Oauth2ServiceGetByIDCall{Ctx: ctx, Id: id})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, id)
+	case f.Fallback != nil:
+		return f.Fallback.GetByID(ctx, id)
+	}
+	return call.results.r0, notProgrammed("FakeOauth2Service.GetByID")
+}
+
+// GetByIDCallCount returns the number of calls to GetByID.
+func (f *FakeOauth2Service) GetByIDCallCount() int {
+	return f.getByID.callCount()
+}
+
+// GetByIDCalls returns the arguments of the calls to GetByID.
+func (f *FakeOauth2Service) GetByIDCalls() []Oauth2ServiceGetByIDCall {
+	return f.getByID.allCalls()
+}
+
+// GetByIDArgsForCall returns the arguments of the i-th call to GetByID.
+func (f *FakeOauth2Service) GetByIDArgsForCall(i int) Oauth2ServiceGetByIDCall {
+	return f.getByID.argsForCall(i)
+}
+
+// GetByIDStub makes GetByID delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) GetByIDStub(stub func(context.Context, *string) (*kong.Oauth2Credential, error)) {
+	f.getByID.setStub(stub, stub != nil)
+}
+
+// GetByIDReturns makes GetByID return the given values.
+func (f *FakeOauth2Service) GetByIDReturns(r0 *kong.Oauth2Credential, r1 error) {
+	f.getByID.setReturns(oauth2ServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// GetByIDReturnsOnCall makes the i-th call to GetByID return the given values.
+func (f *FakeOauth2Service) GetByIDReturnsOnCall(i int, r0 *kong.Oauth2Credential, r1 error) {
+	f.getByID.setReturnsOnCall(i, oauth2ServiceGetByIDResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeOauth2Service) Update(ctx context.Context, consumerUsernameOrID *string, oauth2Cred *kong.Oauth2Credential) (*kong.Oauth2Credential, error) {
+	call := f.update.record(Oauth2ServiceUpdateCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Oauth2Cred: oauth2Cred})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, oauth2Cred)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, consumerUsernameOrID, oauth2Cred)
+	}
+	return call.results.r0, notProgrammed("FakeOauth2Service.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeOauth2Service) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeOauth2Service) UpdateCalls() []Oauth2ServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeOauth2Service) UpdateArgsForCall(i int) Oauth2ServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) UpdateStub(stub func(context.Context, *string, *kong.Oauth2Credential) (*kong.Oauth2Credential, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeOauth2Service) UpdateReturns(r0 *kong.Oauth2Credential, r1 error) {
+	f.update.setReturns(oauth2ServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeOauth2Service) UpdateReturnsOnCall(i int, r0 *kong.Oauth2Credential, r1 error) {
+	f.update.setReturnsOnCall(i, oauth2ServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeOauth2Service) Delete(ctx context.Context, consumerUsernameOrID *string, clientIDorID *string) error {
+	call := f.delete.record(Oauth2ServiceDeleteCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, ClientIDorID: clientIDorID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, clientIDorID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, consumerUsernameOrID, clientIDorID)
+	}
+	return notProgrammed("FakeOauth2Service.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeOauth2Service) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeOauth2Service) DeleteCalls() []Oauth2ServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeOauth2Service) DeleteArgsForCall(i int) Oauth2ServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeOauth2Service) DeleteReturns(r0 error) {
+	f.delete.setReturns(oauth2ServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeOauth2Service) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, oauth2ServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeOauth2Service) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error) {
+	call := f.list.record(Oauth2ServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeOauth2Service.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeOauth2Service) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeOauth2Service) ListCalls() []Oauth2ServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeOauth2Service) ListArgsForCall(i int) Oauth2ServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeOauth2Service) ListReturns(r0 []*kong.Oauth2Credential, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(oauth2ServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeOauth2Service) ListReturnsOnCall(i int, r0 []*kong.Oauth2Credential, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, oauth2ServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeOauth2Service) ListAll(ctx context.Context) ([]*kong.Oauth2Credential, error) {
+	call := f.listAll.record(Oauth2ServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeOauth2Service.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeOauth2Service) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeOauth2Service) ListAllCalls() []Oauth2ServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeOauth2Service) ListAllArgsForCall(i int) Oauth2ServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) ListAllStub(stub func(context.Context) ([]*kong.Oauth2Credential, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeOauth2Service) ListAllReturns(r0 []*kong.Oauth2Credential, r1 error) {
+	f.listAll.setReturns(oauth2ServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeOauth2Service) ListAllReturnsOnCall(i int, r0 []*kong.Oauth2Credential, r1 error) {
+	f.listAll.setReturnsOnCall(i, oauth2ServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForConsumer records the call and returns the programmed results.
+func (f *FakeOauth2Service) ListForConsumer(ctx context.Context, consumerUsernameOrID *string, opt *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error) {
+	call := f.listForConsumer.record(Oauth2ServiceListForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForConsumer(ctx, consumerUsernameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeOauth2Service.ListForConsumer")
+}
+
+// ListForConsumerCallCount returns the number of calls to ListForConsumer.
+func (f *FakeOauth2Service) ListForConsumerCallCount() int {
+	return f.listForConsumer.callCount()
+}
+
+// ListForConsumerCalls returns the arguments of the calls to ListForConsumer.
+func (f *FakeOauth2Service) ListForConsumerCalls() []Oauth2ServiceListForConsumerCall {
+	return f.listForConsumer.allCalls()
+}
+
+// ListForConsumerArgsForCall returns the arguments of the i-th call to ListForConsumer.
+func (f *FakeOauth2Service) ListForConsumerArgsForCall(i int) Oauth2ServiceListForConsumerCall {
+	return f.listForConsumer.argsForCall(i)
+}
+
+// ListForConsumerStub makes ListForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakeOauth2Service) ListForConsumerStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.Oauth2Credential, *kong.ListOpt, error)) {
+	f.listForConsumer.setStub(stub, stub != nil)
+}
+
+// ListForConsumerReturns makes ListForConsumer return the given values.
+func (f *FakeOauth2Service) ListForConsumerReturns(r0 []*kong.Oauth2Credential, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturns(oauth2ServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForConsumerReturnsOnCall makes the i-th call to ListForConsumer return the given values.
+func (f *FakeOauth2Service) ListForConsumerReturnsOnCall(i int, r0 []*kong.Oauth2Credential, r1 *kong.ListOpt, r2 error) {
+	f.listForConsumer.setReturnsOnCall(i, oauth2ServiceListForConsumerResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeOauth2Service) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getByID.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForConsumer.reset()
+}
+
+// PartialServiceCreateCall holds the arguments of a call to FakePartialService.Create.
+type PartialServiceCreateCall struct {
+	Ctx     context.Context
+	Partial *kong.Partial
+}
+
+type partialServiceCreateResults struct {
+	r0 *kong.Partial
+	r1 error
+}
+
+// PartialServiceGetCall holds the arguments of a call to FakePartialService.Get.
+type PartialServiceGetCall struct {
+	Ctx       context.Context
+	PartialID *string
+}
+
+type partialServiceGetResults struct {
+	r0 *kong.Partial
+	r1 error
+}
+
+// PartialServiceUpdateCall holds the arguments of a call to FakePartialService.Update.
+type PartialServiceUpdateCall struct {
+	Ctx     context.Context
+	Partial *kong.Partial
+}
+
+type partialServiceUpdateResults struct {
+	r0 *kong.Partial
+	r1 error
+}
+
+// PartialServiceDeleteCall holds the arguments of a call to FakePartialService.Delete.
+type PartialServiceDeleteCall struct {
+	Ctx       context.Context
+	PartialID *string
+}
+
+type partialServiceDeleteResults struct {
+	r0 error
+}
+
+// PartialServiceListCall holds the arguments of a call to FakePartialService.List.
+type PartialServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type partialServiceListResults struct {
+	r0 []*kong.Partial
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// PartialServiceListAllCall holds the arguments of a call to FakePartialService.ListAll.
+type PartialServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type partialServiceListAllResults struct {
+	r0 []*kong.Partial
+	r1 error
+}
+
+// PartialServiceGetFullSchemaCall holds the arguments of a call to FakePartialService.GetFullSchema.
+type PartialServiceGetFullSchemaCall struct {
+	Ctx         context.Context
+	PartialName *string
+}
+
+type partialServiceGetFullSchemaResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// PartialServiceGetLinkedPluginsCall holds the arguments of a call to FakePartialService.GetLinkedPlugins.
+type PartialServiceGetLinkedPluginsCall struct {
+	Ctx       context.Context
+	PartialID *string
+	Opt       *kong.ListOpt
+}
+
+type partialServiceGetLinkedPluginsResults struct {
+	r0 []*kong.Plugin
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// PartialServiceLinkPartialCall holds the arguments of a call to FakePartialService.LinkPartial.
+type PartialServiceLinkPartialCall struct {
+	Ctx       context.Context
+	Plugin    *kong.Plugin
+	PartialID *string
+	Path      string
+}
+
+type partialServiceLinkPartialResults struct {
+	r0 error
+}
+
+// PartialServiceEffectiveConfigCall holds the arguments of a call to FakePartialService.EffectiveConfig.
+type PartialServiceEffectiveConfigCall struct {
+	Ctx    context.Context
+	Plugin *kong.Plugin
+}
+
+type partialServiceEffectiveConfigResults struct {
+	r0 *kong.EffectiveConfiguration
+	r1 error
+}
+
+// FakePartialService is a fake implementation of kong.AbstractPartialService.
+type FakePartialService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractPartialService
+
+	create           method[PartialServiceCreateCall, partialServiceCreateResults, func(context.Context, *kong.Partial) (*kong.Partial, error)]
+	get              method[PartialServiceGetCall, partialServiceGetResults, func(context.Context, *string) (*kong.Partial, error)]
+	update           method[PartialServiceUpdateCall, partialServiceUpdateResults, func(context.Context, *kong.Partial) (*kong.Partial, error)]
+	delete           method[PartialServiceDeleteCall, partialServiceDeleteResults, func(context.Context, *string) error]
+	list             method[PartialServiceListCall, partialServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Partial, *kong.ListOpt, error)]
+	listAll          method[PartialServiceListAllCall, partialServiceListAllResults, func(context.Context) ([]*kong.Partial, error)]
+	getFullSchema    method[PartialServiceGetFullSchemaCall, partialServiceGetFullSchemaResults, func(context.Context, *string) (kong.Schema, error)]
+	getLinkedPlugins method[PartialServiceGetLinkedPluginsCall, partialServiceGetLinkedPluginsResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error)]
+	linkPartial      method[PartialServiceLinkPartialCall, partialServiceLinkPartialResults, func(context.Context, *kong.Plugin, *string, string) error]
+	effectiveConfig  method[PartialServiceEffectiveConfigCall, partialServiceEffectiveConfigResults, func(context.Context, *kong.Plugin) (*kong.EffectiveConfiguration, error)]
+}
+
+var _ kong.AbstractPartialService = &FakePartialService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakePartialService) Create(ctx context.Context, partial *kong.Partial) (*kong.Partial, error) {
+	call := f.create.record(PartialServiceCreateCall{Ctx: ctx, Partial: partial})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, partial)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, partial)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakePartialService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakePartialService) CreateCalls() []PartialServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakePartialService) CreateArgsForCall(i int) PartialServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) CreateStub(stub func(context.Context, *kong.Partial) (*kong.Partial, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakePartialService) CreateReturns(r0 *kong.Partial, r1 error) {
+	f.create.setReturns(partialServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakePartialService) CreateReturnsOnCall(i int, r0 *kong.Partial, r1 error) {
+	f.create.setReturnsOnCall(i, partialServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakePartialService) Get(ctx context.Context, partialID *string) (*kong.Partial, error) {
+	call := f.get.record(PartialServiceGetCall{Ctx: ctx, PartialID: partialID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, partialID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, partialID)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakePartialService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakePartialService) GetCalls() []PartialServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakePartialService) GetArgsForCall(i int) PartialServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) GetStub(stub func(context.Context, *string) (*kong.Partial, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakePartialService) GetReturns(r0 *kong.Partial, r1 error) {
+	f.get.setReturns(partialServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakePartialService) GetReturnsOnCall(i int, r0 *kong.Partial, r1 error) {
+	f.get.setReturnsOnCall(i, partialServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakePartialService) Update(ctx context.Context, partial *kong.Partial) (*kong.Partial, error) {
+	call := f.update.record(PartialServiceUpdateCall{Ctx: ctx, Partial: partial})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, partial)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, partial)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakePartialService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakePartialService) UpdateCalls() []PartialServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakePartialService) UpdateArgsForCall(i int) PartialServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) UpdateStub(stub func(context.Context, *kong.Partial) (*kong.Partial, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakePartialService) UpdateReturns(r0 *kong.Partial, r1 error) {
+	f.update.setReturns(partialServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakePartialService) UpdateReturnsOnCall(i int, r0 *kong.Partial, r1 error) {
+	f.update.setReturnsOnCall(i, partialServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakePartialService) Delete(ctx context.Context, partialID *string) error {
+	call := f.delete.record(PartialServiceDeleteCall{Ctx: ctx, PartialID: partialID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, partialID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, partialID)
+	}
+	return notProgrammed("FakePartialService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakePartialService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakePartialService) DeleteCalls() []PartialServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakePartialService) DeleteArgsForCall(i int) PartialServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakePartialService) DeleteReturns(r0 error) {
+	f.delete.setReturns(partialServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakePartialService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, partialServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakePartialService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Partial, *kong.ListOpt, error) {
+	call := f.list.record(PartialServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakePartialService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakePartialService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakePartialService) ListCalls() []PartialServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakePartialService) ListArgsForCall(i int) PartialServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Partial, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakePartialService) ListReturns(r0 []*kong.Partial, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(partialServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakePartialService) ListReturnsOnCall(i int, r0 []*kong.Partial, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, partialServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakePartialService) ListAll(ctx context.Context) ([]*kong.Partial, error) {
+	call := f.listAll.record(PartialServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakePartialService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakePartialService) ListAllCalls() []PartialServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakePartialService) ListAllArgsForCall(i int) PartialServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) ListAllStub(stub func(context.Context) ([]*kong.Partial, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakePartialService) ListAllReturns(r0 []*kong.Partial, r1 error) {
+	f.listAll.setReturns(partialServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakePartialService) ListAllReturnsOnCall(i int, r0 []*kong.Partial, r1 error) {
+	f.listAll.setReturnsOnCall(i, partialServiceListAllResults{r0: r0, r1: r1})
+}
+
+// GetFullSchema records the call and returns the programmed results.
+func (f *FakePartialService) GetFullSchema(ctx context.Context, partialName *string) (kong.Schema, error) {
+	call := f.getFullSchema.record(PartialServiceGetFullSchemaCall{Ctx: ctx, PartialName: partialName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, partialName)
+	case f.Fallback != nil:
+		return f.Fallback.GetFullSchema(ctx, partialName)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.GetFullSchema")
+}
+
+// GetFullSchemaCallCount returns the number of calls to GetFullSchema.
+func (f *FakePartialService) GetFullSchemaCallCount() int {
+	return f.getFullSchema.callCount()
+}
+
+// GetFullSchemaCalls returns the arguments of the calls to GetFullSchema.
+func (f *FakePartialService) GetFullSchemaCalls() []PartialServiceGetFullSchemaCall {
+	return f.getFullSchema.allCalls()
+}
+
+// GetFullSchemaArgsForCall returns the arguments of the i-th call to GetFullSchema.
+func (f *FakePartialService) GetFullSchemaArgsForCall(i int) PartialServiceGetFullSchemaCall {
+	return f.getFullSchema.argsForCall(i)
+}
+
+// GetFullSchemaStub makes GetFullSchema delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) GetFullSchemaStub(stub func(context.Context, *string) (kong.Schema, error)) {
+	f.getFullSchema.setStub(stub, stub != nil)
+}
+
+// GetFullSchemaReturns makes GetFullSchema return the given values.
+func (f *FakePartialService) GetFullSchemaReturns(r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturns(partialServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// GetFullSchemaReturnsOnCall makes the i-th call to GetFullSchema return the given values.
+func (f *FakePartialService) GetFullSchemaReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturnsOnCall(i, partialServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// GetLinkedPlugins records the call and returns the programmed results.
+func (f *FakePartialService) GetLinkedPlugins(ctx context.Context, partialID *string, opt *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error) {
+	call := f.getLinkedPlugins.record(PartialServiceGetLinkedPluginsCall{Ctx: ctx, PartialID: partialID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, partialID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.GetLinkedPlugins(ctx, partialID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakePartialService.GetLinkedPlugins")
+}
+
+// GetLinkedPluginsCallCount returns the number of calls to GetLinkedPlugins.
+func (f *FakePartialService) GetLinkedPluginsCallCount() int {
+	return f.getLinkedPlugins.callCount()
+}
+
+// GetLinkedPluginsCalls returns the arguments of the calls to GetLinkedPlugins.
+func (f *FakePartialService) GetLinkedPluginsCalls() []PartialServiceGetLinkedPluginsCall {
+	return f.getLinkedPlugins.allCalls()
+}
+
+// GetLinkedPluginsArgsForCall returns the arguments of the i-th call to GetLinkedPlugins.
+func (f *FakePartialService) GetLinkedPluginsArgsForCall(i int) PartialServiceGetLinkedPluginsCall {
+	return f.getLinkedPlugins.argsForCall(i)
+}
+
+// GetLinkedPluginsStub makes GetLinkedPlugins delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) GetLinkedPluginsStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error)) {
+	f.getLinkedPlugins.setStub(stub, stub != nil)
+}
+
+// GetLinkedPluginsReturns makes GetLinkedPlugins return the given values.
+func (f *FakePartialService) GetLinkedPluginsReturns(r0 []*kong.Plugin, r1 *kong.ListOpt, r2 error) {
+	f.getLinkedPlugins.setReturns(partialServiceGetLinkedPluginsResults{r0: r0, r1: r1, r2: r2})
+}
+
+// GetLinkedPluginsReturnsOnCall makes the i-th call to GetLinkedPlugins return the given values.
+func (f *FakePartialService) GetLinkedPluginsReturnsOnCall(i int, r0 []*kong.Plugin, r1 *kong.ListOpt, r2 error) {
+	f.getLinkedPlugins.setReturnsOnCall(i, partialServiceGetLinkedPluginsResults{r0: r0, r1: r1, r2: r2})
+}
+
+// LinkPartial records the call and returns the programmed results.
+func (f *FakePartialService) LinkPartial(ctx context.Context, plugin *kong.Plugin, partialID *string, path string) error {
+	call := f.linkPartial.record(PartialServiceLinkPartialCall{Ctx: ctx, Plugin: plugin, PartialID: partialID, Path: path})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, plugin, partialID, path)
+	case f.Fallback != nil:
+		return f.Fallback.LinkPartial(ctx, plugin, partialID, path)
+	}
+	return notProgrammed("FakePartialService.LinkPartial")
+}
+
+// LinkPartialCallCount returns the number of calls to LinkPartial.
+func (f *FakePartialService) LinkPartialCallCount() int {
+	return f.linkPartial.callCount()
+}
+
+// LinkPartialCalls returns the arguments of the calls to LinkPartial.
+func (f *FakePartialService) LinkPartialCalls() []PartialServiceLinkPartialCall {
+	return f.linkPartial.allCalls()
+}
+
+// LinkPartialArgsForCall returns the arguments of the i-th call to LinkPartial.
+func (f *FakePartialService) LinkPartialArgsForCall(i int) PartialServiceLinkPartialCall {
+	return f.linkPartial.argsForCall(i)
+}
+
+// LinkPartialStub makes LinkPartial delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) LinkPartialStub(stub func(context.Context, *kong.Plugin, *string, string) error) {
+	f.linkPartial.setStub(stub, stub != nil)
+}
+
+// LinkPartialReturns makes LinkPartial return the given values.
+func (f *FakePartialService) LinkPartialReturns(r0 error) {
+	f.linkPartial.setReturns(partialServiceLinkPartialResults{r0: r0})
+}
+
+// LinkPartialReturnsOnCall makes the i-th call to LinkPartial return the given values.
+func (f *FakePartialService) LinkPartialReturnsOnCall(i int, r0 error) {
+	f.linkPartial.setReturnsOnCall(i, partialServiceLinkPartialResults{r0: r0})
+}
+
+// EffectiveConfig records the call and returns the programmed results.
+func (f *FakePartialService) EffectiveConfig(ctx context.Context, plugin *kong.Plugin) (*kong.EffectiveConfiguration, error) {
+	call := f.effectiveConfig.record(PartialServiceEffectiveConfigCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.EffectiveConfig(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePartialService.EffectiveConfig")
+}
+
+// EffectiveConfigCallCount returns the number of calls to EffectiveConfig.
+func (f *FakePartialService) EffectiveConfigCallCount() int {
+	return f.effectiveConfig.callCount()
+}
+
+// EffectiveConfigCalls returns the arguments of the calls to EffectiveConfig.
+func (f *FakePartialService) EffectiveConfigCalls() []PartialServiceEffectiveConfigCall {
+	return f.effectiveConfig.allCalls()
+}
+
+// EffectiveConfigArgsForCall returns the arguments of the i-th call to EffectiveConfig.
+func (f *FakePartialService) EffectiveConfigArgsForCall(i int) PartialServiceEffectiveConfigCall {
+	return f.effectiveConfig.argsForCall(i)
+}
+
+// EffectiveConfigStub makes EffectiveConfig delegate to stub, or stop doing so if stub is nil.
+func (f *FakePartialService) EffectiveConfigStub(stub func(context.Context, *kong.Plugin) (*kong.EffectiveConfiguration, error)) {
+	f.effectiveConfig.setStub(stub, stub != nil)
+}
+
+// EffectiveConfigReturns makes EffectiveConfig return the given values.
+func (f *FakePartialService) EffectiveConfigReturns(r0 *kong.EffectiveConfiguration, r1 error) {
+	f.effectiveConfig.setReturns(partialServiceEffectiveConfigResults{r0: r0, r1: r1})
+}
+
+// EffectiveConfigReturnsOnCall makes the i-th call to EffectiveConfig return the given values.
+func (f *FakePartialService) EffectiveConfigReturnsOnCall(i int, r0 *kong.EffectiveConfiguration, r1 error) {
+	f.effectiveConfig.setReturnsOnCall(i, partialServiceEffectiveConfigResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakePartialService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.getFullSchema.reset()
+	f.getLinkedPlugins.reset()
+	f.linkPartial.reset()
+	f.effectiveConfig.reset()
+}
+
+// PluginServiceCreateCall holds the arguments of a call to FakePluginService.Create.
+type PluginServiceCreateCall struct {
+	Ctx    context.Context
+	Plugin *kong.Plugin
+}
+
+type pluginServiceCreateResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceCreateForServiceCall holds the arguments of a call to FakePluginService.CreateForService.
+type PluginServiceCreateForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	Plugin          *kong.Plugin
+}
+
+type pluginServiceCreateForServiceResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceCreateForRouteCall holds the arguments of a call to FakePluginService.CreateForRoute.
+type PluginServiceCreateForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	Plugin        *kong.Plugin
+}
+
+type pluginServiceCreateForRouteResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceCreateForConsumerCall holds the arguments of a call to FakePluginService.CreateForConsumer.
+type PluginServiceCreateForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Plugin               *kong.Plugin
+}
+
+type pluginServiceCreateForConsumerResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceCreateForConsumerGroupCall holds the arguments of a call to FakePluginService.CreateForConsumerGroup.
+type PluginServiceCreateForConsumerGroupCall struct {
+	Ctx        context.Context
+	CgIDorName *string
+	Plugin     *kong.Plugin
+}
+
+type pluginServiceCreateForConsumerGroupResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceGetCall holds the arguments of a call to FakePluginService.Get.
+type PluginServiceGetCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type pluginServiceGetResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceUpdateCall holds the arguments of a call to FakePluginService.Update.
+type PluginServiceUpdateCall struct {
+	Ctx    context.Context
+	Plugin *kong.Plugin
+}
+
+type pluginServiceUpdateResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceUpdateForServiceCall holds the arguments of a call to FakePluginService.UpdateForService.
+type PluginServiceUpdateForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	Plugin          *kong.Plugin
+}
+
+type pluginServiceUpdateForServiceResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceUpdateForRouteCall holds the arguments of a call to FakePluginService.UpdateForRoute.
+type PluginServiceUpdateForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	Plugin        *kong.Plugin
+}
+
+type pluginServiceUpdateForRouteResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceUpdateForConsumerCall holds the arguments of a call to FakePluginService.UpdateForConsumer.
+type PluginServiceUpdateForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	Plugin               *kong.Plugin
+}
+
+type pluginServiceUpdateForConsumerResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceUpdateForConsumerGroupCall holds the arguments of a call to FakePluginService.UpdateForConsumerGroup.
+type PluginServiceUpdateForConsumerGroupCall struct {
+	Ctx        context.Context
+	CgIDorName *string
+	Plugin     *kong.Plugin
+}
+
+type pluginServiceUpdateForConsumerGroupResults struct {
+	r0 *kong.Plugin
+	r1 error
+}
+
+// PluginServiceDeleteCall holds the arguments of a call to FakePluginService.Delete.
+type PluginServiceDeleteCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type pluginServiceDeleteResults struct {
+	r0 error
+}
+
+// PluginServiceDeleteForServiceCall holds the arguments of a call to FakePluginService.DeleteForService.
+type PluginServiceDeleteForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+	PluginID        *string
+}
+
+type pluginServiceDeleteForServiceResults struct {
+	r0 error
+}
+
+// PluginServiceDeleteForRouteCall holds the arguments of a call to FakePluginService.DeleteForRoute.
+type PluginServiceDeleteForRouteCall struct {
+	Ctx           context.Context
+	RouteIDorName *string
+	PluginID      *string
+}
+
+type pluginServiceDeleteForRouteResults struct {
+	r0 error
+}
+
+// PluginServiceDeleteForConsumerCall holds the arguments of a call to FakePluginService.DeleteForConsumer.
+type PluginServiceDeleteForConsumerCall struct {
+	Ctx                  context.Context
+	ConsumerUsernameOrID *string
+	PluginID             *string
+}
+
+type pluginServiceDeleteForConsumerResults struct {
+	r0 error
+}
+
+// PluginServiceDeleteForConsumerGroupCall holds the arguments of a call to FakePluginService.DeleteForConsumerGroup.
+type PluginServiceDeleteForConsumerGroupCall struct {
+	Ctx        context.Context
+	CgIDorName *string
+	PluginID   *string
+}
+
+type pluginServiceDeleteForConsumerGroupResults struct {
+	r0 error
+}
+
+// PluginServiceListCall holds the arguments of a call to FakePluginService.List.
+type PluginServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type pluginServiceListResults struct {
+	r0 []*kong.Plugin
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// PluginServiceListAllCall holds the arguments of a call to FakePluginService.ListAll.
+type PluginServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type pluginServiceListAllResults struct {
+	r0 []*kong.Plugin
+	r1 error
+}
+
+// PluginServiceListAllForConsumerCall holds the arguments of a call to FakePluginService.ListAllForConsumer.
+type PluginServiceListAllForConsumerCall struct {
+	Ctx              context.Context
+	ConsumerIDorName *string
+}
+
+type pluginServiceListAllForConsumerResults struct {
+	r0 []*kong.Plugin
+	r1 error
+}
+
+// PluginServiceListAllForServiceCall holds the arguments of a call to FakePluginService.ListAllForService.
+type PluginServiceListAllForServiceCall struct {
+	Ctx             context.Context
+	ServiceIDorName *string
+}
+
+type pluginServiceListAllForServiceResults struct {
+	r0 []*kong.Plugin
+	r1 error
+}
+
+// PluginServiceListAllForRouteCall holds the arguments of a call to FakePluginService.ListAllForRoute.
+type PluginServiceListAllForRouteCall struct {
+	Ctx     context.Context
+	RouteID *string
+}
+
+type pluginServiceListAllForRouteResults struct {
+	r0 []*kong.Plugin
+	r1 error
+}
+
+// PluginServiceListAllForConsumerGroupsCall holds the arguments of a call to FakePluginService.ListAllForConsumerGroups.
+type PluginServiceListAllForConsumerGroupsCall struct {
+	Ctx  context.Context
+	CgID *string
+}
+
+type pluginServiceListAllForConsumerGroupsResults struct {
+	r0 []*kong.Plugin
+	r1 error
+}
+
+// PluginServiceValidateCall holds the arguments of a call to FakePluginService.Validate.
+type PluginServiceValidateCall struct {
+	Ctx    context.Context
+	Plugin *kong.Plugin
+}
+
+type pluginServiceValidateResults struct {
+	r0 bool
+	r1 string
+	r2 error
+}
+
+// PluginServiceGetSchemaCall holds the arguments of a call to FakePluginService.GetSchema.
+type PluginServiceGetSchemaCall struct {
+	Ctx        context.Context
+	PluginName *string
+}
+
+type pluginServiceGetSchemaResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// PluginServiceGetFullSchemaCall holds the arguments of a call to FakePluginService.GetFullSchema.
+type PluginServiceGetFullSchemaCall struct {
+	Ctx        context.Context
+	PluginName *string
+}
+
+type pluginServiceGetFullSchemaResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// FakePluginService is a fake implementation of kong.AbstractPluginService.
+type FakePluginService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractPluginService
+
+	create                   method[PluginServiceCreateCall, pluginServiceCreateResults, func(context.Context, *kong.Plugin) (*kong.Plugin, error)]
+	createForService         method[PluginServiceCreateForServiceCall, pluginServiceCreateForServiceResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	createForRoute           method[PluginServiceCreateForRouteCall, pluginServiceCreateForRouteResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	createForConsumer        method[PluginServiceCreateForConsumerCall, pluginServiceCreateForConsumerResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	createForConsumerGroup   method[PluginServiceCreateForConsumerGroupCall, pluginServiceCreateForConsumerGroupResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	get                      method[PluginServiceGetCall, pluginServiceGetResults, func(context.Context, *string) (*kong.Plugin, error)]
+	update                   method[PluginServiceUpdateCall, pluginServiceUpdateResults, func(context.Context, *kong.Plugin) (*kong.Plugin, error)]
+	updateForService         method[PluginServiceUpdateForServiceCall, pluginServiceUpdateForServiceResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	updateForRoute           method[PluginServiceUpdateForRouteCall, pluginServiceUpdateForRouteResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	updateForConsumer        method[PluginServiceUpdateForConsumerCall, pluginServiceUpdateForConsumerResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	updateForConsumerGroup   method[PluginServiceUpdateForConsumerGroupCall, pluginServiceUpdateForConsumerGroupResults, func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)]
+	delete                   method[PluginServiceDeleteCall, pluginServiceDeleteResults, func(context.Context, *string) error]
+	deleteForService         method[PluginServiceDeleteForServiceCall, pluginServiceDeleteForServiceResults, func(context.Context, *string, *string) error]
+	deleteForRoute           method[PluginServiceDeleteForRouteCall, pluginServiceDeleteForRouteResults, func(context.Context, *string, *string) error]
+	deleteForConsumer        method[PluginServiceDeleteForConsumerCall, pluginServiceDeleteForConsumerResults, func(context.Context, *string, *string) error]
+	deleteForConsumerGroup   method[PluginServiceDeleteForConsumerGroupCall, pluginServiceDeleteForConsumerGroupResults, func(context.Context, *string, *string) error]
+	list                     method[PluginServiceListCall, pluginServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error)]
+	listAll                  method[PluginServiceListAllCall, pluginServiceListAllResults, func(context.Context) ([]*kong.Plugin, error)]
+	listAllForConsumer       method[PluginServiceListAllForConsumerCall, pluginServiceListAllForConsumerResults, func(context.Context, *string) ([]*kong.Plugin, error)]
+	listAllForService        method[PluginServiceListAllForServiceCall, pluginServiceListAllForServiceResults, func(context.Context, *string) ([]*kong.Plugin, error)]
+	listAllForRoute          method[PluginServiceListAllForRouteCall, pluginServiceListAllForRouteResults, func(context.Context, *string) ([]*kong.Plugin, error)]
+	listAllForConsumerGroups method[PluginServiceListAllForConsumerGroupsCall, pluginServiceListAllForConsumerGroupsResults, func(context.Context, *string) ([]*kong.Plugin, error)]
+	validate                 method[PluginServiceValidateCall, pluginServiceValidateResults, func(context.Context, *kong.Plugin) (bool, string, error)]
+	getSchema                method[PluginServiceGetSchemaCall, pluginServiceGetSchemaResults, func(context.Context, *string) (kong.Schema, error)]
+	getFullSchema            method[PluginServiceGetFullSchemaCall, pluginServiceGetFullSchemaResults, func(context.Context, *string) (kong.Schema, error)]
+}
+
+var _ kong.AbstractPluginService = &FakePluginService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakePluginService) Create(ctx context.Context, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.create.record(PluginServiceCreateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakePluginService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakePluginService) CreateCalls() []PluginServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakePluginService) CreateArgsForCall(i int) PluginServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) CreateStub(stub func(context.Context, *kong.Plugin) (*kong.Plugin, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakePluginService) CreateReturns(r0 *kong.Plugin, r1 error) {
+	f.create.setReturns(pluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakePluginService) CreateReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.create.setReturnsOnCall(i, pluginServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateForService records the call and returns the programmed results.
+func (f *FakePluginService) CreateForService(ctx context.Context, serviceIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.createForService.record(PluginServiceCreateForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForService(ctx, serviceIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.CreateForService")
+}
+
+// CreateForServiceCallCount returns the number of calls to CreateForService.
+func (f *FakePluginService) CreateForServiceCallCount() int {
+	return f.createForService.callCount()
+}
+
+// CreateForServiceCalls returns the arguments of the calls to CreateForService.
+func (f *FakePluginService) CreateForServiceCalls() []PluginServiceCreateForServiceCall {
+	return f.createForService.allCalls()
+}
+
+// CreateForServiceArgsForCall returns the arguments of the i-th call to CreateForService.
+func (f *FakePluginService) CreateForServiceArgsForCall(i int) PluginServiceCreateForServiceCall {
+	return f.createForService.argsForCall(i)
+}
+
+// CreateForServiceStub makes CreateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) CreateForServiceStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.createForService.setStub(stub, stub != nil)
+}
+
+// CreateForServiceReturns makes CreateForService return the given values.
+func (f *FakePluginService) CreateForServiceReturns(r0 *kong.Plugin, r1 error) {
+	f.createForService.setReturns(pluginServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// CreateForServiceReturnsOnCall makes the i-th call to CreateForService return the given values.
+func (f *FakePluginService) CreateForServiceReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.createForService.setReturnsOnCall(i, pluginServiceCreateForServiceResults{r0: r0, r1: r1})
+}
+
+// CreateForRoute records the call and returns the programmed results.
+func (f *FakePluginService) CreateForRoute(ctx context.Context, routeIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.createForRoute.record(PluginServiceCreateForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForRoute(ctx, routeIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.CreateForRoute")
+}
+
+// CreateForRouteCallCount returns the number of calls to CreateForRoute.
+func (f *FakePluginService) CreateForRouteCallCount() int {
+	return f.createForRoute.callCount()
+}
+
+// CreateForRouteCalls returns the arguments of the calls to CreateForRoute.
+func (f *FakePluginService) CreateForRouteCalls() []PluginServiceCreateForRouteCall {
+	return f.createForRoute.allCalls()
+}
+
+// CreateForRouteArgsForCall returns the arguments of the i-th call to CreateForRoute.
+func (f *FakePluginService) CreateForRouteArgsForCall(i int) PluginServiceCreateForRouteCall {
+	return f.createForRoute.argsForCall(i)
+}
+
+// CreateForRouteStub makes CreateForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) CreateForRouteStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.createForRoute.setStub(stub, stub != nil)
+}
+
+// CreateForRouteReturns makes CreateForRoute return the given values.
+func (f *FakePluginService) CreateForRouteReturns(r0 *kong.Plugin, r1 error) {
+	f.createForRoute.setReturns(pluginServiceCreateForRouteResults{r0: r0, r1: r1})
+}
+
+// CreateForRouteReturnsOnCall makes the i-th call to CreateForRoute return the given values.
+func (f *FakePluginService) CreateForRouteReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.createForRoute.setReturnsOnCall(i, pluginServiceCreateForRouteResults{r0: r0, r1: r1})
+}
+
+// CreateForConsumer records the call and returns the programmed results.
+func (f *FakePluginService) CreateForConsumer(ctx context.Context, consumerUsernameOrID *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.createForConsumer.record(PluginServiceCreateForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForConsumer(ctx, consumerUsernameOrID, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.CreateForConsumer")
+}
+
+// CreateForConsumerCallCount returns the number of calls to CreateForConsumer.
+func (f *FakePluginService) CreateForConsumerCallCount() int {
+	return f.createForConsumer.callCount()
+}
+
+// CreateForConsumerCalls returns the arguments of the calls to CreateForConsumer.
+func (f *FakePluginService) CreateForConsumerCalls() []PluginServiceCreateForConsumerCall {
+	return f.createForConsumer.allCalls()
+}
+
+// CreateForConsumerArgsForCall returns the arguments of the i-th call to CreateForConsumer.
+func (f *FakePluginService) CreateForConsumerArgsForCall(i int) PluginServiceCreateForConsumerCall {
+	return f.createForConsumer.argsForCall(i)
+}
+
+// CreateForConsumerStub makes CreateForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) CreateForConsumerStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.createForConsumer.setStub(stub, stub != nil)
+}
+
+// CreateForConsumerReturns makes CreateForConsumer return the given values.
+func (f *FakePluginService) CreateForConsumerReturns(r0 *kong.Plugin, r1 error) {
+	f.createForConsumer.setReturns(pluginServiceCreateForConsumerResults{r0: r0, r1: r1})
+}
+
+// CreateForConsumerReturnsOnCall makes the i-th call to CreateForConsumer return the given values.
+func (f *FakePluginService) CreateForConsumerReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.createForConsumer.setReturnsOnCall(i, pluginServiceCreateForConsumerResults{r0: r0, r1: r1})
+}
+
+// CreateForConsumerGroup records the call and returns the programmed results.
+func (f *FakePluginService) CreateForConsumerGroup(ctx context.Context, cgIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.createForConsumerGroup.record(PluginServiceCreateForConsumerGroupCall{Ctx: ctx, CgIDorName: cgIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, cgIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.CreateForConsumerGroup(ctx, cgIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.CreateForConsumerGroup")
+}
+
+// CreateForConsumerGroupCallCount returns the number of calls to CreateForConsumerGroup.
+func (f *FakePluginService) CreateForConsumerGroupCallCount() int {
+	return f.createForConsumerGroup.callCount()
+}
+
+// CreateForConsumerGroupCalls returns the arguments of the calls to CreateForConsumerGroup.
+func (f *FakePluginService) CreateForConsumerGroupCalls() []PluginServiceCreateForConsumerGroupCall {
+	return f.createForConsumerGroup.allCalls()
+}
+
+// CreateForConsumerGroupArgsForCall returns the arguments of the i-th call to CreateForConsumerGroup.
+func (f *FakePluginService) CreateForConsumerGroupArgsForCall(i int) PluginServiceCreateForConsumerGroupCall {
+	return f.createForConsumerGroup.argsForCall(i)
+}
+
+// CreateForConsumerGroupStub makes CreateForConsumerGroup delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) CreateForConsumerGroupStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.createForConsumerGroup.setStub(stub, stub != nil)
+}
+
+// CreateForConsumerGroupReturns makes CreateForConsumerGroup return the given values.
+func (f *FakePluginService) CreateForConsumerGroupReturns(r0 *kong.Plugin, r1 error) {
+	f.createForConsumerGroup.setReturns(pluginServiceCreateForConsumerGroupResults{r0: r0, r1: r1})
+}
+
+// CreateForConsumerGroupReturnsOnCall makes the i-th call to CreateForConsumerGroup return the given values.
+func (f *FakePluginService) CreateForConsumerGroupReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.createForConsumerGroup.setReturnsOnCall(i, pluginServiceCreateForConsumerGroupResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakePluginService) Get(ctx context.Context, usernameOrID *string) (*kong.Plugin, error) {
+	call := f.get.record(PluginServiceGetCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakePluginService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakePluginService) GetCalls() []PluginServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakePluginService) GetArgsForCall(i int) PluginServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) GetStub(stub func(context.Context, *string) (*kong.Plugin, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakePluginService) GetReturns(r0 *kong.Plugin, r1 error) {
+	f.get.setReturns(pluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakePluginService) GetReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.get.setReturnsOnCall(i, pluginServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakePluginService) Update(ctx context.Context, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.update.record(PluginServiceUpdateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakePluginService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakePluginService) UpdateCalls() []PluginServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakePluginService) UpdateArgsForCall(i int) PluginServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) UpdateStub(stub func(context.Context, *kong.Plugin) (*kong.Plugin, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakePluginService) UpdateReturns(r0 *kong.Plugin, r1 error) {
+	f.update.setReturns(pluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakePluginService) UpdateReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.update.setReturnsOnCall(i, pluginServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateForService records the call and returns the programmed results.
+func (f *FakePluginService) UpdateForService(ctx context.Context, serviceIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.updateForService.record(PluginServiceUpdateForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForService(ctx, serviceIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.UpdateForService")
+}
+
+// UpdateForServiceCallCount returns the number of calls to UpdateForService.
+func (f *FakePluginService) UpdateForServiceCallCount() int {
+	return f.updateForService.callCount()
+}
+
+// UpdateForServiceCalls returns the arguments of the calls to UpdateForService.
+func (f *FakePluginService) UpdateForServiceCalls() []PluginServiceUpdateForServiceCall {
+	return f.updateForService.allCalls()
+}
+
+// UpdateForServiceArgsForCall returns the arguments of the i-th call to UpdateForService.
+func (f *FakePluginService) UpdateForServiceArgsForCall(i int) PluginServiceUpdateForServiceCall {
+	return f.updateForService.argsForCall(i)
+}
+
+// UpdateForServiceStub makes UpdateForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) UpdateForServiceStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.updateForService.setStub(stub, stub != nil)
+}
+
+// UpdateForServiceReturns makes UpdateForService return the given values.
+func (f *FakePluginService) UpdateForServiceReturns(r0 *kong.Plugin, r1 error) {
+	f.updateForService.setReturns(pluginServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForServiceReturnsOnCall makes the i-th call to UpdateForService return the given values.
+func (f *FakePluginService) UpdateForServiceReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.updateForService.setReturnsOnCall(i, pluginServiceUpdateForServiceResults{r0: r0, r1: r1})
+}
+
+// UpdateForRoute records the call and returns the programmed results.
+func (f *FakePluginService) UpdateForRoute(ctx context.Context, routeIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.updateForRoute.record(PluginServiceUpdateForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForRoute(ctx, routeIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.UpdateForRoute")
+}
+
+// UpdateForRouteCallCount returns the number of calls to UpdateForRoute.
+func (f *FakePluginService) UpdateForRouteCallCount() int {
+	return f.updateForRoute.callCount()
+}
+
+// UpdateForRouteCalls returns the arguments of the calls to UpdateForRoute.
+func (f *FakePluginService) UpdateForRouteCalls() []PluginServiceUpdateForRouteCall {
+	return f.updateForRoute.allCalls()
+}
+
+// UpdateForRouteArgsForCall returns the arguments of the i-th call to UpdateForRoute.
+func (f *FakePluginService) UpdateForRouteArgsForCall(i int) PluginServiceUpdateForRouteCall {
+	return f.updateForRoute.argsForCall(i)
+}
+
+// UpdateForRouteStub makes UpdateForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) UpdateForRouteStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.updateForRoute.setStub(stub, stub != nil)
+}
+
+// UpdateForRouteReturns makes UpdateForRoute return the given values.
+func (f *FakePluginService) UpdateForRouteReturns(r0 *kong.Plugin, r1 error) {
+	f.updateForRoute.setReturns(pluginServiceUpdateForRouteResults{r0: r0, r1: r1})
+}
+
+// UpdateForRouteReturnsOnCall makes the i-th call to UpdateForRoute return the given values.
+func (f *FakePluginService) UpdateForRouteReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.updateForRoute.setReturnsOnCall(i, pluginServiceUpdateForRouteResults{r0: r0, r1: r1})
+}
+
+// UpdateForConsumer records the call and returns the programmed results.
+func (f *FakePluginService) UpdateForConsumer(ctx context.Context, consumerUsernameOrID *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.updateForConsumer.record(PluginServiceUpdateForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForConsumer(ctx, consumerUsernameOrID, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.UpdateForConsumer")
+}
+
+// UpdateForConsumerCallCount returns the number of calls to UpdateForConsumer.
+func (f *FakePluginService) UpdateForConsumerCallCount() int {
+	return f.updateForConsumer.callCount()
+}
+
+// UpdateForConsumerCalls returns the arguments of the calls to UpdateForConsumer.
+func (f *FakePluginService) UpdateForConsumerCalls() []PluginServiceUpdateForConsumerCall {
+	return f.updateForConsumer.allCalls()
+}
+
+// UpdateForConsumerArgsForCall returns the arguments of the i-th call to UpdateForConsumer.
+func (f *FakePluginService) UpdateForConsumerArgsForCall(i int) PluginServiceUpdateForConsumerCall {
+	return f.updateForConsumer.argsForCall(i)
+}
+
+// UpdateForConsumerStub makes UpdateForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) UpdateForConsumerStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.updateForConsumer.setStub(stub, stub != nil)
+}
+
+// UpdateForConsumerReturns makes UpdateForConsumer return the given values.
+func (f *FakePluginService) UpdateForConsumerReturns(r0 *kong.Plugin, r1 error) {
+	f.updateForConsumer.setReturns(pluginServiceUpdateForConsumerResults{r0: r0, r1: r1})
+}
+
+// UpdateForConsumerReturnsOnCall makes the i-th call to UpdateForConsumer return the given values.
+func (f *FakePluginService) UpdateForConsumerReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.updateForConsumer.setReturnsOnCall(i, pluginServiceUpdateForConsumerResults{r0: r0, r1: r1})
+}
+
+// UpdateForConsumerGroup records the call and returns the programmed results.
+func (f *FakePluginService) UpdateForConsumerGroup(ctx context.Context, cgIDorName *string, plugin *kong.Plugin) (*kong.Plugin, error) {
+	call := f.updateForConsumerGroup.record(PluginServiceUpdateForConsumerGroupCall{Ctx: ctx, CgIDorName: cgIDorName, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, cgIDorName, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.UpdateForConsumerGroup(ctx, cgIDorName, plugin)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.UpdateForConsumerGroup")
+}
+
+// UpdateForConsumerGroupCallCount returns the number of calls to UpdateForConsumerGroup.
+func (f *FakePluginService) UpdateForConsumerGroupCallCount() int {
+	return f.updateForConsumerGroup.callCount()
+}
+
+// UpdateForConsumerGroupCalls returns the arguments of the calls to UpdateForConsumerGroup.
+func (f *FakePluginService) UpdateForConsumerGroupCalls() []PluginServiceUpdateForConsumerGroupCall {
+	return f.updateForConsumerGroup.allCalls()
+}
+
+// UpdateForConsumerGroupArgsForCall returns the arguments of the i-th call to UpdateForConsumerGroup.
+func (f *FakePluginService) UpdateForConsumerGroupArgsForCall(i int) PluginServiceUpdateForConsumerGroupCall {
+	return f.updateForConsumerGroup.argsForCall(i)
+}
+
+// UpdateForConsumerGroupStub makes UpdateForConsumerGroup delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) UpdateForConsumerGroupStub(stub func(context.Context, *string, *kong.Plugin) (*kong.Plugin, error)) {
+	f.updateForConsumerGroup.setStub(stub, stub != nil)
+}
+
+// UpdateForConsumerGroupReturns makes UpdateForConsumerGroup return the given values.
+func (f *FakePluginService) UpdateForConsumerGroupReturns(r0 *kong.Plugin, r1 error) {
+	f.updateForConsumerGroup.setReturns(pluginServiceUpdateForConsumerGroupResults{r0: r0, r1: r1})
+}
+
+// UpdateForConsumerGroupReturnsOnCall makes the i-th call to UpdateForConsumerGroup return the given values.
+func (f *FakePluginService) UpdateForConsumerGroupReturnsOnCall(i int, r0 *kong.Plugin, r1 error) {
+	f.updateForConsumerGroup.setReturnsOnCall(i, pluginServiceUpdateForConsumerGroupResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakePluginService) Delete(ctx context.Context, usernameOrID *string) error {
+	call := f.delete.record(PluginServiceDeleteCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, usernameOrID)
+	}
+	return notProgrammed("FakePluginService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakePluginService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakePluginService) DeleteCalls() []PluginServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakePluginService) DeleteArgsForCall(i int) PluginServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakePluginService) DeleteReturns(r0 error) {
+	f.delete.setReturns(pluginServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakePluginService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, pluginServiceDeleteResults{r0: r0})
+}
+
+// DeleteForService records the call and returns the programmed results.
+func (f *FakePluginService) DeleteForService(ctx context.Context, serviceIDorName *string, pluginID *string) error {
+	call := f.deleteForService.record(PluginServiceDeleteForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName, PluginID: pluginID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName, pluginID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForService(ctx, serviceIDorName, pluginID)
+	}
+	return notProgrammed("FakePluginService.DeleteForService")
+}
+
+// DeleteForServiceCallCount returns the number of calls to DeleteForService.
+func (f *FakePluginService) DeleteForServiceCallCount() int {
+	return f.deleteForService.callCount()
+}
+
+// DeleteForServiceCalls returns the arguments of the calls to DeleteForService.
+func (f *FakePluginService) DeleteForServiceCalls() []PluginServiceDeleteForServiceCall {
+	return f.deleteForService.allCalls()
+}
+
+// DeleteForServiceArgsForCall returns the arguments of the i-th call to DeleteForService.
+func (f *FakePluginService) DeleteForServiceArgsForCall(i int) PluginServiceDeleteForServiceCall {
+	return f.deleteForService.argsForCall(i)
+}
+
+// DeleteForServiceStub makes DeleteForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) DeleteForServiceStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForService.setStub(stub, stub != nil)
+}
+
+// DeleteForServiceReturns makes DeleteForService return the given values.
+func (f *FakePluginService) DeleteForServiceReturns(r0 error) {
+	f.deleteForService.setReturns(pluginServiceDeleteForServiceResults{r0: r0})
+}
+
+// DeleteForServiceReturnsOnCall makes the i-th call to DeleteForService return the given values.
+func (f *FakePluginService) DeleteForServiceReturnsOnCall(i int, r0 error) {
+	f.deleteForService.setReturnsOnCall(i, pluginServiceDeleteForServiceResults{r0: r0})
+}
+
+// DeleteForRoute records the call and returns the programmed results.
+func (f *FakePluginService) DeleteForRoute(ctx context.Context, routeIDorName *string, pluginID *string) error {
+	call := f.deleteForRoute.record(PluginServiceDeleteForRouteCall{Ctx: ctx, RouteIDorName: routeIDorName, PluginID: pluginID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, routeIDorName, pluginID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForRoute(ctx, routeIDorName, pluginID)
+	}
+	return notProgrammed("FakePluginService.DeleteForRoute")
+}
+
+// DeleteForRouteCallCount returns the number of calls to DeleteForRoute.
+func (f *FakePluginService) DeleteForRouteCallCount() int {
+	return f.deleteForRoute.callCount()
+}
+
+// DeleteForRouteCalls returns the arguments of the calls to DeleteForRoute.
+func (f *FakePluginService) DeleteForRouteCalls() []PluginServiceDeleteForRouteCall {
+	return f.deleteForRoute.allCalls()
+}
+
+// DeleteForRouteArgsForCall returns the arguments of the i-th call to DeleteForRoute.
+func (f *FakePluginService) DeleteForRouteArgsForCall(i int) PluginServiceDeleteForRouteCall {
+	return f.deleteForRoute.argsForCall(i)
+}
+
+// DeleteForRouteStub makes DeleteForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) DeleteForRouteStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForRoute.setStub(stub, stub != nil)
+}
+
+// DeleteForRouteReturns makes DeleteForRoute return the given values.
+func (f *FakePluginService) DeleteForRouteReturns(r0 error) {
+	f.deleteForRoute.setReturns(pluginServiceDeleteForRouteResults{r0: r0})
+}
+
+// DeleteForRouteReturnsOnCall makes the i-th call to DeleteForRoute return the given values.
+func (f *FakePluginService) DeleteForRouteReturnsOnCall(i int, r0 error) {
+	f.deleteForRoute.setReturnsOnCall(i, pluginServiceDeleteForRouteResults{r0: r0})
+}
+
+// DeleteForConsumer records the call and returns the programmed results.
+func (f *FakePluginService) DeleteForConsumer(ctx context.Context, consumerUsernameOrID *string, pluginID *string) error {
+	call := f.deleteForConsumer.record(PluginServiceDeleteForConsumerCall{Ctx: ctx, ConsumerUsernameOrID: consumerUsernameOrID, PluginID: pluginID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, consumerUsernameOrID, pluginID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForConsumer(ctx, consumerUsernameOrID, pluginID)
+	}
+	return notProgrammed("FakePluginService.DeleteForConsumer")
+}
+
+// DeleteForConsumerCallCount returns the number of calls to DeleteForConsumer.
+func (f *FakePluginService) DeleteForConsumerCallCount() int {
+	return f.deleteForConsumer.callCount()
+}
+
+// DeleteForConsumerCalls returns the arguments of the calls to DeleteForConsumer.
+func (f *FakePluginService) DeleteForConsumerCalls() []PluginServiceDeleteForConsumerCall {
+	return f.deleteForConsumer.allCalls()
+}
+
+// DeleteForConsumerArgsForCall returns the arguments of the i-th call to DeleteForConsumer.
+func (f *FakePluginService) DeleteForConsumerArgsForCall(i int) PluginServiceDeleteForConsumerCall {
+	return f.deleteForConsumer.argsForCall(i)
+}
+
+// DeleteForConsumerStub makes DeleteForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) DeleteForConsumerStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForConsumer.setStub(stub, stub != nil)
+}
+
+// DeleteForConsumerReturns makes DeleteForConsumer return the given values.
+func (f *FakePluginService) DeleteForConsumerReturns(r0 error) {
+	f.deleteForConsumer.setReturns(pluginServiceDeleteForConsumerResults{r0: r0})
+}
+
+// DeleteForConsumerReturnsOnCall makes the i-th call to DeleteForConsumer return the given values.
+func (f *FakePluginService) DeleteForConsumerReturnsOnCall(i int, r0 error) {
+	f.deleteForConsumer.setReturnsOnCall(i, pluginServiceDeleteForConsumerResults{r0: r0})
+}
+
+// DeleteForConsumerGroup records the call and returns the programmed results.
+func (f *FakePluginService) DeleteForConsumerGroup(ctx context.Context, cgIDorName *string, pluginID *string) error {
+	call := f.deleteForConsumerGroup.record(PluginServiceDeleteForConsumerGroupCall{Ctx: ctx, CgIDorName: cgIDorName, PluginID: pluginID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, cgIDorName, pluginID)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteForConsumerGroup(ctx, cgIDorName, pluginID)
+	}
+	return notProgrammed("FakePluginService.DeleteForConsumerGroup")
+}
+
+// DeleteForConsumerGroupCallCount returns the number of calls to DeleteForConsumerGroup.
+func (f *FakePluginService) DeleteForConsumerGroupCallCount() int {
+	return f.deleteForConsumerGroup.callCount()
+}
+
+// DeleteForConsumerGroupCalls returns the arguments of the calls to DeleteForConsumerGroup.
+func (f *FakePluginService) DeleteForConsumerGroupCalls() []PluginServiceDeleteForConsumerGroupCall {
+	return f.deleteForConsumerGroup.allCalls()
+}
+
+// DeleteForConsumerGroupArgsForCall returns the arguments of the i-th call to DeleteForConsumerGroup.
+func (f *FakePluginService) DeleteForConsumerGroupArgsForCall(i int) PluginServiceDeleteForConsumerGroupCall {
+	return f.deleteForConsumerGroup.argsForCall(i)
+}
+
+// DeleteForConsumerGroupStub makes DeleteForConsumerGroup delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) DeleteForConsumerGroupStub(stub func(context.Context, *string, *string) error) {
+	f.deleteForConsumerGroup.setStub(stub, stub != nil)
+}
+
+// DeleteForConsumerGroupReturns makes DeleteForConsumerGroup return the given values.
+func (f *FakePluginService) DeleteForConsumerGroupReturns(r0 error) {
+	f.deleteForConsumerGroup.setReturns(pluginServiceDeleteForConsumerGroupResults{r0: r0})
+}
+
+// DeleteForConsumerGroupReturnsOnCall makes the i-th call to DeleteForConsumerGroup return the given values.
+func (f *FakePluginService) DeleteForConsumerGroupReturnsOnCall(i int, r0 error) {
+	f.deleteForConsumerGroup.setReturnsOnCall(i, pluginServiceDeleteForConsumerGroupResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakePluginService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error) {
+	call := f.list.record(PluginServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakePluginService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakePluginService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakePluginService) ListCalls() []PluginServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakePluginService) ListArgsForCall(i int) PluginServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Plugin, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakePluginService) ListReturns(r0 []*kong.Plugin, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(pluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakePluginService) ListReturnsOnCall(i int, r0 []*kong.Plugin, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, pluginServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakePluginService) ListAll(ctx context.Context) ([]*kong.Plugin, error) {
+	call := f.listAll.record(PluginServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakePluginService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakePluginService) ListAllCalls() []PluginServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakePluginService) ListAllArgsForCall(i int) PluginServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListAllStub(stub func(context.Context) ([]*kong.Plugin, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakePluginService) ListAllReturns(r0 []*kong.Plugin, r1 error) {
+	f.listAll.setReturns(pluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakePluginService) ListAllReturnsOnCall(i int, r0 []*kong.Plugin, r1 error) {
+	f.listAll.setReturnsOnCall(i, pluginServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllForConsumer records the call and returns the programmed results.
+func (f *FakePluginService) ListAllForConsumer(ctx context.Context, consumerIDorName *string) ([]*kong.Plugin, error) {
+	call := f.listAllForConsumer.record(PluginServiceListAllForConsumerCall{Ctx: ctx, ConsumerIDorName: consumerIDorName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, consumerIDorName)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForConsumer(ctx, consumerIDorName)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.ListAllForConsumer")
+}
+
+// ListAllForConsumerCallCount returns the number of calls to ListAllForConsumer.
+func (f *FakePluginService) ListAllForConsumerCallCount() int {
+	return f.listAllForConsumer.callCount()
+}
+
+// ListAllForConsumerCalls returns the arguments of the calls to ListAllForConsumer.
+func (f *FakePluginService) ListAllForConsumerCalls() []PluginServiceListAllForConsumerCall {
+	return f.listAllForConsumer.allCalls()
+}
+
+// ListAllForConsumerArgsForCall returns the arguments of the i-th call to ListAllForConsumer.
+func (f *FakePluginService) ListAllForConsumerArgsForCall(i int) PluginServiceListAllForConsumerCall {
+	return f.listAllForConsumer.argsForCall(i)
+}
+
+// ListAllForConsumerStub makes ListAllForConsumer delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListAllForConsumerStub(stub func(context.Context, *string) ([]*kong.Plugin, error)) {
+	f.listAllForConsumer.setStub(stub, stub != nil)
+}
+
+// ListAllForConsumerReturns makes ListAllForConsumer return the given values.
+func (f *FakePluginService) ListAllForConsumerReturns(r0 []*kong.Plugin, r1 error) {
+	f.listAllForConsumer.setReturns(pluginServiceListAllForConsumerResults{r0: r0, r1: r1})
+}
+
+// ListAllForConsumerReturnsOnCall makes the i-th call to ListAllForConsumer return the given values.
+func (f *FakePluginService) ListAllForConsumerReturnsOnCall(i int, r0 []*kong.Plugin, r1 error) {
+	f.listAllForConsumer.setReturnsOnCall(i, pluginServiceListAllForConsumerResults{r0: r0, r1: r1})
+}
+
+// ListAllForService records the call and returns the programmed results.
+func (f *FakePluginService) ListAllForService(ctx context.Context, serviceIDorName *string) ([]*kong.Plugin, error) {
+	call := f.listAllForService.record(PluginServiceListAllForServiceCall{Ctx: ctx, ServiceIDorName: serviceIDorName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceIDorName)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForService(ctx, serviceIDorName)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.ListAllForService")
+}
+
+// ListAllForServiceCallCount returns the number of calls to ListAllForService.
+func (f *FakePluginService) ListAllForServiceCallCount() int {
+	return f.listAllForService.callCount()
+}
+
+// ListAllForServiceCalls returns the arguments of the calls to ListAllForService.
+func (f *FakePluginService) ListAllForServiceCalls() []PluginServiceListAllForServiceCall {
+	return f.listAllForService.allCalls()
+}
+
+// ListAllForServiceArgsForCall returns the arguments of the i-th call to ListAllForService.
+func (f *FakePluginService) ListAllForServiceArgsForCall(i int) PluginServiceListAllForServiceCall {
+	return f.listAllForService.argsForCall(i)
+}
+
+// ListAllForServiceStub makes ListAllForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListAllForServiceStub(stub func(context.Context, *string) ([]*kong.Plugin, error)) {
+	f.listAllForService.setStub(stub, stub != nil)
+}
+
+// ListAllForServiceReturns makes ListAllForService return the given values.
+func (f *FakePluginService) ListAllForServiceReturns(r0 []*kong.Plugin, r1 error) {
+	f.listAllForService.setReturns(pluginServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// ListAllForServiceReturnsOnCall makes the i-th call to ListAllForService return the given values.
+func (f *FakePluginService) ListAllForServiceReturnsOnCall(i int, r0 []*kong.Plugin, r1 error) {
+	f.listAllForService.setReturnsOnCall(i, pluginServiceListAllForServiceResults{r0: r0, r1: r1})
+}
+
+// ListAllForRoute records the call and returns the programmed results.
+func (f *FakePluginService) ListAllForRoute(ctx context.Context, routeID *string) ([]*kong.Plugin, error) {
+	call := f.listAllForRoute.record(PluginServiceListAllForRouteCall{Ctx: ctx, RouteID: routeID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForRoute(ctx, routeID)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.ListAllForRoute")
+}
+
+// ListAllForRouteCallCount returns the number of calls to ListAllForRoute.
+func (f *FakePluginService) ListAllForRouteCallCount() int {
+	return f.listAllForRoute.callCount()
+}
+
+// ListAllForRouteCalls returns the arguments of the calls to ListAllForRoute.
+func (f *FakePluginService) ListAllForRouteCalls() []PluginServiceListAllForRouteCall {
+	return f.listAllForRoute.allCalls()
+}
+
+// ListAllForRouteArgsForCall returns the arguments of the i-th call to ListAllForRoute.
+func (f *FakePluginService) ListAllForRouteArgsForCall(i int) PluginServiceListAllForRouteCall {
+	return f.listAllForRoute.argsForCall(i)
+}
+
+// ListAllForRouteStub makes ListAllForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListAllForRouteStub(stub func(context.Context, *string) ([]*kong.Plugin, error)) {
+	f.listAllForRoute.setStub(stub, stub != nil)
+}
+
+// ListAllForRouteReturns makes ListAllForRoute return the given values.
+func (f *FakePluginService) ListAllForRouteReturns(r0 []*kong.Plugin, r1 error) {
+	f.listAllForRoute.setReturns(pluginServiceListAllForRouteResults{r0: r0, r1: r1})
+}
+
+// ListAllForRouteReturnsOnCall makes the i-th call to ListAllForRoute return the given values.
+func (f *FakePluginService) ListAllForRouteReturnsOnCall(i int, r0 []*kong.Plugin, r1 error) {
+	f.listAllForRoute.setReturnsOnCall(i, pluginServiceListAllForRouteResults{r0: r0, r1: r1})
+}
+
+// ListAllForConsumerGroups records the call and returns the programmed results.
+func (f *FakePluginService) ListAllForConsumerGroups(ctx context.Context, cgID *string) ([]*kong.Plugin, error) {
+	call := f.listAllForConsumerGroups.record(PluginServiceListAllForConsumerGroupsCall{Ctx: ctx, CgID: cgID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, cgID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForConsumerGroups(ctx, cgID)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.ListAllForConsumerGroups")
+}
+
+// ListAllForConsumerGroupsCallCount returns the number of calls to ListAllForConsumerGroups.
+func (f *FakePluginService) ListAllForConsumerGroupsCallCount() int {
+	return f.listAllForConsumerGroups.callCount()
+}
+
+// ListAllForConsumerGroupsCalls returns the arguments of the calls to ListAllForConsumerGroups.
+func (f *FakePluginService) ListAllForConsumerGroupsCalls() []PluginServiceListAllForConsumerGroupsCall {
+	return f.listAllForConsumerGroups.allCalls()
+}
+
+// ListAllForConsumerGroupsArgsForCall returns the arguments of the i-th call to ListAllForConsumerGroups.
+func (f *FakePluginService) ListAllForConsumerGroupsArgsForCall(i int) PluginServiceListAllForConsumerGroupsCall {
+	return f.listAllForConsumerGroups.argsForCall(i)
+}
+
+// ListAllForConsumerGroupsStub makes ListAllForConsumerGroups delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ListAllForConsumerGroupsStub(stub func(context.Context, *string) ([]*kong.Plugin, error)) {
+	f.listAllForConsumerGroups.setStub(stub, stub != nil)
+}
+
+// ListAllForConsumerGroupsReturns makes ListAllForConsumerGroups return the given values.
+func (f *FakePluginService) ListAllForConsumerGroupsReturns(r0 []*kong.Plugin, r1 error) {
+	f.listAllForConsumerGroups.setReturns(pluginServiceListAllForConsumerGroupsResults{r0: r0, r1: r1})
+}
+
+// ListAllForConsumerGroupsReturnsOnCall makes the i-th call to ListAllForConsumerGroups return the given values.
+func (f *FakePluginService) ListAllForConsumerGroupsReturnsOnCall(i int, r0 []*kong.Plugin, r1 error) {
+	f.listAllForConsumerGroups.setReturnsOnCall(i, pluginServiceListAllForConsumerGroupsResults{r0: r0, r1: r1})
+}
+
+// Validate records the call and returns the programmed results.
+func (f *FakePluginService) Validate(ctx context.Context, plugin *kong.Plugin) (bool, string, error) {
+	call := f.validate.record(PluginServiceValidateCall{Ctx: ctx, Plugin: plugin})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, plugin)
+	case f.Fallback != nil:
+		return f.Fallback.Validate(ctx, plugin)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakePluginService.Validate")
+}
+
+// ValidateCallCount returns the number of calls to Validate.
+func (f *FakePluginService) ValidateCallCount() int {
+	return f.validate.callCount()
+}
+
+// ValidateCalls returns the arguments of the calls to Validate.
+func (f *FakePluginService) ValidateCalls() []PluginServiceValidateCall {
+	return f.validate.allCalls()
+}
+
+// ValidateArgsForCall returns the arguments of the i-th call to Validate.
+func (f *FakePluginService) ValidateArgsForCall(i int) PluginServiceValidateCall {
+	return f.validate.argsForCall(i)
+}
+
+// ValidateStub makes Validate delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) ValidateStub(stub func(context.Context, *kong.Plugin) (bool, string, error)) {
+	f.validate.setStub(stub, stub != nil)
+}
+
+// ValidateReturns makes Validate return the given values.
+func (f *FakePluginService) ValidateReturns(r0 bool, r1 string, r2 error) {
+	f.validate.setReturns(pluginServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ValidateReturnsOnCall makes the i-th call to Validate return the given values.
+func (f *FakePluginService) ValidateReturnsOnCall(i int, r0 bool, r1 string, r2 error) {
+	f.validate.setReturnsOnCall(i, pluginServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// GetSchema records the call and returns the programmed results.
+func (f *FakePluginService) GetSchema(ctx context.Context, pluginName *string) (kong.Schema, error) {
+	call := f.getSchema.record(PluginServiceGetSchemaCall{Ctx: ctx, PluginName: pluginName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, pluginName)
+	case f.Fallback != nil:
+		return f.Fallback.GetSchema(ctx, pluginName)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.GetSchema")
+}
+
+// GetSchemaCallCount returns the number of calls to GetSchema.
+func (f *FakePluginService) GetSchemaCallCount() int {
+	return f.getSchema.callCount()
+}
+
+// GetSchemaCalls returns the arguments of the calls to GetSchema.
+func (f *FakePluginService) GetSchemaCalls() []PluginServiceGetSchemaCall {
+	return f.getSchema.allCalls()
+}
+
+// GetSchemaArgsForCall returns the arguments of the i-th call to GetSchema.
+func (f *FakePluginService) GetSchemaArgsForCall(i int) PluginServiceGetSchemaCall {
+	return f.getSchema.argsForCall(i)
+}
+
+// GetSchemaStub makes GetSchema delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) GetSchemaStub(stub func(context.Context, *string) (kong.Schema, error)) {
+	f.getSchema.setStub(stub, stub != nil)
+}
+
+// GetSchemaReturns makes GetSchema return the given values.
+func (f *FakePluginService) GetSchemaReturns(r0 kong.Schema, r1 error) {
+	f.getSchema.setReturns(pluginServiceGetSchemaResults{r0: r0, r1: r1})
+}
+
+// GetSchemaReturnsOnCall makes the i-th call to GetSchema return the given values.
+func (f *FakePluginService) GetSchemaReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.getSchema.setReturnsOnCall(i, pluginServiceGetSchemaResults{r0: r0, r1: r1})
+}
+
+// GetFullSchema records the call and returns the programmed results.
+func (f *FakePluginService) GetFullSchema(ctx context.Context, pluginName *string) (kong.Schema, error) {
+	call := f.getFullSchema.record(PluginServiceGetFullSchemaCall{Ctx: ctx, PluginName: pluginName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, pluginName)
+	case f.Fallback != nil:
+		return f.Fallback.GetFullSchema(ctx, pluginName)
+	}
+	return call.results.r0, notProgrammed("FakePluginService.GetFullSchema")
+}
+
+// GetFullSchemaCallCount returns the number of calls to GetFullSchema.
+func (f *FakePluginService) GetFullSchemaCallCount() int {
+	return f.getFullSchema.callCount()
+}
+
+// GetFullSchemaCalls returns the arguments of the calls to GetFullSchema.
+func (f *FakePluginService) GetFullSchemaCalls() []PluginServiceGetFullSchemaCall {
+	return f.getFullSchema.allCalls()
+}
+
+// GetFullSchemaArgsForCall returns the arguments of the i-th call to GetFullSchema.
+func (f *FakePluginService) GetFullSchemaArgsForCall(i int) PluginServiceGetFullSchemaCall {
+	return f.getFullSchema.argsForCall(i)
+}
+
+// GetFullSchemaStub makes GetFullSchema delegate to stub, or stop doing so if stub is nil.
+func (f *FakePluginService) GetFullSchemaStub(stub func(context.Context, *string) (kong.Schema, error)) {
+	f.getFullSchema.setStub(stub, stub != nil)
+}
+
+// GetFullSchemaReturns makes GetFullSchema return the given values.
+func (f *FakePluginService) GetFullSchemaReturns(r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturns(pluginServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// GetFullSchemaReturnsOnCall makes the i-th call to GetFullSchema return the given values.
+func (f *FakePluginService) GetFullSchemaReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturnsOnCall(i, pluginServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakePluginService) Reset() {
+	f.create.reset()
+	f.createForService.reset()
+	f.createForRoute.reset()
+	f.createForConsumer.reset()
+	f.createForConsumerGroup.reset()
+	f.get.reset()
+	f.update.reset()
+	f.updateForService.reset()
+	f.updateForRoute.reset()
+	f.updateForConsumer.reset()
+	f.updateForConsumerGroup.reset()
+	f.delete.reset()
+	f.deleteForService.reset()
+	f.deleteForRoute.reset()
+	f.deleteForConsumer.reset()
+	f.deleteForConsumerGroup.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listAllForConsumer.reset()
+	f.listAllForService.reset()
+	f.listAllForRoute.reset()
+	f.listAllForConsumerGroups.reset()
+	f.validate.reset()
+	f.getSchema.reset()
+	f.getFullSchema.reset()
+}
+
+// RBACEndpointPermissionServiceCreateCall holds the arguments of a call to FakeRBACEndpointPermissionService.Create.
+type RBACEndpointPermissionServiceCreateCall struct {
+	Ctx context.Context
+	Ep  *kong.RBACEndpointPermission
+}
+
+type rbacEndpointPermissionServiceCreateResults struct {
+	r0 *kong.RBACEndpointPermission
+	r1 error
+}
+
+// RBACEndpointPermissionServiceGetCall holds the arguments of a call to FakeRBACEndpointPermissionService.Get.
+type RBACEndpointPermissionServiceGetCall struct {
+	Ctx               context.Context
+	RoleNameOrID      *string
+	WorkspaceNameOrID *string
+	EndpointName      *string
+}
+
+type rbacEndpointPermissionServiceGetResults struct {
+	r0 *kong.RBACEndpointPermission
+	r1 error
+}
+
+// RBACEndpointPermissionServiceUpdateCall holds the arguments of a call to FakeRBACEndpointPermissionService.Update.
+type RBACEndpointPermissionServiceUpdateCall struct {
+	Ctx context.Context
+	Ep  *kong.RBACEndpointPermission
+}
+
+type rbacEndpointPermissionServiceUpdateResults struct {
+	r0 *kong.RBACEndpointPermission
+	r1 error
+}
+
+// RBACEndpointPermissionServiceDeleteCall holds the arguments of a call to FakeRBACEndpointPermissionService.Delete.
+type RBACEndpointPermissionServiceDeleteCall struct {
+	Ctx               context.Context
+	RoleNameOrID      *string
+	WorkspaceNameOrID *string
+	Endpoint          *string
+}
+
+type rbacEndpointPermissionServiceDeleteResults struct {
+	r0 error
+}
+
+// RBACEndpointPermissionServiceListAllForRoleCall holds the arguments of a call to FakeRBACEndpointPermissionService.ListAllForRole.
+type RBACEndpointPermissionServiceListAllForRoleCall struct {
+	Ctx          context.Context
+	RoleNameOrID *string
+}
+
+type rbacEndpointPermissionServiceListAllForRoleResults struct {
+	r0 []*kong.RBACEndpointPermission
+	r1 error
+}
+
+// FakeRBACEndpointPermissionService is a fake implementation of kong.AbstractRBACEndpointPermissionService.
+type FakeRBACEndpointPermissionService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractRBACEndpointPermissionService
+
+	create         method[RBACEndpointPermissionServiceCreateCall, rbacEndpointPermissionServiceCreateResults, func(context.Context, *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error)]
+	get            method[RBACEndpointPermissionServiceGetCall, rbacEndpointPermissionServiceGetResults, func(context.Context, *string, *string, *string) (*kong.RBACEndpointPermission, error)]
+	update         method[RBACEndpointPermissionServiceUpdateCall, rbacEndpointPermissionServiceUpdateResults, func(context.Context, *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error)]
+	delete         method[RBACEndpointPermissionServiceDeleteCall, rbacEndpointPermissionServiceDeleteResults, func(context.Context, *string, *string, *string) error]
+	listAllForRole method[RBACEndpointPermissionServiceListAllForRoleCall, rbacEndpointPermissionServiceListAllForRoleResults, func(context.Context, *string) ([]*kong.RBACEndpointPermission, error)]
+}
+
+var _ kong.AbstractRBACEndpointPermissionService = &FakeRBACEndpointPermissionService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeRBACEndpointPermissionService) Create(ctx context.Context, ep *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error) {
+	call := f.create.record(RBACEndpointPermissionServiceCreateCall{Ctx: ctx, Ep: ep})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ep)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, ep)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEndpointPermissionService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeRBACEndpointPermissionService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeRBACEndpointPermissionService) CreateCalls() []RBACEndpointPermissionServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeRBACEndpointPermissionService) CreateArgsForCall(i int) RBACEndpointPermissionServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEndpointPermissionService) CreateStub(stub func(context.Context, *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeRBACEndpointPermissionService) CreateReturns(r0 *kong.RBACEndpointPermission, r1 error) {
+	f.create.setReturns(rbacEndpointPermissionServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeRBACEndpointPermissionService) CreateReturnsOnCall(i int, r0 *kong.RBACEndpointPermission, r1 error) {
+	f.create.setReturnsOnCall(i, rbacEndpointPermissionServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeRBACEndpointPermissionService) Get(ctx context.Context, roleNameOrID *string, workspaceNameOrID *string, endpointName *string) (*kong.RBACEndpointPermission, error) {
+	call := f.get.record(RBACEndpointPermissionServiceGetCall{Ctx: ctx, RoleNameOrID: roleNameOrID, WorkspaceNameOrID: workspaceNameOrID, EndpointName: endpointName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID, workspaceNameOrID, endpointName)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, roleNameOrID, workspaceNameOrID, endpointName)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEndpointPermissionService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeRBACEndpointPermissionService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeRBACEndpointPermissionService) GetCalls() []RBACEndpointPermissionServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeRBACEndpointPermissionService) GetArgsForCall(i int) RBACEndpointPermissionServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEndpointPermissionService) GetStub(stub func(context.Context, *string, *string, *string) (*kong.RBACEndpointPermission, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeRBACEndpointPermissionService) GetReturns(r0 *kong.RBACEndpointPermission, r1 error) {
+	f.get.setReturns(rbacEndpointPermissionServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeRBACEndpointPermissionService) GetReturnsOnCall(i int, r0 *kong.RBACEndpointPermission, r1 error) {
+	f.get.setReturnsOnCall(i, rbacEndpointPermissionServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeRBACEndpointPermissionService) Update(ctx context.Context, ep *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error) {
+	call := f.update.record(RBACEndpointPermissionServiceUpdateCall{Ctx: ctx, Ep: ep})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ep)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, ep)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEndpointPermissionService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeRBACEndpointPermissionService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeRBACEndpointPermissionService) UpdateCalls() []RBACEndpointPermissionServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeRBACEndpointPermissionService) UpdateArgsForCall(i int) RBACEndpointPermissionServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEndpointPermissionService) UpdateStub(stub func(context.Context, *kong.RBACEndpointPermission) (*kong.RBACEndpointPermission, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeRBACEndpointPermissionService) UpdateReturns(r0 *kong.RBACEndpointPermission, r1 error) {
+	f.update.setReturns(rbacEndpointPermissionServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeRBACEndpointPermissionService) UpdateReturnsOnCall(i int, r0 *kong.RBACEndpointPermission, r1 error) {
+	f.update.setReturnsOnCall(i, rbacEndpointPermissionServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeRBACEndpointPermissionService) Delete(ctx context.Context, roleNameOrID *string, workspaceNameOrID *string, endpoint *string) error {
+	call := f.delete.record(RBACEndpointPermissionServiceDeleteCall{Ctx: ctx, RoleNameOrID: roleNameOrID, WorkspaceNameOrID: workspaceNameOrID, Endpoint: endpoint})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID, workspaceNameOrID, endpoint)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, roleNameOrID, workspaceNameOrID, endpoint)
+	}
+	return notProgrammed("FakeRBACEndpointPermissionService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeRBACEndpointPermissionService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeRBACEndpointPermissionService) DeleteCalls() []RBACEndpointPermissionServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeRBACEndpointPermissionService) DeleteArgsForCall(i int) RBACEndpointPermissionServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEndpointPermissionService) DeleteStub(stub func(context.Context, *string, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeRBACEndpointPermissionService) DeleteReturns(r0 error) {
+	f.delete.setReturns(rbacEndpointPermissionServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeRBACEndpointPermissionService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, rbacEndpointPermissionServiceDeleteResults{r0: r0})
+}
+
+// ListAllForRole records the call and returns the programmed results.
+func (f *FakeRBACEndpointPermissionService) ListAllForRole(ctx context.Context, roleNameOrID *string) ([]*kong.RBACEndpointPermission, error) {
+	call := f.listAllForRole.record(RBACEndpointPermissionServiceListAllForRoleCall{Ctx: ctx, RoleNameOrID: roleNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForRole(ctx, roleNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEndpointPermissionService.ListAllForRole")
+}
+
+// ListAllForRoleCallCount returns the number of calls to ListAllForRole.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleCallCount() int {
+	return f.listAllForRole.callCount()
+}
+
+// ListAllForRoleCalls returns the arguments of the calls to ListAllForRole.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleCalls() []RBACEndpointPermissionServiceListAllForRoleCall {
+	return f.listAllForRole.allCalls()
+}
+
+// ListAllForRoleArgsForCall returns the arguments of the i-th call to ListAllForRole.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleArgsForCall(i int) RBACEndpointPermissionServiceListAllForRoleCall {
+	return f.listAllForRole.argsForCall(i)
+}
+
+// ListAllForRoleStub makes ListAllForRole delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleStub(stub func(context.Context, *string) ([]*kong.RBACEndpointPermission, error)) {
+	f.listAllForRole.setStub(stub, stub != nil)
+}
+
+// ListAllForRoleReturns makes ListAllForRole return the given values.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleReturns(r0 []*kong.RBACEndpointPermission, r1 error) {
+	f.listAllForRole.setReturns(rbacEndpointPermissionServiceListAllForRoleResults{r0: r0, r1: r1})
+}
+
+// ListAllForRoleReturnsOnCall makes the i-th call to ListAllForRole return the given values.
+func (f *FakeRBACEndpointPermissionService) ListAllForRoleReturnsOnCall(i int, r0 []*kong.RBACEndpointPermission, r1 error) {
+	f.listAllForRole.setReturnsOnCall(i, rbacEndpointPermissionServiceListAllForRoleResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeRBACEndpointPermissionService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.listAllForRole.reset()
+}
+
+// RBACEntityPermissionServiceCreateCall holds the arguments of a call to FakeRBACEntityPermissionService.Create.
+type RBACEntityPermissionServiceCreateCall struct {
+	Ctx context.Context
+	Ep  *kong.RBACEntityPermission
+}
+
+type rbacEntityPermissionServiceCreateResults struct {
+	r0 *kong.RBACEntityPermission
+	r1 error
+}
+
+// RBACEntityPermissionServiceGetCall holds the arguments of a call to FakeRBACEntityPermissionService.Get.
+type RBACEntityPermissionServiceGetCall struct {
+	Ctx          context.Context
+	RoleNameOrID *string
+	EntityName   *string
+}
+
+type rbacEntityPermissionServiceGetResults struct {
+	r0 *kong.RBACEntityPermission
+	r1 error
+}
+
+// RBACEntityPermissionServiceUpdateCall holds the arguments of a call to FakeRBACEntityPermissionService.Update.
+type RBACEntityPermissionServiceUpdateCall struct {
+	Ctx context.Context
+	Ep  *kong.RBACEntityPermission
+}
+
+type rbacEntityPermissionServiceUpdateResults struct {
+	r0 *kong.RBACEntityPermission
+	r1 error
+}
+
+// RBACEntityPermissionServiceDeleteCall holds the arguments of a call to FakeRBACEntityPermissionService.Delete.
+type RBACEntityPermissionServiceDeleteCall struct {
+	Ctx          context.Context
+	RoleNameOrID *string
+	EntityID     *string
+}
+
+type rbacEntityPermissionServiceDeleteResults struct {
+	r0 error
+}
+
+// RBACEntityPermissionServiceListAllForRoleCall holds the arguments of a call to FakeRBACEntityPermissionService.ListAllForRole.
+type RBACEntityPermissionServiceListAllForRoleCall struct {
+	Ctx          context.Context
+	RoleNameOrID *string
+}
+
+type rbacEntityPermissionServiceListAllForRoleResults struct {
+	r0 []*kong.RBACEntityPermission
+	r1 error
+}
+
+// FakeRBACEntityPermissionService is a fake implementation of kong.AbstractRBACEntityPermissionService.
+type FakeRBACEntityPermissionService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractRBACEntityPermissionService
+
+	create         method[RBACEntityPermissionServiceCreateCall, rbacEntityPermissionServiceCreateResults, func(context.Context, *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error)]
+	get            method[RBACEntityPermissionServiceGetCall, rbacEntityPermissionServiceGetResults, func(context.Context, *string, *string) (*kong.RBACEntityPermission, error)]
+	update         method[RBACEntityPermissionServiceUpdateCall, rbacEntityPermissionServiceUpdateResults, func(context.Context, *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error)]
+	delete         method[RBACEntityPermissionServiceDeleteCall, rbacEntityPermissionServiceDeleteResults, func(context.Context, *string, *string) error]
+	listAllForRole method[RBACEntityPermissionServiceListAllForRoleCall, rbacEntityPermissionServiceListAllForRoleResults, func(context.Context, *string) ([]*kong.RBACEntityPermission, error)]
+}
+
+var _ kong.AbstractRBACEntityPermissionService = &FakeRBACEntityPermissionService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeRBACEntityPermissionService) Create(ctx context.Context, ep *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error) {
+	call := f.create.record(RBACEntityPermissionServiceCreateCall{Ctx: ctx, Ep: ep})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ep)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, ep)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEntityPermissionService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeRBACEntityPermissionService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeRBACEntityPermissionService) CreateCalls() []RBACEntityPermissionServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeRBACEntityPermissionService) CreateArgsForCall(i int) RBACEntityPermissionServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEntityPermissionService) CreateStub(stub func(context.Context, *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeRBACEntityPermissionService) CreateReturns(r0 *kong.RBACEntityPermission, r1 error) {
+	f.create.setReturns(rbacEntityPermissionServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeRBACEntityPermissionService) CreateReturnsOnCall(i int, r0 *kong.RBACEntityPermission, r1 error) {
+	f.create.setReturnsOnCall(i, rbacEntityPermissionServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeRBACEntityPermissionService) Get(ctx context.Context, roleNameOrID *string, entityName *string) (*kong.RBACEntityPermission, error) {
+	call := f.get.record(RBACEntityPermissionServiceGetCall{Ctx: ctx, RoleNameOrID: roleNameOrID, EntityName: entityName})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID, entityName)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, roleNameOrID, entityName)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEntityPermissionService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeRBACEntityPermissionService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeRBACEntityPermissionService) GetCalls() []RBACEntityPermissionServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeRBACEntityPermissionService) GetArgsForCall(i int) RBACEntityPermissionServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEntityPermissionService) GetStub(stub func(context.Context, *string, *string) (*kong.RBACEntityPermission, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeRBACEntityPermissionService) GetReturns(r0 *kong.RBACEntityPermission, r1 error) {
+	f.get.setReturns(rbacEntityPermissionServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeRBACEntityPermissionService) GetReturnsOnCall(i int, r0 *kong.RBACEntityPermission, r1 error) {
+	f.get.setReturnsOnCall(i, rbacEntityPermissionServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeRBACEntityPermissionService) Update(ctx context.Context, ep *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error) {
+	call := f.update.record(RBACEntityPermissionServiceUpdateCall{Ctx: ctx, Ep: ep})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, ep)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, ep)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEntityPermissionService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeRBACEntityPermissionService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeRBACEntityPermissionService) UpdateCalls() []RBACEntityPermissionServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeRBACEntityPermissionService) UpdateArgsForCall(i int) RBACEntityPermissionServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEntityPermissionService) UpdateStub(stub func(context.Context, *kong.RBACEntityPermission) (*kong.RBACEntityPermission, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeRBACEntityPermissionService) UpdateReturns(r0 *kong.RBACEntityPermission, r1 error) {
+	f.update.setReturns(rbacEntityPermissionServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeRBACEntityPermissionService) UpdateReturnsOnCall(i int, r0 *kong.RBACEntityPermission, r1 error) {
+	f.update.setReturnsOnCall(i, rbacEntityPermissionServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeRBACEntityPermissionService) Delete(ctx context.Context, roleNameOrID *string, entityID *string) error {
+	call := f.delete.record(RBACEntityPermissionServiceDeleteCall{Ctx: ctx, RoleNameOrID: roleNameOrID, EntityID: entityID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID, entityID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, roleNameOrID, entityID)
+	}
+	return notProgrammed("FakeRBACEntityPermissionService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeRBACEntityPermissionService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeRBACEntityPermissionService) DeleteCalls() []RBACEntityPermissionServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeRBACEntityPermissionService) DeleteArgsForCall(i int) RBACEntityPermissionServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEntityPermissionService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeRBACEntityPermissionService) DeleteReturns(r0 error) {
+	f.delete.setReturns(rbacEntityPermissionServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeRBACEntityPermissionService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, rbacEntityPermissionServiceDeleteResults{r0: r0})
+}
+
+// ListAllForRole records the call and returns the programmed results.
+func (f *FakeRBACEntityPermissionService) ListAllForRole(ctx context.Context, roleNameOrID *string) ([]*kong.RBACEntityPermission, error) {
+	call := f.listAllForRole.record(RBACEntityPermissionServiceListAllForRoleCall{Ctx: ctx, RoleNameOrID: roleNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, roleNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllForRole(ctx, roleNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACEntityPermissionService.ListAllForRole")
+}
+
+// ListAllForRoleCallCount returns the number of calls to ListAllForRole.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleCallCount() int {
+	return f.listAllForRole.callCount()
+}
+
+// ListAllForRoleCalls returns the arguments of the calls to ListAllForRole.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleCalls() []RBACEntityPermissionServiceListAllForRoleCall {
+	return f.listAllForRole.allCalls()
+}
+
+// ListAllForRoleArgsForCall returns the arguments of the i-th call to ListAllForRole.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleArgsForCall(i int) RBACEntityPermissionServiceListAllForRoleCall {
+	return f.listAllForRole.argsForCall(i)
+}
+
+// ListAllForRoleStub makes ListAllForRole delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleStub(stub func(context.Context, *string) ([]*kong.RBACEntityPermission, error)) {
+	f.listAllForRole.setStub(stub, stub != nil)
+}
+
+// ListAllForRoleReturns makes ListAllForRole return the given values.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleReturns(r0 []*kong.RBACEntityPermission, r1 error) {
+	f.listAllForRole.setReturns(rbacEntityPermissionServiceListAllForRoleResults{r0: r0, r1: r1})
+}
+
+// ListAllForRoleReturnsOnCall makes the i-th call to ListAllForRole return the given values.
+func (f *FakeRBACEntityPermissionService) ListAllForRoleReturnsOnCall(i int, r0 []*kong.RBACEntityPermission, r1 error) {
+	f.listAllForRole.setReturnsOnCall(i, rbacEntityPermissionServiceListAllForRoleResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeRBACEntityPermissionService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.listAllForRole.reset()
+}
+
+// RBACRoleServiceCreateCall holds the arguments of a call to FakeRBACRoleService.Create.
+type RBACRoleServiceCreateCall struct {
+	Ctx  context.Context
+	Role *kong.RBACRole
+}
+
+type rbacRoleServiceCreateResults struct {
+	r0 *kong.RBACRole
+	r1 error
+}
+
+// RBACRoleServiceGetCall holds the arguments of a call to FakeRBACRoleService.Get.
+type RBACRoleServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type rbacRoleServiceGetResults struct {
+	r0 *kong.RBACRole
+	r1 error
+}
+
+// RBACRoleServiceUpdateCall holds the arguments of a call to FakeRBACRoleService.Update.
+type RBACRoleServiceUpdateCall struct {
+	Ctx  context.Context
+	Role *kong.RBACRole
+}
+
+type rbacRoleServiceUpdateResults struct {
+	r0 *kong.RBACRole
+	r1 error
+}
+
+// RBACRoleServiceDeleteCall holds the arguments of a call to FakeRBACRoleService.Delete.
+type RBACRoleServiceDeleteCall struct {
+	Ctx      context.Context
+	RoleOrID *string
+}
+
+type rbacRoleServiceDeleteResults struct {
+	r0 error
+}
+
+// RBACRoleServiceListCall holds the arguments of a call to FakeRBACRoleService.List.
+type RBACRoleServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type rbacRoleServiceListResults struct {
+	r0 []*kong.RBACRole
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// RBACRoleServiceListAllCall holds the arguments of a call to FakeRBACRoleService.ListAll.
+type RBACRoleServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type rbacRoleServiceListAllResults struct {
+	r0 []*kong.RBACRole
+	r1 error
+}
+
+// FakeRBACRoleService is a fake implementation of kong.AbstractRBACRoleService.
+type FakeRBACRoleService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractRBACRoleService
+
+	create  method[RBACRoleServiceCreateCall, rbacRoleServiceCreateResults, func(context.Context, *kong.RBACRole) (*kong.RBACRole, error)]
+	get     method[RBACRoleServiceGetCall, rbacRoleServiceGetResults, func(context.Context, *string) (*kong.RBACRole, error)]
+	update  method[RBACRoleServiceUpdateCall, rbacRoleServiceUpdateResults, func(context.Context, *kong.RBACRole) (*kong.RBACRole, error)]
+	delete  method[RBACRoleServiceDeleteCall, rbacRoleServiceDeleteResults, func(context.Context, *string) error]
+	list    method[RBACRoleServiceListCall, rbacRoleServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.RBACRole, *kong.ListOpt, error)]
+	listAll method[RBACRoleServiceListAllCall, rbacRoleServiceListAllResults, func(context.Context) ([]*kong.RBACRole, error)]
+}
+
+var _ kong.AbstractRBACRoleService = &FakeRBACRoleService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeRBACRoleService) Create(ctx context.Context, role *kong.RBACRole) (*kong.RBACRole, error) {
+	call := f.create.record(RBACRoleServiceCreateCall{Ctx: ctx, Role: role})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, role)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, role)
+	}
+	return call.results.r0, notProgrammed("FakeRBACRoleService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeRBACRoleService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeRBACRoleService) CreateCalls() []RBACRoleServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeRBACRoleService) CreateArgsForCall(i int) RBACRoleServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) CreateStub(stub func(context.Context, *kong.RBACRole) (*kong.RBACRole, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeRBACRoleService) CreateReturns(r0 *kong.RBACRole, r1 error) {
+	f.create.setReturns(rbacRoleServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeRBACRoleService) CreateReturnsOnCall(i int, r0 *kong.RBACRole, r1 error) {
+	f.create.setReturnsOnCall(i, rbacRoleServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeRBACRoleService) Get(ctx context.Context, nameOrID *string) (*kong.RBACRole, error) {
+	call := f.get.record(RBACRoleServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACRoleService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeRBACRoleService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeRBACRoleService) GetCalls() []RBACRoleServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeRBACRoleService) GetArgsForCall(i int) RBACRoleServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) GetStub(stub func(context.Context, *string) (*kong.RBACRole, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeRBACRoleService) GetReturns(r0 *kong.RBACRole, r1 error) {
+	f.get.setReturns(rbacRoleServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeRBACRoleService) GetReturnsOnCall(i int, r0 *kong.RBACRole, r1 error) {
+	f.get.setReturnsOnCall(i, rbacRoleServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeRBACRoleService) Update(ctx context.Context, role *kong.RBACRole) (*kong.RBACRole, error) {
+	call := f.update.record(RBACRoleServiceUpdateCall{Ctx: ctx, Role: role})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, role)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, role)
+	}
+	return call.results.r0, notProgrammed("FakeRBACRoleService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeRBACRoleService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeRBACRoleService) UpdateCalls() []RBACRoleServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeRBACRoleService) UpdateArgsForCall(i int) RBACRoleServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) UpdateStub(stub func(context.Context, *kong.RBACRole) (*kong.RBACRole, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeRBACRoleService) UpdateReturns(r0 *kong.RBACRole, r1 error) {
+	f.update.setReturns(rbacRoleServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeRBACRoleService) UpdateReturnsOnCall(i int, r0 *kong.RBACRole, r1 error) {
+	f.update.setReturnsOnCall(i, rbacRoleServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeRBACRoleService) Delete(ctx context.Context, RoleOrID *string) error {
+	call := f.delete.record(RBACRoleServiceDeleteCall{Ctx: ctx, RoleOrID: RoleOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, RoleOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, RoleOrID)
+	}
+	return notProgrammed("FakeRBACRoleService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeRBACRoleService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeRBACRoleService) DeleteCalls() []RBACRoleServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeRBACRoleService) DeleteArgsForCall(i int) RBACRoleServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeRBACRoleService) DeleteReturns(r0 error) {
+	f.delete.setReturns(rbacRoleServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeRBACRoleService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, rbacRoleServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeRBACRoleService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.RBACRole, *kong.ListOpt, error) {
+	call := f.list.record(RBACRoleServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeRBACRoleService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeRBACRoleService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeRBACRoleService) ListCalls() []RBACRoleServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeRBACRoleService) ListArgsForCall(i int) RBACRoleServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.RBACRole, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeRBACRoleService) ListReturns(r0 []*kong.RBACRole, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(rbacRoleServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeRBACRoleService) ListReturnsOnCall(i int, r0 []*kong.RBACRole, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, rbacRoleServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeRBACRoleService) ListAll(ctx context.Context) ([]*kong.RBACRole, error) {
+	call := f.listAll.record(RBACRoleServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeRBACRoleService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeRBACRoleService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeRBACRoleService) ListAllCalls() []RBACRoleServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeRBACRoleService) ListAllArgsForCall(i int) RBACRoleServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACRoleService) ListAllStub(stub func(context.Context) ([]*kong.RBACRole, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeRBACRoleService) ListAllReturns(r0 []*kong.RBACRole, r1 error) {
+	f.listAll.setReturns(rbacRoleServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeRBACRoleService) ListAllReturnsOnCall(i int, r0 []*kong.RBACRole, r1 error) {
+	f.listAll.setReturnsOnCall(i, rbacRoleServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeRBACRoleService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// RBACUserServiceCreateCall holds the arguments of a call to FakeRBACUserService.Create.
+type RBACUserServiceCreateCall struct {
+	Ctx  context.Context
+	User *kong.RBACUser
+}
+
+type rbacUserServiceCreateResults struct {
+	r0 *kong.RBACUser
+	r1 error
+}
+
+// RBACUserServiceGetCall holds the arguments of a call to FakeRBACUserService.Get.
+type RBACUserServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type rbacUserServiceGetResults struct {
+	r0 *kong.RBACUser
+	r1 error
+}
+
+// RBACUserServiceUpdateCall holds the arguments of a call to FakeRBACUserService.Update.
+type RBACUserServiceUpdateCall struct {
+	Ctx  context.Context
+	User *kong.RBACUser
+}
+
+type rbacUserServiceUpdateResults struct {
+	r0 *kong.RBACUser
+	r1 error
+}
+
+// RBACUserServiceDeleteCall holds the arguments of a call to FakeRBACUserService.Delete.
+type RBACUserServiceDeleteCall struct {
+	Ctx      context.Context
+	UserOrID *string
+}
+
+type rbacUserServiceDeleteResults struct {
+	r0 error
+}
+
+// RBACUserServiceListCall holds the arguments of a call to FakeRBACUserService.List.
+type RBACUserServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type rbacUserServiceListResults struct {
+	r0 []*kong.RBACUser
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// RBACUserServiceListAllCall holds the arguments of a call to FakeRBACUserService.ListAll.
+type RBACUserServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type rbacUserServiceListAllResults struct {
+	r0 []*kong.RBACUser
+	r1 error
+}
+
+// RBACUserServiceAddRolesCall holds the arguments of a call to FakeRBACUserService.AddRoles.
+type RBACUserServiceAddRolesCall struct {
+	Ctx      context.Context
+	NameOrID *string
+	Roles    []*kong.RBACRole
+}
+
+type rbacUserServiceAddRolesResults struct {
+	r0 []*kong.RBACRole
+	r1 error
+}
+
+// RBACUserServiceDeleteRolesCall holds the arguments of a call to FakeRBACUserService.DeleteRoles.
+type RBACUserServiceDeleteRolesCall struct {
+	Ctx      context.Context
+	NameOrID *string
+	Roles    []*kong.RBACRole
+}
+
+type rbacUserServiceDeleteRolesResults struct {
+	r0 error
+}
+
+// RBACUserServiceListRolesCall holds the arguments of a call to FakeRBACUserService.ListRoles.
+type RBACUserServiceListRolesCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type rbacUserServiceListRolesResults struct {
+	r0 []*kong.RBACRole
+	r1 error
+}
+
+// RBACUserServiceListPermissionsCall holds the arguments of a call to FakeRBACUserService.ListPermissions.
+type RBACUserServiceListPermissionsCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type rbacUserServiceListPermissionsResults struct {
+	r0 *kong.RBACPermissionsList
+	r1 error
+}
+
+// FakeRBACUserService is a fake implementation of kong.AbstractRBACUserService.
+type FakeRBACUserService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractRBACUserService
+
+	create          method[RBACUserServiceCreateCall, rbacUserServiceCreateResults, func(context.Context, *kong.RBACUser) (*kong.RBACUser, error)]
+	get             method[RBACUserServiceGetCall, rbacUserServiceGetResults, func(context.Context, *string) (*kong.RBACUser, error)]
+	update          method[RBACUserServiceUpdateCall, rbacUserServiceUpdateResults, func(context.Context, *kong.RBACUser) (*kong.RBACUser, error)]
+	delete          method[RBACUserServiceDeleteCall, rbacUserServiceDeleteResults, func(context.Context, *string) error]
+	list            method[RBACUserServiceListCall, rbacUserServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.RBACUser, *kong.ListOpt, error)]
+	listAll         method[RBACUserServiceListAllCall, rbacUserServiceListAllResults, func(context.Context) ([]*kong.RBACUser, error)]
+	addRoles        method[RBACUserServiceAddRolesCall, rbacUserServiceAddRolesResults, func(context.Context, *string, []*kong.RBACRole) ([]*kong.RBACRole, error)]
+	deleteRoles     method[RBACUserServiceDeleteRolesCall, rbacUserServiceDeleteRolesResults, func(context.Context, *string, []*kong.RBACRole) error]
+	listRoles       method[RBACUserServiceListRolesCall, rbacUserServiceListRolesResults, func(context.Context, *string) ([]*kong.RBACRole, error)]
+	listPermissions method[RBACUserServiceListPermissionsCall, rbacUserServiceListPermissionsResults, func(context.Context, *string) (*kong.RBACPermissionsList, error)]
+}
+
+var _ kong.AbstractRBACUserService = &FakeRBACUserService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeRBACUserService) Create(ctx context.Context, user *kong.RBACUser) (*kong.RBACUser, error) {
+	call := f.create.record(RBACUserServiceCreateCall{Ctx: ctx, User: user})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, user)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, user)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeRBACUserService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeRBACUserService) CreateCalls() []RBACUserServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeRBACUserService) CreateArgsForCall(i int) RBACUserServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) CreateStub(stub func(context.Context, *kong.RBACUser) (*kong.RBACUser, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeRBACUserService) CreateReturns(r0 *kong.RBACUser, r1 error) {
+	f.create.setReturns(rbacUserServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeRBACUserService) CreateReturnsOnCall(i int, r0 *kong.RBACUser, r1 error) {
+	f.create.setReturnsOnCall(i, rbacUserServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeRBACUserService) Get(ctx context.Context, nameOrID *string) (*kong.RBACUser, error) {
+	call := f.get.record(RBACUserServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeRBACUserService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeRBACUserService) GetCalls() []RBACUserServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeRBACUserService) GetArgsForCall(i int) RBACUserServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) GetStub(stub func(context.Context, *string) (*kong.RBACUser, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeRBACUserService) GetReturns(r0 *kong.RBACUser, r1 error) {
+	f.get.setReturns(rbacUserServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeRBACUserService) GetReturnsOnCall(i int, r0 *kong.RBACUser, r1 error) {
+	f.get.setReturnsOnCall(i, rbacUserServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeRBACUserService) Update(ctx context.Context, user *kong.RBACUser) (*kong.RBACUser, error) {
+	call := f.update.record(RBACUserServiceUpdateCall{Ctx: ctx, User: user})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, user)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, user)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeRBACUserService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeRBACUserService) UpdateCalls() []RBACUserServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeRBACUserService) UpdateArgsForCall(i int) RBACUserServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) UpdateStub(stub func(context.Context, *kong.RBACUser) (*kong.RBACUser, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeRBACUserService) UpdateReturns(r0 *kong.RBACUser, r1 error) {
+	f.update.setReturns(rbacUserServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeRBACUserService) UpdateReturnsOnCall(i int, r0 *kong.RBACUser, r1 error) {
+	f.update.setReturnsOnCall(i, rbacUserServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeRBACUserService) Delete(ctx context.Context, userOrID *string) error {
+	call := f.delete.record(RBACUserServiceDeleteCall{Ctx: ctx, UserOrID: userOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, userOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, userOrID)
+	}
+	return notProgrammed("FakeRBACUserService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeRBACUserService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeRBACUserService) DeleteCalls() []RBACUserServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeRBACUserService) DeleteArgsForCall(i int) RBACUserServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeRBACUserService) DeleteReturns(r0 error) {
+	f.delete.setReturns(rbacUserServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeRBACUserService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, rbacUserServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeRBACUserService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.RBACUser, *kong.ListOpt, error) {
+	call := f.list.record(RBACUserServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeRBACUserService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeRBACUserService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeRBACUserService) ListCalls() []RBACUserServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeRBACUserService) ListArgsForCall(i int) RBACUserServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.RBACUser, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeRBACUserService) ListReturns(r0 []*kong.RBACUser, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(rbacUserServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeRBACUserService) ListReturnsOnCall(i int, r0 []*kong.RBACUser, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, rbacUserServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeRBACUserService) ListAll(ctx context.Context) ([]*kong.RBACUser, error) {
+	call := f.listAll.record(RBACUserServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeRBACUserService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeRBACUserService) ListAllCalls() []RBACUserServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeRBACUserService) ListAllArgsForCall(i int) RBACUserServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) ListAllStub(stub func(context.Context) ([]*kong.RBACUser, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeRBACUserService) ListAllReturns(r0 []*kong.RBACUser, r1 error) {
+	f.listAll.setReturns(rbacUserServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeRBACUserService) ListAllReturnsOnCall(i int, r0 []*kong.RBACUser, r1 error) {
+	f.listAll.setReturnsOnCall(i, rbacUserServiceListAllResults{r0: r0, r1: r1})
+}
+
+// AddRoles records the call and returns the programmed results.
+func (f *FakeRBACUserService) AddRoles(ctx context.Context, nameOrID *string, roles []*kong.RBACRole) ([]*kong.RBACRole, error) {
+	call := f.addRoles.record(RBACUserServiceAddRolesCall{Ctx: ctx, NameOrID: nameOrID, Roles: roles})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID, roles)
+	case f.Fallback != nil:
+		return f.Fallback.AddRoles(ctx, nameOrID, roles)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.AddRoles")
+}
+
+// AddRolesCallCount returns the number of calls to AddRoles.
+func (f *FakeRBACUserService) AddRolesCallCount() int {
+	return f.addRoles.callCount()
+}
+
+// AddRolesCalls returns the arguments of the calls to AddRoles.
+func (f *FakeRBACUserService) AddRolesCalls() []RBACUserServiceAddRolesCall {
+	return f.addRoles.allCalls()
+}
+
+// AddRolesArgsForCall returns the arguments of the i-th call to AddRoles.
+func (f *FakeRBACUserService) AddRolesArgsForCall(i int) RBACUserServiceAddRolesCall {
+	return f.addRoles.argsForCall(i)
+}
+
+// AddRolesStub makes AddRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) AddRolesStub(stub func(context.Context, *string, []*kong.RBACRole) ([]*kong.RBACRole, error)) {
+	f.addRoles.setStub(stub, stub != nil)
+}
+
+// AddRolesReturns makes AddRoles return the given values.
+func (f *FakeRBACUserService) AddRolesReturns(r0 []*kong.RBACRole, r1 error) {
+	f.addRoles.setReturns(rbacUserServiceAddRolesResults{r0: r0, r1: r1})
+}
+
+// AddRolesReturnsOnCall makes the i-th call to AddRoles return the given values.
+func (f *FakeRBACUserService) AddRolesReturnsOnCall(i int, r0 []*kong.RBACRole, r1 error) {
+	f.addRoles.setReturnsOnCall(i, rbacUserServiceAddRolesResults{r0: r0, r1: r1})
+}
+
+// DeleteRoles records the call and returns the programmed results.
+func (f *FakeRBACUserService) DeleteRoles(ctx context.Context, nameOrID *string, roles []*kong.RBACRole) error {
+	call := f.deleteRoles.record(RBACUserServiceDeleteRolesCall{Ctx: ctx, NameOrID: nameOrID, Roles: roles})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID, roles)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteRoles(ctx, nameOrID, roles)
+	}
+	return notProgrammed("FakeRBACUserService.DeleteRoles")
+}
+
+// DeleteRolesCallCount returns the number of calls to DeleteRoles.
+func (f *FakeRBACUserService) DeleteRolesCallCount() int {
+	return f.deleteRoles.callCount()
+}
+
+// DeleteRolesCalls returns the arguments of the calls to DeleteRoles.
+func (f *FakeRBACUserService) DeleteRolesCalls() []RBACUserServiceDeleteRolesCall {
+	return f.deleteRoles.allCalls()
+}
+
+// DeleteRolesArgsForCall returns the arguments of the i-th call to DeleteRoles.
+func (f *FakeRBACUserService) DeleteRolesArgsForCall(i int) RBACUserServiceDeleteRolesCall {
+	return f.deleteRoles.argsForCall(i)
+}
+
+// DeleteRolesStub makes DeleteRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) DeleteRolesStub(stub func(context.Context, *string, []*kong.RBACRole) error) {
+	f.deleteRoles.setStub(stub, stub != nil)
+}
+
+// DeleteRolesReturns makes DeleteRoles return the given values.
+func (f *FakeRBACUserService) DeleteRolesReturns(r0 error) {
+	f.deleteRoles.setReturns(rbacUserServiceDeleteRolesResults{r0: r0})
+}
+
+// DeleteRolesReturnsOnCall makes the i-th call to DeleteRoles return the given values.
+func (f *FakeRBACUserService) DeleteRolesReturnsOnCall(i int, r0 error) {
+	f.deleteRoles.setReturnsOnCall(i, rbacUserServiceDeleteRolesResults{r0: r0})
+}
+
+// ListRoles records the call and returns the programmed results.
+func (f *FakeRBACUserService) ListRoles(ctx context.Context, nameOrID *string) ([]*kong.RBACRole, error) {
+	call := f.listRoles.record(RBACUserServiceListRolesCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListRoles(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.ListRoles")
+}
+
+// ListRolesCallCount returns the number of calls to ListRoles.
+func (f *FakeRBACUserService) ListRolesCallCount() int {
+	return f.listRoles.callCount()
+}
+
+// ListRolesCalls returns the arguments of the calls to ListRoles.
+func (f *FakeRBACUserService) ListRolesCalls() []RBACUserServiceListRolesCall {
+	return f.listRoles.allCalls()
+}
+
+// ListRolesArgsForCall returns the arguments of the i-th call to ListRoles.
+func (f *FakeRBACUserService) ListRolesArgsForCall(i int) RBACUserServiceListRolesCall {
+	return f.listRoles.argsForCall(i)
+}
+
+// ListRolesStub makes ListRoles delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) ListRolesStub(stub func(context.Context, *string) ([]*kong.RBACRole, error)) {
+	f.listRoles.setStub(stub, stub != nil)
+}
+
+// ListRolesReturns makes ListRoles return the given values.
+func (f *FakeRBACUserService) ListRolesReturns(r0 []*kong.RBACRole, r1 error) {
+	f.listRoles.setReturns(rbacUserServiceListRolesResults{r0: r0, r1: r1})
+}
+
+// ListRolesReturnsOnCall makes the i-th call to ListRoles return the given values.
+func (f *FakeRBACUserService) ListRolesReturnsOnCall(i int, r0 []*kong.RBACRole, r1 error) {
+	f.listRoles.setReturnsOnCall(i, rbacUserServiceListRolesResults{r0: r0, r1: r1})
+}
+
+// ListPermissions records the call and returns the programmed results.
+func (f *FakeRBACUserService) ListPermissions(ctx context.Context, nameOrID *string) (*kong.RBACPermissionsList, error) {
+	call := f.listPermissions.record(RBACUserServiceListPermissionsCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListPermissions(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRBACUserService.ListPermissions")
+}
+
+// ListPermissionsCallCount returns the number of calls to ListPermissions.
+func (f *FakeRBACUserService) ListPermissionsCallCount() int {
+	return f.listPermissions.callCount()
+}
+
+// ListPermissionsCalls returns the arguments of the calls to ListPermissions.
+func (f *FakeRBACUserService) ListPermissionsCalls() []RBACUserServiceListPermissionsCall {
+	return f.listPermissions.allCalls()
+}
+
+// ListPermissionsArgsForCall returns the arguments of the i-th call to ListPermissions.
+func (f *FakeRBACUserService) ListPermissionsArgsForCall(i int) RBACUserServiceListPermissionsCall {
+	return f.listPermissions.argsForCall(i)
+}
+
+// ListPermissionsStub makes ListPermissions delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRBACUserService) ListPermissionsStub(stub func(context.Context, *string) (*kong.RBACPermissionsList, error)) {
+	f.listPermissions.setStub(stub, stub != nil)
+}
+
+// ListPermissionsReturns makes ListPermissions return the given values.
+func (f *FakeRBACUserService) ListPermissionsReturns(r0 *kong.RBACPermissionsList, r1 error) {
+	f.listPermissions.setReturns(rbacUserServiceListPermissionsResults{r0: r0, r1: r1})
+}
+
+// ListPermissionsReturnsOnCall makes the i-th call to ListPermissions return the given values.
+func (f *FakeRBACUserService) ListPermissionsReturnsOnCall(i int, r0 *kong.RBACPermissionsList, r1 error) {
+	f.listPermissions.setReturnsOnCall(i, rbacUserServiceListPermissionsResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeRBACUserService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.addRoles.reset()
+	f.deleteRoles.reset()
+	f.listRoles.reset()
+	f.listPermissions.reset()
+}
+
+// RouteServiceCreateCall holds the arguments of a call to FakeRouteService.Create.
+type RouteServiceCreateCall struct {
+	Ctx   context.Context
+	Route *kong.Route
+}
+
+type routeServiceCreateResults struct {
+	r0 *kong.Route
+	r1 error
+}
+
+// RouteServiceCreateInServiceCall holds the arguments of a call to FakeRouteService.CreateInService.
+type RouteServiceCreateInServiceCall struct {
+	Ctx       context.Context
+	ServiceID *string
+	Route     *kong.Route
+}
+
+type routeServiceCreateInServiceResults struct {
+	r0 *kong.Route
+	r1 error
+}
+
+// RouteServiceGetCall holds the arguments of a call to FakeRouteService.Get.
+type RouteServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type routeServiceGetResults struct {
+	r0 *kong.Route
+	r1 error
+}
+
+// RouteServiceUpdateCall holds the arguments of a call to FakeRouteService.Update.
+type RouteServiceUpdateCall struct {
+	Ctx   context.Context
+	Route *kong.Route
+}
+
+type routeServiceUpdateResults struct {
+	r0 *kong.Route
+	r1 error
+}
+
+// RouteServiceDeleteCall holds the arguments of a call to FakeRouteService.Delete.
+type RouteServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type routeServiceDeleteResults struct {
+	r0 error
+}
+
+// RouteServiceListCall holds the arguments of a call to FakeRouteService.List.
+type RouteServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type routeServiceListResults struct {
+	r0 []*kong.Route
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// RouteServiceListAllCall holds the arguments of a call to FakeRouteService.ListAll.
+type RouteServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type routeServiceListAllResults struct {
+	r0 []*kong.Route
+	r1 error
+}
+
+// RouteServiceListForServiceCall holds the arguments of a call to FakeRouteService.ListForService.
+type RouteServiceListForServiceCall struct {
+	Ctx             context.Context
+	ServiceNameOrID *string
+	Opt             *kong.ListOpt
+}
+
+type routeServiceListForServiceResults struct {
+	r0 []*kong.Route
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// RouteServiceValidateCall holds the arguments of a call to FakeRouteService.Validate.
+type RouteServiceValidateCall struct {
+	Ctx   context.Context
+	Route *kong.Route
+}
+
+type routeServiceValidateResults struct {
+	r0 bool
+	r1 string
+	r2 error
+}
+
+// FakeRouteService is a fake implementation of kong.AbstractRouteService.
+type FakeRouteService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractRouteService
+
+	create          method[RouteServiceCreateCall, routeServiceCreateResults, func(context.Context, *kong.Route) (*kong.Route, error)]
+	createInService method[RouteServiceCreateInServiceCall, routeServiceCreateInServiceResults, func(context.Context, *string, *kong.Route) (*kong.Route, error)]
+	get             method[RouteServiceGetCall, routeServiceGetResults, func(context.Context, *string) (*kong.Route, error)]
+	update          method[RouteServiceUpdateCall, routeServiceUpdateResults, func(context.Context, *kong.Route) (*kong.Route, error)]
+	delete          method[RouteServiceDeleteCall, routeServiceDeleteResults, func(context.Context, *string) error]
+	list            method[RouteServiceListCall, routeServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error)]
+	listAll         method[RouteServiceListAllCall, routeServiceListAllResults, func(context.Context) ([]*kong.Route, error)]
+	listForService  method[RouteServiceListForServiceCall, routeServiceListForServiceResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error)]
+	validate        method[RouteServiceValidateCall, routeServiceValidateResults, func(context.Context, *kong.Route) (bool, string, error)]
+}
+
+var _ kong.AbstractRouteService = &FakeRouteService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeRouteService) Create(ctx context.Context, route *kong.Route) (*kong.Route, error) {
+	call := f.create.record(RouteServiceCreateCall{Ctx: ctx, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, route)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, route)
+	}
+	return call.results.r0, notProgrammed("FakeRouteService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeRouteService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeRouteService) CreateCalls() []RouteServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeRouteService) CreateArgsForCall(i int) RouteServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) CreateStub(stub func(context.Context, *kong.Route) (*kong.Route, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeRouteService) CreateReturns(r0 *kong.Route, r1 error) {
+	f.create.setReturns(routeServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeRouteService) CreateReturnsOnCall(i int, r0 *kong.Route, r1 error) {
+	f.create.setReturnsOnCall(i, routeServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateInService records the call and returns the programmed results.
+func (f *FakeRouteService) CreateInService(ctx context.Context, serviceID *string, route *kong.Route) (*kong.Route, error) {
+	call := f.createInService.record(RouteServiceCreateInServiceCall{Ctx: ctx, ServiceID: serviceID, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, serviceID, route)
+	case f.Fallback != nil:
+		return f.Fallback.CreateInService(ctx, serviceID, route)
+	}
+	return call.results.r0, notProgrammed("FakeRouteService.CreateInService")
+}
+
+// CreateInServiceCallCount returns the number of calls to CreateInService.
+func (f *FakeRouteService) CreateInServiceCallCount() int {
+	return f.createInService.callCount()
+}
+
+// CreateInServiceCalls returns the arguments of the calls to CreateInService.
+func (f *FakeRouteService) CreateInServiceCalls() []RouteServiceCreateInServiceCall {
+	return f.createInService.allCalls()
+}
+
+// CreateInServiceArgsForCall returns the arguments of the i-th call to CreateInService.
+func (f *FakeRouteService) CreateInServiceArgsForCall(i int) RouteServiceCreateInServiceCall {
+	return f.createInService.argsForCall(i)
+}
+
+// CreateInServiceStub makes CreateInService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) CreateInServiceStub(stub func(context.Context, *string, *kong.Route) (*kong.Route, error)) {
+	f.createInService.setStub(stub, stub != nil)
+}
+
+// CreateInServiceReturns makes CreateInService return the given values.
+func (f *FakeRouteService) CreateInServiceReturns(r0 *kong.Route, r1 error) {
+	f.createInService.setReturns(routeServiceCreateInServiceResults{r0: r0, r1: r1})
+}
+
+// CreateInServiceReturnsOnCall makes the i-th call to CreateInService return the given values.
+func (f *FakeRouteService) CreateInServiceReturnsOnCall(i int, r0 *kong.Route, r1 error) {
+	f.createInService.setReturnsOnCall(i, routeServiceCreateInServiceResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeRouteService) Get(ctx context.Context, nameOrID *string) (*kong.Route, error) {
+	call := f.get.record(RouteServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeRouteService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeRouteService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeRouteService) GetCalls() []RouteServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeRouteService) GetArgsForCall(i int) RouteServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) GetStub(stub func(context.Context, *string) (*kong.Route, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeRouteService) GetReturns(r0 *kong.Route, r1 error) {
+	f.get.setReturns(routeServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeRouteService) GetReturnsOnCall(i int, r0 *kong.Route, r1 error) {
+	f.get.setReturnsOnCall(i, routeServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeRouteService) Update(ctx context.Context, route *kong.Route) (*kong.Route, error) {
+	call := f.update.record(RouteServiceUpdateCall{Ctx: ctx, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, route)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, route)
+	}
+	return call.results.r0, notProgrammed("FakeRouteService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeRouteService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeRouteService) UpdateCalls() []RouteServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeRouteService) UpdateArgsForCall(i int) RouteServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) UpdateStub(stub func(context.Context, *kong.Route) (*kong.Route, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeRouteService) UpdateReturns(r0 *kong.Route, r1 error) {
+	f.update.setReturns(routeServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeRouteService) UpdateReturnsOnCall(i int, r0 *kong.Route, r1 error) {
+	f.update.setReturnsOnCall(i, routeServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeRouteService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(RouteServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeRouteService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeRouteService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeRouteService) DeleteCalls() []RouteServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeRouteService) DeleteArgsForCall(i int) RouteServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeRouteService) DeleteReturns(r0 error) {
+	f.delete.setReturns(routeServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeRouteService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, routeServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeRouteService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error) {
+	call := f.list.record(RouteServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeRouteService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeRouteService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeRouteService) ListCalls() []RouteServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeRouteService) ListArgsForCall(i int) RouteServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeRouteService) ListReturns(r0 []*kong.Route, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(routeServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeRouteService) ListReturnsOnCall(i int, r0 []*kong.Route, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, routeServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeRouteService) ListAll(ctx context.Context) ([]*kong.Route, error) {
+	call := f.listAll.record(RouteServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeRouteService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeRouteService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeRouteService) ListAllCalls() []RouteServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeRouteService) ListAllArgsForCall(i int) RouteServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) ListAllStub(stub func(context.Context) ([]*kong.Route, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeRouteService) ListAllReturns(r0 []*kong.Route, r1 error) {
+	f.listAll.setReturns(routeServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeRouteService) ListAllReturnsOnCall(i int, r0 []*kong.Route, r1 error) {
+	f.listAll.setReturnsOnCall(i, routeServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListForService records the call and returns the programmed results.
+func (f *FakeRouteService) ListForService(ctx context.Context, serviceNameOrID *string, opt *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error) {
+	call := f.listForService.record(RouteServiceListForServiceCall{Ctx: ctx, ServiceNameOrID: serviceNameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, serviceNameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForService(ctx, serviceNameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeRouteService.ListForService")
+}
+
+// ListForServiceCallCount returns the number of calls to ListForService.
+func (f *FakeRouteService) ListForServiceCallCount() int {
+	return f.listForService.callCount()
+}
+
+// ListForServiceCalls returns the arguments of the calls to ListForService.
+func (f *FakeRouteService) ListForServiceCalls() []RouteServiceListForServiceCall {
+	return f.listForService.allCalls()
+}
+
+// ListForServiceArgsForCall returns the arguments of the i-th call to ListForService.
+func (f *FakeRouteService) ListForServiceArgsForCall(i int) RouteServiceListForServiceCall {
+	return f.listForService.argsForCall(i)
+}
+
+// ListForServiceStub makes ListForService delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) ListForServiceStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.Route, *kong.ListOpt, error)) {
+	f.listForService.setStub(stub, stub != nil)
+}
+
+// ListForServiceReturns makes ListForService return the given values.
+func (f *FakeRouteService) ListForServiceReturns(r0 []*kong.Route, r1 *kong.ListOpt, r2 error) {
+	f.listForService.setReturns(routeServiceListForServiceResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForServiceReturnsOnCall makes the i-th call to ListForService return the given values.
+func (f *FakeRouteService) ListForServiceReturnsOnCall(i int, r0 []*kong.Route, r1 *kong.ListOpt, r2 error) {
+	f.listForService.setReturnsOnCall(i, routeServiceListForServiceResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Validate records the call and returns the programmed results.
+func (f *FakeRouteService) Validate(ctx context.Context, route *kong.Route) (bool, string, error) {
+	call := f.validate.record(RouteServiceValidateCall{Ctx: ctx, Route: route})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, route)
+	case f.Fallback != nil:
+		return f.Fallback.Validate(ctx, route)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeRouteService.Validate")
+}
+
+// ValidateCallCount returns the number of calls to Validate.
+func (f *FakeRouteService) ValidateCallCount() int {
+	return f.validate.callCount()
+}
+
+// ValidateCalls returns the arguments of the calls to Validate.
+func (f *FakeRouteService) ValidateCalls() []RouteServiceValidateCall {
+	return f.validate.allCalls()
+}
+
+// ValidateArgsForCall returns the arguments of the i-th call to Validate.
+func (f *FakeRouteService) ValidateArgsForCall(i int) RouteServiceValidateCall {
+	return f.validate.argsForCall(i)
+}
+
+// ValidateStub makes Validate delegate to stub, or stop doing so if stub is nil.
+func (f *FakeRouteService) ValidateStub(stub func(context.Context, *kong.Route) (bool, string, error)) {
+	f.validate.setStub(stub, stub != nil)
+}
+
+// ValidateReturns makes Validate return the given values.
+func (f *FakeRouteService) ValidateReturns(r0 bool, r1 string, r2 error) {
+	f.validate.setReturns(routeServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ValidateReturnsOnCall makes the i-th call to Validate return the given values.
+func (f *FakeRouteService) ValidateReturnsOnCall(i int, r0 bool, r1 string, r2 error) {
+	f.validate.setReturnsOnCall(i, routeServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeRouteService) Reset() {
+	f.create.reset()
+	f.createInService.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.listForService.reset()
+	f.validate.reset()
+}
+
+// SNIServiceCreateCall holds the arguments of a call to FakeSNIService.Create.
+type SNIServiceCreateCall struct {
+	Ctx context.Context
+	Sni *kong.SNI
+}
+
+type sniServiceCreateResults struct {
+	r0 *kong.SNI
+	r1 error
+}
+
+// SNIServiceGetCall holds the arguments of a call to FakeSNIService.Get.
+type SNIServiceGetCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type sniServiceGetResults struct {
+	r0 *kong.SNI
+	r1 error
+}
+
+// SNIServiceUpdateCall holds the arguments of a call to FakeSNIService.Update.
+type SNIServiceUpdateCall struct {
+	Ctx context.Context
+	Sni *kong.SNI
+}
+
+type sniServiceUpdateResults struct {
+	r0 *kong.SNI
+	r1 error
+}
+
+// SNIServiceDeleteCall holds the arguments of a call to FakeSNIService.Delete.
+type SNIServiceDeleteCall struct {
+	Ctx          context.Context
+	UsernameOrID *string
+}
+
+type sniServiceDeleteResults struct {
+	r0 error
+}
+
+// SNIServiceListCall holds the arguments of a call to FakeSNIService.List.
+type SNIServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type sniServiceListResults struct {
+	r0 []*kong.SNI
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// SNIServiceListForCertificateCall holds the arguments of a call to FakeSNIService.ListForCertificate.
+type SNIServiceListForCertificateCall struct {
+	Ctx           context.Context
+	CertificateID *string
+	Opt           *kong.ListOpt
+}
+
+type sniServiceListForCertificateResults struct {
+	r0 []*kong.SNI
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// SNIServiceListAllCall holds the arguments of a call to FakeSNIService.ListAll.
+type SNIServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type sniServiceListAllResults struct {
+	r0 []*kong.SNI
+	r1 error
+}
+
+// FakeSNIService is a fake implementation of kong.AbstractSNIService.
+type FakeSNIService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractSNIService
+
+	create             method[SNIServiceCreateCall, sniServiceCreateResults, func(context.Context, *kong.SNI) (*kong.SNI, error)]
+	get                method[SNIServiceGetCall, sniServiceGetResults, func(context.Context, *string) (*kong.SNI, error)]
+	update             method[SNIServiceUpdateCall, sniServiceUpdateResults, func(context.Context, *kong.SNI) (*kong.SNI, error)]
+	delete             method[SNIServiceDeleteCall, sniServiceDeleteResults, func(context.Context, *string) error]
+	list               method[SNIServiceListCall, sniServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error)]
+	listForCertificate method[SNIServiceListForCertificateCall, sniServiceListForCertificateResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error)]
+	listAll            method[SNIServiceListAllCall, sniServiceListAllResults, func(context.Context) ([]*kong.SNI, error)]
+}
+
+var _ kong.AbstractSNIService = &FakeSNIService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeSNIService) Create(ctx context.Context, sni *kong.SNI) (*kong.SNI, error) {
+	call := f.create.record(SNIServiceCreateCall{Ctx: ctx, Sni: sni})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, sni)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, sni)
+	}
+	return call.results.r0, notProgrammed("FakeSNIService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeSNIService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeSNIService) CreateCalls() []SNIServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeSNIService) CreateArgsForCall(i int) SNIServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) CreateStub(stub func(context.Context, *kong.SNI) (*kong.SNI, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeSNIService) CreateReturns(r0 *kong.SNI, r1 error) {
+	f.create.setReturns(sniServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeSNIService) CreateReturnsOnCall(i int, r0 *kong.SNI, r1 error) {
+	f.create.setReturnsOnCall(i, sniServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeSNIService) Get(ctx context.Context, usernameOrID *string) (*kong.SNI, error) {
+	call := f.get.record(SNIServiceGetCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, usernameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeSNIService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeSNIService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeSNIService) GetCalls() []SNIServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeSNIService) GetArgsForCall(i int) SNIServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) GetStub(stub func(context.Context, *string) (*kong.SNI, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeSNIService) GetReturns(r0 *kong.SNI, r1 error) {
+	f.get.setReturns(sniServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeSNIService) GetReturnsOnCall(i int, r0 *kong.SNI, r1 error) {
+	f.get.setReturnsOnCall(i, sniServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeSNIService) Update(ctx context.Context, sni *kong.SNI) (*kong.SNI, error) {
+	call := f.update.record(SNIServiceUpdateCall{Ctx: ctx, Sni: sni})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, sni)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, sni)
+	}
+	return call.results.r0, notProgrammed("FakeSNIService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeSNIService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeSNIService) UpdateCalls() []SNIServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeSNIService) UpdateArgsForCall(i int) SNIServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) UpdateStub(stub func(context.Context, *kong.SNI) (*kong.SNI, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeSNIService) UpdateReturns(r0 *kong.SNI, r1 error) {
+	f.update.setReturns(sniServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeSNIService) UpdateReturnsOnCall(i int, r0 *kong.SNI, r1 error) {
+	f.update.setReturnsOnCall(i, sniServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeSNIService) Delete(ctx context.Context, usernameOrID *string) error {
+	call := f.delete.record(SNIServiceDeleteCall{Ctx: ctx, UsernameOrID: usernameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, usernameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, usernameOrID)
+	}
+	return notProgrammed("FakeSNIService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeSNIService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeSNIService) DeleteCalls() []SNIServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeSNIService) DeleteArgsForCall(i int) SNIServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeSNIService) DeleteReturns(r0 error) {
+	f.delete.setReturns(sniServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeSNIService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, sniServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeSNIService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error) {
+	call := f.list.record(SNIServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeSNIService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeSNIService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeSNIService) ListCalls() []SNIServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeSNIService) ListArgsForCall(i int) SNIServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeSNIService) ListReturns(r0 []*kong.SNI, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(sniServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeSNIService) ListReturnsOnCall(i int, r0 []*kong.SNI, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, sniServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForCertificate records the call and returns the programmed results.
+func (f *FakeSNIService) ListForCertificate(ctx context.Context, certificateID *string, opt *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error) {
+	call := f.listForCertificate.record(SNIServiceListForCertificateCall{Ctx: ctx, CertificateID: certificateID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, certificateID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListForCertificate(ctx, certificateID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeSNIService.ListForCertificate")
+}
+
+// ListForCertificateCallCount returns the number of calls to ListForCertificate.
+func (f *FakeSNIService) ListForCertificateCallCount() int {
+	return f.listForCertificate.callCount()
+}
+
+// ListForCertificateCalls returns the arguments of the calls to ListForCertificate.
+func (f *FakeSNIService) ListForCertificateCalls() []SNIServiceListForCertificateCall {
+	return f.listForCertificate.allCalls()
+}
+
+// ListForCertificateArgsForCall returns the arguments of the i-th call to ListForCertificate.
+func (f *FakeSNIService) ListForCertificateArgsForCall(i int) SNIServiceListForCertificateCall {
+	return f.listForCertificate.argsForCall(i)
+}
+
+// ListForCertificateStub makes ListForCertificate delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) ListForCertificateStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.SNI, *kong.ListOpt, error)) {
+	f.listForCertificate.setStub(stub, stub != nil)
+}
+
+// ListForCertificateReturns makes ListForCertificate return the given values.
+func (f *FakeSNIService) ListForCertificateReturns(r0 []*kong.SNI, r1 *kong.ListOpt, r2 error) {
+	f.listForCertificate.setReturns(sniServiceListForCertificateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListForCertificateReturnsOnCall makes the i-th call to ListForCertificate return the given values.
+func (f *FakeSNIService) ListForCertificateReturnsOnCall(i int, r0 []*kong.SNI, r1 *kong.ListOpt, r2 error) {
+	f.listForCertificate.setReturnsOnCall(i, sniServiceListForCertificateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeSNIService) ListAll(ctx context.Context) ([]*kong.SNI, error) {
+	call := f.listAll.record(SNIServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeSNIService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeSNIService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeSNIService) ListAllCalls() []SNIServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeSNIService) ListAllArgsForCall(i int) SNIServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSNIService) ListAllStub(stub func(context.Context) ([]*kong.SNI, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeSNIService) ListAllReturns(r0 []*kong.SNI, r1 error) {
+	f.listAll.setReturns(sniServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeSNIService) ListAllReturnsOnCall(i int, r0 []*kong.SNI, r1 error) {
+	f.listAll.setReturnsOnCall(i, sniServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeSNIService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listForCertificate.reset()
+	f.listAll.reset()
+}
+
+// SchemaServiceGetCall holds the arguments of a call to FakeSchemaService.Get.
+type SchemaServiceGetCall struct {
+	Ctx    context.Context
+	Entity string
+}
+
+type schemaServiceGetResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// SchemaServiceValidateCall holds the arguments of a call to FakeSchemaService.Validate.
+type SchemaServiceValidateCall struct {
+	Ctx        context.Context
+	EntityType kong.EntityType
+	Entity     interface{}
+}
+
+type schemaServiceValidateResults struct {
+	r0 bool
+	r1 string
+	r2 error
+}
+
+// FakeSchemaService is a fake implementation of kong.AbstractSchemaService.
+type FakeSchemaService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractSchemaService
+
+	get      method[SchemaServiceGetCall, schemaServiceGetResults, func(context.Context, string) (kong.Schema, error)]
+	validate method[SchemaServiceValidateCall, schemaServiceValidateResults, func(context.Context, kong.EntityType, interface{}) (bool, string, error)]
+}
+
+var _ kong.AbstractSchemaService = &FakeSchemaService{}
+
+// Get records the call and returns the programmed results.
+func (f *FakeSchemaService) Get(ctx context.Context, entity string) (kong.Schema, error) {
+	call := f.get.record(SchemaServiceGetCall{Ctx: ctx, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, entity)
+	}
+	return call.results.r0, notProgrammed("FakeSchemaService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeSchemaService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeSchemaService) GetCalls() []SchemaServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeSchemaService) GetArgsForCall(i int) SchemaServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSchemaService) GetStub(stub func(context.Context, string) (kong.Schema, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeSchemaService) GetReturns(r0 kong.Schema, r1 error) {
+	f.get.setReturns(schemaServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeSchemaService) GetReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.get.setReturnsOnCall(i, schemaServiceGetResults{r0: r0, r1: r1})
+}
+
+// Validate records the call and returns the programmed results.
+func (f *FakeSchemaService) Validate(ctx context.Context, entityType kong.EntityType, entity interface{}) (bool, string, error) {
+	call := f.validate.record(SchemaServiceValidateCall{Ctx: ctx, EntityType: entityType, Entity: entity})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, entityType, entity)
+	case f.Fallback != nil:
+		return f.Fallback.Validate(ctx, entityType, entity)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeSchemaService.Validate")
+}
+
+// ValidateCallCount returns the number of calls to Validate.
+func (f *FakeSchemaService) ValidateCallCount() int {
+	return f.validate.callCount()
+}
+
+// ValidateCalls returns the arguments of the calls to Validate.
+func (f *FakeSchemaService) ValidateCalls() []SchemaServiceValidateCall {
+	return f.validate.allCalls()
+}
+
+// ValidateArgsForCall returns the arguments of the i-th call to Validate.
+func (f *FakeSchemaService) ValidateArgsForCall(i int) SchemaServiceValidateCall {
+	return f.validate.argsForCall(i)
+}
+
+// ValidateStub makes Validate delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSchemaService) ValidateStub(stub func(context.Context, kong.EntityType, interface{}) (bool, string, error)) {
+	f.validate.setStub(stub, stub != nil)
+}
+
+// ValidateReturns makes Validate return the given values.
+func (f *FakeSchemaService) ValidateReturns(r0 bool, r1 string, r2 error) {
+	f.validate.setReturns(schemaServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ValidateReturnsOnCall makes the i-th call to Validate return the given values.
+func (f *FakeSchemaService) ValidateReturnsOnCall(i int, r0 bool, r1 string, r2 error) {
+	f.validate.setReturnsOnCall(i, schemaServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeSchemaService) Reset() {
+	f.get.reset()
+	f.validate.reset()
+}
+
+// SvcServiceCreateCall holds the arguments of a call to FakeSvcService.Create.
+type SvcServiceCreateCall struct {
+	Ctx     context.Context
+	Service *kong.Service
+}
+
+type svcServiceCreateResults struct {
+	r0 *kong.Service
+	r1 error
+}
+
+// SvcServiceGetCall holds the arguments of a call to FakeSvcService.Get.
+type SvcServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type svcServiceGetResults struct {
+	r0 *kong.Service
+	r1 error
+}
+
+// SvcServiceGetForRouteCall holds the arguments of a call to FakeSvcService.GetForRoute.
+type SvcServiceGetForRouteCall struct {
+	Ctx     context.Context
+	RouteID *string
+}
+
+type svcServiceGetForRouteResults struct {
+	r0 *kong.Service
+	r1 error
+}
+
+// SvcServiceUpdateCall holds the arguments of a call to FakeSvcService.Update.
+type SvcServiceUpdateCall struct {
+	Ctx     context.Context
+	Service *kong.Service
+}
+
+type svcServiceUpdateResults struct {
+	r0 *kong.Service
+	r1 error
+}
+
+// SvcServiceDeleteCall holds the arguments of a call to FakeSvcService.Delete.
+type SvcServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type svcServiceDeleteResults struct {
+	r0 error
+}
+
+// SvcServiceListCall holds the arguments of a call to FakeSvcService.List.
+type SvcServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type svcServiceListResults struct {
+	r0 []*kong.Service
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// SvcServiceListAllCall holds the arguments of a call to FakeSvcService.ListAll.
+type SvcServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type svcServiceListAllResults struct {
+	r0 []*kong.Service
+	r1 error
+}
+
+// FakeSvcService is a fake implementation of kong.AbstractSvcService.
+type FakeSvcService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractSvcService
+
+	create      method[SvcServiceCreateCall, svcServiceCreateResults, func(context.Context, *kong.Service) (*kong.Service, error)]
+	get         method[SvcServiceGetCall, svcServiceGetResults, func(context.Context, *string) (*kong.Service, error)]
+	getForRoute method[SvcServiceGetForRouteCall, svcServiceGetForRouteResults, func(context.Context, *string) (*kong.Service, error)]
+	update      method[SvcServiceUpdateCall, svcServiceUpdateResults, func(context.Context, *kong.Service) (*kong.Service, error)]
+	delete      method[SvcServiceDeleteCall, svcServiceDeleteResults, func(context.Context, *string) error]
+	list        method[SvcServiceListCall, svcServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Service, *kong.ListOpt, error)]
+	listAll     method[SvcServiceListAllCall, svcServiceListAllResults, func(context.Context) ([]*kong.Service, error)]
+}
+
+var _ kong.AbstractSvcService = &FakeSvcService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeSvcService) Create(ctx context.Context, service *kong.Service) (*kong.Service, error) {
+	call := f.create.record(SvcServiceCreateCall{Ctx: ctx, Service: service})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, service)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, service)
+	}
+	return call.results.r0, notProgrammed("FakeSvcService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeSvcService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeSvcService) CreateCalls() []SvcServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeSvcService) CreateArgsForCall(i int) SvcServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) CreateStub(stub func(context.Context, *kong.Service) (*kong.Service, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeSvcService) CreateReturns(r0 *kong.Service, r1 error) {
+	f.create.setReturns(svcServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeSvcService) CreateReturnsOnCall(i int, r0 *kong.Service, r1 error) {
+	f.create.setReturnsOnCall(i, svcServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeSvcService) Get(ctx context.Context, nameOrID *string) (*kong.Service, error) {
+	call := f.get.record(SvcServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeSvcService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeSvcService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeSvcService) GetCalls() []SvcServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeSvcService) GetArgsForCall(i int) SvcServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) GetStub(stub func(context.Context, *string) (*kong.Service, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeSvcService) GetReturns(r0 *kong.Service, r1 error) {
+	f.get.setReturns(svcServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeSvcService) GetReturnsOnCall(i int, r0 *kong.Service, r1 error) {
+	f.get.setReturnsOnCall(i, svcServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetForRoute records the call and returns the programmed results.
+func (f *FakeSvcService) GetForRoute(ctx context.Context, routeID *string) (*kong.Service, error) {
+	call := f.getForRoute.record(SvcServiceGetForRouteCall{Ctx: ctx, RouteID: routeID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, routeID)
+	case f.Fallback != nil:
+		return f.Fallback.GetForRoute(ctx, routeID)
+	}
+	return call.results.r0, notProgrammed("FakeSvcService.GetForRoute")
+}
+
+// GetForRouteCallCount returns the number of calls to GetForRoute.
+func (f *FakeSvcService) GetForRouteCallCount() int {
+	return f.getForRoute.callCount()
+}
+
+// GetForRouteCalls returns the arguments of the calls to GetForRoute.
+func (f *FakeSvcService) GetForRouteCalls() []SvcServiceGetForRouteCall {
+	return f.getForRoute.allCalls()
+}
+
+// GetForRouteArgsForCall returns the arguments of the i-th call to GetForRoute.
+func (f *FakeSvcService) GetForRouteArgsForCall(i int) SvcServiceGetForRouteCall {
+	return f.getForRoute.argsForCall(i)
+}
+
+// GetForRouteStub makes GetForRoute delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) GetForRouteStub(stub func(context.Context, *string) (*kong.Service, error)) {
+	f.getForRoute.setStub(stub, stub != nil)
+}
+
+// GetForRouteReturns makes GetForRoute return the given values.
+func (f *FakeSvcService) GetForRouteReturns(r0 *kong.Service, r1 error) {
+	f.getForRoute.setReturns(svcServiceGetForRouteResults{r0: r0, r1: r1})
+}
+
+// GetForRouteReturnsOnCall makes the i-th call to GetForRoute return the given values.
+func (f *FakeSvcService) GetForRouteReturnsOnCall(i int, r0 *kong.Service, r1 error) {
+	f.getForRoute.setReturnsOnCall(i, svcServiceGetForRouteResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeSvcService) Update(ctx context.Context, service *kong.Service) (*kong.Service, error) {
+	call := f.update.record(SvcServiceUpdateCall{Ctx: ctx, Service: service})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, service)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, service)
+	}
+	return call.results.r0, notProgrammed("FakeSvcService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeSvcService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeSvcService) UpdateCalls() []SvcServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeSvcService) UpdateArgsForCall(i int) SvcServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) UpdateStub(stub func(context.Context, *kong.Service) (*kong.Service, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeSvcService) UpdateReturns(r0 *kong.Service, r1 error) {
+	f.update.setReturns(svcServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeSvcService) UpdateReturnsOnCall(i int, r0 *kong.Service, r1 error) {
+	f.update.setReturnsOnCall(i, svcServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeSvcService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(SvcServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeSvcService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeSvcService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeSvcService) DeleteCalls() []SvcServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeSvcService) DeleteArgsForCall(i int) SvcServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeSvcService) DeleteReturns(r0 error) {
+	f.delete.setReturns(svcServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeSvcService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, svcServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeSvcService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Service, *kong.ListOpt, error) {
+	call := f.list.record(SvcServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeSvcService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeSvcService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeSvcService) ListCalls() []SvcServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeSvcService) ListArgsForCall(i int) SvcServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Service, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeSvcService) ListReturns(r0 []*kong.Service, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(svcServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeSvcService) ListReturnsOnCall(i int, r0 []*kong.Service, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, svcServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeSvcService) ListAll(ctx context.Context) ([]*kong.Service, error) {
+	call := f.listAll.record(SvcServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeSvcService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeSvcService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeSvcService) ListAllCalls() []SvcServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeSvcService) ListAllArgsForCall(i int) SvcServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeSvcService) ListAllStub(stub func(context.Context) ([]*kong.Service, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeSvcService) ListAllReturns(r0 []*kong.Service, r1 error) {
+	f.listAll.setReturns(svcServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeSvcService) ListAllReturnsOnCall(i int, r0 []*kong.Service, r1 error) {
+	f.listAll.setReturnsOnCall(i, svcServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeSvcService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.getForRoute.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// TagServiceExistsCall holds the arguments of a call to FakeTagService.Exists.
+type TagServiceExistsCall struct {
+	Ctx context.Context
+}
+
+type tagServiceExistsResults struct {
+	r0 bool
+	r1 error
+}
+
+// FakeTagService is a fake implementation of kong.AbstractTagService.
+type FakeTagService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractTagService
+
+	exists method[TagServiceExistsCall, tagServiceExistsResults, func(context.Context) (bool, error)]
+}
+
+var _ kong.AbstractTagService = &FakeTagService{}
+
+// Exists records the call and returns the programmed results.
+func (f *FakeTagService) Exists(ctx context.Context) (bool, error) {
+	call := f.exists.record(TagServiceExistsCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.Exists(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeTagService.Exists")
+}
+
+// ExistsCallCount returns the number of calls to Exists.
+func (f *FakeTagService) ExistsCallCount() int {
+	return f.exists.callCount()
+}
+
+// ExistsCalls returns the arguments of the calls to Exists.
+func (f *FakeTagService) ExistsCalls() []TagServiceExistsCall {
+	return f.exists.allCalls()
+}
+
+// ExistsArgsForCall returns the arguments of the i-th call to Exists.
+func (f *FakeTagService) ExistsArgsForCall(i int) TagServiceExistsCall {
+	return f.exists.argsForCall(i)
+}
+
+// ExistsStub makes Exists delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTagService) ExistsStub(stub func(context.Context) (bool, error)) {
+	f.exists.setStub(stub, stub != nil)
+}
+
+// ExistsReturns makes Exists return the given values.
+func (f *FakeTagService) ExistsReturns(r0 bool, r1 error) {
+	f.exists.setReturns(tagServiceExistsResults{r0: r0, r1: r1})
+}
+
+// ExistsReturnsOnCall makes the i-th call to Exists return the given values.
+func (f *FakeTagService) ExistsReturnsOnCall(i int, r0 bool, r1 error) {
+	f.exists.setReturnsOnCall(i, tagServiceExistsResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeTagService) Reset() {
+	f.exists.reset()
+}
+
+// TargetServiceCreateCall holds the arguments of a call to FakeTargetService.Create.
+type TargetServiceCreateCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	Target           *kong.Target
+}
+
+type targetServiceCreateResults struct {
+	r0 *kong.Target
+	r1 error
+}
+
+// TargetServiceDeleteCall holds the arguments of a call to FakeTargetService.Delete.
+type TargetServiceDeleteCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	TargetOrID       *string
+}
+
+type targetServiceDeleteResults struct {
+	r0 error
+}
+
+// TargetServiceListCall holds the arguments of a call to FakeTargetService.List.
+type TargetServiceListCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	Opt              *kong.ListOpt
+}
+
+type targetServiceListResults struct {
+	r0 []*kong.Target
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// TargetServiceListAllCall holds the arguments of a call to FakeTargetService.ListAll.
+type TargetServiceListAllCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+}
+
+type targetServiceListAllResults struct {
+	r0 []*kong.Target
+	r1 error
+}
+
+// TargetServiceMarkHealthyCall holds the arguments of a call to FakeTargetService.MarkHealthy.
+type TargetServiceMarkHealthyCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	Target           *kong.Target
+}
+
+type targetServiceMarkHealthyResults struct {
+	r0 error
+}
+
+// TargetServiceMarkUnhealthyCall holds the arguments of a call to FakeTargetService.MarkUnhealthy.
+type TargetServiceMarkUnhealthyCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	Target           *kong.Target
+}
+
+type targetServiceMarkUnhealthyResults struct {
+	r0 error
+}
+
+// TargetServiceUpdateCall holds the arguments of a call to FakeTargetService.Update.
+type TargetServiceUpdateCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	TargetOrID       *string
+	Target           *kong.Target
+}
+
+type targetServiceUpdateResults struct {
+	r0 *kong.Target
+	r1 error
+}
+
+// TargetServiceListAllTargetsCall holds the arguments of a call to FakeTargetService.ListAllTargets.
+type TargetServiceListAllTargetsCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type targetServiceListAllTargetsResults struct {
+	r0 []*kong.Target
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// TargetServiceListAllTargetsAllCall holds the arguments of a call to FakeTargetService.ListAllTargetsAll.
+type TargetServiceListAllTargetsAllCall struct {
+	Ctx context.Context
+}
+
+type targetServiceListAllTargetsAllResults struct {
+	r0 []*kong.Target
+	r1 error
+}
+
+// FakeTargetService is a fake implementation of kong.AbstractTargetService.
+type FakeTargetService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractTargetService
+
+	create            method[TargetServiceCreateCall, targetServiceCreateResults, func(context.Context, *string, *kong.Target) (*kong.Target, error)]
+	delete            method[TargetServiceDeleteCall, targetServiceDeleteResults, func(context.Context, *string, *string) error]
+	list              method[TargetServiceListCall, targetServiceListResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error)]
+	listAll           method[TargetServiceListAllCall, targetServiceListAllResults, func(context.Context, *string) ([]*kong.Target, error)]
+	markHealthy       method[TargetServiceMarkHealthyCall, targetServiceMarkHealthyResults, func(context.Context, *string, *kong.Target) error]
+	markUnhealthy     method[TargetServiceMarkUnhealthyCall, targetServiceMarkUnhealthyResults, func(context.Context, *string, *kong.Target) error]
+	update            method[TargetServiceUpdateCall, targetServiceUpdateResults, func(context.Context, *string, *string, *kong.Target) (*kong.Target, error)]
+	listAllTargets    method[TargetServiceListAllTargetsCall, targetServiceListAllTargetsResults, func(context.Context, *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error)]
+	listAllTargetsAll method[TargetServiceListAllTargetsAllCall, targetServiceListAllTargetsAllResults, func(context.Context) ([]*kong.Target, error)]
+}
+
+var _ kong.AbstractTargetService = &FakeTargetService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeTargetService) Create(ctx context.Context, upstreamNameOrID *string, target *kong.Target) (*kong.Target, error) {
+	call := f.create.record(TargetServiceCreateCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, Target: target})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, target)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, upstreamNameOrID, target)
+	}
+	return call.results.r0, notProgrammed("FakeTargetService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeTargetService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeTargetService) CreateCalls() []TargetServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeTargetService) CreateArgsForCall(i int) TargetServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) CreateStub(stub func(context.Context, *string, *kong.Target) (*kong.Target, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeTargetService) CreateReturns(r0 *kong.Target, r1 error) {
+	f.create.setReturns(targetServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeTargetService) CreateReturnsOnCall(i int, r0 *kong.Target, r1 error) {
+	f.create.setReturnsOnCall(i, targetServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeTargetService) Delete(ctx context.Context, upstreamNameOrID *string, targetOrID *string) error {
+	call := f.delete.record(TargetServiceDeleteCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, TargetOrID: targetOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, targetOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, upstreamNameOrID, targetOrID)
+	}
+	return notProgrammed("FakeTargetService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeTargetService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeTargetService) DeleteCalls() []TargetServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeTargetService) DeleteArgsForCall(i int) TargetServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) DeleteStub(stub func(context.Context, *string, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeTargetService) DeleteReturns(r0 error) {
+	f.delete.setReturns(targetServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeTargetService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, targetServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeTargetService) List(ctx context.Context, upstreamNameOrID *string, opt *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error) {
+	call := f.list.record(TargetServiceListCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, upstreamNameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeTargetService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeTargetService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeTargetService) ListCalls() []TargetServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeTargetService) ListArgsForCall(i int) TargetServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) ListStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeTargetService) ListReturns(r0 []*kong.Target, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(targetServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeTargetService) ListReturnsOnCall(i int, r0 []*kong.Target, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, targetServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeTargetService) ListAll(ctx context.Context, upstreamNameOrID *string) ([]*kong.Target, error) {
+	call := f.listAll.record(TargetServiceListAllCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx, upstreamNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeTargetService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeTargetService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeTargetService) ListAllCalls() []TargetServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeTargetService) ListAllArgsForCall(i int) TargetServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) ListAllStub(stub func(context.Context, *string) ([]*kong.Target, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeTargetService) ListAllReturns(r0 []*kong.Target, r1 error) {
+	f.listAll.setReturns(targetServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeTargetService) ListAllReturnsOnCall(i int, r0 []*kong.Target, r1 error) {
+	f.listAll.setReturnsOnCall(i, targetServiceListAllResults{r0: r0, r1: r1})
+}
+
+// MarkHealthy records the call and returns the programmed results.
+func (f *FakeTargetService) MarkHealthy(ctx context.Context, upstreamNameOrID *string, target *kong.Target) error {
+	call := f.markHealthy.record(TargetServiceMarkHealthyCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, Target: target})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, target)
+	case f.Fallback != nil:
+		return f.Fallback.MarkHealthy(ctx, upstreamNameOrID, target)
+	}
+	return notProgrammed("FakeTargetService.MarkHealthy")
+}
+
+// MarkHealthyCallCount returns the number of calls to MarkHealthy.
+func (f *FakeTargetService) MarkHealthyCallCount() int {
+	return f.markHealthy.callCount()
+}
+
+// MarkHealthyCalls returns the arguments of the calls to MarkHealthy.
+func (f *FakeTargetService) MarkHealthyCalls() []TargetServiceMarkHealthyCall {
+	return f.markHealthy.allCalls()
+}
+
+// MarkHealthyArgsForCall returns the arguments of the i-th call to MarkHealthy.
+func (f *FakeTargetService) MarkHealthyArgsForCall(i int) TargetServiceMarkHealthyCall {
+	return f.markHealthy.argsForCall(i)
+}
+
+// MarkHealthyStub makes MarkHealthy delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) MarkHealthyStub(stub func(context.Context, *string, *kong.Target) error) {
+	f.markHealthy.setStub(stub, stub != nil)
+}
+
+// MarkHealthyReturns makes MarkHealthy return the given values.
+func (f *FakeTargetService) MarkHealthyReturns(r0 error) {
+	f.markHealthy.setReturns(targetServiceMarkHealthyResults{r0: r0})
+}
+
+// MarkHealthyReturnsOnCall makes the i-th call to MarkHealthy return the given values.
+func (f *FakeTargetService) MarkHealthyReturnsOnCall(i int, r0 error) {
+	f.markHealthy.setReturnsOnCall(i, targetServiceMarkHealthyResults{r0: r0})
+}
+
+// MarkUnhealthy records the call and returns the programmed results.
+func (f *FakeTargetService) MarkUnhealthy(ctx context.Context, upstreamNameOrID *string, target *kong.Target) error {
+	call := f.markUnhealthy.record(TargetServiceMarkUnhealthyCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, Target: target})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, target)
+	case f.Fallback != nil:
+		return f.Fallback.MarkUnhealthy(ctx, upstreamNameOrID, target)
+	}
+	return notProgrammed("FakeTargetService.MarkUnhealthy")
+}
+
+// MarkUnhealthyCallCount returns the number of calls to MarkUnhealthy.
+func (f *FakeTargetService) MarkUnhealthyCallCount() int {
+	return f.markUnhealthy.callCount()
+}
+
+// MarkUnhealthyCalls returns the arguments of the calls to MarkUnhealthy.
+func (f *FakeTargetService) MarkUnhealthyCalls() []TargetServiceMarkUnhealthyCall {
+	return f.markUnhealthy.allCalls()
+}
+
+// MarkUnhealthyArgsForCall returns the arguments of the i-th call to MarkUnhealthy.
+func (f *FakeTargetService) MarkUnhealthyArgsForCall(i int) TargetServiceMarkUnhealthyCall {
+	return f.markUnhealthy.argsForCall(i)
+}
+
+// MarkUnhealthyStub makes MarkUnhealthy delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) MarkUnhealthyStub(stub func(context.Context, *string, *kong.Target) error) {
+	f.markUnhealthy.setStub(stub, stub != nil)
+}
+
+// MarkUnhealthyReturns makes MarkUnhealthy return the given values.
+func (f *FakeTargetService) MarkUnhealthyReturns(r0 error) {
+	f.markUnhealthy.setReturns(targetServiceMarkUnhealthyResults{r0: r0})
+}
+
+// MarkUnhealthyReturnsOnCall makes the i-th call to MarkUnhealthy return the given values.
+func (f *FakeTargetService) MarkUnhealthyReturnsOnCall(i int, r0 error) {
+	f.markUnhealthy.setReturnsOnCall(i, targetServiceMarkUnhealthyResults{r0: r0})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeTargetService) Update(ctx context.Context, upstreamNameOrID *string, targetOrID *string, target *kong.Target) (*kong.Target, error) {
+	call := f.update.record(TargetServiceUpdateCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, TargetOrID: targetOrID, Target: target})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, targetOrID, target)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, upstreamNameOrID, targetOrID, target)
+	}
+	return call.results.r0, notProgrammed("FakeTargetService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeTargetService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeTargetService) UpdateCalls() []TargetServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeTargetService) UpdateArgsForCall(i int) TargetServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) UpdateStub(stub func(context.Context, *string, *string, *kong.Target) (*kong.Target, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeTargetService) UpdateReturns(r0 *kong.Target, r1 error) {
+	f.update.setReturns(targetServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeTargetService) UpdateReturnsOnCall(i int, r0 *kong.Target, r1 error) {
+	f.update.setReturnsOnCall(i, targetServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// ListAllTargets records the call and returns the programmed results.
+func (f *FakeTargetService) ListAllTargets(ctx context.Context, opt *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error) {
+	call := f.listAllTargets.record(TargetServiceListAllTargetsCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllTargets(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeTargetService.ListAllTargets")
+}
+
+// ListAllTargetsCallCount returns the number of calls to ListAllTargets.
+func (f *FakeTargetService) ListAllTargetsCallCount() int {
+	return f.listAllTargets.callCount()
+}
+
+// ListAllTargetsCalls returns the arguments of the calls to ListAllTargets.
+func (f *FakeTargetService) ListAllTargetsCalls() []TargetServiceListAllTargetsCall {
+	return f.listAllTargets.allCalls()
+}
+
+// ListAllTargetsArgsForCall returns the arguments of the i-th call to ListAllTargets.
+func (f *FakeTargetService) ListAllTargetsArgsForCall(i int) TargetServiceListAllTargetsCall {
+	return f.listAllTargets.argsForCall(i)
+}
+
+// ListAllTargetsStub makes ListAllTargets delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) ListAllTargetsStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Target, *kong.ListOpt, error)) {
+	f.listAllTargets.setStub(stub, stub != nil)
+}
+
+// ListAllTargetsReturns makes ListAllTargets return the given values.
+func (f *FakeTargetService) ListAllTargetsReturns(r0 []*kong.Target, r1 *kong.ListOpt, r2 error) {
+	f.listAllTargets.setReturns(targetServiceListAllTargetsResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAllTargetsReturnsOnCall makes the i-th call to ListAllTargets return the given values.
+func (f *FakeTargetService) ListAllTargetsReturnsOnCall(i int, r0 []*kong.Target, r1 *kong.ListOpt, r2 error) {
+	f.listAllTargets.setReturnsOnCall(i, targetServiceListAllTargetsResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAllTargetsAll records the call and returns the programmed results.
+func (f *FakeTargetService) ListAllTargetsAll(ctx context.Context) ([]*kong.Target, error) {
+	call := f.listAllTargetsAll.record(TargetServiceListAllTargetsAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAllTargetsAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeTargetService.ListAllTargetsAll")
+}
+
+// ListAllTargetsAllCallCount returns the number of calls to ListAllTargetsAll.
+func (f *FakeTargetService) ListAllTargetsAllCallCount() int {
+	return f.listAllTargetsAll.callCount()
+}
+
+// ListAllTargetsAllCalls returns the arguments of the calls to ListAllTargetsAll.
+func (f *FakeTargetService) ListAllTargetsAllCalls() []TargetServiceListAllTargetsAllCall {
+	return f.listAllTargetsAll.allCalls()
+}
+
+// ListAllTargetsAllArgsForCall returns the arguments of the i-th call to ListAllTargetsAll.
+func (f *FakeTargetService) ListAllTargetsAllArgsForCall(i int) TargetServiceListAllTargetsAllCall {
+	return f.listAllTargetsAll.argsForCall(i)
+}
+
+// ListAllTargetsAllStub makes ListAllTargetsAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeTargetService) ListAllTargetsAllStub(stub func(context.Context) ([]*kong.Target, error)) {
+	f.listAllTargetsAll.setStub(stub, stub != nil)
+}
+
+// ListAllTargetsAllReturns makes ListAllTargetsAll return the given values.
+func (f *FakeTargetService) ListAllTargetsAllReturns(r0 []*kong.Target, r1 error) {
+	f.listAllTargetsAll.setReturns(targetServiceListAllTargetsAllResults{r0: r0, r1: r1})
+}
+
+// ListAllTargetsAllReturnsOnCall makes the i-th call to ListAllTargetsAll return the given values.
+func (f *FakeTargetService) ListAllTargetsAllReturnsOnCall(i int, r0 []*kong.Target, r1 error) {
+	f.listAllTargetsAll.setReturnsOnCall(i, targetServiceListAllTargetsAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeTargetService) Reset() {
+	f.create.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.markHealthy.reset()
+	f.markUnhealthy.reset()
+	f.update.reset()
+	f.listAllTargets.reset()
+	f.listAllTargetsAll.reset()
+}
+
+// UpstreamNodeHealthServiceListCall holds the arguments of a call to FakeUpstreamNodeHealthService.List.
+type UpstreamNodeHealthServiceListCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+	Opt              *kong.ListOpt
+}
+
+type upstreamNodeHealthServiceListResults struct {
+	r0 []*kong.UpstreamNodeHealth
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// UpstreamNodeHealthServiceListAllCall holds the arguments of a call to FakeUpstreamNodeHealthService.ListAll.
+type UpstreamNodeHealthServiceListAllCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+}
+
+type upstreamNodeHealthServiceListAllResults struct {
+	r0 []*kong.UpstreamNodeHealth
+	r1 error
+}
+
+// FakeUpstreamNodeHealthService is a fake implementation of kong.AbstractUpstreamNodeHealthService.
+type FakeUpstreamNodeHealthService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractUpstreamNodeHealthService
+
+	list    method[UpstreamNodeHealthServiceListCall, upstreamNodeHealthServiceListResults, func(context.Context, *string, *kong.ListOpt) ([]*kong.UpstreamNodeHealth, *kong.ListOpt, error)]
+	listAll method[UpstreamNodeHealthServiceListAllCall, upstreamNodeHealthServiceListAllResults, func(context.Context, *string) ([]*kong.UpstreamNodeHealth, error)]
+}
+
+var _ kong.AbstractUpstreamNodeHealthService = &FakeUpstreamNodeHealthService{}
+
+// List records the call and returns the programmed results.
+func (f *FakeUpstreamNodeHealthService) List(ctx context.Context, upstreamNameOrID *string, opt *kong.ListOpt) ([]*kong.UpstreamNodeHealth, *kong.ListOpt, error) {
+	call := f.list.record(UpstreamNodeHealthServiceListCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, upstreamNameOrID, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeUpstreamNodeHealthService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeUpstreamNodeHealthService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeUpstreamNodeHealthService) ListCalls() []UpstreamNodeHealthServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeUpstreamNodeHealthService) ListArgsForCall(i int) UpstreamNodeHealthServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamNodeHealthService) ListStub(stub func(context.Context, *string, *kong.ListOpt) ([]*kong.UpstreamNodeHealth, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeUpstreamNodeHealthService) ListReturns(r0 []*kong.UpstreamNodeHealth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(upstreamNodeHealthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeUpstreamNodeHealthService) ListReturnsOnCall(i int, r0 []*kong.UpstreamNodeHealth, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, upstreamNodeHealthServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeUpstreamNodeHealthService) ListAll(ctx context.Context, upstreamNameOrID *string) ([]*kong.UpstreamNodeHealth, error) {
+	call := f.listAll.record(UpstreamNodeHealthServiceListAllCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx, upstreamNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeUpstreamNodeHealthService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeUpstreamNodeHealthService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeUpstreamNodeHealthService) ListAllCalls() []UpstreamNodeHealthServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeUpstreamNodeHealthService) ListAllArgsForCall(i int) UpstreamNodeHealthServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamNodeHealthService) ListAllStub(stub func(context.Context, *string) ([]*kong.UpstreamNodeHealth, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeUpstreamNodeHealthService) ListAllReturns(r0 []*kong.UpstreamNodeHealth, r1 error) {
+	f.listAll.setReturns(upstreamNodeHealthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeUpstreamNodeHealthService) ListAllReturnsOnCall(i int, r0 []*kong.UpstreamNodeHealth, r1 error) {
+	f.listAll.setReturnsOnCall(i, upstreamNodeHealthServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeUpstreamNodeHealthService) Reset() {
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// UpstreamServiceCreateCall holds the arguments of a call to FakeUpstreamService.Create.
+type UpstreamServiceCreateCall struct {
+	Ctx      context.Context
+	Upstream *kong.Upstream
+}
+
+type upstreamServiceCreateResults struct {
+	r0 *kong.Upstream
+	r1 error
+}
+
+// UpstreamServiceGetCall holds the arguments of a call to FakeUpstreamService.Get.
+type UpstreamServiceGetCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+}
+
+type upstreamServiceGetResults struct {
+	r0 *kong.Upstream
+	r1 error
+}
+
+// UpstreamServiceUpdateCall holds the arguments of a call to FakeUpstreamService.Update.
+type UpstreamServiceUpdateCall struct {
+	Ctx      context.Context
+	Upstream *kong.Upstream
+}
+
+type upstreamServiceUpdateResults struct {
+	r0 *kong.Upstream
+	r1 error
+}
+
+// UpstreamServiceDeleteCall holds the arguments of a call to FakeUpstreamService.Delete.
+type UpstreamServiceDeleteCall struct {
+	Ctx              context.Context
+	UpstreamNameOrID *string
+}
+
+type upstreamServiceDeleteResults struct {
+	r0 error
+}
+
+// UpstreamServiceListCall holds the arguments of a call to FakeUpstreamService.List.
+type UpstreamServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type upstreamServiceListResults struct {
+	r0 []*kong.Upstream
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// UpstreamServiceListAllCall holds the arguments of a call to FakeUpstreamService.ListAll.
+type UpstreamServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type upstreamServiceListAllResults struct {
+	r0 []*kong.Upstream
+	r1 error
+}
+
+// FakeUpstreamService is a fake implementation of kong.AbstractUpstreamService.
+type FakeUpstreamService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractUpstreamService
+
+	create  method[UpstreamServiceCreateCall, upstreamServiceCreateResults, func(context.Context, *kong.Upstream) (*kong.Upstream, error)]
+	get     method[UpstreamServiceGetCall, upstreamServiceGetResults, func(context.Context, *string) (*kong.Upstream, error)]
+	update  method[UpstreamServiceUpdateCall, upstreamServiceUpdateResults, func(context.Context, *kong.Upstream) (*kong.Upstream, error)]
+	delete  method[UpstreamServiceDeleteCall, upstreamServiceDeleteResults, func(context.Context, *string) error]
+	list    method[UpstreamServiceListCall, upstreamServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Upstream, *kong.ListOpt, error)]
+	listAll method[UpstreamServiceListAllCall, upstreamServiceListAllResults, func(context.Context) ([]*kong.Upstream, error)]
+}
+
+var _ kong.AbstractUpstreamService = &FakeUpstreamService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeUpstreamService) Create(ctx context.Context, upstream *kong.Upstream) (*kong.Upstream, error) {
+	call := f.create.record(UpstreamServiceCreateCall{Ctx: ctx, Upstream: upstream})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstream)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, upstream)
+	}
+	return call.results.r0, notProgrammed("FakeUpstreamService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeUpstreamService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeUpstreamService) CreateCalls() []UpstreamServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeUpstreamService) CreateArgsForCall(i int) UpstreamServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) CreateStub(stub func(context.Context, *kong.Upstream) (*kong.Upstream, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeUpstreamService) CreateReturns(r0 *kong.Upstream, r1 error) {
+	f.create.setReturns(upstreamServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeUpstreamService) CreateReturnsOnCall(i int, r0 *kong.Upstream, r1 error) {
+	f.create.setReturnsOnCall(i, upstreamServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeUpstreamService) Get(ctx context.Context, upstreamNameOrID *string) (*kong.Upstream, error) {
+	call := f.get.record(UpstreamServiceGetCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, upstreamNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeUpstreamService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeUpstreamService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeUpstreamService) GetCalls() []UpstreamServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeUpstreamService) GetArgsForCall(i int) UpstreamServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) GetStub(stub func(context.Context, *string) (*kong.Upstream, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeUpstreamService) GetReturns(r0 *kong.Upstream, r1 error) {
+	f.get.setReturns(upstreamServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeUpstreamService) GetReturnsOnCall(i int, r0 *kong.Upstream, r1 error) {
+	f.get.setReturnsOnCall(i, upstreamServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeUpstreamService) Update(ctx context.Context, upstream *kong.Upstream) (*kong.Upstream, error) {
+	call := f.update.record(UpstreamServiceUpdateCall{Ctx: ctx, Upstream: upstream})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, upstream)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, upstream)
+	}
+	return call.results.r0, notProgrammed("FakeUpstreamService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeUpstreamService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeUpstreamService) UpdateCalls() []UpstreamServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeUpstreamService) UpdateArgsForCall(i int) UpstreamServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) UpdateStub(stub func(context.Context, *kong.Upstream) (*kong.Upstream, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeUpstreamService) UpdateReturns(r0 *kong.Upstream, r1 error) {
+	f.update.setReturns(upstreamServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeUpstreamService) UpdateReturnsOnCall(i int, r0 *kong.Upstream, r1 error) {
+	f.update.setReturnsOnCall(i, upstreamServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeUpstreamService) Delete(ctx context.Context, upstreamNameOrID *string) error {
+	call := f.delete.record(UpstreamServiceDeleteCall{Ctx: ctx, UpstreamNameOrID: upstreamNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, upstreamNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, upstreamNameOrID)
+	}
+	return notProgrammed("FakeUpstreamService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeUpstreamService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeUpstreamService) DeleteCalls() []UpstreamServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeUpstreamService) DeleteArgsForCall(i int) UpstreamServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeUpstreamService) DeleteReturns(r0 error) {
+	f.delete.setReturns(upstreamServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeUpstreamService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, upstreamServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeUpstreamService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Upstream, *kong.ListOpt, error) {
+	call := f.list.record(UpstreamServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeUpstreamService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeUpstreamService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeUpstreamService) ListCalls() []UpstreamServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeUpstreamService) ListArgsForCall(i int) UpstreamServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Upstream, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeUpstreamService) ListReturns(r0 []*kong.Upstream, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(upstreamServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeUpstreamService) ListReturnsOnCall(i int, r0 []*kong.Upstream, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, upstreamServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeUpstreamService) ListAll(ctx context.Context) ([]*kong.Upstream, error) {
+	call := f.listAll.record(UpstreamServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeUpstreamService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeUpstreamService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeUpstreamService) ListAllCalls() []UpstreamServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeUpstreamService) ListAllArgsForCall(i int) UpstreamServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeUpstreamService) ListAllStub(stub func(context.Context) ([]*kong.Upstream, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeUpstreamService) ListAllReturns(r0 []*kong.Upstream, r1 error) {
+	f.listAll.setReturns(upstreamServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeUpstreamService) ListAllReturnsOnCall(i int, r0 []*kong.Upstream, r1 error) {
+	f.listAll.setReturnsOnCall(i, upstreamServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeUpstreamService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+}
+
+// VaultServiceCreateCall holds the arguments of a call to FakeVaultService.Create.
+type VaultServiceCreateCall struct {
+	Ctx   context.Context
+	Vault *kong.Vault
+}
+
+type vaultServiceCreateResults struct {
+	r0 *kong.Vault
+	r1 error
+}
+
+// VaultServiceGetCall holds the arguments of a call to FakeVaultService.Get.
+type VaultServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type vaultServiceGetResults struct {
+	r0 *kong.Vault
+	r1 error
+}
+
+// VaultServiceUpdateCall holds the arguments of a call to FakeVaultService.Update.
+type VaultServiceUpdateCall struct {
+	Ctx   context.Context
+	Vault *kong.Vault
+}
+
+type vaultServiceUpdateResults struct {
+	r0 *kong.Vault
+	r1 error
+}
+
+// VaultServiceDeleteCall holds the arguments of a call to FakeVaultService.Delete.
+type VaultServiceDeleteCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type vaultServiceDeleteResults struct {
+	r0 error
+}
+
+// VaultServiceListCall holds the arguments of a call to FakeVaultService.List.
+type VaultServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type vaultServiceListResults struct {
+	r0 []*kong.Vault
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// VaultServiceListAllCall holds the arguments of a call to FakeVaultService.ListAll.
+type VaultServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type vaultServiceListAllResults struct {
+	r0 []*kong.Vault
+	r1 error
+}
+
+// VaultServiceValidateCall holds the arguments of a call to FakeVaultService.Validate.
+type VaultServiceValidateCall struct {
+	Ctx   context.Context
+	Vault *kong.Vault
+}
+
+type vaultServiceValidateResults struct {
+	r0 bool
+	r1 string
+	r2 error
+}
+
+// VaultServiceValidateReferenceCall holds the arguments of a call to FakeVaultService.ValidateReference.
+type VaultServiceValidateReferenceCall struct {
+	Ctx       context.Context
+	Reference string
+}
+
+type vaultServiceValidateReferenceResults struct {
+	r0 error
+}
+
+// VaultServiceTestReferenceCall holds the arguments of a call to FakeVaultService.TestReference.
+type VaultServiceTestReferenceCall struct {
+	Ctx       context.Context
+	Reference string
+}
+
+type vaultServiceTestReferenceResults struct {
+	r0 error
+}
+
+// VaultServiceGetFullSchemaCall holds the arguments of a call to FakeVaultService.GetFullSchema.
+type VaultServiceGetFullSchemaCall struct {
+	Ctx  context.Context
+	Name *string
+}
+
+type vaultServiceGetFullSchemaResults struct {
+	r0 kong.Schema
+	r1 error
+}
+
+// FakeVaultService is a fake implementation of kong.AbstractVaultService.
+type FakeVaultService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractVaultService
+
+	create            method[VaultServiceCreateCall, vaultServiceCreateResults, func(context.Context, *kong.Vault) (*kong.Vault, error)]
+	get               method[VaultServiceGetCall, vaultServiceGetResults, func(context.Context, *string) (*kong.Vault, error)]
+	update            method[VaultServiceUpdateCall, vaultServiceUpdateResults, func(context.Context, *kong.Vault) (*kong.Vault, error)]
+	delete            method[VaultServiceDeleteCall, vaultServiceDeleteResults, func(context.Context, *string) error]
+	list              method[VaultServiceListCall, vaultServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Vault, *kong.ListOpt, error)]
+	listAll           method[VaultServiceListAllCall, vaultServiceListAllResults, func(context.Context) ([]*kong.Vault, error)]
+	validate          method[VaultServiceValidateCall, vaultServiceValidateResults, func(context.Context, *kong.Vault) (bool, string, error)]
+	validateReference method[VaultServiceValidateReferenceCall, vaultServiceValidateReferenceResults, func(context.Context, string) error]
+	testReference     method[VaultServiceTestReferenceCall, vaultServiceTestReferenceResults, func(context.Context, string) error]
+	getFullSchema     method[VaultServiceGetFullSchemaCall, vaultServiceGetFullSchemaResults, func(context.Context, *string) (kong.Schema, error)]
+}
+
+var _ kong.AbstractVaultService = &FakeVaultService{}
+
+// Create records the call and returns the programmed results.
+func (f *FakeVaultService) Create(ctx context.Context, vault *kong.Vault) (*kong.Vault, error) {
+	call := f.create.record(VaultServiceCreateCall{Ctx: ctx, Vault: vault})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, vault)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, vault)
+	}
+	return call.results.r0, notProgrammed("FakeVaultService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeVaultService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeVaultService) CreateCalls() []VaultServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeVaultService) CreateArgsForCall(i int) VaultServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) CreateStub(stub func(context.Context, *kong.Vault) (*kong.Vault, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeVaultService) CreateReturns(r0 *kong.Vault, r1 error) {
+	f.create.setReturns(vaultServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeVaultService) CreateReturnsOnCall(i int, r0 *kong.Vault, r1 error) {
+	f.create.setReturnsOnCall(i, vaultServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeVaultService) Get(ctx context.Context, nameOrID *string) (*kong.Vault, error) {
+	call := f.get.record(VaultServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeVaultService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeVaultService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeVaultService) GetCalls() []VaultServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeVaultService) GetArgsForCall(i int) VaultServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) GetStub(stub func(context.Context, *string) (*kong.Vault, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeVaultService) GetReturns(r0 *kong.Vault, r1 error) {
+	f.get.setReturns(vaultServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeVaultService) GetReturnsOnCall(i int, r0 *kong.Vault, r1 error) {
+	f.get.setReturnsOnCall(i, vaultServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeVaultService) Update(ctx context.Context, vault *kong.Vault) (*kong.Vault, error) {
+	call := f.update.record(VaultServiceUpdateCall{Ctx: ctx, Vault: vault})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, vault)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, vault)
+	}
+	return call.results.r0, notProgrammed("FakeVaultService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeVaultService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeVaultService) UpdateCalls() []VaultServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeVaultService) UpdateArgsForCall(i int) VaultServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) UpdateStub(stub func(context.Context, *kong.Vault) (*kong.Vault, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeVaultService) UpdateReturns(r0 *kong.Vault, r1 error) {
+	f.update.setReturns(vaultServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeVaultService) UpdateReturnsOnCall(i int, r0 *kong.Vault, r1 error) {
+	f.update.setReturnsOnCall(i, vaultServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeVaultService) Delete(ctx context.Context, nameOrID *string) error {
+	call := f.delete.record(VaultServiceDeleteCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, nameOrID)
+	}
+	return notProgrammed("FakeVaultService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeVaultService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeVaultService) DeleteCalls() []VaultServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeVaultService) DeleteArgsForCall(i int) VaultServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeVaultService) DeleteReturns(r0 error) {
+	f.delete.setReturns(vaultServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeVaultService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, vaultServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeVaultService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Vault, *kong.ListOpt, error) {
+	call := f.list.record(VaultServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeVaultService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeVaultService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeVaultService) ListCalls() []VaultServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeVaultService) ListArgsForCall(i int) VaultServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Vault, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeVaultService) ListReturns(r0 []*kong.Vault, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(vaultServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeVaultService) ListReturnsOnCall(i int, r0 []*kong.Vault, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, vaultServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeVaultService) ListAll(ctx context.Context) ([]*kong.Vault, error) {
+	call := f.listAll.record(VaultServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeVaultService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeVaultService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeVaultService) ListAllCalls() []VaultServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeVaultService) ListAllArgsForCall(i int) VaultServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) ListAllStub(stub func(context.Context) ([]*kong.Vault, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeVaultService) ListAllReturns(r0 []*kong.Vault, r1 error) {
+	f.listAll.setReturns(vaultServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeVaultService) ListAllReturnsOnCall(i int, r0 []*kong.Vault, r1 error) {
+	f.listAll.setReturnsOnCall(i, vaultServiceListAllResults{r0: r0, r1: r1})
+}
+
+// Validate records the call and returns the programmed results.
+func (f *FakeVaultService) Validate(ctx context.Context, vault *kong.Vault) (bool, string, error) {
+	call := f.validate.record(VaultServiceValidateCall{Ctx: ctx, Vault: vault})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, vault)
+	case f.Fallback != nil:
+		return f.Fallback.Validate(ctx, vault)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeVaultService.Validate")
+}
+
+// ValidateCallCount returns the number of calls to Validate.
+func (f *FakeVaultService) ValidateCallCount() int {
+	return f.validate.callCount()
+}
+
+// ValidateCalls returns the arguments of the calls to Validate.
+func (f *FakeVaultService) ValidateCalls() []VaultServiceValidateCall {
+	return f.validate.allCalls()
+}
+
+// ValidateArgsForCall returns the arguments of the i-th call to Validate.
+func (f *FakeVaultService) ValidateArgsForCall(i int) VaultServiceValidateCall {
+	return f.validate.argsForCall(i)
+}
+
+// ValidateStub makes Validate delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) ValidateStub(stub func(context.Context, *kong.Vault) (bool, string, error)) {
+	f.validate.setStub(stub, stub != nil)
+}
+
+// ValidateReturns makes Validate return the given values.
+func (f *FakeVaultService) ValidateReturns(r0 bool, r1 string, r2 error) {
+	f.validate.setReturns(vaultServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ValidateReturnsOnCall makes the i-th call to Validate return the given values.
+func (f *FakeVaultService) ValidateReturnsOnCall(i int, r0 bool, r1 string, r2 error) {
+	f.validate.setReturnsOnCall(i, vaultServiceValidateResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ValidateReference records the call and returns the programmed results.
+func (f *FakeVaultService) ValidateReference(ctx context.Context, reference string) error {
+	call := f.validateReference.record(VaultServiceValidateReferenceCall{Ctx: ctx, Reference: reference})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, reference)
+	case f.Fallback != nil:
+		return f.Fallback.ValidateReference(ctx, reference)
+	}
+	return notProgrammed("FakeVaultService.ValidateReference")
+}
+
+// ValidateReferenceCallCount returns the number of calls to ValidateReference.
+func (f *FakeVaultService) ValidateReferenceCallCount() int {
+	return f.validateReference.callCount()
+}
+
+// ValidateReferenceCalls returns the arguments of the calls to ValidateReference.
+func (f *FakeVaultService) ValidateReferenceCalls() []VaultServiceValidateReferenceCall {
+	return f.validateReference.allCalls()
+}
+
+// ValidateReferenceArgsForCall returns the arguments of the i-th call to ValidateReference.
+func (f *FakeVaultService) ValidateReferenceArgsForCall(i int) VaultServiceValidateReferenceCall {
+	return f.validateReference.argsForCall(i)
+}
+
+// ValidateReferenceStub makes ValidateReference delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) ValidateReferenceStub(stub func(context.Context, string) error) {
+	f.validateReference.setStub(stub, stub != nil)
+}
+
+// ValidateReferenceReturns makes ValidateReference return the given values.
+func (f *FakeVaultService) ValidateReferenceReturns(r0 error) {
+	f.validateReference.setReturns(vaultServiceValidateReferenceResults{r0: r0})
+}
+
+// ValidateReferenceReturnsOnCall makes the i-th call to ValidateReference return the given values.
+func (f *FakeVaultService) ValidateReferenceReturnsOnCall(i int, r0 error) {
+	f.validateReference.setReturnsOnCall(i, vaultServiceValidateReferenceResults{r0: r0})
+}
+
+// TestReference records the call and returns the programmed results.
+func (f *FakeVaultService) TestReference(ctx context.Context, reference string) error {
+	call := f.testReference.record(VaultServiceTestReferenceCall{Ctx: ctx, Reference: reference})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, reference)
+	case f.Fallback != nil:
+		return f.Fallback.TestReference(ctx, reference)
+	}
+	return notProgrammed("FakeVaultService.TestReference")
+}
+
+// TestReferenceCallCount returns the number of calls to TestReference.
+func (f *FakeVaultService) TestReferenceCallCount() int {
+	return f.testReference.callCount()
+}
+
+// TestReferenceCalls returns the arguments of the calls to TestReference.
+func (f *FakeVaultService) TestReferenceCalls() []VaultServiceTestReferenceCall {
+	return f.testReference.allCalls()
+}
+
+// TestReferenceArgsForCall returns the arguments of the i-th call to TestReference.
+func (f *FakeVaultService) TestReferenceArgsForCall(i int) VaultServiceTestReferenceCall {
+	return f.testReference.argsForCall(i)
+}
+
+// TestReferenceStub makes TestReference delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) TestReferenceStub(stub func(context.Context, string) error) {
+	f.testReference.setStub(stub, stub != nil)
+}
+
+// TestReferenceReturns makes TestReference return the given values.
+func (f *FakeVaultService) TestReferenceReturns(r0 error) {
+	f.testReference.setReturns(vaultServiceTestReferenceResults{r0: r0})
+}
+
+// TestReferenceReturnsOnCall makes the i-th call to TestReference return the given values.
+func (f *FakeVaultService) TestReferenceReturnsOnCall(i int, r0 error) {
+	f.testReference.setReturnsOnCall(i, vaultServiceTestReferenceResults{r0: r0})
+}
+
+// GetFullSchema records the call and returns the programmed results.
+func (f *FakeVaultService) GetFullSchema(ctx context.Context, name *string) (kong.Schema, error) {
+	call := f.getFullSchema.record(VaultServiceGetFullSchemaCall{Ctx: ctx, Name: name})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, name)
+	case f.Fallback != nil:
+		return f.Fallback.GetFullSchema(ctx, name)
+	}
+	return call.results.r0, notProgrammed("FakeVaultService.GetFullSchema")
+}
+
+// GetFullSchemaCallCount returns the number of calls to GetFullSchema.
+func (f *FakeVaultService) GetFullSchemaCallCount() int {
+	return f.getFullSchema.callCount()
+}
+
+// GetFullSchemaCalls returns the arguments of the calls to GetFullSchema.
+func (f *FakeVaultService) GetFullSchemaCalls() []VaultServiceGetFullSchemaCall {
+	return f.getFullSchema.allCalls()
+}
+
+// GetFullSchemaArgsForCall returns the arguments of the i-th call to GetFullSchema.
+func (f *FakeVaultService) GetFullSchemaArgsForCall(i int) VaultServiceGetFullSchemaCall {
+	return f.getFullSchema.argsForCall(i)
+}
+
+// GetFullSchemaStub makes GetFullSchema delegate to stub, or stop doing so if stub is nil.
+func (f *FakeVaultService) GetFullSchemaStub(stub func(context.Context, *string) (kong.Schema, error)) {
+	f.getFullSchema.setStub(stub, stub != nil)
+}
+
+// GetFullSchemaReturns makes GetFullSchema return the given values.
+func (f *FakeVaultService) GetFullSchemaReturns(r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturns(vaultServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// GetFullSchemaReturnsOnCall makes the i-th call to GetFullSchema return the given values.
+func (f *FakeVaultService) GetFullSchemaReturnsOnCall(i int, r0 kong.Schema, r1 error) {
+	f.getFullSchema.setReturnsOnCall(i, vaultServiceGetFullSchemaResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeVaultService) Reset() {
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.validate.reset()
+	f.validateReference.reset()
+	f.testReference.reset()
+	f.getFullSchema.reset()
+}
+
+// WorkspaceServiceExistsCall holds the arguments of a call to FakeWorkspaceService.Exists.
+type WorkspaceServiceExistsCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type workspaceServiceExistsResults struct {
+	r0 bool
+	r1 error
+}
+
+// WorkspaceServiceExistsByNameCall holds the arguments of a call to FakeWorkspaceService.ExistsByName.
+type WorkspaceServiceExistsByNameCall struct {
+	Ctx  context.Context
+	Name *string
+}
+
+type workspaceServiceExistsByNameResults struct {
+	r0 bool
+	r1 error
+}
+
+// WorkspaceServiceCreateCall holds the arguments of a call to FakeWorkspaceService.Create.
+type WorkspaceServiceCreateCall struct {
+	Ctx       context.Context
+	Workspace *kong.Workspace
+}
+
+type workspaceServiceCreateResults struct {
+	r0 *kong.Workspace
+	r1 error
+}
+
+// WorkspaceServiceGetCall holds the arguments of a call to FakeWorkspaceService.Get.
+type WorkspaceServiceGetCall struct {
+	Ctx      context.Context
+	NameOrID *string
+}
+
+type workspaceServiceGetResults struct {
+	r0 *kong.Workspace
+	r1 error
+}
+
+// WorkspaceServiceUpdateCall holds the arguments of a call to FakeWorkspaceService.Update.
+type WorkspaceServiceUpdateCall struct {
+	Ctx       context.Context
+	Workspace *kong.Workspace
+}
+
+type workspaceServiceUpdateResults struct {
+	r0 *kong.Workspace
+	r1 error
+}
+
+// WorkspaceServiceDeleteCall holds the arguments of a call to FakeWorkspaceService.Delete.
+type WorkspaceServiceDeleteCall struct {
+	Ctx           context.Context
+	WorkspaceOrID *string
+}
+
+type workspaceServiceDeleteResults struct {
+	r0 error
+}
+
+// WorkspaceServiceListCall holds the arguments of a call to FakeWorkspaceService.List.
+type WorkspaceServiceListCall struct {
+	Ctx context.Context
+	Opt *kong.ListOpt
+}
+
+type workspaceServiceListResults struct {
+	r0 []*kong.Workspace
+	r1 *kong.ListOpt
+	r2 error
+}
+
+// WorkspaceServiceListAllCall holds the arguments of a call to FakeWorkspaceService.ListAll.
+type WorkspaceServiceListAllCall struct {
+	Ctx context.Context
+}
+
+type workspaceServiceListAllResults struct {
+	r0 []*kong.Workspace
+	r1 error
+}
+
+// WorkspaceServiceAddEntitiesCall holds the arguments of a call to FakeWorkspaceService.AddEntities.
+type WorkspaceServiceAddEntitiesCall struct {
+	Ctx               context.Context
+	WorkspaceNameOrID *string
+	EntityIDs         *string
+}
+
+type workspaceServiceAddEntitiesResults struct {
+	r0 *[]map[string]interface{}
+	r1 error
+}
+
+// WorkspaceServiceDeleteEntitiesCall holds the arguments of a call to FakeWorkspaceService.DeleteEntities.
+type WorkspaceServiceDeleteEntitiesCall struct {
+	Ctx               context.Context
+	WorkspaceNameOrID *string
+	EntityIDs         *string
+}
+
+type workspaceServiceDeleteEntitiesResults struct {
+	r0 error
+}
+
+// WorkspaceServiceListEntitiesCall holds the arguments of a call to FakeWorkspaceService.ListEntities.
+type WorkspaceServiceListEntitiesCall struct {
+	Ctx               context.Context
+	WorkspaceNameOrID *string
+}
+
+type workspaceServiceListEntitiesResults struct {
+	r0 []*kong.WorkspaceEntity
+	r1 error
+}
+
+// FakeWorkspaceService is a fake implementation of kong.AbstractWorkspaceService.
+type FakeWorkspaceService struct {
+	// Fallback serves the calls which aren't programmed.
+	// Such calls fail with ErrNotProgrammed when it is nil.
+	Fallback kong.AbstractWorkspaceService
+
+	exists         method[WorkspaceServiceExistsCall, workspaceServiceExistsResults, func(context.Context, *string) (bool, error)]
+	existsByName   method[WorkspaceServiceExistsByNameCall, workspaceServiceExistsByNameResults, func(context.Context, *string) (bool, error)]
+	create         method[WorkspaceServiceCreateCall, workspaceServiceCreateResults, func(context.Context, *kong.Workspace) (*kong.Workspace, error)]
+	get            method[WorkspaceServiceGetCall, workspaceServiceGetResults, func(context.Context, *string) (*kong.Workspace, error)]
+	update         method[WorkspaceServiceUpdateCall, workspaceServiceUpdateResults, func(context.Context, *kong.Workspace) (*kong.Workspace, error)]
+	delete         method[WorkspaceServiceDeleteCall, workspaceServiceDeleteResults, func(context.Context, *string) error]
+	list           method[WorkspaceServiceListCall, workspaceServiceListResults, func(context.Context, *kong.ListOpt) ([]*kong.Workspace, *kong.ListOpt, error)]
+	listAll        method[WorkspaceServiceListAllCall, workspaceServiceListAllResults, func(context.Context) ([]*kong.Workspace, error)]
+	addEntities    method[WorkspaceServiceAddEntitiesCall, workspaceServiceAddEntitiesResults, func(context.Context, *string, *string) (*[]map[string]interface{}, error)]
+	deleteEntities method[WorkspaceServiceDeleteEntitiesCall, workspaceServiceDeleteEntitiesResults, func(context.Context, *string, *string) error]
+	listEntities   method[WorkspaceServiceListEntitiesCall, workspaceServiceListEntitiesResults, func(context.Context, *string) ([]*kong.WorkspaceEntity, error)]
+}
+
+var _ kong.AbstractWorkspaceService = &FakeWorkspaceService{}
+
+// Exists records the call and returns the programmed results.
+func (f *FakeWorkspaceService) Exists(ctx context.Context, nameOrID *string) (bool, error) {
+	call := f.exists.record(WorkspaceServiceExistsCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Exists(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.Exists")
+}
+
+// ExistsCallCount returns the number of calls to Exists.
+func (f *FakeWorkspaceService) ExistsCallCount() int {
+	return f.exists.callCount()
+}
+
+// ExistsCalls returns the arguments of the calls to Exists.
+func (f *FakeWorkspaceService) ExistsCalls() []WorkspaceServiceExistsCall {
+	return f.exists.allCalls()
+}
+
+// ExistsArgsForCall returns the arguments of the i-th call to Exists.
+func (f *FakeWorkspaceService) ExistsArgsForCall(i int) WorkspaceServiceExistsCall {
+	return f.exists.argsForCall(i)
+}
+
+// ExistsStub makes Exists delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) ExistsStub(stub func(context.Context, *string) (bool, error)) {
+	f.exists.setStub(stub, stub != nil)
+}
+
+// ExistsReturns makes Exists return the given values.
+func (f *FakeWorkspaceService) ExistsReturns(r0 bool, r1 error) {
+	f.exists.setReturns(workspaceServiceExistsResults{r0: r0, r1: r1})
+}
+
+// ExistsReturnsOnCall makes the i-th call to Exists return the given values.
+func (f *FakeWorkspaceService) ExistsReturnsOnCall(i int, r0 bool, r1 error) {
+	f.exists.setReturnsOnCall(i, workspaceServiceExistsResults{r0: r0, r1: r1})
+}
+
+// ExistsByName records the call and returns the programmed results.
+func (f *FakeWorkspaceService) ExistsByName(ctx context.Context, name *string) (bool, error) {
+	call := f.existsByName.record(WorkspaceServiceExistsByNameCall{Ctx: ctx, Name: name})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, name)
+	case f.Fallback != nil:
+		return f.Fallback.ExistsByName(ctx, name)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.ExistsByName")
+}
+
+// ExistsByNameCallCount returns the number of calls to ExistsByName.
+func (f *FakeWorkspaceService) ExistsByNameCallCount() int {
+	return f.existsByName.callCount()
+}
+
+// ExistsByNameCalls returns the arguments of the calls to ExistsByName.
+func (f *FakeWorkspaceService) ExistsByNameCalls() []WorkspaceServiceExistsByNameCall {
+	return f.existsByName.allCalls()
+}
+
+// ExistsByNameArgsForCall returns the arguments of the i-th call to ExistsByName.
+func (f *FakeWorkspaceService) ExistsByNameArgsForCall(i int) WorkspaceServiceExistsByNameCall {
+	return f.existsByName.argsForCall(i)
+}
+
+// ExistsByNameStub makes ExistsByName delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) ExistsByNameStub(stub func(context.Context, *string) (bool, error)) {
+	f.existsByName.setStub(stub, stub != nil)
+}
+
+// ExistsByNameReturns makes ExistsByName return the given values.
+func (f *FakeWorkspaceService) ExistsByNameReturns(r0 bool, r1 error) {
+	f.existsByName.setReturns(workspaceServiceExistsByNameResults{r0: r0, r1: r1})
+}
+
+// ExistsByNameReturnsOnCall makes the i-th call to ExistsByName return the given values.
+func (f *FakeWorkspaceService) ExistsByNameReturnsOnCall(i int, r0 bool, r1 error) {
+	f.existsByName.setReturnsOnCall(i, workspaceServiceExistsByNameResults{r0: r0, r1: r1})
+}
+
+// Create records the call and returns the programmed results.
+func (f *FakeWorkspaceService) Create(ctx context.Context, workspace *kong.Workspace) (*kong.Workspace, error) {
+	call := f.create.record(WorkspaceServiceCreateCall{Ctx: ctx, Workspace: workspace})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, workspace)
+	case f.Fallback != nil:
+		return f.Fallback.Create(ctx, workspace)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.Create")
+}
+
+// CreateCallCount returns the number of calls to Create.
+func (f *FakeWorkspaceService) CreateCallCount() int {
+	return f.create.callCount()
+}
+
+// CreateCalls returns the arguments of the calls to Create.
+func (f *FakeWorkspaceService) CreateCalls() []WorkspaceServiceCreateCall {
+	return f.create.allCalls()
+}
+
+// CreateArgsForCall returns the arguments of the i-th call to Create.
+func (f *FakeWorkspaceService) CreateArgsForCall(i int) WorkspaceServiceCreateCall {
+	return f.create.argsForCall(i)
+}
+
+// CreateStub makes Create delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) CreateStub(stub func(context.Context, *kong.Workspace) (*kong.Workspace, error)) {
+	f.create.setStub(stub, stub != nil)
+}
+
+// CreateReturns makes Create return the given values.
+func (f *FakeWorkspaceService) CreateReturns(r0 *kong.Workspace, r1 error) {
+	f.create.setReturns(workspaceServiceCreateResults{r0: r0, r1: r1})
+}
+
+// CreateReturnsOnCall makes the i-th call to Create return the given values.
+func (f *FakeWorkspaceService) CreateReturnsOnCall(i int, r0 *kong.Workspace, r1 error) {
+	f.create.setReturnsOnCall(i, workspaceServiceCreateResults{r0: r0, r1: r1})
+}
+
+// Get records the call and returns the programmed results.
+func (f *FakeWorkspaceService) Get(ctx context.Context, nameOrID *string) (*kong.Workspace, error) {
+	call := f.get.record(WorkspaceServiceGetCall{Ctx: ctx, NameOrID: nameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, nameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Get(ctx, nameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.Get")
+}
+
+// GetCallCount returns the number of calls to Get.
+func (f *FakeWorkspaceService) GetCallCount() int {
+	return f.get.callCount()
+}
+
+// GetCalls returns the arguments of the calls to Get.
+func (f *FakeWorkspaceService) GetCalls() []WorkspaceServiceGetCall {
+	return f.get.allCalls()
+}
+
+// GetArgsForCall returns the arguments of the i-th call to Get.
+func (f *FakeWorkspaceService) GetArgsForCall(i int) WorkspaceServiceGetCall {
+	return f.get.argsForCall(i)
+}
+
+// GetStub makes Get delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) GetStub(stub func(context.Context, *string) (*kong.Workspace, error)) {
+	f.get.setStub(stub, stub != nil)
+}
+
+// GetReturns makes Get return the given values.
+func (f *FakeWorkspaceService) GetReturns(r0 *kong.Workspace, r1 error) {
+	f.get.setReturns(workspaceServiceGetResults{r0: r0, r1: r1})
+}
+
+// GetReturnsOnCall makes the i-th call to Get return the given values.
+func (f *FakeWorkspaceService) GetReturnsOnCall(i int, r0 *kong.Workspace, r1 error) {
+	f.get.setReturnsOnCall(i, workspaceServiceGetResults{r0: r0, r1: r1})
+}
+
+// Update records the call and returns the programmed results.
+func (f *FakeWorkspaceService) Update(ctx context.Context, workspace *kong.Workspace) (*kong.Workspace, error) {
+	call := f.update.record(WorkspaceServiceUpdateCall{Ctx: ctx, Workspace: workspace})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, workspace)
+	case f.Fallback != nil:
+		return f.Fallback.Update(ctx, workspace)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.Update")
+}
+
+// UpdateCallCount returns the number of calls to Update.
+func (f *FakeWorkspaceService) UpdateCallCount() int {
+	return f.update.callCount()
+}
+
+// UpdateCalls returns the arguments of the calls to Update.
+func (f *FakeWorkspaceService) UpdateCalls() []WorkspaceServiceUpdateCall {
+	return f.update.allCalls()
+}
+
+// UpdateArgsForCall returns the arguments of the i-th call to Update.
+func (f *FakeWorkspaceService) UpdateArgsForCall(i int) WorkspaceServiceUpdateCall {
+	return f.update.argsForCall(i)
+}
+
+// UpdateStub makes Update delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) UpdateStub(stub func(context.Context, *kong.Workspace) (*kong.Workspace, error)) {
+	f.update.setStub(stub, stub != nil)
+}
+
+// UpdateReturns makes Update return the given values.
+func (f *FakeWorkspaceService) UpdateReturns(r0 *kong.Workspace, r1 error) {
+	f.update.setReturns(workspaceServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// UpdateReturnsOnCall makes the i-th call to Update return the given values.
+func (f *FakeWorkspaceService) UpdateReturnsOnCall(i int, r0 *kong.Workspace, r1 error) {
+	f.update.setReturnsOnCall(i, workspaceServiceUpdateResults{r0: r0, r1: r1})
+}
+
+// Delete records the call and returns the programmed results.
+func (f *FakeWorkspaceService) Delete(ctx context.Context, WorkspaceOrID *string) error {
+	call := f.delete.record(WorkspaceServiceDeleteCall{Ctx: ctx, WorkspaceOrID: WorkspaceOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, WorkspaceOrID)
+	case f.Fallback != nil:
+		return f.Fallback.Delete(ctx, WorkspaceOrID)
+	}
+	return notProgrammed("FakeWorkspaceService.Delete")
+}
+
+// DeleteCallCount returns the number of calls to Delete.
+func (f *FakeWorkspaceService) DeleteCallCount() int {
+	return f.delete.callCount()
+}
+
+// DeleteCalls returns the arguments of the calls to Delete.
+func (f *FakeWorkspaceService) DeleteCalls() []WorkspaceServiceDeleteCall {
+	return f.delete.allCalls()
+}
+
+// DeleteArgsForCall returns the arguments of the i-th call to Delete.
+func (f *FakeWorkspaceService) DeleteArgsForCall(i int) WorkspaceServiceDeleteCall {
+	return f.delete.argsForCall(i)
+}
+
+// DeleteStub makes Delete delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) DeleteStub(stub func(context.Context, *string) error) {
+	f.delete.setStub(stub, stub != nil)
+}
+
+// DeleteReturns makes Delete return the given values.
+func (f *FakeWorkspaceService) DeleteReturns(r0 error) {
+	f.delete.setReturns(workspaceServiceDeleteResults{r0: r0})
+}
+
+// DeleteReturnsOnCall makes the i-th call to Delete return the given values.
+func (f *FakeWorkspaceService) DeleteReturnsOnCall(i int, r0 error) {
+	f.delete.setReturnsOnCall(i, workspaceServiceDeleteResults{r0: r0})
+}
+
+// List records the call and returns the programmed results.
+func (f *FakeWorkspaceService) List(ctx context.Context, opt *kong.ListOpt) ([]*kong.Workspace, *kong.ListOpt, error) {
+	call := f.list.record(WorkspaceServiceListCall{Ctx: ctx, Opt: opt})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1, call.results.r2
+	case call.stubbed:
+		return call.stub(ctx, opt)
+	case f.Fallback != nil:
+		return f.Fallback.List(ctx, opt)
+	}
+	return call.results.r0, call.results.r1, notProgrammed("FakeWorkspaceService.List")
+}
+
+// ListCallCount returns the number of calls to List.
+func (f *FakeWorkspaceService) ListCallCount() int {
+	return f.list.callCount()
+}
+
+// ListCalls returns the arguments of the calls to List.
+func (f *FakeWorkspaceService) ListCalls() []WorkspaceServiceListCall {
+	return f.list.allCalls()
+}
+
+// ListArgsForCall returns the arguments of the i-th call to List.
+func (f *FakeWorkspaceService) ListArgsForCall(i int) WorkspaceServiceListCall {
+	return f.list.argsForCall(i)
+}
+
+// ListStub makes List delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) ListStub(stub func(context.Context, *kong.ListOpt) ([]*kong.Workspace, *kong.ListOpt, error)) {
+	f.list.setStub(stub, stub != nil)
+}
+
+// ListReturns makes List return the given values.
+func (f *FakeWorkspaceService) ListReturns(r0 []*kong.Workspace, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturns(workspaceServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListReturnsOnCall makes the i-th call to List return the given values.
+func (f *FakeWorkspaceService) ListReturnsOnCall(i int, r0 []*kong.Workspace, r1 *kong.ListOpt, r2 error) {
+	f.list.setReturnsOnCall(i, workspaceServiceListResults{r0: r0, r1: r1, r2: r2})
+}
+
+// ListAll records the call and returns the programmed results.
+func (f *FakeWorkspaceService) ListAll(ctx context.Context) ([]*kong.Workspace, error) {
+	call := f.listAll.record(WorkspaceServiceListAllCall{Ctx: ctx})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx)
+	case f.Fallback != nil:
+		return f.Fallback.ListAll(ctx)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.ListAll")
+}
+
+// ListAllCallCount returns the number of calls to ListAll.
+func (f *FakeWorkspaceService) ListAllCallCount() int {
+	return f.listAll.callCount()
+}
+
+// ListAllCalls returns the arguments of the calls to ListAll.
+func (f *FakeWorkspaceService) ListAllCalls() []WorkspaceServiceListAllCall {
+	return f.listAll.allCalls()
+}
+
+// ListAllArgsForCall returns the arguments of the i-th call to ListAll.
+func (f *FakeWorkspaceService) ListAllArgsForCall(i int) WorkspaceServiceListAllCall {
+	return f.listAll.argsForCall(i)
+}
+
+// ListAllStub makes ListAll delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) ListAllStub(stub func(context.Context) ([]*kong.Workspace, error)) {
+	f.listAll.setStub(stub, stub != nil)
+}
+
+// ListAllReturns makes ListAll return the given values.
+func (f *FakeWorkspaceService) ListAllReturns(r0 []*kong.Workspace, r1 error) {
+	f.listAll.setReturns(workspaceServiceListAllResults{r0: r0, r1: r1})
+}
+
+// ListAllReturnsOnCall makes the i-th call to ListAll return the given values.
+func (f *FakeWorkspaceService) ListAllReturnsOnCall(i int, r0 []*kong.Workspace, r1 error) {
+	f.listAll.setReturnsOnCall(i, workspaceServiceListAllResults{r0: r0, r1: r1})
+}
+
+// AddEntities records the call and returns the programmed results.
+func (f *FakeWorkspaceService) AddEntities(ctx context.Context, workspaceNameOrID *string, entityIDs *string) (*[]map[string]interface{}, error) {
+	call := f.addEntities.record(WorkspaceServiceAddEntitiesCall{Ctx: ctx, WorkspaceNameOrID: workspaceNameOrID, EntityIDs: entityIDs})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, workspaceNameOrID, entityIDs)
+	case f.Fallback != nil:
+		return f.Fallback.AddEntities(ctx, workspaceNameOrID, entityIDs)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.AddEntities")
+}
+
+// AddEntitiesCallCount returns the number of calls to AddEntities.
+func (f *FakeWorkspaceService) AddEntitiesCallCount() int {
+	return f.addEntities.callCount()
+}
+
+// AddEntitiesCalls returns the arguments of the calls to AddEntities.
+func (f *FakeWorkspaceService) AddEntitiesCalls() []WorkspaceServiceAddEntitiesCall {
+	return f.addEntities.allCalls()
+}
+
+// AddEntitiesArgsForCall returns the arguments of the i-th call to AddEntities.
+func (f *FakeWorkspaceService) AddEntitiesArgsForCall(i int) WorkspaceServiceAddEntitiesCall {
+	return f.addEntities.argsForCall(i)
+}
+
+// AddEntitiesStub makes AddEntities delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) AddEntitiesStub(stub func(context.Context, *string, *string) (*[]map[string]interface{}, error)) {
+	f.addEntities.setStub(stub, stub != nil)
+}
+
+// AddEntitiesReturns makes AddEntities return the given values.
+func (f *FakeWorkspaceService) AddEntitiesReturns(r0 *[]map[string]interface{}, r1 error) {
+	f.addEntities.setReturns(workspaceServiceAddEntitiesResults{r0: r0, r1: r1})
+}
+
+// AddEntitiesReturnsOnCall makes the i-th call to AddEntities return the given values.
+func (f *FakeWorkspaceService) AddEntitiesReturnsOnCall(i int, r0 *[]map[string]interface{}, r1 error) {
+	f.addEntities.setReturnsOnCall(i, workspaceServiceAddEntitiesResults{r0: r0, r1: r1})
+}
+
+// DeleteEntities records the call and returns the programmed results.
+func (f *FakeWorkspaceService) DeleteEntities(ctx context.Context, workspaceNameOrID *string, entityIDs *string) error {
+	call := f.deleteEntities.record(WorkspaceServiceDeleteEntitiesCall{Ctx: ctx, WorkspaceNameOrID: workspaceNameOrID, EntityIDs: entityIDs})
+	switch {
+	case call.programmed:
+		return call.results.r0
+	case call.stubbed:
+		return call.stub(ctx, workspaceNameOrID, entityIDs)
+	case f.Fallback != nil:
+		return f.Fallback.DeleteEntities(ctx, workspaceNameOrID, entityIDs)
+	}
+	return notProgrammed("FakeWorkspaceService.DeleteEntities")
+}
+
+// DeleteEntitiesCallCount returns the number of calls to DeleteEntities.
+func (f *FakeWorkspaceService) DeleteEntitiesCallCount() int {
+	return f.deleteEntities.callCount()
+}
+
+// DeleteEntitiesCalls returns the arguments of the calls to DeleteEntities.
+func (f *FakeWorkspaceService) DeleteEntitiesCalls() []WorkspaceServiceDeleteEntitiesCall {
+	return f.deleteEntities.allCalls()
+}
+
+// DeleteEntitiesArgsForCall returns the arguments of the i-th call to DeleteEntities.
+func (f *FakeWorkspaceService) DeleteEntitiesArgsForCall(i int) WorkspaceServiceDeleteEntitiesCall {
+	return f.deleteEntities.argsForCall(i)
+}
+
+// DeleteEntitiesStub makes DeleteEntities delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) DeleteEntitiesStub(stub func(context.Context, *string, *string) error) {
+	f.deleteEntities.setStub(stub, stub != nil)
+}
+
+// DeleteEntitiesReturns makes DeleteEntities return the given values.
+func (f *FakeWorkspaceService) DeleteEntitiesReturns(r0 error) {
+	f.deleteEntities.setReturns(workspaceServiceDeleteEntitiesResults{r0: r0})
+}
+
+// DeleteEntitiesReturnsOnCall makes the i-th call to DeleteEntities return the given values.
+func (f *FakeWorkspaceService) DeleteEntitiesReturnsOnCall(i int, r0 error) {
+	f.deleteEntities.setReturnsOnCall(i, workspaceServiceDeleteEntitiesResults{r0: r0})
+}
+
+// ListEntities records the call and returns the programmed results.
+func (f *FakeWorkspaceService) ListEntities(ctx context.Context, workspaceNameOrID *string) ([]*kong.WorkspaceEntity, error) {
+	call := f.listEntities.record(WorkspaceServiceListEntitiesCall{Ctx: ctx, WorkspaceNameOrID: workspaceNameOrID})
+	switch {
+	case call.programmed:
+		return call.results.r0, call.results.r1
+	case call.stubbed:
+		return call.stub(ctx, workspaceNameOrID)
+	case f.Fallback != nil:
+		return f.Fallback.ListEntities(ctx, workspaceNameOrID)
+	}
+	return call.results.r0, notProgrammed("FakeWorkspaceService.ListEntities")
+}
+
+// ListEntitiesCallCount returns the number of calls to ListEntities.
+func (f *FakeWorkspaceService) ListEntitiesCallCount() int {
+	return f.listEntities.callCount()
+}
+
+// ListEntitiesCalls returns the arguments of the calls to ListEntities.
+func (f *FakeWorkspaceService) ListEntitiesCalls() []WorkspaceServiceListEntitiesCall {
+	return f.listEntities.allCalls()
+}
+
+// ListEntitiesArgsForCall returns the arguments of the i-th call to ListEntities.
+func (f *FakeWorkspaceService) ListEntitiesArgsForCall(i int) WorkspaceServiceListEntitiesCall {
+	return f.listEntities.argsForCall(i)
+}
+
+// ListEntitiesStub makes ListEntities delegate to stub, or stop doing so if stub is nil.
+func (f *FakeWorkspaceService) ListEntitiesStub(stub func(context.Context, *string) ([]*kong.WorkspaceEntity, error)) {
+	f.listEntities.setStub(stub, stub != nil)
+}
+
+// ListEntitiesReturns makes ListEntities return the given values.
+func (f *FakeWorkspaceService) ListEntitiesReturns(r0 []*kong.WorkspaceEntity, r1 error) {
+	f.listEntities.setReturns(workspaceServiceListEntitiesResults{r0: r0, r1: r1})
+}
+
+// ListEntitiesReturnsOnCall makes the i-th call to ListEntities return the given values.
+func (f *FakeWorkspaceService) ListEntitiesReturnsOnCall(i int, r0 []*kong.WorkspaceEntity, r1 error) {
+	f.listEntities.setReturnsOnCall(i, workspaceServiceListEntitiesResults{r0: r0, r1: r1})
+}
+
+// Reset forgets the recorded calls and the programmed results.
+func (f *FakeWorkspaceService) Reset() {
+	f.exists.reset()
+	f.existsByName.reset()
+	f.create.reset()
+	f.get.reset()
+	f.update.reset()
+	f.delete.reset()
+	f.list.reset()
+	f.listAll.reset()
+	f.addEntities.reset()
+	f.deleteEntities.reset()
+	f.listEntities.reset()
+}
+
+// Fakes holds the fakes wired in a Client built by NewClient.
+type Fakes struct {
+	ConsumerGroupConsumers             *FakeConsumerGroupConsumerService
+	ConsumerGroups                     *FakeConsumerGroupService
+	Consumers                          *FakeConsumerService
+	Developers                         *FakeDeveloperService
+	DeveloperRoles                     *FakeDeveloperRoleService
+	Services                           *FakeSvcService
+	Routes                             *FakeRouteService
+	CACertificates                     *FakeCACertificateService
+	Certificates                       *FakeCertificateService
+	Plugins                            *FakePluginService
+	SNIs                               *FakeSNIService
+	Upstreams                          *FakeUpstreamService
+	UpstreamNodeHealth                 *FakeUpstreamNodeHealthService
+	Targets                            *FakeTargetService
+	Workspaces                         *FakeWorkspaceService
+	Admins                             *FakeAdminService
+	RBACUsers                          *FakeRBACUserService
+	RBACRoles                          *FakeRBACRoleService
+	RBACEndpointPermissions            *FakeRBACEndpointPermissionService
+	RBACEntityPermissions              *FakeRBACEntityPermissionService
+	Vaults                             *FakeVaultService
+	Keys                               *FakeKeyService
+	KeySets                            *FakeKeySetService
+	KonnectApplication                 *FakeKonnectApplicationService
+	Licenses                           *FakeLicenseService
+	FilterChains                       *FakeFilterChainService
+	Partials                           *FakePartialService
+	ClonedPlugins                      *FakeClonedPluginService
+	CustomPlugins                      *FakeCustomPluginService
+	KeyAuths                           *FakeKeyAuthService
+	BasicAuths                         *FakeBasicAuthService
+	HMACAuths                          *FakeHMACAuthService
+	JWTAuths                           *FakeJWTAuthService
+	MTLSAuths                          *FakeMTLSAuthService
+	ACLs                               *FakeACLService
+	Oauth2Credentials                  *FakeOauth2Service
+	Tags                               *FakeTagService
+	Info                               *FakeInfoService
+	GraphqlRateLimitingCostDecorations *FakeGraphqlRateLimitingCostDecorationService
+	DegraphqlRoutes                    *FakeDegraphqlRouteService
+	Schemas                            *FakeSchemaService
+	CustomEntities                     *FakeCustomEntityService
+}
+
+// wireFakes replaces the services of client with fakes falling back to them.
+func wireFakes(client *kong.Client) *Fakes {
+	fakes := &Fakes{
+		ConsumerGroupConsumers:             &FakeConsumerGroupConsumerService{Fallback: client.ConsumerGroupConsumers},
+		ConsumerGroups:                     &FakeConsumerGroupService{Fallback: client.ConsumerGroups},
+		Consumers:                          &FakeConsumerService{Fallback: client.Consumers},
+		Developers:                         &FakeDeveloperService{Fallback: client.Developers},
+		DeveloperRoles:                     &FakeDeveloperRoleService{Fallback: client.DeveloperRoles},
+		Services:                           &FakeSvcService{Fallback: client.Services},
+		Routes:                             &FakeRouteService{Fallback: client.Routes},
+		CACertificates:                     &FakeCACertificateService{Fallback: client.CACertificates},
+		Certificates:                       &FakeCertificateService{Fallback: client.Certificates},
+		Plugins:                            &FakePluginService{Fallback: client.Plugins},
+		SNIs:                               &FakeSNIService{Fallback: client.SNIs},
+		Upstreams:                          &FakeUpstreamService{Fallback: client.Upstreams},
+		UpstreamNodeHealth:                 &FakeUpstreamNodeHealthService{Fallback: client.UpstreamNodeHealth},
+		Targets:                            &FakeTargetService{Fallback: client.Targets},
+		Workspaces:                         &FakeWorkspaceService{Fallback: client.Workspaces},
+		Admins:                             &FakeAdminService{Fallback: client.Admins},
+		RBACUsers:                          &FakeRBACUserService{Fallback: client.RBACUsers},
+		RBACRoles:                          &FakeRBACRoleService{Fallback: client.RBACRoles},
+		RBACEndpointPermissions:            &FakeRBACEndpointPermissionService{Fallback: client.RBACEndpointPermissions},
+		RBACEntityPermissions:              &FakeRBACEntityPermissionService{Fallback: client.RBACEntityPermissions},
+		Vaults:                             &FakeVaultService{Fallback: client.Vaults},
+		Keys:                               &FakeKeyService{Fallback: client.Keys},
+		KeySets:                            &FakeKeySetService{Fallback: client.KeySets},
+		KonnectApplication:                 &FakeKonnectApplicationService{Fallback: client.KonnectApplication},
+		Licenses:                           &FakeLicenseService{Fallback: client.Licenses},
+		FilterChains:                       &FakeFilterChainService{Fallback: client.FilterChains},
+		Partials:                           &FakePartialService{Fallback: client.Partials},
+		ClonedPlugins:                      &FakeClonedPluginService{Fallback: client.ClonedPlugins},
+		CustomPlugins:                      &FakeCustomPluginService{Fallback: client.CustomPlugins},
+		KeyAuths:                           &FakeKeyAuthService{Fallback: client.KeyAuths},
+		BasicAuths:                         &FakeBasicAuthService{Fallback: client.BasicAuths},
+		HMACAuths:                          &FakeHMACAuthService{Fallback: client.HMACAuths},
+		JWTAuths:                           &FakeJWTAuthService{Fallback: client.JWTAuths},
+		MTLSAuths:                          &FakeMTLSAuthService{Fallback: client.MTLSAuths},
+		ACLs:                               &FakeACLService{Fallback: client.ACLs},
+		Oauth2Credentials:                  &FakeOauth2Service{Fallback: client.Oauth2Credentials},
+		Tags:                               &FakeTagService{Fallback: client.Tags},
+		Info:                               &FakeInfoService{Fallback: client.Info},
+		GraphqlRateLimitingCostDecorations: &FakeGraphqlRateLimitingCostDecorationService{Fallback: client.GraphqlRateLimitingCostDecorations},
+		DegraphqlRoutes:                    &FakeDegraphqlRouteService{Fallback: client.DegraphqlRoutes},
+		Schemas:                            &FakeSchemaService{Fallback: client.Schemas},
+		CustomEntities:                     &FakeCustomEntityService{Fallback: client.CustomEntities},
+	}
+	client.ConsumerGroupConsumers = fakes.ConsumerGroupConsumers
+	client.ConsumerGroups = fakes.ConsumerGroups
+	client.Consumers = fakes.Consumers
+	client.Developers = fakes.Developers
+	client.DeveloperRoles = fakes.DeveloperRoles
+	client.Services = fakes.Services
+	client.Routes = fakes.Routes
+	client.CACertificates = fakes.CACertificates
+	client.Certificates = fakes.Certificates
+	client.Plugins = fakes.Plugins
+	client.SNIs = fakes.SNIs
+	client.Upstreams = fakes.Upstreams
+	client.UpstreamNodeHealth = fakes.UpstreamNodeHealth
+	client.Targets = fakes.Targets
+	client.Workspaces = fakes.Workspaces
+	client.Admins = fakes.Admins
+	client.RBACUsers = fakes.RBACUsers
+	client.RBACRoles = fakes.RBACRoles
+	client.RBACEndpointPermissions = fakes.RBACEndpointPermissions
+	client.RBACEntityPermissions = fakes.RBACEntityPermissions
+	client.Vaults = fakes.Vaults
+	client.Keys = fakes.Keys
+	client.KeySets = fakes.KeySets
+	client.KonnectApplication = fakes.KonnectApplication
+	client.Licenses = fakes.Licenses
+	client.FilterChains = fakes.FilterChains
+	client.Partials = fakes.Partials
+	client.ClonedPlugins = fakes.ClonedPlugins
+	client.CustomPlugins = fakes.CustomPlugins
+	client.KeyAuths = fakes.KeyAuths
+	client.BasicAuths = fakes.BasicAuths
+	client.HMACAuths = fakes.HMACAuths
+	client.JWTAuths = fakes.JWTAuths
+	client.MTLSAuths = fakes.MTLSAuths
+	client.ACLs = fakes.ACLs
+	client.Oauth2Credentials = fakes.Oauth2Credentials
+	client.Tags = fakes.Tags
+	client.Info = fakes.Info
+	client.GraphqlRateLimitingCostDecorations = fakes.GraphqlRateLimitingCostDecorations
+	client.DegraphqlRoutes = fakes.DegraphqlRoutes
+	client.Schemas = fakes.Schemas
+	client.CustomEntities = fakes.CustomEntities
+	return fakes
+}
+
+// Reset forgets the recorded calls and the programmed results of all fakes.
+func (f *Fakes) Reset() {
+	f.ConsumerGroupConsumers.Reset()
+	f.ConsumerGroups.Reset()
+	f.Consumers.Reset()
+	f.Developers.Reset()
+	f.DeveloperRoles.Reset()
+	f.Services.Reset()
+	f.Routes.Reset()
+	f.CACertificates.Reset()
+	f.Certificates.Reset()
+	f.Plugins.Reset()
+	f.SNIs.Reset()
+	f.Upstreams.Reset()
+	f.UpstreamNodeHealth.Reset()
+	f.Targets.Reset()
+	f.Workspaces.Reset()
+	f.Admins.Reset()
+	f.RBACUsers.Reset()
+	f.RBACRoles.Reset()
+	f.RBACEndpointPermissions.Reset()
+	f.RBACEntityPermissions.Reset()
+	f.Vaults.Reset()
+	f.Keys.Reset()
+	f.KeySets.Reset()
+	f.KonnectApplication.Reset()
+	f.Licenses.Reset()
+	f.FilterChains.Reset()
+	f.Partials.Reset()
+	f.ClonedPlugins.Reset()
+	f.CustomPlugins.Reset()
+	f.KeyAuths.Reset()
+	f.BasicAuths.Reset()
+	f.HMACAuths.Reset()
+	f.JWTAuths.Reset()
+	f.MTLSAuths.Reset()
+	f.ACLs.Reset()
+	f.Oauth2Credentials.Reset()
+	f.Tags.Reset()
+	f.Info.Reset()
+	f.GraphqlRateLimitingCostDecorations.Reset()
+	f.DegraphqlRoutes.Reset()
+	f.Schemas.Reset()
+	f.CustomEntities.Reset()
+}
@@ -0,0 +1,436 @@
+package kongtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode a Recorder operates in.
+type Mode int
+
+const (
+	// ModeReplay serves the interactions recorded in the cassette and never
+	// sends requests to the Admin API.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the Admin API and records the
+	// interactions in the cassette, replacing its previous content.
+	ModeRecord
+	// ModeReplayOrRecord replays the cassette if it exists and records it
+	// otherwise.
+	ModeReplayOrRecord
+)
+
+// ErrNoCassette is returned by NewRecorder in replay mode when the cassette
+// file doesn't exist.
+var ErrNoCassette = errors.New("cassette not found")
+
+// Redacted is the value substituted to secrets in recorded interactions.
+const Redacted = "REDACTED"
+
+var (
+	defaultRedactedHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Kong-Admin-Token",
+		"Proxy-Authorization",
+		"Set-Cookie",
+	}
+	defaultRedactedFields = []string{
+		"client_secret",
+		"key",
+		"password",
+		"private_key",
+		"secret",
+	}
+)
+
+// Cassette holds the Admin API interactions recorded by a Recorder.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded Admin API request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request in a Cassette.
+// Path doesn't include the scheme and host of the Admin API, which allows
+// replaying cassettes against any address.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Body holds JSON bodies, with their secrets redacted.
+	Body json.RawMessage `json:"body,omitempty"`
+	// RawBody holds the bodies which aren't JSON, as they were sent.
+	RawBody []byte `json:"raw_body,omitempty"`
+}
+
+// RecordedResponse is a response in a Cassette.
+type RecordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Body holds JSON bodies, with their secrets redacted.
+	Body json.RawMessage `json:"body,omitempty"`
+	// RawBody holds the bodies which aren't JSON, as they were received.
+	RawBody []byte `json:"raw_body,omitempty"`
+}
+
+// UnmatchedRequestError is returned by a replaying Recorder when a request
+// matches none of the interactions left in its cassette.
+type UnmatchedRequestError struct {
+	Request RecordedRequest
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	target := e.Request.Path
+	if e.Request.Query != "" {
+		target += "?" + e.Request.Query
+	}
+	return fmt.Sprintf("no recorded interaction matches %s %s", e.Request.Method, target)
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithRedactedHeaders adds headers to redact from recorded requests and
+// responses, in addition to the credentials and cookie headers.
+func WithRedactedHeaders(headers ...string) RecorderOption {
+	return func(r *Recorder) {
+		for _, h := range headers {
+			r.redactedHeaders = append(r.redactedHeaders, http.CanonicalHeaderKey(h))
+		}
+	}
+}
+
+// WithRedactedFields adds JSON body fields to redact from recorded requests
+// and responses, in addition to the passwords, keys and secrets.
+// Fields are redacted at any depth.
+func WithRedactedFields(fields ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.redactedFields = append(r.redactedFields, fields...)
+	}
+}
+
+// WithTransport sets the transport used by RoundTrip to send requests while
+// recording. It defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// Recorder records Admin API interactions to a cassette file and replays
+// them.
+// It can be used as the transport of the http.Client passed to
+// kong.NewClient, or as the Doer of a kong.Client through its Do method:
+//
+//	recorder, err := kongtest.NewRecorder("testdata/services.json", kongtest.ModeReplayOrRecord)
+//	...
+//	defer recorder.Stop()
+//	client.SetDoer(recorder.Do)
+//
+// Secrets are redacted before interactions are recorded or matched, so
+// replayed responses contain Redacted in place of secrets.
+type Recorder struct {
+	path            string
+	mode            Mode
+	transport       http.RoundTripper
+	redactedHeaders []string
+	redactedFields  []string
+
+	lock     sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file at path.
+// In replay mode, the cassette is loaded immediately.
+func NewRecorder(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:            path,
+		mode:            mode,
+		transport:       http.DefaultTransport,
+		redactedHeaders: append([]string{}, defaultRedactedHeaders...),
+		redactedFields:  append([]string{}, defaultRedactedFields...),
+		cassette:        &Cassette{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+	if r.mode == ModeReplay {
+		b, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrNoCassette, path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading cassette: %w", err)
+		}
+		if err := json.Unmarshal(b, r.cassette); err != nil {
+			return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode the Recorder operates in, ModeReplayOrRecord being
+// resolved to either ModeReplay or ModeRecord.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Cassette returns the interactions recorded or loaded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return &Cassette{Interactions: append([]*Interaction{}, r.cassette.Interactions...)}
+}
+
+// HTTPClient returns an http.Client using the Recorder as its transport.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.handle(req, r.transport.RoundTrip)
+}
+
+// Do dispatches requests with the kong.Doer signature.
+func (r *Recorder) Do(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error) {
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+	return r.handle(req, client.Do)
+}
+
+// Stop writes the recorded interactions to the cassette file.
+// It is a no-op in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) handle(
+	req *http.Request, send func(*http.Request) (*http.Response, error),
+) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.Query().Encode(),
+		Headers: r.redactHeaders(req.Header),
+	}
+	recorded.Body, recorded.RawBody = r.redactBody(body)
+
+	if r.mode == ModeReplay {
+		interaction, err := r.match(recorded)
+		if err != nil {
+			return nil, err
+		}
+		return interaction.Response.toHTTP(req), nil
+	}
+
+	resp, err := send(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	recordedResp := RecordedResponse{
+		Status:  resp.StatusCode,
+		Headers: r.redactHeaders(resp.Header),
+	}
+	recordedResp.Body, recordedResp.RawBody = r.redactBody(respBody)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recorded,
+		Response: recordedResp,
+	})
+	return resp, nil
+}
+
+// match returns the first unused interaction matching req by method, path,
+// query and normalized JSON body or raw body.
+func (r *Recorder) match(req RecordedRequest) (*Interaction, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	body := normalizeJSON(req.Body)
+	for i, interaction := range r.cassette.Interactions {
+		recorded := interaction.Request
+		if r.used[i] ||
+			recorded.Method != req.Method ||
+			recorded.Path != req.Path ||
+			recorded.Query != req.Query ||
+			!bytes.Equal(normalizeJSON(recorded.Body), body) ||
+			!bytes.Equal(recorded.RawBody, req.RawBody) {
+			continue
+		}
+		r.used[i] = true
+		return interaction, nil
+	}
+	return nil, &UnmatchedRequestError{Request: req}
+}
+
+func (resp RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := resp.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	body := []byte(resp.Body)
+	if resp.RawBody != nil {
+		body = resp.RawBody
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.Status, http.StatusText(resp.Status)),
+		StatusCode:    resp.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readBody reads body and replaces it with a reader over the same content.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// redactHeaders redacts the secrets of header. Content-Length is dropped as
+// redacting bodies changes their length, it is set back on replay.
+func (r *Recorder) redactHeaders(header http.Header) http.Header {
+	out := header.Clone()
+	out.Del("Content-Length")
+	if len(out) == 0 {
+		return nil
+	}
+	for _, h := range r.redactedHeaders {
+		if _, ok := out[h]; ok {
+			out[h] = []string{Redacted}
+		}
+	}
+	return out
+}
+
+// redactBody redacts the secrets of a JSON body. Bodies which aren't JSON are
+// returned untouched as the second value.
+func (r *Recorder) redactBody(body []byte) (json.RawMessage, []byte) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+	if !json.Valid(body) {
+		return nil, body
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, body
+	}
+	b, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return nil, body
+	}
+	return b, nil
+}
+
+func (r *Recorder) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e != nil && r.isRedactedField(k) {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = r.redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = r.redactValue(e)
+		}
+	}
+	return v
+}
+
+func (r *Recorder) isRedactedField(field string) bool {
+	for _, f := range r.redactedFields {
+		if strings.EqualFold(f, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeJSON returns body re-encoded with sorted object keys, so that
+// bodies differing only by formatting or key order compare equal.
+func normalizeJSON(body json.RawMessage) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return b
+}
@@ -0,0 +1,176 @@
+package kongtest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+// exercise runs the interactions recorded and replayed by the tests.
+func exercise(ctx context.Context, t *testing.T, client *kong.Client) {
+	t.Helper()
+
+	service, err := client.Services.Create(ctx, &kong.Service{
+		ID:   kong.String("0a1e4b11-0e32-4a3c-9c6c-1d1cf0f1a0a1"),
+		Name: kong.String("svc"),
+		Host: kong.String("example.com"),
+		Tags: kong.StringSlice("a", "b"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "svc", *service.Name)
+
+	consumer, err := client.Consumers.Create(ctx, &kong.Consumer{Username: kong.String("alice")})
+	require.NoError(t, err)
+	_, err = client.KeyAuths.Create(ctx, consumer.ID, &kong.KeyAuth{Key: kong.String("s3cr3t")})
+	require.NoError(t, err)
+
+	services, _, err := client.Services.List(ctx, &kong.ListOpt{Size: 10})
+	require.NoError(t, err)
+	assert.Len(t, services, 1)
+
+	_, err = client.Services.Get(ctx, kong.String("missing"))
+	assert.True(t, kong.IsNotFoundErr(err))
+}
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+
+	// Record against the fake Admin API, through the transport.
+	server := kongtest.NewServer()
+	recorder, err := kongtest.NewRecorder(path, kongtest.ModeReplayOrRecord)
+	require.NoError(t, err)
+	require.Equal(t, kongtest.ModeRecord, recorder.Mode())
+	httpClient := kong.HTTPClientWithHeaders(recorder.HTTPClient(), http.Header{
+		"Kong-Admin-Token": []string{"admin-token"},
+	})
+	client, err := kong.NewClient(kong.String(server.URL), httpClient)
+	require.NoError(t, err)
+	exercise(ctx, t, client)
+	require.NoError(t, recorder.Stop())
+	server.Close()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "s3cr3t")
+	assert.NotContains(t, string(content), "admin-token")
+	assert.Contains(t, string(content), kongtest.Redacted)
+	assert.Len(t, recorder.Cassette().Interactions, 5)
+
+	// Replay without any server, through the Doer.
+	recorder, err = kongtest.NewRecorder(path, kongtest.ModeReplayOrRecord)
+	require.NoError(t, err)
+	require.Equal(t, kongtest.ModeReplay, recorder.Mode())
+	client, err = kong.NewClient(kong.String("http://kong.invalid:8001"), nil)
+	require.NoError(t, err)
+	client.SetDoer(recorder.Do)
+	exercise(ctx, t, client)
+
+	// Every interaction has been consumed.
+	_, err = client.Services.Get(ctx, kong.String("missing"))
+	var unmatched *kongtest.UnmatchedRequestError
+	require.True(t, errors.As(err, &unmatched))
+	assert.Equal(t, "/services/missing", unmatched.Request.Path)
+}
+
+func TestRecorderMatchesNormalizedJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "interactions": [
+    {
+      "request": {
+        "method": "POST",
+        "path": "/services",
+        "query": "a=1&b=2",
+        "body": {"name": "svc", "host": "example.com"}
+      },
+      "response": {
+        "status": 201,
+        "headers": {"Content-Type": ["application/json"]},
+        "body": {"id": "0a1e4b11-0e32-4a3c-9c6c-1d1cf0f1a0a1", "name": "svc"}
+      }
+    }
+  ]
+}`), 0o600))
+
+	recorder, err := kongtest.NewRecorder(path, kongtest.ModeReplay)
+	require.NoError(t, err)
+	client := recorder.HTTPClient()
+
+	resp, err := client.Post("http://localhost:8001/services?b=2&a=1", "application/json",
+		strings.NewReader(`{ "host":"example.com","name":"svc" }`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	_, err = client.Post("http://localhost:8001/services?a=1&b=2", "application/json",
+		strings.NewReader(`{"name":"other","host":"example.com"}`))
+	require.Error(t, err)
+}
+
+func TestRecorderRawBodies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/text" {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("plain \"text\"\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"password": "a-long-password"}`))
+	}))
+	defer server.Close()
+
+	recorder, err := kongtest.NewRecorder(path, kongtest.ModeRecord)
+	require.NoError(t, err)
+	send := func(recorder *kongtest.Recorder, path string) (*http.Response, string) {
+		resp, err := recorder.HTTPClient().Post(server.URL+path, "application/x-www-form-urlencoded",
+			strings.NewReader("name=svc&host=example.com"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+	_, body := send(recorder, "/text")
+	assert.Equal(t, "plain \"text\"\n", body)
+	send(recorder, "/json")
+	require.NoError(t, recorder.Stop())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "Content-Length")
+
+	recorder, err = kongtest.NewRecorder(path, kongtest.ModeReplay)
+	require.NoError(t, err)
+	// Raw request bodies are matched as sent.
+	_, err = recorder.HTTPClient().Post(server.URL+"/text", "application/x-www-form-urlencoded",
+		strings.NewReader("name=other&host=example.com"))
+	var unmatched *kongtest.UnmatchedRequestError
+	require.ErrorAs(t, err, &unmatched)
+	resp, body := send(recorder, "/text")
+	assert.Equal(t, "plain \"text\"\n", body)
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+	resp, body = send(recorder, "/json")
+	assert.JSONEq(t, `{"password": "REDACTED"}`, body)
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+	assert.Equal(t, strconv.Itoa(len(body)), resp.Header.Get("Content-Length"))
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := kongtest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), kongtest.ModeReplay)
+	assert.ErrorIs(t, err, kongtest.ErrNoCassette)
+}
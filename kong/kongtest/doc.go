@@ -0,0 +1,4 @@
+// Package kongtest provides an in-memory fake of the Kong Admin API
+// to unit test code built on go-kong without a running Kong, and a
+// Recorder to record Admin API interactions and replay them in tests.
+package kongtest
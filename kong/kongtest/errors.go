@@ -0,0 +1,105 @@
+package kongtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Error codes used by Kong in error responses.
+// See https://github.com/Kong/kong/blob/master/kong/db/errors.lua
+const (
+	codeSchemaViolation     = 2
+	codePrimaryKeyViolation = 3
+	codeForeignKeyViolation = 4
+	codeUniqueViolation     = 5
+	codeInvalidOffset       = 7
+	codeInvalidSize         = 9
+)
+
+// apiError is an error response of the fake Admin API.
+type apiError struct {
+	status int
+	body   record
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("HTTP status %d (message: %q)", e.status, e.body["message"])
+}
+
+func newAPIError(status int, message string) *apiError {
+	return &apiError{status: status, body: record{"message": message}}
+}
+
+func notFound() *apiError {
+	return newAPIError(http.StatusNotFound, "Not found")
+}
+
+func methodNotAllowed() *apiError {
+	return newAPIError(http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+func dbError(status, code int, name, message string, fields record) *apiError {
+	body := record{
+		"code":    code,
+		"name":    name,
+		"message": message,
+	}
+	if fields != nil {
+		body["fields"] = fields
+	}
+	return &apiError{status: status, body: body}
+}
+
+// schemaViolation returns an error reporting field violations, keyed by field
+// name, and entity-level violations.
+func schemaViolation(fields map[string]string, entity []string) *apiError {
+	var parts []string
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	body := record{}
+	for _, name := range names {
+		parts = append(parts, name+": "+fields[name])
+		body[name] = fields[name]
+	}
+	if len(entity) > 0 {
+		parts = append(parts, entity...)
+		checks := make([]interface{}, 0, len(entity))
+		for _, e := range entity {
+			checks = append(checks, e)
+		}
+		body["@entity"] = checks
+	}
+
+	message := "schema violation (" + strings.Join(parts, "; ") + ")"
+	if len(parts) > 1 {
+		message = fmt.Sprintf("%d schema violations (%s)", len(parts), strings.Join(parts, "; "))
+	}
+	return dbError(http.StatusBadRequest, codeSchemaViolation, "schema violation", message, body)
+}
+
+// luaValue formats v the way Kong formats values in error messages.
+func luaValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case record:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := make([]string, 0, len(keys))
+		for _, k := range keys {
+			parts = append(parts, k+"="+luaValue(v[k]))
+		}
+		return "{" + strings.Join(parts, ",") + "}"
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,419 @@
+package kongtest
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// record is an entity as stored by the fake Admin API.
+type record = map[string]interface{}
+
+// foreignKey describes a foreign reference held by an entity.
+type foreignKey struct {
+	field     string
+	reference string
+	required  bool
+	// cascade deletes the entity when the referenced entity is deleted.
+	// Otherwise the referenced entity can't be deleted.
+	cascade bool
+}
+
+// entitySchema describes the semantics of an entity type of the Admin API.
+type entitySchema struct {
+	// name is the name of the entity type, as used in errors and tags.
+	name string
+	// path is the top-level Admin API collection of the entity type.
+	path string
+	// nested holds the collection of the entity type nested under an entity
+	// of another type, keyed by the name of that type.
+	nested map[string]string
+	// endpointKey is the field which can be used instead of the ID in URLs.
+	endpointKey string
+
+	foreignKeys []foreignKey
+	// uniques lists the sets of fields whose combined values must be unique.
+	uniques  [][]string
+	required []string
+	defaults record
+	// generated holds generators for fields which are given a random value
+	// when not set.
+	generated map[string]func() string
+	// normalize is called with the entity to write and the request body
+	// before defaults are applied and the entity is validated.
+	normalize func(e, body record)
+	// check returns the entity-level violations of the entity.
+	check func(e record) []string
+}
+
+func (s *entitySchema) foreignKey(field string) (foreignKey, bool) {
+	for _, fk := range s.foreignKeys {
+		if fk.field == field {
+			return fk, true
+		}
+	}
+	return foreignKey{}, false
+}
+
+func randomHex() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+var (
+	defaultUpstreamHealthchecks = record{
+		"threshold": 0,
+		"active": record{
+			"type":                     "http",
+			"concurrency":              10,
+			"http_path":                "/",
+			"https_verify_certificate": true,
+			"timeout":                  1,
+			"healthy": record{
+				"http_statuses": []interface{}{200, 302},
+				"interval":      0,
+				"successes":     0,
+			},
+			"unhealthy": record{
+				"http_failures": 0,
+				"http_statuses": []interface{}{429, 404, 500, 501, 502, 503, 504, 505},
+				"interval":      0,
+				"tcp_failures":  0,
+				"timeouts":      0,
+			},
+		},
+		"passive": record{
+			"type": "http",
+			"healthy": record{
+				"http_statuses": []interface{}{
+					200, 201, 202, 203, 204, 205, 206, 207, 208, 226,
+					300, 301, 302, 303, 304, 305, 306, 307, 308,
+				},
+				"successes": 0,
+			},
+			"unhealthy": record{
+				"http_failures": 0,
+				"http_statuses": []interface{}{429, 500, 503},
+				"tcp_failures":  0,
+				"timeouts":      0,
+			},
+		},
+	}
+
+	consumerForeignKey = foreignKey{field: "consumer", reference: "consumers", required: true, cascade: true}
+)
+
+// schemas holds the entity types supported by the fake Admin API, in the
+// order they are listed by the tags endpoint.
+var schemas = []*entitySchema{
+	{
+		name:        "services",
+		path:        "services",
+		endpointKey: "name",
+		foreignKeys: []foreignKey{{field: "client_certificate", reference: "certificates"}},
+		uniques:     [][]string{{"name"}},
+		required:    []string{"host"},
+		defaults: record{
+			"protocol":        "http",
+			"port":            80,
+			"retries":         5,
+			"connect_timeout": 60000,
+			"write_timeout":   60000,
+			"read_timeout":    60000,
+			"enabled":         true,
+		},
+		normalize: normalizeServiceURL,
+	},
+	{
+		name:        "routes",
+		path:        "routes",
+		nested:      map[string]string{"services": "routes"},
+		endpointKey: "name",
+		foreignKeys: []foreignKey{{field: "service", reference: "services"}},
+		uniques:     [][]string{{"name"}},
+		defaults: record{
+			"protocols":                  []interface{}{"http", "https"},
+			"https_redirect_status_code": 426,
+			"regex_priority":             0,
+			"strip_path":                 true,
+			"preserve_host":              false,
+			"request_buffering":          true,
+			"response_buffering":         true,
+			"path_handling":              "v0",
+		},
+		check: checkRouteMatchingRules,
+	},
+	{
+		name:        "consumers",
+		path:        "consumers",
+		endpointKey: "username",
+		uniques:     [][]string{{"username"}, {"custom_id"}},
+		check: func(e record) []string {
+			if isEmpty(e["username"]) && isEmpty(e["custom_id"]) {
+				return []string{"at least one of these fields must be non-empty: 'custom_id', 'username'"}
+			}
+			return nil
+		},
+	},
+	{
+		name: "plugins",
+		path: "plugins",
+		nested: map[string]string{
+			"services":  "plugins",
+			"routes":    "plugins",
+			"consumers": "plugins",
+		},
+		foreignKeys: []foreignKey{
+			{field: "service", reference: "services", cascade: true},
+			{field: "route", reference: "routes", cascade: true},
+			{field: "consumer", reference: "consumers", cascade: true},
+		},
+		uniques:  [][]string{{"name", "service", "route", "consumer"}, {"instance_name"}},
+		required: []string{"name"},
+		defaults: record{
+			"enabled":   true,
+			"protocols": []interface{}{"grpc", "grpcs", "http", "https"},
+			"config":    record{},
+		},
+	},
+	{
+		name:     "certificates",
+		path:     "certificates",
+		required: []string{"cert", "key"},
+		// SNIs are stored as separate entities, see Server.syncCertificateSNIs.
+		normalize: func(e, _ record) { delete(e, "snis") },
+	},
+	{
+		name:        "snis",
+		path:        "snis",
+		nested:      map[string]string{"certificates": "snis"},
+		endpointKey: "name",
+		foreignKeys: []foreignKey{{field: "certificate", reference: "certificates", required: true, cascade: true}},
+		uniques:     [][]string{{"name"}},
+		required:    []string{"name"},
+	},
+	{
+		name:     "ca_certificates",
+		path:     "ca_certificates",
+		uniques:  [][]string{{"cert"}},
+		required: []string{"cert"},
+	},
+	{
+		name:        "upstreams",
+		path:        "upstreams",
+		endpointKey: "name",
+		foreignKeys: []foreignKey{{field: "client_certificate", reference: "certificates"}},
+		uniques:     [][]string{{"name"}},
+		required:    []string{"name"},
+		defaults: record{
+			"algorithm":           "round-robin",
+			"hash_on":             "none",
+			"hash_fallback":       "none",
+			"hash_on_cookie_path": "/",
+			"slots":               10000,
+			"use_srv_name":        false,
+			"healthchecks":        defaultUpstreamHealthchecks,
+		},
+	},
+	{
+		name:        "targets",
+		path:        "targets",
+		nested:      map[string]string{"upstreams": "targets"},
+		endpointKey: "target",
+		foreignKeys: []foreignKey{{field: "upstream", reference: "upstreams", required: true, cascade: true}},
+		uniques:     [][]string{{"upstream", "target"}},
+		required:    []string{"target"},
+		defaults:    record{"weight": 100},
+		normalize: func(e, _ record) {
+			target, ok := e["target"].(string)
+			if !ok || target == "" {
+				return
+			}
+			if _, _, err := net.SplitHostPort(target); err != nil {
+				e["target"] = net.JoinHostPort(strings.Trim(target, "[]"), "8000")
+			}
+		},
+	},
+	{
+		name:        "keyauth_credentials",
+		path:        "key-auths",
+		nested:      map[string]string{"consumers": "key-auth"},
+		endpointKey: "key",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"key"}},
+		generated:   map[string]func() string{"key": randomHex},
+	},
+	{
+		name:        "basicauth_credentials",
+		path:        "basic-auths",
+		nested:      map[string]string{"consumers": "basic-auth"},
+		endpointKey: "username",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"username"}},
+		required:    []string{"username", "password"},
+		normalize:   hashBasicAuthPassword,
+	},
+	{
+		name:        "hmacauth_credentials",
+		path:        "hmac-auths",
+		nested:      map[string]string{"consumers": "hmac-auth"},
+		endpointKey: "username",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"username"}},
+		required:    []string{"username"},
+		generated:   map[string]func() string{"secret": randomHex},
+	},
+	{
+		name:        "jwt_secrets",
+		path:        "jwts",
+		nested:      map[string]string{"consumers": "jwt"},
+		endpointKey: "key",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"key"}},
+		defaults:    record{"algorithm": "HS256"},
+		generated:   map[string]func() string{"key": randomHex, "secret": randomHex},
+	},
+	{
+		name:        "acls",
+		path:        "acls",
+		nested:      map[string]string{"consumers": "acls"},
+		endpointKey: "group",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"consumer", "group"}},
+		required:    []string{"group"},
+	},
+	{
+		name:        "oauth2_credentials",
+		path:        "oauth2",
+		nested:      map[string]string{"consumers": "oauth2"},
+		endpointKey: "client_id",
+		foreignKeys: []foreignKey{consumerForeignKey},
+		uniques:     [][]string{{"client_id"}},
+		required:    []string{"name"},
+		defaults:    record{"hash_secret": false, "client_type": "confidential"},
+		generated:   map[string]func() string{"client_id": randomHex, "client_secret": randomHex},
+	},
+	{
+		name:   "mtls_auth_credentials",
+		path:   "mtls-auths",
+		nested: map[string]string{"consumers": "mtls-auth"},
+		foreignKeys: []foreignKey{
+			consumerForeignKey,
+			{field: "ca_certificate", reference: "ca_certificates"},
+		},
+		required: []string{"subject_name"},
+	},
+}
+
+var (
+	schemasByName = map[string]*entitySchema{}
+	schemasByPath = map[string]*entitySchema{}
+)
+
+func init() {
+	for _, s := range schemas {
+		schemasByName[s.name] = s
+		schemasByPath[s.path] = s
+	}
+}
+
+// nestedSchema returns the schema of the entities listed under path for an
+// entity of type parent, along with the foreign key referencing the parent.
+func nestedSchema(parent *entitySchema, path string) (*entitySchema, foreignKey, bool) {
+	for _, s := range schemas {
+		if s.nested[parent.name] != path {
+			continue
+		}
+		for _, fk := range s.foreignKeys {
+			if fk.reference == parent.name {
+				return s, fk, true
+			}
+		}
+	}
+	return nil, foreignKey{}, false
+}
+
+// normalizeServiceURL splits the url shorthand field of services into the
+// protocol, host, port and path fields.
+func normalizeServiceURL(e, _ record) {
+	rawURL, ok := e["url"].(string)
+	delete(e, "url")
+	if !ok || rawURL == "" {
+		return
+	}
+
+	protocol, rest, found := strings.Cut(rawURL, "://")
+	if !found {
+		return
+	}
+	e["protocol"] = protocol
+	hostPort, path, hasPath := strings.Cut(rest, "/")
+	if hasPath {
+		e["path"] = "/" + path
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		host = hostPort
+		switch protocol {
+		case "https", "grpcs", "tls":
+			port = "443"
+		default:
+			port = "80"
+		}
+	}
+	e["host"] = host
+	var p int
+	if _, err := fmt.Sscanf(port, "%d", &p); err == nil {
+		e["port"] = p
+	}
+}
+
+// checkRouteMatchingRules makes sure routes proxying HTTP traffic have at
+// least one matching rule.
+func checkRouteMatchingRules(e record) []string {
+	protocols, _ := e["protocols"].([]interface{})
+	http := false
+	for _, p := range protocols {
+		if p == "http" || p == "https" {
+			http = true
+		}
+	}
+	if !http {
+		return nil
+	}
+	for _, field := range []string{"methods", "hosts", "headers", "paths", "expression"} {
+		if !isEmpty(e[field]) {
+			return nil
+		}
+	}
+	return []string{"must set one of 'methods', 'hosts', 'headers', 'paths' when 'protocols' is 'http'"}
+}
+
+// hashBasicAuthPassword hashes passwords set by the request the way Kong does.
+func hashBasicAuthPassword(e, body record) {
+	password, ok := body["password"].(string)
+	if !ok {
+		return
+	}
+	consumer, _ := e["consumer"].(record)
+	consumerID, _ := consumer["id"].(string)
+	sum := sha1.Sum([]byte(password + consumerID)) //nolint:gosec
+	e["password"] = hex.EncodeToString(sum[:])
+}
+
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case record:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,981 @@
+package kongtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kong/go-kong/kong"
+)
+
+const (
+	// DefaultVersion is the Kong version reported by a Handler unless
+	// configured otherwise with WithVersion.
+	DefaultVersion = "3.10.0"
+	// DefaultWorkspace is the name of the workspace which always exists.
+	DefaultWorkspace = "default"
+
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// Handler is an in-memory fake of the Kong Admin API.
+// It implements CRUD operations, pagination, uniqueness and foreign key
+// constraints for the core Kong entities (services, routes, plugins,
+// consumers, upstreams, targets, certificates, SNIs, CA certificates and
+// consumer credentials), as well as tags and workspaces.
+// It doesn't proxy traffic nor validate plugin configurations.
+//
+// A Handler can be served by an http.Server, or used as the transport of an
+// http.Client to serve requests without any network listener.
+type Handler struct {
+	lock       sync.Mutex
+	version    string
+	now        func() time.Time
+	workspaces []*workspace
+}
+
+// Server is an HTTP server serving a Handler on a system-chosen port on the
+// local loopback interface, for use in end-to-end tests.
+type Server struct {
+	*httptest.Server
+	*Handler
+}
+
+type workspace struct {
+	entity record
+	// entities holds the entities of the workspace by type and ID.
+	entities map[string]map[string]record
+}
+
+func (ws *workspace) name() string {
+	name, _ := ws.entity["name"].(string)
+	return name
+}
+
+// Option configures a Handler.
+type Option func(*Handler)
+
+// WithVersion sets the Kong version reported by the root endpoint.
+func WithVersion(version string) Option {
+	return func(h *Handler) {
+		h.version = version
+	}
+}
+
+// WithClock sets the function used to timestamp entities.
+func WithClock(now func() time.Time) Option {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+// NewHandler returns a new fake Admin API handler.
+func NewHandler(opts ...Option) *Handler {
+	h := &Handler{
+		version: DefaultVersion,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.workspaces = []*workspace{h.newWorkspace(record{"name": DefaultWorkspace})}
+	return h
+}
+
+// NewServer starts and returns a new fake Admin API server.
+// The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	h := NewHandler(opts...)
+	return &Server{
+		Server:  httptest.NewServer(h),
+		Handler: h,
+	}
+}
+
+// SetVersion changes the Kong version reported by the root endpoint.
+func (h *Handler) SetVersion(version string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.version = version
+}
+
+func (h *Handler) newWorkspace(entity record) *workspace {
+	if _, ok := entity["id"]; !ok {
+		entity["id"] = uuid.NewString()
+	}
+	if _, ok := entity["config"]; !ok {
+		entity["config"] = record{}
+	}
+	if _, ok := entity["meta"]; !ok {
+		entity["meta"] = record{}
+	}
+	entity["created_at"] = h.now().Unix()
+	return &workspace{entity: entity, entities: map[string]map[string]record{}}
+}
+
+func (h *Handler) workspace(nameOrID string) *workspace {
+	for _, ws := range h.workspaces {
+		if ws.name() == nameOrID || ws.entity["id"] == nameOrID {
+			return ws
+		}
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper by serving req in-process.
+func (h *Handler) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.lock.Lock()
+	status, body := h.serve(r)
+	h.lock.Unlock()
+
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// request is an Admin API request being served.
+type request struct {
+	method   string
+	query    url.Values
+	body     record
+	segments []string
+	ws       *workspace
+}
+
+func (h *Handler) serve(r *http.Request) (int, interface{}) {
+	req := &request{
+		method:   r.Method,
+		query:    r.URL.Query(),
+		segments: splitPath(r.URL.Path),
+		ws:       h.workspaces[0],
+	}
+
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		req.body = record{}
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			return errorResponse(newAPIError(http.StatusBadRequest, "Cannot read request body"))
+		}
+		if b.Len() > 0 {
+			decoder := json.NewDecoder(&b)
+			decoder.UseNumber()
+			if err := decoder.Decode(&req.body); err != nil {
+				return errorResponse(newAPIError(http.StatusBadRequest, "Cannot parse JSON body"))
+			}
+		}
+	}
+
+	// A leading segment which isn't a known endpoint selects a workspace.
+	if len(req.segments) > 0 && !isTopLevelEndpoint(req.segments[0]) {
+		ws := h.workspace(req.segments[0])
+		if ws == nil {
+			return errorResponse(notFound())
+		}
+		req.ws = ws
+		req.segments = req.segments[1:]
+	}
+
+	status, body, err := h.route(req)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return status, body
+}
+
+func errorResponse(err *apiError) (int, interface{}) {
+	return err.status, err.body
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if s == "" {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(s); err == nil {
+			s = unescaped
+		}
+		segments = append(segments, s)
+	}
+	return segments
+}
+
+func isTopLevelEndpoint(segment string) bool {
+	switch segment {
+	case "kong", "status", "workspaces", "tags":
+		return true
+	}
+	_, ok := schemasByPath[segment]
+	return ok
+}
+
+func (h *Handler) route(req *request) (int, interface{}, *apiError) {
+	segs := req.segments
+	if len(segs) == 0 || (len(segs) == 1 && segs[0] == "kong") {
+		if req.method != http.MethodGet {
+			return 0, nil, methodNotAllowed()
+		}
+		return http.StatusOK, h.rootInfo(), nil
+	}
+
+	switch segs[0] {
+	case "status":
+		return http.StatusOK, record{
+			"database": record{"reachable": true},
+			"server": record{
+				"connections_accepted": 1, "connections_active": 1, "connections_handled": 1,
+				"connections_reading": 0, "connections_waiting": 0, "connections_writing": 1,
+				"total_requests": 1,
+			},
+		}, nil
+	case "workspaces":
+		return h.routeWorkspaces(req, segs[1:])
+	case "tags":
+		if req.method != http.MethodGet || len(segs) > 2 {
+			return 0, nil, methodNotAllowed()
+		}
+		tag := ""
+		if len(segs) == 2 {
+			tag = segs[1]
+		}
+		return h.listTags(req, tag)
+	}
+
+	schema := schemasByPath[segs[0]]
+	switch len(segs) {
+	case 1:
+		return h.collection(req, schema, nil)
+	case 2:
+		return h.item(req, schema, segs[1], nil)
+	}
+
+	// Nested collections, e.g. /services/{service}/routes.
+	parent, err := h.find(req.ws, schema, segs[1], nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	child, fk, ok := nestedSchema(schema, segs[2])
+	if !ok {
+		return 0, nil, notFound()
+	}
+	scope := &parentScope{fk: fk, id: parent["id"].(string)}
+	switch {
+	case len(segs) == 3:
+		return h.collection(req, child, scope)
+	case len(segs) == 4 && child.name == "targets" && segs[3] == "all":
+		return h.collection(req, child, scope)
+	case len(segs) == 4:
+		return h.item(req, child, segs[3], scope)
+	case len(segs) == 5 && child.name == "targets" && (segs[4] == "healthy" || segs[4] == "unhealthy"):
+		if req.method != http.MethodPost && req.method != http.MethodPut {
+			return 0, nil, methodNotAllowed()
+		}
+		if _, err := h.find(req.ws, child, segs[3], scope); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, notFound()
+}
+
+// parentScope restricts the entities of a nested collection to the ones
+// referencing a parent entity.
+type parentScope struct {
+	fk foreignKey
+	id string
+}
+
+func (p *parentScope) matches(e record) bool {
+	if p == nil {
+		return true
+	}
+	return foreignID(e[p.fk.field]) == p.id
+}
+
+func (h *Handler) collection(req *request, schema *entitySchema, scope *parentScope) (int, interface{}, *apiError) {
+	switch req.method {
+	case http.MethodGet:
+		var entities []record
+		for _, e := range req.ws.entities[schema.name] {
+			if !scope.matches(e) {
+				continue
+			}
+			if customID := req.query.Get("custom_id"); customID != "" && e["custom_id"] != customID {
+				continue
+			}
+			entities = append(entities, e)
+		}
+		entities, err := filterByTags(entities, req.query.Get("tags"))
+		if err != nil {
+			return 0, nil, err
+		}
+		items := make([]listItem, 0, len(entities))
+		for _, e := range entities {
+			items = append(items, listItem{key: e["id"].(string), value: h.render(req.ws, schema, e)})
+		}
+		return paginate(req, items)
+	case http.MethodPost:
+		e, err := h.write(req.ws, schema, nil, req.body, scope)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusCreated, h.render(req.ws, schema, e), nil
+	}
+	return 0, nil, methodNotAllowed()
+}
+
+func (h *Handler) item(
+	req *request, schema *entitySchema, key string, scope *parentScope,
+) (int, interface{}, *apiError) {
+	switch req.method {
+	case http.MethodGet:
+		e, err := h.find(req.ws, schema, key, scope)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, h.render(req.ws, schema, e), nil
+	case http.MethodPatch:
+		existing, err := h.find(req.ws, schema, key, scope)
+		if err != nil {
+			return 0, nil, err
+		}
+		e, err := h.write(req.ws, schema, existing, mergeRecords(deepCopy(existing).(record), req.body), scope)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, h.render(req.ws, schema, e), nil
+	case http.MethodPut:
+		existing, err := h.find(req.ws, schema, key, scope)
+		if err != nil && err.status != http.StatusNotFound {
+			return 0, nil, err
+		}
+		body := req.body
+		if existing == nil {
+			if _, err := uuid.Parse(key); err == nil {
+				body["id"] = key
+			} else if schema.endpointKey != "" {
+				body[schema.endpointKey] = key
+			} else {
+				return 0, nil, notFound()
+			}
+		}
+		e, err := h.write(req.ws, schema, existing, body, scope)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, h.render(req.ws, schema, e), nil
+	case http.MethodDelete:
+		e, err := h.find(req.ws, schema, key, scope)
+		if err != nil {
+			if err.status == http.StatusNotFound {
+				return http.StatusNoContent, nil, nil
+			}
+			return 0, nil, err
+		}
+		if err := h.delete(req.ws, schema, e["id"].(string)); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed()
+}
+
+// find looks up an entity by ID or endpoint key.
+func (h *Handler) find(ws *workspace, schema *entitySchema, key string, scope *parentScope) (record, *apiError) {
+	if e, ok := ws.entities[schema.name][key]; ok && scope.matches(e) {
+		return e, nil
+	}
+	if schema.endpointKey != "" {
+		for _, id := range sortedIDs(ws.entities[schema.name]) {
+			e := ws.entities[schema.name][id]
+			if e[schema.endpointKey] == key && scope.matches(e) {
+				return e, nil
+			}
+		}
+	}
+	return nil, notFound()
+}
+
+// write validates and stores entity, which replaces existing when not nil.
+func (h *Handler) write(
+	ws *workspace, schema *entitySchema, existing, body record, scope *parentScope,
+) (record, *apiError) {
+	e := deepCopy(body).(record)
+	if scope != nil {
+		e[scope.fk.field] = record{"id": scope.id}
+	}
+
+	// Identity and timestamps.
+	now := h.now().Unix()
+	if existing != nil {
+		e["id"] = existing["id"]
+		e["created_at"] = existing["created_at"]
+	} else {
+		id, ok := e["id"].(string)
+		switch {
+		case !ok || id == "":
+			e["id"] = uuid.NewString()
+		case uuid.Validate(id) != nil:
+			return nil, schemaViolation(map[string]string{"id": "expected a valid UUID"}, nil)
+		case ws.entities[schema.name][id] != nil:
+			return nil, dbError(http.StatusBadRequest, codePrimaryKeyViolation, "primary key violation",
+				fmt.Sprintf("primary key violation on key '{id=%q}'", id), record{"id": id})
+		}
+		e["created_at"] = now
+	}
+	e["updated_at"] = now
+
+	// Foreign keys are normalized to {"id": ...} references.
+	var snis []interface{}
+	if schema.name == "certificates" {
+		snis, _ = body["snis"].([]interface{})
+	}
+	if err := h.resolveForeignKeys(ws, schema, e); err != nil {
+		return nil, err
+	}
+	if schema.normalize != nil {
+		schema.normalize(e, body)
+	}
+	for field, generate := range schema.generated {
+		if isEmpty(e[field]) {
+			e[field] = generate()
+		}
+	}
+	applyDefaults(e, schema.defaults)
+
+	if err := validate(schema, e); err != nil {
+		return nil, err
+	}
+	if err := h.checkUniques(ws, schema, e); err != nil {
+		return nil, err
+	}
+	if schema.name == "certificates" && body["snis"] != nil {
+		if err := h.checkCertificateSNIs(ws, e["id"].(string), snis); err != nil {
+			return nil, err
+		}
+	}
+
+	if ws.entities[schema.name] == nil {
+		ws.entities[schema.name] = map[string]record{}
+	}
+	ws.entities[schema.name][e["id"].(string)] = e
+	if schema.name == "certificates" && body["snis"] != nil {
+		h.syncCertificateSNIs(ws, e["id"].(string), snis)
+	}
+	return e, nil
+}
+
+func (h *Handler) resolveForeignKeys(ws *workspace, schema *entitySchema, e record) *apiError {
+	for _, fk := range schema.foreignKeys {
+		ref, ok := e[fk.field]
+		if !ok || ref == nil {
+			delete(e, fk.field)
+			if fk.required {
+				return schemaViolation(map[string]string{fk.field: "required field missing"}, nil)
+			}
+			continue
+		}
+		refRecord, ok := ref.(record)
+		if !ok {
+			return schemaViolation(map[string]string{fk.field: "expected a record"}, nil)
+		}
+		referenced := schemasByName[fk.reference]
+		var target record
+		if id, ok := refRecord["id"].(string); ok {
+			target = ws.entities[referenced.name][id]
+		} else if referenced.endpointKey != "" {
+			if key, ok := refRecord[referenced.endpointKey].(string); ok {
+				target, _ = h.find(ws, referenced, key, nil)
+			}
+		}
+		if target == nil {
+			return dbError(http.StatusBadRequest, codeForeignKeyViolation, "foreign key violation",
+				fmt.Sprintf("the foreign key '%s' does not reference an existing '%s' entity.",
+					luaValue(refRecord), fk.reference),
+				record{fk.field: refRecord})
+		}
+		e[fk.field] = record{"id": target["id"]}
+	}
+	return nil
+}
+
+func validate(schema *entitySchema, e record) *apiError {
+	fields := map[string]string{}
+	for _, field := range schema.required {
+		if isEmpty(e[field]) {
+			fields[field] = "required field missing"
+		}
+	}
+	if tags, ok := e["tags"]; ok && tags != nil {
+		if _, ok := tags.([]interface{}); !ok {
+			fields["tags"] = "expected a set"
+		}
+	}
+	var entity []string
+	if schema.check != nil {
+		entity = schema.check(e)
+	}
+	if len(fields) > 0 || len(entity) > 0 {
+		return schemaViolation(fields, entity)
+	}
+	return nil
+}
+
+func (h *Handler) checkUniques(ws *workspace, schema *entitySchema, e record) *apiError {
+	for _, fields := range schema.uniques {
+		allNull := true
+		for _, f := range fields {
+			if e[f] != nil {
+				allNull = false
+			}
+		}
+		if allNull {
+			continue
+		}
+		key := uniqueKey(e, fields)
+		for id, other := range ws.entities[schema.name] {
+			if id == e["id"] || uniqueKey(other, fields) != key {
+				continue
+			}
+			values := record{}
+			for _, f := range fields {
+				values[f] = e[f]
+			}
+			return dbError(http.StatusConflict, codeUniqueViolation, "unique constraint violation",
+				fmt.Sprintf("UNIQUE violation detected on '%s'", luaValue(values)), values)
+		}
+	}
+	return nil
+}
+
+func uniqueKey(e record, fields []string) string {
+	values := make([]interface{}, 0, len(fields))
+	for _, f := range fields {
+		values = append(values, e[f])
+	}
+	b, _ := json.Marshal(values)
+	return string(b)
+}
+
+func (h *Handler) checkCertificateSNIs(ws *workspace, certificateID string, snis []interface{}) *apiError {
+	for _, name := range snis {
+		for _, sni := range ws.entities["snis"] {
+			if sni["name"] == name && foreignID(sni["certificate"]) != certificateID {
+				return dbError(http.StatusConflict, codeUniqueViolation, "unique constraint violation",
+					fmt.Sprintf("UNIQUE violation detected on '{name=%q}'", name), record{"name": name})
+			}
+		}
+	}
+	return nil
+}
+
+// syncCertificateSNIs makes the SNIs of a certificate match the names set in
+// its snis field.
+func (h *Handler) syncCertificateSNIs(ws *workspace, certificateID string, names []interface{}) {
+	wanted := map[interface{}]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+	if ws.entities["snis"] == nil {
+		ws.entities["snis"] = map[string]record{}
+	}
+	for id, sni := range ws.entities["snis"] {
+		if foreignID(sni["certificate"]) != certificateID {
+			continue
+		}
+		if !wanted[sni["name"]] {
+			delete(ws.entities["snis"], id)
+		}
+		delete(wanted, sni["name"])
+	}
+	now := h.now().Unix()
+	for _, name := range names {
+		if !wanted[name] {
+			continue
+		}
+		id := uuid.NewString()
+		ws.entities["snis"][id] = record{
+			"id":          id,
+			"name":        name,
+			"certificate": record{"id": certificateID},
+			"created_at":  now,
+			"updated_at":  now,
+		}
+	}
+}
+
+// delete deletes an entity along with the entities referencing it with a
+// cascading foreign key. It fails if other entities reference it.
+func (h *Handler) delete(ws *workspace, schema *entitySchema, id string) *apiError {
+	type key struct{ typ, id string }
+	toDelete := map[key]bool{}
+	var collect func(typ, id string)
+	collect = func(typ, id string) {
+		k := key{typ, id}
+		if toDelete[k] {
+			return
+		}
+		toDelete[k] = true
+		for _, other := range schemas {
+			for _, fk := range other.foreignKeys {
+				if fk.reference != typ || !fk.cascade {
+					continue
+				}
+				for otherID, e := range ws.entities[other.name] {
+					if foreignID(e[fk.field]) == id {
+						collect(other.name, otherID)
+					}
+				}
+			}
+		}
+	}
+	collect(schema.name, id)
+
+	for k := range toDelete {
+		for _, other := range schemas {
+			for _, fk := range other.foreignKeys {
+				if fk.reference != k.typ || fk.cascade {
+					continue
+				}
+				for otherID, e := range ws.entities[other.name] {
+					if foreignID(e[fk.field]) == k.id && !toDelete[key{other.name, otherID}] {
+						return dbError(http.StatusBadRequest, codeForeignKeyViolation, "foreign key violation",
+							fmt.Sprintf("an existing '%s' entity references this '%s' entity", other.name, k.typ),
+							record{"@referenced_by": other.name})
+					}
+				}
+			}
+		}
+	}
+
+	for k := range toDelete {
+		delete(ws.entities[k.typ], k.id)
+	}
+	return nil
+}
+
+// render returns the representation of an entity returned by the Admin API.
+func (h *Handler) render(ws *workspace, schema *entitySchema, e record) record {
+	out := deepCopy(e).(record)
+	if schema.name == "certificates" {
+		var names []string
+		for _, sni := range ws.entities["snis"] {
+			if foreignID(sni["certificate"]) == e["id"] {
+				names = append(names, sni["name"].(string))
+			}
+		}
+		sort.Strings(names)
+		snis := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			snis = append(snis, name)
+		}
+		out["snis"] = snis
+	}
+	return out
+}
+
+func (h *Handler) rootInfo() record {
+	plugins := record{}
+	for name, priority := range kong.BundledPluginPriorities() {
+		plugins[name] = record{"version": h.version, "priority": priority}
+	}
+	return record{
+		"version":     h.version,
+		"tagline":     "Welcome to kong",
+		"hostname":    "kongtest",
+		"node_id":     "6a72192c-a3a1-4c8d-95c6-efabae9fb969",
+		"lua_version": "LuaJIT 2.1.0-20231117",
+		"plugins": record{
+			"available_on_server": plugins,
+			"enabled_in_cluster":  []interface{}{},
+		},
+		"configuration": record{
+			"database":      "postgres",
+			"router_flavor": "traditional_compatible",
+			"plugins":       []interface{}{"bundled"},
+		},
+	}
+}
+
+func (h *Handler) routeWorkspaces(req *request, segs []string) (int, interface{}, *apiError) {
+	switch {
+	case len(segs) == 0 && req.method == http.MethodGet:
+		items := make([]listItem, 0, len(h.workspaces))
+		for _, ws := range h.workspaces {
+			items = append(items, listItem{key: ws.entity["id"].(string), value: deepCopy(ws.entity)})
+		}
+		return paginate(req, items)
+	case len(segs) == 0 && req.method == http.MethodPost:
+		name, _ := req.body["name"].(string)
+		if name == "" {
+			return 0, nil, schemaViolation(map[string]string{"name": "required field missing"}, nil)
+		}
+		if h.workspace(name) != nil || isTopLevelEndpoint(name) {
+			return 0, nil, dbError(http.StatusConflict, codeUniqueViolation, "unique constraint violation",
+				fmt.Sprintf("UNIQUE violation detected on '{name=%q}'", name), record{"name": name})
+		}
+		ws := h.newWorkspace(deepCopy(req.body).(record))
+		h.workspaces = append(h.workspaces, ws)
+		return http.StatusCreated, deepCopy(ws.entity), nil
+	case len(segs) == 1:
+		ws := h.workspace(segs[0])
+		switch req.method {
+		case http.MethodGet:
+			if ws == nil {
+				return 0, nil, notFound()
+			}
+			return http.StatusOK, deepCopy(ws.entity), nil
+		case http.MethodPatch, http.MethodPut:
+			if ws == nil {
+				return 0, nil, notFound()
+			}
+			for k, v := range req.body {
+				if k != "id" && k != "name" && k != "created_at" {
+					ws.entity[k] = deepCopy(v)
+				}
+			}
+			return http.StatusOK, deepCopy(ws.entity), nil
+		case http.MethodDelete:
+			if ws == nil {
+				return http.StatusNoContent, nil, nil
+			}
+			if ws == h.workspaces[0] {
+				return 0, nil, newAPIError(http.StatusBadRequest, "Cannot delete default workspace")
+			}
+			for _, entities := range ws.entities {
+				if len(entities) > 0 {
+					return 0, nil, newAPIError(http.StatusBadRequest, "Workspace is not empty")
+				}
+			}
+			for i, other := range h.workspaces {
+				if other == ws {
+					h.workspaces = append(h.workspaces[:i], h.workspaces[i+1:]...)
+					break
+				}
+			}
+			return http.StatusNoContent, nil, nil
+		}
+	}
+	return 0, nil, methodNotAllowed()
+}
+
+func (h *Handler) listTags(req *request, tag string) (int, interface{}, *apiError) {
+	var items []listItem
+	for _, schema := range schemas {
+		for id, e := range req.ws.entities[schema.name] {
+			tags, _ := e["tags"].([]interface{})
+			for _, t := range tags {
+				t, ok := t.(string)
+				if !ok || (tag != "" && t != tag) {
+					continue
+				}
+				items = append(items, listItem{
+					key:   t + "/" + id,
+					value: record{"entity_name": schema.name, "entity_id": id, "tag": t},
+				})
+			}
+		}
+	}
+	return paginate(req, items)
+}
+
+// filterByTags filters entities by the value of the tags query parameter:
+// "a,b" matches entities tagged with both a and b, "a/b" matches entities
+// tagged with either a or b.
+func filterByTags(entities []record, query string) ([]record, *apiError) {
+	if query == "" {
+		return entities, nil
+	}
+	if strings.Contains(query, ",") && strings.Contains(query, "/") {
+		return nil, schemaViolation(map[string]string{"tags": "invalid filter: mixing ',' and '/' is not supported"}, nil)
+	}
+	matchAll := !strings.Contains(query, "/")
+	wanted := strings.FieldsFunc(query, func(r rune) bool { return r == ',' || r == '/' })
+
+	var out []record
+	for _, e := range entities {
+		has := map[string]bool{}
+		tags, _ := e["tags"].([]interface{})
+		for _, t := range tags {
+			if t, ok := t.(string); ok {
+				has[t] = true
+			}
+		}
+		matched := matchAll
+		for _, t := range wanted {
+			if matchAll && !has[t] {
+				matched = false
+				break
+			}
+			if !matchAll && has[t] {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+type listItem struct {
+	key   string
+	value interface{}
+}
+
+// paginate returns a page of items, sorted by key, following the size and
+// offset query parameters.
+func paginate(req *request, items []listItem) (int, interface{}, *apiError) {
+	size := defaultPageSize
+	if raw := req.query.Get("size"); raw != "" {
+		var err error
+		size, err = strconv.Atoi(raw)
+		if err != nil || size < 1 || size > maxPageSize {
+			return 0, nil, dbError(http.StatusBadRequest, codeInvalidSize, "invalid size",
+				fmt.Sprintf("size must be an integer between 1 and %d", maxPageSize), nil)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].key < items[j].key })
+
+	start := 0
+	if raw := req.query.Get("offset"); raw != "" {
+		after, err := decodeOffset(raw)
+		if err != nil {
+			return 0, nil, dbError(http.StatusBadRequest, codeInvalidOffset, "invalid offset",
+				fmt.Sprintf("'%s' is not a valid offset: bad base64 encoding", raw), nil)
+		}
+		start = sort.Search(len(items), func(i int) bool { return items[i].key > after })
+	}
+
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	data := make([]interface{}, 0, end-start)
+	for _, item := range items[start:end] {
+		data = append(data, item.value)
+	}
+
+	page := record{"data": data, "next": nil}
+	if end < len(items) {
+		offset := encodeOffset(items[end-1].key)
+		page["offset"] = offset
+		q := url.Values{}
+		for k, v := range req.query {
+			q[k] = v
+		}
+		q.Set("offset", offset)
+		page["next"] = "/" + strings.Join(req.segments, "/") + "?" + q.Encode()
+	}
+	return http.StatusOK, page, nil
+}
+
+func encodeOffset(key string) string {
+	b, _ := json.Marshal([]string{key})
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func decodeOffset(offset string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(offset)
+	if err != nil {
+		return "", err
+	}
+	var keys []string
+	if err := json.Unmarshal(b, &keys); err != nil || len(keys) != 1 {
+		return "", fmt.Errorf("invalid offset")
+	}
+	return keys[0], nil
+}
+
+func foreignID(ref interface{}) string {
+	r, ok := ref.(record)
+	if !ok {
+		return ""
+	}
+	id, _ := r["id"].(string)
+	return id
+}
+
+func sortedIDs(entities map[string]record) []string {
+	ids := make([]string, 0, len(entities))
+	for id := range entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// applyDefaults sets the fields of defaults missing from e, recursively.
+func applyDefaults(e, defaults record) {
+	for k, d := range defaults {
+		v, ok := e[k]
+		if !ok || v == nil {
+			e[k] = deepCopy(d)
+			continue
+		}
+		if vRecord, ok := v.(record); ok {
+			if dRecord, ok := d.(record); ok {
+				applyDefaults(vRecord, dRecord)
+			}
+		}
+	}
+}
+
+// mergeRecords merges patch into e the way PATCH requests update nested
+// records: records are merged recursively, other values are replaced.
+func mergeRecords(e, patch record) record {
+	for k, v := range patch {
+		if vRecord, ok := v.(record); ok {
+			if eRecord, ok := e[k].(record); ok {
+				e[k] = mergeRecords(eRecord, vRecord)
+				continue
+			}
+		}
+		e[k] = deepCopy(v)
+	}
+	return e
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case record:
+		out := make(record, len(v))
+		for k, e := range v {
+			out[k] = deepCopy(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = deepCopy(e)
+		}
+		return out
+	}
+	return v
+}
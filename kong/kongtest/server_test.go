@@ -0,0 +1,316 @@
+package kongtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+func newClient(t *testing.T, opts ...kongtest.Option) (*kongtest.Server, *kong.Client) {
+	t.Helper()
+	server := kongtest.NewServer(opts...)
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(kong.String(server.URL), nil)
+	require.NoError(t, err)
+	return server, client
+}
+
+func statusCode(err error) int {
+	var apiErr *kong.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code()
+	}
+	return 0
+}
+
+func TestServerRoot(t *testing.T) {
+	server, client := newClient(t, kongtest.WithVersion("3.4.2"))
+	ctx := context.Background()
+
+	info, err := client.Root(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "3.4.2", kong.VersionFromInfo(info))
+
+	server.SetVersion("3.10.0")
+	info, err = client.Root(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "3.10.0", kong.VersionFromInfo(info))
+
+	// The bundled plugins are available with their priorities in Kong.
+	priorities, err := kong.PluginPriorities(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, kong.BundledPluginPriorities(), priorities)
+	assert.Equal(t, 998, priorities["grpc-gateway"])
+}
+
+func TestHandlerAsTransport(t *testing.T) {
+	handler := kongtest.NewHandler(kongtest.WithVersion("3.4.2"))
+	client, err := kong.NewClient(kong.String("http://kong.invalid"), &http.Client{Transport: handler})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	info, err := client.Root(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "3.4.2", kong.VersionFromInfo(info))
+
+	_, err = client.Services.Create(ctx, &kong.Service{Name: kong.String("svc"), Host: kong.String("example.com")})
+	require.NoError(t, err)
+	service, err := client.Services.Get(ctx, kong.String("svc"))
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", *service.Host)
+}
+
+func TestServerServicesAndRoutes(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	service, err := client.Services.Create(ctx, &kong.Service{
+		Name: kong.String("svc"),
+		URL:  kong.String("https://example.com:8443/api"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, service.ID)
+	assert.Equal(t, "https", *service.Protocol)
+	assert.Equal(t, "example.com", *service.Host)
+	assert.Equal(t, 8443, *service.Port)
+	assert.Equal(t, "/api", *service.Path)
+	assert.Equal(t, 60000, *service.ReadTimeout)
+
+	_, err = client.Services.Create(ctx, &kong.Service{Name: kong.String("svc"), Host: kong.String("other")})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, statusCode(err))
+
+	route, err := client.Routes.CreateInService(ctx, service.ID, &kong.Route{
+		Name:  kong.String("route"),
+		Paths: kong.StringSlice("/"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, *service.ID, *route.Service.ID)
+
+	_, err = client.Routes.Create(ctx, &kong.Route{Name: kong.String("no-match")})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode(err))
+
+	_, err = client.Routes.Create(ctx, &kong.Route{
+		Paths:   kong.StringSlice("/"),
+		Service: &kong.Service{ID: kong.String("1c6d8d5b-2b3e-4f2a-9a52-6d1d8a3b4c5d")},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not reference an existing 'services' entity")
+
+	routes, _, err := client.Routes.ListForService(ctx, service.Name, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	service.Retries = kong.Int(3)
+	service, err = client.Services.Update(ctx, service)
+	require.NoError(t, err)
+	assert.Equal(t, 3, *service.Retries)
+
+	// Services referenced by routes can't be deleted.
+	err = client.Services.Delete(ctx, service.Name)
+	require.Error(t, err)
+	require.NoError(t, client.Routes.Delete(ctx, route.ID))
+	require.NoError(t, client.Services.Delete(ctx, service.Name))
+
+	_, err = client.Services.Get(ctx, service.ID)
+	assert.True(t, kong.IsNotFoundErr(err))
+}
+
+func TestServerUpsertByEndpointKey(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	consumer, err := client.Consumers.Create(ctx, &kong.Consumer{
+		ID:       kong.String("4e8e5a5c-77c4-4e57-a9cb-6a3e1ba5b2f0"),
+		Username: kong.String("alice"),
+		CustomID: kong.String("42"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "4e8e5a5c-77c4-4e57-a9cb-6a3e1ba5b2f0", *consumer.ID)
+
+	found, err := client.Consumers.GetByCustomID(ctx, kong.String("42"))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", *found.Username)
+
+	_, err = client.Consumers.Create(ctx, &kong.Consumer{Username: kong.String("bob"), CustomID: kong.String("42")})
+	assert.Equal(t, http.StatusConflict, statusCode(err))
+}
+
+func TestServerPagination(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, err := client.Upstreams.Create(ctx, &kong.Upstream{Name: kong.String(name)})
+		require.NoError(t, err)
+	}
+
+	seen := map[string]bool{}
+	opt := &kong.ListOpt{Size: 2}
+	pages := 0
+	for {
+		upstreams, next, err := client.Upstreams.List(ctx, opt)
+		require.NoError(t, err)
+		pages++
+		for _, u := range upstreams {
+			seen[*u.Name] = true
+		}
+		if next == nil {
+			break
+		}
+		opt = next
+	}
+	assert.Equal(t, 3, pages)
+	assert.Len(t, seen, 5)
+
+	all, err := client.Upstreams.ListAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+}
+
+func TestServerTags(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	for name, tags := range map[string][]string{
+		"one":   {"a", "b"},
+		"two":   {"a"},
+		"three": {"c"},
+	} {
+		_, err := client.Services.Create(ctx, &kong.Service{
+			Name: kong.String(name),
+			Host: kong.String("example.com"),
+			Tags: kong.StringSlice(tags...),
+		})
+		require.NoError(t, err)
+	}
+
+	services, _, err := client.Services.List(ctx, &kong.ListOpt{Tags: kong.StringSlice("a", "b"), MatchAllTags: true})
+	require.NoError(t, err)
+	assert.Len(t, services, 1)
+
+	services, _, err = client.Services.List(ctx, &kong.ListOpt{Tags: kong.StringSlice("b", "c")})
+	require.NoError(t, err)
+	assert.Len(t, services, 2)
+}
+
+func TestServerWorkspaces(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	_, err := client.Workspaces.Create(ctx, &kong.Workspace{Name: kong.String("team")})
+	require.NoError(t, err)
+	exists, err := client.Workspaces.Exists(ctx, kong.String("team"))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	client.SetWorkspace("team")
+	_, err = client.Services.Create(ctx, &kong.Service{Name: kong.String("svc"), Host: kong.String("example.com")})
+	require.NoError(t, err)
+
+	client.SetWorkspace("")
+	services, err := client.Services.ListAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, services)
+
+	// Non-empty workspaces can't be deleted.
+	err = client.Workspaces.Delete(ctx, kong.String("team"))
+	require.Error(t, err)
+}
+
+func TestServerCredentialsAndCascade(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	consumer, err := client.Consumers.Create(ctx, &kong.Consumer{Username: kong.String("alice")})
+	require.NoError(t, err)
+
+	keyAuth, err := client.KeyAuths.Create(ctx, consumer.Username, &kong.KeyAuth{})
+	require.NoError(t, err)
+	require.NotEmpty(t, *keyAuth.Key)
+	assert.Equal(t, *consumer.ID, *keyAuth.Consumer.ID)
+
+	basicAuth, err := client.BasicAuths.Create(ctx, consumer.ID, &kong.BasicAuth{
+		Username: kong.String("alice"),
+		Password: kong.String("secret"),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, "secret", *basicAuth.Password)
+
+	acl, err := client.ACLs.Create(ctx, consumer.ID, &kong.ACLGroup{Group: kong.String("admins")})
+	require.NoError(t, err)
+
+	plugin, err := client.Plugins.Create(ctx, &kong.Plugin{
+		Name:     kong.String("rate-limiting"),
+		Consumer: &kong.Consumer{ID: consumer.ID},
+		Config:   kong.Configuration{"minute": 10},
+	})
+	require.NoError(t, err)
+
+	keyAuths, _, err := client.KeyAuths.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, keyAuths, 1)
+
+	// Credentials and plugins are deleted along with their consumer.
+	require.NoError(t, client.Consumers.Delete(ctx, consumer.ID))
+	_, err = client.KeyAuths.Get(ctx, consumer.ID, keyAuth.ID)
+	assert.True(t, kong.IsNotFoundErr(err))
+	_, err = client.ACLs.GetByID(ctx, acl.ID)
+	assert.True(t, kong.IsNotFoundErr(err))
+	_, err = client.Plugins.Get(ctx, plugin.ID)
+	assert.True(t, kong.IsNotFoundErr(err))
+}
+
+func TestServerTargetsAndCertificates(t *testing.T) {
+	_, client := newClient(t)
+	ctx := context.Background()
+
+	upstream, err := client.Upstreams.Create(ctx, &kong.Upstream{Name: kong.String("up")})
+	require.NoError(t, err)
+	target, err := client.Targets.Create(ctx, upstream.ID, &kong.Target{Target: kong.String("10.0.0.1")})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:8000", *target.Target)
+	assert.Equal(t, 100, *target.Weight)
+	require.NoError(t, client.Targets.MarkHealthy(ctx, upstream.ID, target))
+
+	_, err = client.Targets.Create(ctx, upstream.ID, &kong.Target{Target: kong.String("10.0.0.1:8000")})
+	assert.Equal(t, http.StatusConflict, statusCode(err))
+
+	certificate, err := client.Certificates.Create(ctx, &kong.Certificate{
+		Cert: kong.String("cert"),
+		Key:  kong.String("key"),
+		SNIs: kong.StringSlice("b.example.com", "a.example.com"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*string{kong.String("a.example.com"), kong.String("b.example.com")}, certificate.SNIs)
+
+	snis, _, err := client.SNIs.ListForCertificate(ctx, certificate.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, snis, 2)
+
+	sni, err := client.SNIs.Get(ctx, kong.String("a.example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, *certificate.ID, *sni.Certificate.ID)
+}
+
+func TestServerErrors(t *testing.T) {
+	server, _ := newClient(t)
+
+	resp, err := http.Get(server.URL + "/services?size=0")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/unknown/services")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,509 @@
+package kong
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// MigrationOpts holds the options of a migration performed by Migrate.
+type MigrationOpts struct {
+	// RegenerateIDs replaces the IDs of the source entities with deterministic
+	// IDs generated for TargetWorkspace, see IDFillable. Entities for which no
+	// deterministic ID can be generated get their ID from the target Kong.
+	// When false, the IDs of the source entities are preserved.
+	RegenerateIDs bool
+	// TargetWorkspace is the workspace used to generate IDs when
+	// RegenerateIDs is set. It should match the workspace of the target Client.
+	TargetWorkspace string
+	// TargetVersion is the version of the target Kong. When nil, it is
+	// fetched from the root endpoint of the target.
+	TargetVersion *Version
+	// SkipEntityTypes lists entity types which must not be migrated.
+	SkipEntityTypes []EntityType
+	// DryRun only reads the source entities and reports what would be copied,
+	// without writing anything to the target.
+	DryRun bool
+}
+
+// MigrationReport describes the outcome of a migration performed by Migrate.
+type MigrationReport struct {
+	// TargetVersion is the version of the target Kong.
+	TargetVersion Version
+	// Copied holds the number of entities copied to the target per entity
+	// type. In dry-run mode, it holds the number of entities that would be copied.
+	Copied map[EntityType]int
+	// Skipped lists entities and entity types which were not copied.
+	Skipped []MigrationSkip
+	// IDs maps the IDs of the source entities to the IDs of the corresponding
+	// target entities, per entity type.
+	IDs map[EntityType]map[string]string
+}
+
+// MigrationSkip describes an entity, or a whole entity type, which was not
+// copied by Migrate.
+type MigrationSkip struct {
+	Type EntityType
+	// Entity identifies the skipped entity, e.g. "routes/my-route".
+	// It is empty when the whole entity type was skipped.
+	Entity string
+	Reason string
+}
+
+// migrationEntityTypeRanges holds the Kong versions supporting entity types
+// which are not supported by all Kong 1.x and later releases.
+var migrationEntityTypeRanges = map[EntityType]string{
+	EntityTypeCACertificates:         ">=1.3.0",
+	EntityTypeConsumerGroups:         ">=2.7.0",
+	EntityTypeConsumerGroupConsumers: ">=2.7.0",
+	EntityTypeVaults:                 ">=2.8.0",
+	EntityTypeKeySets:                ">=3.1.0",
+	EntityTypeKeys:                   ">=3.1.0",
+	EntityTypeFilterChains:           ">=3.4.0",
+	EntityTypePartials:               ">=3.10.0",
+}
+
+// Migrate copies all entities of the workspace of source to the workspace of
+// target, which may belong to different Kong clusters.
+// Entities are written in dependency order. Entities with an ID are written
+// using upserts and consumer group memberships already present on the target
+// are left as is, so a migration can be retried as long as every entity has
+// an ID: the source one, or a deterministic one with RegenerateIDs. Entities
+// getting their ID from the target are created anew on every run, which fails
+// with a conflict when they have a unique name.
+// Foreign references are remapped to the IDs of the target entities. Entity
+// types not supported by the target version are skipped, as are the entities
+// referencing skipped entities.
+// A report is returned even when an error occurs, describing what was copied
+// before the error.
+func Migrate(ctx context.Context, source, target *Client, opts MigrationOpts) (*MigrationReport, error) {
+	report := &MigrationReport{
+		Copied: map[EntityType]int{},
+		IDs:    map[EntityType]map[string]string{},
+	}
+	if source == nil || target == nil {
+		return report, fmt.Errorf("source and target clients are required")
+	}
+
+	if opts.TargetVersion != nil {
+		report.TargetVersion = *opts.TargetVersion
+	} else {
+		info, err := target.Root(ctx)
+		if err != nil {
+			return report, fmt.Errorf("fetching target version: %w", err)
+		}
+		v, err := ParseSemanticVersion(VersionFromInfo(info))
+		if err != nil {
+			return report, fmt.Errorf("parsing target version: %w", err)
+		}
+		report.TargetVersion = v
+	}
+
+	m := &migrator{
+		source: source,
+		target: target,
+		opts:   opts,
+		report: report,
+	}
+	for _, step := range m.steps() {
+		if err := m.run(ctx, step); err != nil {
+			return report, fmt.Errorf("migrating %s: %w", step.typ, err)
+		}
+	}
+	return report, nil
+}
+
+type migrator struct {
+	source, target *Client
+	opts           MigrationOpts
+	report         *MigrationReport
+
+	// sourceUpstreams and sourceConsumerGroups are used to list the entities
+	// nested under them.
+	sourceUpstreams      []*Upstream
+	sourceConsumerGroups []*ConsumerGroup
+}
+
+type migrationStep struct {
+	typ EntityType
+	// list returns the source entities, as pointers to Kong entities.
+	list func(ctx context.Context) ([]interface{}, error)
+	// create upserts entity in the target and returns its ID.
+	create func(ctx context.Context, entity interface{}) (*string, error)
+}
+
+func (m *migrator) steps() []migrationStep {
+	src, dst := m.source, m.target
+	return []migrationStep{
+		{
+			typ:  EntityTypeVaults,
+			list: listOf(src.Vaults.ListAll),
+			create: createOf(func(ctx context.Context, v *Vault) (*Vault, error) {
+				return dst.Vaults.Create(ctx, v)
+			}),
+		},
+		{
+			typ:  EntityTypeCertificates,
+			list: listOf(src.Certificates.ListAll),
+			create: createOf(func(ctx context.Context, c *Certificate) (*Certificate, error) {
+				// SNIs are migrated separately, to preserve their IDs.
+				c.SNIs = nil
+				return dst.Certificates.Create(ctx, c)
+			}),
+		},
+		{
+			typ:  EntityTypeSNIs,
+			list: listOf(src.SNIs.ListAll),
+			create: createOf(func(ctx context.Context, sni *SNI) (*SNI, error) {
+				return dst.SNIs.Create(ctx, sni)
+			}),
+		},
+		{
+			typ:  EntityTypeCACertificates,
+			list: listOf(src.CACertificates.ListAll),
+			create: createOf(func(ctx context.Context, c *CACertificate) (*CACertificate, error) {
+				return dst.CACertificates.Create(ctx, c)
+			}),
+		},
+		{
+			typ:  EntityTypeKeySets,
+			list: listOf(src.KeySets.ListAll),
+			create: createOf(func(ctx context.Context, ks *KeySet) (*KeySet, error) {
+				return dst.KeySets.Create(ctx, ks)
+			}),
+		},
+		{
+			typ:  EntityTypeKeys,
+			list: listOf(src.Keys.ListAll),
+			create: createOf(func(ctx context.Context, k *Key) (*Key, error) {
+				return dst.Keys.Create(ctx, k)
+			}),
+		},
+		{
+			typ:  EntityTypeServices,
+			list: listOf(src.Services.ListAll),
+			create: createOf(func(ctx context.Context, s *Service) (*Service, error) {
+				return dst.Services.Create(ctx, s)
+			}),
+		},
+		{
+			typ:  EntityTypeRoutes,
+			list: listOf(src.Routes.ListAll),
+			create: createOf(func(ctx context.Context, r *Route) (*Route, error) {
+				return dst.Routes.Create(ctx, r)
+			}),
+		},
+		{
+			typ: EntityTypeUpstreams,
+			list: listOf(func(ctx context.Context) ([]*Upstream, error) {
+				upstreams, err := src.Upstreams.ListAll(ctx)
+				m.sourceUpstreams = upstreams
+				return upstreams, err
+			}),
+			create: createOf(func(ctx context.Context, u *Upstream) (*Upstream, error) {
+				return dst.Upstreams.Create(ctx, u)
+			}),
+		},
+		{
+			typ: EntityTypeTargets,
+			list: listOf(func(ctx context.Context) ([]*Target, error) {
+				var targets []*Target
+				for _, u := range m.sourceUpstreams {
+					data, err := src.Targets.ListAll(ctx, u.ID)
+					if err != nil {
+						return nil, err
+					}
+					targets = append(targets, data...)
+				}
+				return targets, nil
+			}),
+			create: createOf(func(ctx context.Context, t *Target) (*Target, error) {
+				return dst.Targets.Create(ctx, t.Upstream.ID, t)
+			}),
+		},
+		{
+			typ: EntityTypeConsumerGroups,
+			list: listOf(func(ctx context.Context) ([]*ConsumerGroup, error) {
+				groups, err := src.ConsumerGroups.ListAll(ctx)
+				m.sourceConsumerGroups = groups
+				return groups, err
+			}),
+			create: createOf(func(ctx context.Context, cg *ConsumerGroup) (*ConsumerGroup, error) {
+				return dst.ConsumerGroups.Create(ctx, cg)
+			}),
+		},
+		{
+			typ:  EntityTypeConsumers,
+			list: listOf(src.Consumers.ListAll),
+			create: createOf(func(ctx context.Context, c *Consumer) (*Consumer, error) {
+				return dst.Consumers.Create(ctx, c)
+			}),
+		},
+		{
+			typ: EntityTypeConsumerGroupConsumers,
+			list: listOf(func(ctx context.Context) ([]*consumerGroupMembership, error) {
+				var memberships []*consumerGroupMembership
+				for _, cg := range m.sourceConsumerGroups {
+					consumers, err := src.ConsumerGroupConsumers.ListAll(ctx, cg.ID)
+					if err != nil {
+						return nil, err
+					}
+					for _, c := range consumers {
+						memberships = append(memberships, &consumerGroupMembership{
+							ConsumerGroup: &ConsumerGroup{ID: cg.ID, Name: cg.Name},
+							Consumer:      &Consumer{ID: c.ID, Username: c.Username},
+						})
+					}
+				}
+				return memberships, nil
+			}),
+			create: createOf(func(ctx context.Context, cgc *consumerGroupMembership) (*consumerGroupMembership, error) {
+				_, err := dst.ConsumerGroupConsumers.Create(ctx, cgc.ConsumerGroup.ID, cgc.Consumer.ID)
+				if IsConflictErr(err) {
+					// The consumer is already a member, e.g. when retrying.
+					return cgc, nil
+				}
+				return cgc, err
+			}),
+		},
+		{
+			typ:  EntityTypeKeyAuths,
+			list: listOf(src.KeyAuths.ListAll),
+			create: createOf(func(ctx context.Context, c *KeyAuth) (*KeyAuth, error) {
+				return dst.KeyAuths.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypeBasicAuths,
+			list: listOf(src.BasicAuths.ListAll),
+			create: createOf(func(ctx context.Context, c *BasicAuth) (*BasicAuth, error) {
+				// Passwords are read hashed from the source.
+				return dst.BasicAuths.CreateWithOptions(ctx, c.Consumer.ID, &BasicAuthOptions{
+					BasicAuth: *c,
+					SkipHash:  Bool(true),
+				})
+			}),
+		},
+		{
+			typ:  EntityTypeHMACAuths,
+			list: listOf(src.HMACAuths.ListAll),
+			create: createOf(func(ctx context.Context, c *HMACAuth) (*HMACAuth, error) {
+				return dst.HMACAuths.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypeJWTAuths,
+			list: listOf(src.JWTAuths.ListAll),
+			create: createOf(func(ctx context.Context, c *JWTAuth) (*JWTAuth, error) {
+				return dst.JWTAuths.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypeMTLSAuths,
+			list: listOf(src.MTLSAuths.ListAll),
+			create: createOf(func(ctx context.Context, c *MTLSAuth) (*MTLSAuth, error) {
+				return dst.MTLSAuths.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypeACLs,
+			list: listOf(src.ACLs.ListAll),
+			create: createOf(func(ctx context.Context, c *ACLGroup) (*ACLGroup, error) {
+				return dst.ACLs.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypeOauth2Credentials,
+			list: listOf(src.Oauth2Credentials.ListAll),
+			create: createOf(func(ctx context.Context, c *Oauth2Credential) (*Oauth2Credential, error) {
+				return dst.Oauth2Credentials.Create(ctx, c.Consumer.ID, c)
+			}),
+		},
+		{
+			typ:  EntityTypePartials,
+			list: listOf(src.Partials.ListAll),
+			create: createOf(func(ctx context.Context, p *Partial) (*Partial, error) {
+				return dst.Partials.Create(ctx, p)
+			}),
+		},
+		{
+			typ:  EntityTypePlugins,
+			list: listOf(src.Plugins.ListAll),
+			create: createOf(func(ctx context.Context, p *Plugin) (*Plugin, error) {
+				return dst.Plugins.Create(ctx, p)
+			}),
+		},
+		{
+			typ:  EntityTypeFilterChains,
+			list: listOf(src.FilterChains.ListAll),
+			create: createOf(func(ctx context.Context, f *FilterChain) (*FilterChain, error) {
+				return dst.FilterChains.Create(ctx, f)
+			}),
+		},
+	}
+}
+
+func (m *migrator) skip(typ EntityType, entity, reason string) {
+	m.report.Skipped = append(m.report.Skipped, MigrationSkip{Type: typ, Entity: entity, Reason: reason})
+}
+
+func (m *migrator) run(ctx context.Context, step migrationStep) error {
+	for _, typ := range m.opts.SkipEntityTypes {
+		if typ == step.typ {
+			m.skip(step.typ, "", "excluded by the migration options")
+			return nil
+		}
+	}
+	if rangeStr, ok := migrationEntityTypeRanges[step.typ]; ok && !MustNewRange(rangeStr)(m.report.TargetVersion) {
+		m.skip(step.typ, "", fmt.Sprintf("not supported by Kong %s (requires %s)", m.report.TargetVersion, rangeStr))
+		return nil
+	}
+
+	entities, err := step.list(ctx)
+	if err != nil {
+		// Entity types unknown to the source Kong, e.g. enterprise entities
+		// on Kong OSS, are reported as not found.
+		if IsNotFoundErr(err) {
+			m.skip(step.typ, "", "not available on the source")
+			return nil
+		}
+		return fmt.Errorf("listing source entities: %w", err)
+	}
+
+	for _, entity := range entities {
+		label, skipReason := m.remapReferences(entity)
+		if skipReason != "" {
+			m.skip(step.typ, label, skipReason)
+			continue
+		}
+
+		sourceID := entityIDOf(entity)
+		if m.opts.RegenerateIDs && sourceID != nil {
+			setEntityIDOf(entity, nil)
+			if fillable, ok := entity.(IDFillable); ok {
+				if err := fillable.FillID(m.opts.TargetWorkspace); err != nil {
+					// No deterministic ID for this entity, Kong generates one.
+					setEntityIDOf(entity, nil)
+				}
+			}
+		}
+
+		targetID := entityIDOf(entity)
+		if !m.opts.DryRun {
+			targetID, err = step.create(ctx, entity)
+			if err != nil {
+				return fmt.Errorf("writing %s: %w", label, err)
+			}
+		}
+
+		m.report.Copied[step.typ]++
+		if sourceID != nil {
+			if m.report.IDs[step.typ] == nil {
+				m.report.IDs[step.typ] = map[string]string{}
+			}
+			if targetID == nil {
+				// Dry-run of an entity whose ID would be generated by Kong.
+				targetID = sourceID
+			}
+			m.report.IDs[step.typ][*sourceID] = *targetID
+		}
+	}
+	return nil
+}
+
+// remapReferences rewrites the foreign references of entity to the IDs of
+// the target entities. It returns a label identifying entity and, when a
+// referenced entity was not migrated, the reason why entity must be skipped.
+func (m *migrator) remapReferences(entity interface{}) (string, string) {
+	var label string
+	var refs []*foreignRef
+	switch e := entity.(type) {
+	case *consumerGroupMembership:
+		label = "consumer_group_consumers/" + e.ConsumerGroup.FriendlyName() + "/" + e.Consumer.FriendlyName()
+		refs = []*foreignRef{
+			consumerGroupRef("consumer_group", &e.ConsumerGroup),
+			consumerRef("consumer", &e.Consumer),
+		}
+	default:
+		var err error
+		label, refs, err = entityReferences(entity)
+		if err != nil {
+			return fmt.Sprintf("%T", entity), err.Error()
+		}
+	}
+
+	for _, ref := range refs {
+		if ref.id == nil {
+			return label, fmt.Sprintf("%s reference has no ID", ref.field)
+		}
+		targetID, ok := m.report.IDs[ref.typ][*ref.id]
+		if !ok {
+			return label, fmt.Sprintf("references %s %s which was not migrated", ref.typ, *ref.id)
+		}
+		ref.set(String(targetID), nil)
+	}
+
+	if s, ok := entity.(*Service); ok {
+		for i, id := range s.CACertificates {
+			if id == nil {
+				continue
+			}
+			targetID, ok := m.report.IDs[EntityTypeCACertificates][*id]
+			if !ok {
+				return label, fmt.Sprintf("references %s %s which was not migrated", EntityTypeCACertificates, *id)
+			}
+			s.CACertificates[i] = String(targetID)
+		}
+	}
+	return label, ""
+}
+
+// consumerGroupMembership is the membership of a Consumer in a ConsumerGroup.
+type consumerGroupMembership struct {
+	ConsumerGroup *ConsumerGroup
+	Consumer      *Consumer
+}
+
+// listOf adapts a ListAll method to migrationStep.list.
+func listOf[T any](listAll func(ctx context.Context) ([]*T, error)) func(ctx context.Context) ([]interface{}, error) {
+	return func(ctx context.Context) ([]interface{}, error) {
+		entities, err := listAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(entities))
+		for _, e := range entities {
+			out = append(out, e)
+		}
+		return out, nil
+	}
+}
+
+// createOf adapts a Create method to migrationStep.create.
+func createOf[T any](
+	create func(ctx context.Context, entity *T) (*T, error),
+) func(ctx context.Context, entity interface{}) (*string, error) {
+	return func(ctx context.Context, entity interface{}) (*string, error) {
+		created, err := create(ctx, entity.(*T))
+		if err != nil {
+			return nil, err
+		}
+		return entityIDOf(created), nil
+	}
+}
+
+// entityIDOf returns the ID field of entity, a pointer to a Kong entity.
+func entityIDOf(entity interface{}) *string {
+	v := reflect.ValueOf(entity).Elem().FieldByName("ID")
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface().(*string)
+}
+
+func setEntityIDOf(entity interface{}, id *string) {
+	v := reflect.ValueOf(entity).Elem().FieldByName("ID")
+	if v.IsValid() {
+		v.Set(reflect.ValueOf(id))
+	}
+}
@@ -0,0 +1,252 @@
+package kong
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	migrationServiceID  = "c5e2bd2e-0000-4000-8000-000000000001"
+	migrationRouteID    = "c5e2bd2e-0000-4000-8000-000000000002"
+	migrationConsumerID = "c5e2bd2e-0000-4000-8000-000000000003"
+	migrationKeyAuthID  = "c5e2bd2e-0000-4000-8000-000000000004"
+	migrationPartialID  = "c5e2bd2e-0000-4000-8000-000000000005"
+	migrationPluginID   = "c5e2bd2e-0000-4000-8000-000000000006"
+	migrationPlugin2ID  = "c5e2bd2e-0000-4000-8000-000000000007"
+	migrationGroupID    = "c5e2bd2e-0000-4000-8000-000000000008"
+)
+
+func newMigrationSourceServer(t *testing.T, extraLists map[string]string) *httptest.Server {
+	t.Helper()
+	lists := map[string]string{
+		"/services": `[{"id": "` + migrationServiceID + `", "name": "svc", "host": "example.com"}]`,
+		"/routes": `[{"id": "` + migrationRouteID + `", "name": "route", "paths": ["/"],
+			"service": {"id": "` + migrationServiceID + `"}}]`,
+		"/consumers": `[{"id": "` + migrationConsumerID + `", "username": "alice"}]`,
+		"/key-auths": `[{"id": "` + migrationKeyAuthID + `", "key": "secret",
+			"consumer": {"id": "` + migrationConsumerID + `"}}]`,
+		"/partials": `[{"id": "` + migrationPartialID + `", "name": "redis", "type": "redis-ee"}]`,
+		"/plugins": `[
+			{"id": "` + migrationPluginID + `", "name": "key-auth", "route": {"id": "` + migrationRouteID + `"}},
+			{"id": "` + migrationPlugin2ID + `", "name": "rate-limiting-advanced",
+				"partials": [{"id": "` + migrationPartialID + `"}]}
+		]`,
+	}
+	for path, data := range extraLists {
+		lists[path] = data
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := lists[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": ` + data + `, "next": null}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+type migrationWrite struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+func newMigrationTargetServer(t *testing.T, version string) (*httptest.Server, func() []migrationWrite) {
+	t.Helper()
+	var (
+		lock    sync.Mutex
+		writes  []migrationWrite
+		created = map[string]bool{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/" {
+			_, _ = w.Write([]byte(`{"version": "` + version + `", "configuration": {}}`))
+			return
+		}
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &body))
+
+		lock.Lock()
+		writes = append(writes, migrationWrite{method: r.Method, path: r.URL.Path, body: body})
+		// Like Kong, reject adding a consumer to a group twice.
+		membership := strings.HasPrefix(r.URL.Path, "/consumer_groups/") && r.Method == http.MethodPost
+		key := r.URL.Path + "/" + string(b)
+		conflict := membership && created[key]
+		created[key] = true
+		lock.Unlock()
+
+		if conflict {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message": "UNIQUE violation detected"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			body["id"] = "c5e2bd2e-0000-4000-8000-0000000000ff"
+		} else {
+			body["id"] = r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		}
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []migrationWrite {
+		lock.Lock()
+		defer lock.Unlock()
+		return writes
+	}
+}
+
+func newMigrationClients(t *testing.T, targetVersion string) (*Client, *Client, func() []migrationWrite) {
+	t.Helper()
+	return newMigrationClientsWithLists(t, targetVersion, nil)
+}
+
+func newMigrationClientsWithLists(
+	t *testing.T, targetVersion string, extraLists map[string]string,
+) (*Client, *Client, func() []migrationWrite) {
+	t.Helper()
+	sourceServer := newMigrationSourceServer(t, extraLists)
+	targetServer, writes := newMigrationTargetServer(t, targetVersion)
+	source, err := NewClient(String(sourceServer.URL), nil)
+	require.NoError(t, err)
+	target, err := NewClient(String(targetServer.URL), nil)
+	require.NoError(t, err)
+	return source, target, writes
+}
+
+func TestMigrate_PreserveIDs(t *testing.T) {
+	source, target, writes := newMigrationClients(t, "3.4.2")
+
+	report, err := Migrate(context.Background(), source, target, MigrationOpts{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "3.4.2", report.TargetVersion.String())
+	assert.Equal(t, map[EntityType]int{
+		EntityTypeServices:  1,
+		EntityTypeRoutes:    1,
+		EntityTypeConsumers: 1,
+		EntityTypeKeyAuths:  1,
+		EntityTypePlugins:   1,
+	}, report.Copied)
+	assert.Equal(t, migrationRouteID, report.IDs[EntityTypeRoutes][migrationRouteID])
+
+	assert.Contains(t, report.Skipped, MigrationSkip{
+		Type:   EntityTypePartials,
+		Reason: "not supported by Kong 3.4.2 (requires >=3.10.0)",
+	})
+	assert.Contains(t, report.Skipped, MigrationSkip{
+		Type:   EntityTypePlugins,
+		Entity: "plugins/rate-limiting-advanced",
+		Reason: "references partials " + migrationPartialID + " which was not migrated",
+	})
+	assert.Contains(t, report.Skipped, MigrationSkip{
+		Type:   EntityTypeVaults,
+		Reason: "not available on the source",
+	})
+
+	var paths []string
+	for _, w := range writes() {
+		assert.Equal(t, http.MethodPut, w.method)
+		paths = append(paths, w.path)
+	}
+	assert.Equal(t, []string{
+		"/services/" + migrationServiceID,
+		"/routes/" + migrationRouteID,
+		"/consumers/" + migrationConsumerID,
+		"/consumers/" + migrationConsumerID + "/key-auth/" + migrationKeyAuthID,
+		"/plugins/" + migrationPluginID,
+	}, paths)
+}
+
+func TestMigrate_Retry(t *testing.T) {
+	source, target, writes := newMigrationClientsWithLists(t, "3.10.0", map[string]string{
+		"/consumer_groups": `[{"id": "` + migrationGroupID + `", "name": "gold"}]`,
+		"/consumer_groups/" + migrationGroupID + "/consumers": `[{"id": "` + migrationConsumerID +
+			`", "username": "alice"}]`,
+	})
+
+	first, err := Migrate(context.Background(), source, target, MigrationOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Copied[EntityTypeConsumerGroups])
+	assert.Equal(t, 1, first.Copied[EntityTypeConsumerGroupConsumers])
+	writesOfFirst := len(writes())
+
+	second, err := Migrate(context.Background(), source, target, MigrationOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, first.Copied, second.Copied)
+	assert.Equal(t, first.IDs, second.IDs)
+
+	// The second run wrote the same entities again, the membership being
+	// rejected by the target as it already exists.
+	all := writes()
+	require.Len(t, all, 2*writesOfFirst)
+	for i, w := range all[writesOfFirst:] {
+		assert.Equal(t, all[i].method, w.method)
+		assert.Equal(t, all[i].path, w.path)
+	}
+	assert.Contains(t, all[writesOfFirst:], migrationWrite{
+		method: http.MethodPost,
+		path:   "/consumer_groups/" + migrationGroupID + "/consumers",
+		body:   map[string]interface{}{"consumer": migrationConsumerID},
+	})
+}
+
+func TestMigrate_RegenerateIDs(t *testing.T) {
+	source, target, writes := newMigrationClients(t, "3.10.0")
+
+	report, err := Migrate(context.Background(), source, target, MigrationOpts{
+		RegenerateIDs:   true,
+		TargetWorkspace: "team-a",
+		SkipEntityTypes: []EntityType{EntityTypeKeyAuths},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, report.Skipped, MigrationSkip{
+		Type:   EntityTypeKeyAuths,
+		Reason: "excluded by the migration options",
+	})
+	assert.Equal(t, 2, report.Copied[EntityTypePlugins])
+
+	svc := &Service{Name: String("svc")}
+	require.NoError(t, svc.FillID("team-a"))
+	route := &Route{Name: String("route")}
+	require.NoError(t, route.FillID("team-a"))
+	assert.Equal(t, *svc.ID, report.IDs[EntityTypeServices][migrationServiceID])
+	assert.Equal(t, *route.ID, report.IDs[EntityTypeRoutes][migrationRouteID])
+
+	var routeWrite migrationWrite
+	for _, w := range writes() {
+		if strings.HasPrefix(w.path, "/routes/") {
+			routeWrite = w
+		}
+	}
+	assert.Equal(t, "/routes/"+*route.ID, routeWrite.path)
+	assert.Equal(t, map[string]interface{}{"id": *svc.ID}, routeWrite.body["service"])
+}
+
+func TestMigrate_DryRun(t *testing.T) {
+	source, target, writes := newMigrationClients(t, "3.10.0")
+
+	v := MustNewVersion("3.0.0")
+	report, err := Migrate(context.Background(), source, target, MigrationOpts{
+		DryRun:        true,
+		TargetVersion: &v,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, writes())
+	assert.Equal(t, 1, report.Copied[EntityTypeServices])
+	assert.Equal(t, 1, report.Copied[EntityTypePlugins])
+}
@@ -0,0 +1,288 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// LinkPartial links partial to plugin, at path in the configuration of the
+// plugin, e.g. "config.redis".
+// The type of the partial must be listed in the supported_partials of the
+// full schema of the plugin, as returned by PluginService.GetFullSchema, and
+// path must be one of the paths listed for that type. path may be empty when
+// the schema lists a single path for the type.
+// If the partial is already linked to the plugin, its path is updated.
+// Only plugin is modified, use PluginService.Update to save the link in Kong.
+func LinkPartial(plugin *Plugin, partial *Partial, pluginSchema Schema, path string) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	if partial == nil || isEmptyString(partial.ID) {
+		return fmt.Errorf("partial ID cannot be nil")
+	}
+	if isEmptyString(partial.Type) {
+		return fmt.Errorf("partial type cannot be nil")
+	}
+
+	jsonb, err := json.Marshal(&pluginSchema)
+	if err != nil {
+		return err
+	}
+	supportedPartials := gjson.ParseBytes(jsonb).Get("supported_partials")
+	if !supportedPartials.Exists() {
+		return fmt.Errorf("plugin %q does not support partials", plugin.FriendlyName())
+	}
+	supportedPaths, ok := supportedPartials.Map()[*partial.Type]
+	if !ok {
+		return fmt.Errorf("plugin %q does not support partials of type %q", plugin.FriendlyName(), *partial.Type)
+	}
+	var paths []string
+	for _, p := range supportedPaths.Array() {
+		paths = append(paths, p.String())
+	}
+
+	switch {
+	case path == "" && len(paths) == 1:
+		path = paths[0]
+	case path == "":
+		return fmt.Errorf("plugin %q supports partials of type %q at %s; provide a path",
+			plugin.FriendlyName(), *partial.Type, strings.Join(paths, ", "))
+	case !slices.Contains(paths, path):
+		return fmt.Errorf("plugin %q does not support partials of type %q at %s",
+			plugin.FriendlyName(), *partial.Type, path)
+	}
+
+	var existing *PartialLink
+	for _, link := range plugin.Partials {
+		if link == nil || link.Partial == nil {
+			continue
+		}
+		if link.ID != nil && *link.ID == *partial.ID {
+			existing = link
+			continue
+		}
+		// Partials linked at the same path would replace each other, unless
+		// they are appended to an array.
+		if link.Path != nil && *link.Path == path && !strings.HasSuffix(path, "[]") {
+			return fmt.Errorf("partial %q is already linked at %s", link.FriendlyName(), path)
+		}
+	}
+	if existing != nil {
+		existing.Path = String(path)
+		return nil
+	}
+	plugin.Partials = append(plugin.Partials, &PartialLink{
+		Partial: &Partial{ID: partial.ID, Name: partial.Name},
+		Path:    String(path),
+	})
+	return nil
+}
+
+// UnlinkPartial removes the link between plugin and the partial identified
+// by partialIDorName.
+// Only plugin is modified. Since PluginService.Update doesn't send an empty
+// Partials, unlinking the last partial of a plugin must be saved by upserting
+// the plugin with PluginService.Create.
+func UnlinkPartial(plugin *Plugin, partialIDorName string) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	if partialIDorName == "" {
+		return fmt.Errorf("partialIDorName cannot be empty")
+	}
+
+	links := make([]*PartialLink, 0, len(plugin.Partials))
+	for _, link := range plugin.Partials {
+		if link != nil && link.Partial != nil &&
+			((link.ID != nil && *link.ID == partialIDorName) ||
+				(link.Name != nil && *link.Name == partialIDorName)) {
+			continue
+		}
+		links = append(links, link)
+	}
+	if len(links) == len(plugin.Partials) {
+		return fmt.Errorf("partial %q is not linked to plugin %q", partialIDorName, plugin.FriendlyName())
+	}
+	if len(links) == 0 {
+		links = nil
+	}
+	plugin.Partials = links
+	return nil
+}
+
+// ConfigSource is the origin of a value in the effective configuration of a
+// plugin.
+type ConfigSource string
+
+const (
+	// ConfigSourcePlugin is the source of values set in the configuration of
+	// the plugin.
+	ConfigSourcePlugin ConfigSource = "plugin"
+	// ConfigSourcePartial is the source of values coming from a partial
+	// linked to the plugin.
+	ConfigSourcePartial ConfigSource = "partial"
+	// ConfigSourceDefault is the source of values filled from the defaults of
+	// the plugin schema.
+	ConfigSourceDefault ConfigSource = "default"
+)
+
+// ConfigOrigin describes where a value of an effective configuration comes
+// from.
+type ConfigOrigin struct {
+	Source ConfigSource
+	// Partial is the partial the value comes from, when Source is
+	// ConfigSourcePartial.
+	Partial *Partial
+}
+
+func (o ConfigOrigin) String() string {
+	if o.Source == ConfigSourcePartial && o.Partial != nil {
+		return fmt.Sprintf("%s %s", o.Source, o.Partial.FriendlyName())
+	}
+	return string(o.Source)
+}
+
+// EffectiveConfiguration is the configuration Kong uses for a plugin, with
+// the partials linked to the plugin and the defaults of its schema merged in.
+type EffectiveConfiguration struct {
+	Config Configuration
+	// Origins holds the origin of the fields of Config by dotted path, e.g.
+	// "redis.host". Records are described field by field, other values,
+	// including arrays, as a whole.
+	Origins map[string]ConfigOrigin
+}
+
+// Paths returns the paths of the fields of the configuration, sorted.
+func (c *EffectiveConfiguration) Paths() []string {
+	paths := make([]string, 0, len(c.Origins))
+	for path := range c.Origins {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Origin returns the origin of the field at the dotted path.
+func (c *EffectiveConfiguration) Origin(path string) (ConfigOrigin, bool) {
+	origin, ok := c.Origins[path]
+	return origin, ok
+}
+
+// EffectiveConfig returns the configuration Kong uses for plugin: the
+// configuration of the plugin, in which the configuration of the linked
+// partials replaces the values at their path, filled with the defaults of
+// the full schema of the plugin.
+// partials must hold the partials linked to the plugin, which links reference
+// by ID or, without one, by name. Links without a path use the only path the
+// schema supports for the type of their partial.
+// plugin isn't modified.
+func EffectiveConfig(plugin *Plugin, pluginSchema Schema, partials []*Partial) (*EffectiveConfiguration, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+	jsonb, err := json.Marshal(&pluginSchema)
+	if err != nil {
+		return nil, err
+	}
+	gjsonSchema := gjson.ParseBytes(jsonb)
+	configSchema, err := getConfigSchema(gjsonSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	p := plugin.DeepCopy()
+	if p.Config == nil {
+		p.Config = Configuration{}
+	}
+	origins := map[string]ConfigOrigin{}
+	recordConfigOrigins(p.Config, "", ConfigOrigin{Source: ConfigSourcePlugin}, origins, false)
+
+	if len(p.Partials) > 0 {
+		if err := getDefaultPartialPath(p.Partials, gjsonSchema, partials); err != nil {
+			return nil, err
+		}
+		if err := fillPartialConfigsInPlugin(p, partials); err != nil {
+			return nil, err
+		}
+		// Partial configurations are set as Configuration, which defaults
+		// are only filled in once converted to maps.
+		p.Config = p.Config.DeepCopy()
+		for _, link := range p.Partials {
+			partial, err := findLinkedPartial(link, partials)
+			if err != nil {
+				return nil, err
+			}
+			origin := ConfigOrigin{Source: ConfigSourcePartial, Partial: partial}
+			path := strings.TrimPrefix(*link.Path, "config.")
+			if strings.HasSuffix(path, "[]") {
+				// The partial is appended to the array, which is described
+				// as a whole.
+				origins[strings.TrimSuffix(path, "[]")] = origin
+				continue
+			}
+			for existing := range origins {
+				if existing == path || strings.HasPrefix(existing, path+".") {
+					delete(origins, existing)
+				}
+			}
+			recordConfigOrigins(configValue(p.Config, path), path, origin, origins, false)
+		}
+	}
+
+	config := fillConfigRecord(configSchema, p.Config, nil, FillRecordOptions{FillDefaults: true})
+	recordConfigOrigins(config, "", ConfigOrigin{Source: ConfigSourceDefault}, origins, true)
+	return &EffectiveConfiguration{Config: config, Origins: origins}, nil
+}
+
+// recordConfigOrigins sets origin as the origin of the fields of value, which
+// is at path in the configuration. If onlyMissing is true, fields with an
+// origin are left untouched.
+func recordConfigOrigins(value interface{}, path string, origin ConfigOrigin,
+	origins map[string]ConfigOrigin, onlyMissing bool,
+) {
+	var record map[string]interface{}
+	switch v := value.(type) {
+	case Configuration:
+		record = v
+	case map[string]interface{}:
+		record = v
+	}
+	if record != nil && (len(record) > 0 || path == "") {
+		if path != "" {
+			delete(origins, path)
+		}
+		for k, v := range record {
+			field := k
+			if path != "" {
+				field = path + "." + k
+			}
+			recordConfigOrigins(v, field, origin, origins, onlyMissing)
+		}
+		return
+	}
+	if _, ok := origins[path]; ok && onlyMissing {
+		return
+	}
+	origins[path] = origin
+}
+
+// configValue returns the value at the dotted path in config, or nil.
+func configValue(config Configuration, path string) interface{} {
+	var value interface{} = config
+	for _, part := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case Configuration:
+			value = v[part]
+		case map[string]interface{}:
+			value = v[part]
+		default:
+			return nil
+		}
+	}
+	return value
+}
@@ -0,0 +1,197 @@
+package kong
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// partialPluginSchema is a trimmed down full schema of a plugin supporting
+// redis partials.
+const partialPluginSchema = `{
+	"supported_partials": {
+		"redis-ee": ["config.redis"],
+		"vault-auth": ["config.vaults[]", "config.other_vaults[]"]
+	},
+	"fields": [
+		{"config": {"type": "record", "fields": [
+			{"limit": {"type": "number", "default": 10}},
+			{"strategy": {"type": "string", "default": "local"}},
+			{"vaults": {"type": "array", "elements": {"type": "string"}}},
+			{"other_vaults": {"type": "array", "elements": {"type": "string"}}},
+			{"redis": {"type": "record", "fields": [
+				{"host": {"type": "string", "default": "127.0.0.1"}},
+				{"port": {"type": "integer", "default": 6379}},
+				{"database": {"type": "integer", "default": 0}}
+			]}}
+		]}}
+	]
+}`
+
+func mustPartialPluginSchema(t *testing.T) Schema {
+	t.Helper()
+	var schema Schema
+	require.NoError(t, json.Unmarshal([]byte(partialPluginSchema), &schema))
+	return schema
+}
+
+func TestLinkPartial(t *testing.T) {
+	schema := mustPartialPluginSchema(t)
+	redis := &Partial{ID: String("redis-id"), Name: String("redis"), Type: String("redis-ee")}
+	plugin := &Plugin{Name: String("rate-limiting-advanced")}
+
+	require.NoError(t, LinkPartial(plugin, redis, schema, ""))
+	assert.Equal(t, []*PartialLink{{
+		Partial: &Partial{ID: String("redis-id"), Name: String("redis")},
+		Path:    String("config.redis"),
+	}}, plugin.Partials)
+
+	// Linking again is a no-op.
+	require.NoError(t, LinkPartial(plugin, redis, schema, "config.redis"))
+	assert.Len(t, plugin.Partials, 1)
+
+	other := &Partial{ID: String("other-id"), Type: String("redis-ee")}
+	err := LinkPartial(plugin, other, schema, "")
+	assert.EqualError(t, err, `partial "redis" is already linked at config.redis`)
+
+	vault := &Partial{ID: String("vault-id"), Type: String("vault-auth")}
+	err = LinkPartial(plugin, vault, schema, "")
+	assert.EqualError(t, err, `plugin "rate-limiting-advanced" supports partials of type "vault-auth" at `+
+		`config.vaults[], config.other_vaults[]; provide a path`)
+	require.NoError(t, LinkPartial(plugin, vault, schema, "config.vaults[]"))
+	// Partials appended to arrays can share a path.
+	require.NoError(t, LinkPartial(plugin,
+		&Partial{ID: String("vault-2"), Type: String("vault-auth")}, schema, "config.vaults[]"))
+	assert.Len(t, plugin.Partials, 3)
+
+	err = LinkPartial(plugin, &Partial{ID: String("id"), Type: String("unknown")}, schema, "")
+	assert.EqualError(t, err, `plugin "rate-limiting-advanced" does not support partials of type "unknown"`)
+	err = LinkPartial(plugin, redis, schema, "config.other")
+	assert.EqualError(t, err,
+		`plugin "rate-limiting-advanced" does not support partials of type "redis-ee" at config.other`)
+	err = LinkPartial(plugin, redis, Schema{}, "")
+	assert.EqualError(t, err, `plugin "rate-limiting-advanced" does not support partials`)
+	err = LinkPartial(plugin, &Partial{ID: String("id")}, schema, "")
+	assert.EqualError(t, err, "partial type cannot be nil")
+
+	require.NoError(t, UnlinkPartial(plugin, "redis"))
+	require.NoError(t, UnlinkPartial(plugin, "vault-id"))
+	assert.Len(t, plugin.Partials, 1)
+	err = UnlinkPartial(plugin, "redis")
+	assert.EqualError(t, err, `partial "redis" is not linked to plugin "rate-limiting-advanced"`)
+	require.NoError(t, UnlinkPartial(plugin, "vault-2"))
+	assert.Nil(t, plugin.Partials)
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	schema := mustPartialPluginSchema(t)
+	redis := &Partial{
+		ID:     String("redis-id"),
+		Name:   String("redis"),
+		Type:   String("redis-ee"),
+		Config: Configuration{"host": "redis.example.com", "port": float64(7000)},
+	}
+	vault := &Partial{ID: String("vault-id"), Type: String("vault-auth"), Config: Configuration{"name": "v"}}
+	plugin := &Plugin{
+		Name: String("rate-limiting-advanced"),
+		Config: Configuration{
+			"limit": 5,
+			"redis": map[string]interface{}{"host": "ignored"},
+		},
+		Partials: []*PartialLink{
+			{Partial: &Partial{ID: String("redis-id")}},
+			{Partial: &Partial{ID: String("vault-id")}, Path: String("config.vaults[]")},
+		},
+	}
+
+	effective, err := EffectiveConfig(plugin, schema, []*Partial{redis, vault})
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{
+		"limit":        float64(5),
+		"strategy":     "local",
+		"vaults":       []interface{}{map[string]interface{}{"name": "v"}},
+		"other_vaults": nil,
+		"redis": map[string]interface{}{
+			"host":     "redis.example.com",
+			"port":     float64(7000),
+			"database": float64(0),
+		},
+	}, effective.Config)
+
+	fromRedis := ConfigOrigin{Source: ConfigSourcePartial, Partial: redis}
+	assert.Equal(t, map[string]ConfigOrigin{
+		"limit":          {Source: ConfigSourcePlugin},
+		"strategy":       {Source: ConfigSourceDefault},
+		"vaults":         {Source: ConfigSourcePartial, Partial: vault},
+		"other_vaults":   {Source: ConfigSourceDefault},
+		"redis.host":     fromRedis,
+		"redis.port":     fromRedis,
+		"redis.database": {Source: ConfigSourceDefault},
+	}, effective.Origins)
+	assert.Equal(t, []string{
+		"limit", "other_vaults", "redis.database", "redis.host", "redis.port", "strategy", "vaults",
+	}, effective.Paths())
+	origin, ok := effective.Origin("redis.host")
+	require.True(t, ok)
+	assert.Equal(t, "partial redis", origin.String())
+
+	// The plugin is left untouched.
+	assert.Nil(t, plugin.Partials[0].Path)
+	assert.Equal(t, map[string]interface{}{"host": "ignored"}, plugin.Config["redis"])
+
+	_, err = EffectiveConfig(plugin, schema, []*Partial{redis})
+	assert.EqualError(t, err, "partial with ID vault-id not found")
+
+	// Links without an ID reference their partial by name.
+	byName := plugin.DeepCopy()
+	byName.Partials[0].Partial = &Partial{Name: String("redis")}
+	effectiveByName, err := EffectiveConfig(byName, schema, []*Partial{redis, vault})
+	require.NoError(t, err)
+	assert.Equal(t, effective, effectiveByName)
+	byName.Partials[0].Partial = &Partial{Name: String("unknown")}
+	_, err = EffectiveConfig(byName, schema, []*Partial{redis, vault})
+	assert.EqualError(t, err, "partial with name unknown not found")
+
+	for _, link := range []*PartialLink{{}, {Partial: &Partial{}, Path: String("config.redis")}} {
+		invalid := plugin.DeepCopy()
+		invalid.Partials[0] = link
+		_, err = EffectiveConfig(invalid, schema, []*Partial{redis, vault})
+		assert.EqualError(t, err, "partial link has neither an ID nor a name")
+	}
+	untyped := plugin.DeepCopy()
+	untyped.Partials[0].Partial = &Partial{Name: String("untyped")}
+	_, err = EffectiveConfig(untyped, schema, []*Partial{{Name: String("untyped")}, vault})
+	assert.EqualError(t, err, "partial untyped has no type to find its default path")
+}
+
+func TestPartialServiceEffectiveConfig(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/schemas/plugins/rate-limiting-advanced", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(partialPluginSchema))
+	})
+	mux.HandleFunc("/partials/redis-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "redis-id", "name": "redis", "type": "redis-ee", "config": {"port": 7000}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	plugin := &Plugin{Name: String("rate-limiting-advanced")}
+	require.NoError(t, client.Partials.LinkPartial(defaultCtx, plugin, String("redis-id"), ""))
+	assert.Equal(t, "config.redis", *plugin.Partials[0].Path)
+
+	effective, err := client.Partials.EffectiveConfig(defaultCtx, plugin)
+	require.NoError(t, err)
+	assert.Equal(t, float64(7000), effective.Config["redis"].(map[string]interface{})["port"])
+	assert.Equal(t, ConfigSourcePartial, effective.Origins["redis.port"].Source)
+	assert.Equal(t, ConfigSourceDefault, effective.Origins["redis.host"].Source)
+
+	err = client.Partials.LinkPartial(defaultCtx, plugin, String("unknown"), "")
+	assert.True(t, IsNotFoundErr(err))
+}
@@ -26,6 +26,12 @@ type AbstractPartialService interface {
 	// GetLinkedPlugins fetches a list of Plugins in Kong,
 	// linked with the Partial. ListOpt can be used to control pagination.
 	GetLinkedPlugins(ctx context.Context, partialID *string, opt *ListOpt) ([]*Plugin, *ListOpt, error)
+	// LinkPartial links a Partial to a Plugin, validating the link against
+	// the schema of the plugin.
+	LinkPartial(ctx context.Context, plugin *Plugin, partialID *string, path string) error
+	// EffectiveConfig returns the configuration of a Plugin merged with its
+	// Partials and defaults, with the origin of each field.
+	EffectiveConfig(ctx context.Context, plugin *Plugin) (*EffectiveConfiguration, error)
 }
 
 // PartialService handles Partials in Kong.
@@ -230,3 +236,52 @@ func (s *PartialService) GetFullSchema(ctx context.Context,
 	}
 	return schema, nil
 }
+
+// LinkPartial links the Partial identified by partialID to plugin, at path
+// in the configuration of the plugin, as the LinkPartial function does.
+// The partial and the full schema of the plugin are fetched from Kong.
+// Only plugin is modified, use PluginService.Update to save the link.
+func (s *PartialService) LinkPartial(ctx context.Context,
+	plugin *Plugin, partialID *string, path string,
+) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	partial, err := s.Get(ctx, partialID)
+	if err != nil {
+		return err
+	}
+	schema, err := s.client.Plugins.GetFullSchema(ctx, plugin.Name)
+	if err != nil {
+		return err
+	}
+	return LinkPartial(plugin, partial, schema, path)
+}
+
+// EffectiveConfig returns the configuration Kong uses for plugin, with the
+// origin of each field, as the EffectiveConfig function does.
+// The partials linked to the plugin and the full schema of the plugin are
+// fetched from Kong.
+func (s *PartialService) EffectiveConfig(ctx context.Context,
+	plugin *Plugin,
+) (*EffectiveConfiguration, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+	schema, err := s.client.Plugins.GetFullSchema(ctx, plugin.Name)
+	if err != nil {
+		return nil, err
+	}
+	partials := make([]*Partial, 0, len(plugin.Partials))
+	for _, link := range plugin.Partials {
+		if link == nil || link.Partial == nil {
+			continue
+		}
+		partial, err := s.Get(ctx, link.ID)
+		if err != nil {
+			return nil, err
+		}
+		partials = append(partials, partial)
+	}
+	return EffectiveConfig(plugin, schema, partials)
+}
@@ -1,5 +1,13 @@
 package kong
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
 // Plugin represents a Plugin in Kong.
 // Read https://docs.konghq.com/gateway/latest/admin-api/#plugin-object
 // +k8s:deepcopy-gen=true
@@ -54,3 +62,56 @@ func (p *Plugin) FriendlyName() string {
 	}
 	return ""
 }
+
+// pluginScopes are the fields scoping a plugin, with the entities they
+// reference.
+var pluginScopes = []struct {
+	field, entity string
+	set           func(*Plugin) bool
+}{
+	{"service", "service", func(p *Plugin) bool { return p.Service != nil }},
+	{"route", "route", func(p *Plugin) bool { return p.Route != nil }},
+	{"consumer", "consumer", func(p *Plugin) bool { return p.Consumer != nil }},
+	{"consumer_group", "consumer group", func(p *Plugin) bool { return p.ConsumerGroup != nil }},
+}
+
+// ValidatePluginScope checks that the plugin can be applied to the entities
+// it is scoped to, according to the full schema of the plugin as returned by
+// PluginService.GetFullSchema.
+// Plugins declared with no_service, no_route, no_consumer or
+// no_consumer_group can't be applied to services, routes, consumers or
+// consumer groups respectively.
+// The scoped create and update methods of PluginService call it with the
+// entity of their endpoint added to the scope of the plugin.
+func ValidatePluginScope(plugin *Plugin, schema Schema) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	jsonb, err := json.Marshal(&schema)
+	if err != nil {
+		return err
+	}
+	fields := gjson.ParseBytes(jsonb).Get("fields")
+
+	var disallowed []string
+	for _, scope := range pluginScopes {
+		if !scope.set(plugin) {
+			continue
+		}
+		for _, field := range fields.Array() {
+			def := field.Get(scope.field)
+			if !def.Exists() {
+				continue
+			}
+			// no_* fields are foreign keys which must be null.
+			if eq := def.Get("eq"); eq.Exists() && eq.Type == gjson.Null {
+				disallowed = append(disallowed, scope.entity)
+			}
+		}
+	}
+	if len(disallowed) > 0 {
+		return fmt.Errorf("plugin %q cannot be applied to a %s", plugin.FriendlyName(),
+			strings.Join(disallowed, " or "))
+	}
+	return nil
+}
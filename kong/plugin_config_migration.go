@@ -0,0 +1,312 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// PluginConfigChangeAction is the action taken on a field of a plugin
+// configuration by MigratePluginConfig.
+type PluginConfigChangeAction string
+
+const (
+	// PluginConfigFieldRenamed is reported when a deprecated field is moved
+	// to the fields replacing it.
+	PluginConfigFieldRenamed PluginConfigChangeAction = "renamed"
+	// PluginConfigFieldRemoved is reported when a field unknown to the
+	// target schema is dropped.
+	PluginConfigFieldRemoved PluginConfigChangeAction = "removed"
+)
+
+// PluginConfigChange describes a change made to a plugin configuration by
+// MigratePluginConfig.
+type PluginConfigChange struct {
+	Action PluginConfigChangeAction
+	// Path is the dotted path of the field in the original configuration,
+	// e.g. "redis_host".
+	Path string
+	// NewPaths holds the dotted paths the value was moved to, for renamed
+	// fields, e.g. "redis.host".
+	NewPaths []string
+	Value    interface{}
+	// Message is the deprecation message of the field, if the schema has
+	// one.
+	Message string
+}
+
+func (c PluginConfigChange) String() string {
+	if c.Action == PluginConfigFieldRenamed {
+		return fmt.Sprintf("%s: renamed to %s", c.Path, strings.Join(c.NewPaths, ", "))
+	}
+	return fmt.Sprintf("%s: %s", c.Path, c.Action)
+}
+
+// PluginConfigMigrationReport is the result of MigratePluginConfig.
+type PluginConfigMigrationReport struct {
+	Plugin      string
+	FromVersion Version
+	ToVersion   Version
+	// Changes holds the changes made to the configuration, sorted by path.
+	Changes []PluginConfigChange
+	// Warnings holds the fields that were overwritten while moving
+	// deprecated fields. They only name the fields, not their values, which
+	// may be secrets.
+	Warnings []string
+}
+
+// Changed returns true if the configuration was modified.
+func (r *PluginConfigMigrationReport) Changed() bool {
+	return len(r.Changes) > 0
+}
+
+// String returns a human readable description of the migration.
+func (r *PluginConfigMigrationReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "plugin %q: %s -> %s\n", r.Plugin, r.FromVersion, r.ToVersion)
+	if !r.Changed() {
+		b.WriteString("no changes\n")
+	}
+	for _, c := range r.Changes {
+		fmt.Fprintf(&b, "%s\n", c)
+	}
+	for _, w := range r.Warnings {
+		fmt.Fprintf(&b, "warning: %s\n", w)
+	}
+	return b.String()
+}
+
+// MigratePluginConfig migrates the configuration of plugin, written for Kong
+// fromVersion, to Kong toVersion:
+//   - deprecated fields listed in the shorthand_fields of the schema of
+//     either version are moved to the fields replacing them, e.g.
+//     "redis_host" to "redis.host" in the rate-limiting plugin. Fields
+//     deprecated in fromVersion are moved even if toVersion removed them.
+//   - fields unknown to the schema of toVersion are dropped.
+//
+// fromSchema and toSchema must be the full schemas of the plugin in Kong
+// fromVersion and toVersion, as returned by PluginService.GetFullSchema. If
+// toSchema is nil, the bundled schema of toVersion is used. If fromSchema is
+// nil, the bundled schema of fromVersion is used if there is one.
+// Values of deprecated fields take precedence over the values of the fields
+// replacing them, as in Kong; overwritten fields are listed in the warnings of
+// the report.
+// plugin is modified in place.
+func MigratePluginConfig(plugin *Plugin, fromVersion, toVersion Version,
+	fromSchema, toSchema Schema,
+) (*PluginConfigMigrationReport, error) {
+	if plugin == nil || isEmptyString(plugin.Name) {
+		return nil, fmt.Errorf("plugin name cannot be nil")
+	}
+	if toVersion.version.LT(fromVersion.version) {
+		return nil, fmt.Errorf("cannot migrate plugin %q from %s to the older %s",
+			*plugin.Name, fromVersion, toVersion)
+	}
+	if toSchema == nil {
+		var err error
+		toSchema, err = BundledPluginSchema(toVersion, *plugin.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if fromSchema == nil {
+		// Without the schema of fromVersion, only the fields still deprecated
+		// in toVersion are moved.
+		fromSchema, _ = BundledPluginSchema(fromVersion, *plugin.Name)
+	}
+	configSchema, err := pluginConfigSchema(toSchema)
+	if err != nil {
+		return nil, err
+	}
+	var fromConfigSchema gjson.Result
+	if fromSchema != nil {
+		fromConfigSchema, err = pluginConfigSchema(fromSchema)
+		if err != nil {
+			return nil, fmt.Errorf("schema of Kong %s: %w", fromVersion, err)
+		}
+	}
+
+	report := &PluginConfigMigrationReport{
+		Plugin:      *plugin.Name,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	}
+	if plugin.Config != nil {
+		migrateConfigRecord(configSchema, fromConfigSchema, plugin.Config, "", report)
+	}
+	sort.SliceStable(report.Changes, func(i, j int) bool {
+		return report.Changes[i].Path < report.Changes[j].Path
+	})
+	return report, nil
+}
+
+func pluginConfigSchema(schema Schema) (gjson.Result, error) {
+	jsonb, err := json.Marshal(&schema)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+	return getConfigSchema(gjson.ParseBytes(jsonb))
+}
+
+// schemaFields returns the fields of the record described by schema listed
+// under key, "fields" or "shorthand_fields", by name.
+func schemaFields(schema gjson.Result, key string) map[string]gjson.Result {
+	fields := map[string]gjson.Result{}
+	for _, field := range schema.Get(key).Array() {
+		for name, fieldSchema := range field.Map() {
+			fields[name] = fieldSchema
+		}
+	}
+	return fields
+}
+
+// migrateConfigRecord migrates config, the value of the record described by
+// schema at path. fromSchema describes the record in the version migrated
+// from, it doesn't exist if that version is unknown.
+func migrateConfigRecord(schema, fromSchema gjson.Result, config map[string]interface{}, path string,
+	report *PluginConfigMigrationReport,
+) {
+	fields := schemaFields(schema, "fields")
+	fromFields := schemaFields(fromSchema, "fields")
+	shorthands := schemaFields(schema, "shorthand_fields")
+	// Shorthands deprecated in the version migrated from are still moved
+	// after being removed.
+	movable := map[string]gjson.Result{}
+	for name, shorthand := range schemaFields(fromSchema, "shorthand_fields") {
+		if _, ok := fields[name]; !ok {
+			movable[name] = shorthand
+		}
+	}
+	for name, shorthand := range shorthands {
+		movable[name] = shorthand
+	}
+
+	// Shorthands are moved first so that the fields replacing them are
+	// migrated along with the rest of the record.
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	kept := map[string]bool{}
+	for _, name := range names {
+		shorthand, ok := movable[name]
+		if !ok {
+			continue
+		}
+		replacements := parseReplacedWithPaths(shorthand)
+		if replacements == nil {
+			// Kong still accepts shorthands which aren't deprecated.
+			continue
+		}
+		value := config[name]
+		delete(config, name)
+		if value == nil {
+			continue
+		}
+		change := PluginConfigChange{
+			Action:  PluginConfigFieldRenamed,
+			Path:    joinConfigPath(path, name),
+			Value:   value,
+			Message: shorthand.Get("deprecation.message").String(),
+		}
+		for _, replacement := range replacements {
+			newPath := joinConfigPath(path, strings.Join(replacement, "."))
+			previous, err := replaceConfigValue(config, replacement, value)
+			if err != nil {
+				report.Warnings = append(report.Warnings,
+					fmt.Sprintf("cannot move %s to %s: %v", change.Path, newPath, err))
+				continue
+			}
+			if previous != nil && !reflect.DeepEqual(previous, value) {
+				report.Warnings = append(report.Warnings,
+					fmt.Sprintf("%s overwrote %s", change.Path, newPath))
+			}
+			change.NewPaths = append(change.NewPaths, newPath)
+		}
+		if len(change.NewPaths) == 0 {
+			// Keep the value rather than losing it.
+			config[name] = value
+			kept[name] = true
+			continue
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	for name, value := range config {
+		fieldSchema, ok := fields[name]
+		if !ok {
+			if _, ok := shorthands[name]; ok || kept[name] {
+				continue
+			}
+			delete(config, name)
+			report.Changes = append(report.Changes, PluginConfigChange{
+				Action: PluginConfigFieldRemoved,
+				Path:   joinConfigPath(path, name),
+				Value:  value,
+			})
+			continue
+		}
+		migrateConfigValue(fieldSchema, fromFields[name], value, joinConfigPath(path, name), report)
+	}
+}
+
+// migrateConfigValue migrates the records found in value, which is described
+// by schema, and by fromSchema in the version migrated from.
+func migrateConfigValue(schema, fromSchema gjson.Result, value interface{}, path string,
+	report *PluginConfigMigrationReport,
+) {
+	switch schema.Get("type").String() {
+	case "record":
+		switch v := value.(type) {
+		case Configuration:
+			migrateConfigRecord(schema, fromSchema, v, path, report)
+		case map[string]interface{}:
+			migrateConfigRecord(schema, fromSchema, v, path, report)
+		}
+	case "array", "set":
+		elements, ok := value.([]interface{})
+		if !ok {
+			return
+		}
+		for i, element := range elements {
+			migrateConfigValue(schema.Get("elements"), fromSchema.Get("elements"), element,
+				fmt.Sprintf("%s[%d]", path, i), report)
+		}
+	}
+}
+
+// replaceConfigValue sets value at path in config, creating the missing
+// records, and returns the value it replaced.
+func replaceConfigValue(config map[string]interface{}, path []string, value interface{}) (interface{}, error) {
+	current := config
+	for i, part := range path[:len(path)-1] {
+		switch v := current[part].(type) {
+		case map[string]interface{}:
+			current = v
+		case Configuration:
+			current = v
+		case nil:
+			record := map[string]interface{}{}
+			current[part] = record
+			current = record
+		default:
+			return nil, fmt.Errorf("%s is not a record", strings.Join(path[:i+1], "."))
+		}
+	}
+	last := path[len(path)-1]
+	previous := current[last]
+	current[last] = value
+	return previous, nil
+}
+
+func joinConfigPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,171 @@
+package kong
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePluginConfig(t *testing.T) {
+	plugin := &Plugin{
+		Name: String("rate-limiting"),
+		Config: Configuration{
+			"minute":     float64(10),
+			"policy":     "redis",
+			"redis_host": "redis.example.com",
+			"redis_port": float64(7000),
+			"redis_ssl":  nil,
+			"unknown":    true,
+		},
+	}
+
+	report, err := MigratePluginConfig(plugin, MustNewVersion("3.4.0"), MustNewVersion("3.10.0"), nil,
+		mustBundledPluginSchema(t, "3.10.0", "rate-limiting"))
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{
+		"minute": float64(10),
+		"policy": "redis",
+		"redis": map[string]interface{}{
+			"host": "redis.example.com",
+			"port": float64(7000),
+		},
+	}, plugin.Config)
+	require.True(t, report.Changed())
+	assert.Equal(t, []PluginConfigChange{
+		{
+			Action:   PluginConfigFieldRenamed,
+			Path:     "redis_host",
+			NewPaths: []string{"redis.host"},
+			Value:    "redis.example.com",
+			Message:  "rate-limiting: config.redis_host is deprecated, please use config.redis.host instead",
+		},
+		{
+			Action:   PluginConfigFieldRenamed,
+			Path:     "redis_port",
+			NewPaths: []string{"redis.port"},
+			Value:    float64(7000),
+			Message:  "rate-limiting: config.redis_port is deprecated, please use config.redis.port instead",
+		},
+		{Action: PluginConfigFieldRemoved, Path: "unknown", Value: true},
+	}, report.Changes)
+	assert.Empty(t, report.Warnings)
+	assert.Equal(t, `plugin "rate-limiting": 3.4.0 -> 3.10.0
+redis_host: renamed to redis.host
+redis_port: renamed to redis.port
+unknown: removed
+`, report.String())
+
+	// Migrating again is a no-op.
+	report, err = MigratePluginConfig(plugin, MustNewVersion("3.10.0"), MustNewVersion("3.10.0"), nil, nil)
+	require.NoError(t, err)
+	assert.False(t, report.Changed())
+}
+
+func TestMigratePluginConfigNested(t *testing.T) {
+	var schema Schema
+	require.NoError(t, json.Unmarshal([]byte(`{"fields": [{"config": {"type": "record", "fields": [
+		{"redis": {"type": "record", "fields": [{"host": {"type": "string"}}]}},
+		{"rules": {"type": "array", "elements": {"type": "record",
+			"fields": [{"limit": {"type": "number"}}],
+			"shorthand_fields": [
+				{"max": {"type": "number", "deprecation": {"replaced_with": [{"path": ["limit"]}]}}}
+			]
+		}}}
+	], "shorthand_fields": [
+		{"host": {"type": "string", "deprecation": {"replaced_with": [{"path": ["redis", "host"]}]}}},
+		{"alias": {"type": "string"}}
+	]}}]}`), &schema))
+
+	plugin := &Plugin{
+		Name: String("custom"),
+		Config: Configuration{
+			"host":  "new.example.com",
+			"alias": "kept",
+			"redis": map[string]interface{}{"host": "old.example.com", "port": float64(6379)},
+			"rules": []interface{}{
+				map[string]interface{}{"max": float64(5)},
+				map[string]interface{}{"limit": float64(1), "window": float64(60)},
+			},
+		},
+	}
+	report, err := MigratePluginConfig(plugin, MustNewVersion("3.4.0"), MustNewVersion("3.8.0"), nil, schema)
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{
+		"alias": "kept",
+		"redis": map[string]interface{}{"host": "new.example.com"},
+		"rules": []interface{}{
+			map[string]interface{}{"limit": float64(5)},
+			map[string]interface{}{"limit": float64(1)},
+		},
+	}, plugin.Config)
+	assert.Equal(t, `plugin "custom": 3.4.0 -> 3.8.0
+host: renamed to redis.host
+redis.port: removed
+rules[0].max: renamed to rules[0].limit
+rules[1].window: removed
+warning: host overwrote redis.host
+`, report.String())
+
+	plugin.Config = Configuration{"host": float64(1), "redis": "invalid"}
+	report, err = MigratePluginConfig(plugin, MustNewVersion("3.4.0"), MustNewVersion("3.8.0"), nil, schema)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cannot move host to redis.host: redis is not a record"}, report.Warnings)
+	assert.Equal(t, Configuration{"host": float64(1), "redis": "invalid"}, plugin.Config)
+}
+
+func TestMigratePluginConfigFromSchema(t *testing.T) {
+	var fromSchema, toSchema Schema
+	require.NoError(t, json.Unmarshal([]byte(`{"fields": [{"config": {"type": "record", "fields": [
+		{"redis": {"type": "record", "fields": [{"password": {"type": "string", "encrypted": true}}]}}
+	], "shorthand_fields": [
+		{"redis_password": {"type": "string", "deprecation": {"message": "use config.redis.password",
+			"replaced_with": [{"path": ["redis", "password"]}]}}},
+		{"alias": {"type": "string"}}
+	]}}]}`), &fromSchema))
+	require.NoError(t, json.Unmarshal([]byte(`{"fields": [{"config": {"type": "record", "fields": [
+		{"redis": {"type": "record", "fields": [{"password": {"type": "string", "encrypted": true}}]}}
+	]}}]}`), &toSchema))
+
+	plugin := &Plugin{
+		Name: String("custom"),
+		Config: Configuration{
+			"redis_password": "new-secret",
+			"alias":          "dropped",
+			"redis":          map[string]interface{}{"password": "old-secret"},
+		},
+	}
+	report, err := MigratePluginConfig(plugin, MustNewVersion("3.4.0"), MustNewVersion("4.0.0"), fromSchema, toSchema)
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{"redis": map[string]interface{}{"password": "new-secret"}}, plugin.Config)
+	assert.Equal(t, `plugin "custom": 3.4.0 -> 4.0.0
+alias: removed
+redis_password: renamed to redis.password
+warning: redis_password overwrote redis.password
+`, report.String())
+	assert.NotContains(t, report.String(), "secret")
+
+	// Without the schema of the version migrated from, the removed field is
+	// dropped.
+	plugin.Config = Configuration{"redis_password": "secret"}
+	report, err = MigratePluginConfig(plugin, MustNewVersion("2.8.0"), MustNewVersion("4.0.0"), nil, toSchema)
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{}, plugin.Config)
+	assert.Equal(t, []PluginConfigChange{
+		{Action: PluginConfigFieldRemoved, Path: "redis_password", Value: "secret"},
+	}, report.Changes)
+}
+
+func TestMigratePluginConfigErrors(t *testing.T) {
+	_, err := MigratePluginConfig(&Plugin{Name: String("rate-limiting")},
+		MustNewVersion("3.10.0"), MustNewVersion("3.4.0"), nil, nil)
+	assert.EqualError(t, err, `cannot migrate plugin "rate-limiting" from 3.10.0 to the older 3.4.0`)
+
+	_, err = MigratePluginConfig(&Plugin{}, MustNewVersion("3.4.0"), MustNewVersion("3.10.0"), nil, nil)
+	assert.EqualError(t, err, "plugin name cannot be nil")
+
+	_, err = MigratePluginConfig(&Plugin{Name: String("unknown")},
+		MustNewVersion("3.4.0"), MustNewVersion("3.10.0"), nil, nil)
+	assert.EqualError(t, err, `no bundled schema for plugin "unknown" in Kong 3.10.0`)
+}
@@ -0,0 +1,393 @@
+package kong
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PluginOrderingPhaseAccess is the only phase in which Kong supports
+// dynamic plugin ordering.
+const PluginOrderingPhaseAccess = "access"
+
+// bundledPluginPriorities holds the static priorities of the plugins bundled
+// with Kong 3.x, used when the priorities can't be fetched from Kong.
+var bundledPluginPriorities = map[string]int{
+	"pre-function":          1000000,
+	"correlation-id":        100001,
+	"zipkin":                100000,
+	"bot-detection":         2500,
+	"cors":                  2000,
+	"session":               1900,
+	"acme":                  1705,
+	"jwt":                   1450,
+	"oauth2":                1400,
+	"key-auth":              1250,
+	"ldap-auth":             1200,
+	"basic-auth":            1100,
+	"hmac-auth":             1030,
+	"grpc-gateway":          998,
+	"ip-restriction":        990,
+	"request-size-limiting": 951,
+	"acl":                   950,
+	"rate-limiting":         910,
+	"response-ratelimiting": 900,
+	"request-transformer":   801,
+	"response-transformer":  800,
+	"aws-lambda":            750,
+	"azure-functions":       749,
+	"proxy-cache":           100,
+	"opentelemetry":         14,
+	"prometheus":            13,
+	"http-log":              12,
+	"statsd":                11,
+	"datadog":               10,
+	"file-log":              9,
+	"udp-log":               8,
+	"tcp-log":               7,
+	"loggly":                6,
+	"syslog":                4,
+	"grpc-web":              3,
+	"request-termination":   2,
+	"post-function":         -1000,
+}
+
+// BundledPluginPriorities returns the static priorities of the plugins
+// bundled with Kong 3.x by plugin name.
+func BundledPluginPriorities() map[string]int {
+	priorities := make(map[string]int, len(bundledPluginPriorities))
+	for name, priority := range bundledPluginPriorities {
+		priorities[name] = priority
+	}
+	return priorities
+}
+
+// PluginPriorities returns the priorities of the plugins available on the
+// Kong node client is connected to, by plugin name.
+// Plugins bundled with Kong which the node doesn't report a priority for
+// get their priority from BundledPluginPriorities.
+func PluginPriorities(ctx context.Context, client *Client) (map[string]int, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	available, err := availablePlugins(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	priorities := BundledPluginPriorities()
+	for name, priority := range available {
+		if priority != nil {
+			priorities[name] = *priority
+		}
+	}
+	return priorities, nil
+}
+
+// availablePlugins returns the plugins available on the Kong node client is
+// connected to, with their priority. Priorities are nil for Kong 2.x, which
+// only reports whether plugins are available.
+func availablePlugins(ctx context.Context, client *Client) (map[string]*int, error) {
+	root, err := client.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plugins, _ := root["plugins"].(map[string]interface{})
+	available, _ := plugins["available_on_server"].(map[string]interface{})
+	res := make(map[string]*int, len(available))
+	for name, info := range available {
+		res[name] = nil
+		details, ok := info.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if priority, ok := details["priority"].(float64); ok {
+			res[name] = Int(int(priority))
+		}
+	}
+	return res, nil
+}
+
+// PluginOrderingCycleError is returned when the ordering constraints of
+// plugins form a cycle.
+type PluginOrderingCycleError struct {
+	// Cycle holds the plugins forming the cycle, each one running before the
+	// next one, the first plugin being repeated at the end.
+	Cycle []string
+}
+
+func (e *PluginOrderingCycleError) Error() string {
+	return "plugin ordering cycle: " + strings.Join(e.Cycle, " -> ")
+}
+
+// PluginExecutionOrder is the order Kong executes plugins in during the
+// access phase.
+type PluginExecutionOrder struct {
+	// Plugins holds the plugins in execution order.
+	Plugins []*Plugin
+	// Priorities holds the static priorities of the plugins by name.
+	Priorities map[string]int
+	// Reordered holds the names of the plugins whose ordering constraints
+	// move them from the position given by their static priority.
+	Reordered []string
+	// Warnings describes the ordering constraints which have no effect, like
+	// the ones referencing plugins which aren't executed.
+	Warnings []string
+}
+
+// Names returns the names of the plugins in execution order.
+func (o *PluginExecutionOrder) Names() []string {
+	names := make([]string, 0, len(o.Plugins))
+	for _, p := range o.Plugins {
+		names = append(names, *p.Name)
+	}
+	return names
+}
+
+// String renders the execution order, one plugin per line, with their
+// static priority.
+func (o *PluginExecutionOrder) String() string {
+	reordered := map[string]bool{}
+	for _, name := range o.Reordered {
+		reordered[name] = true
+	}
+	var b strings.Builder
+	for i, name := range o.Names() {
+		fmt.Fprintf(&b, "%d. %s (priority %d", i+1, name, o.Priorities[name])
+		if reordered[name] {
+			b.WriteString(", reordered")
+		}
+		b.WriteString(")\n")
+	}
+	return b.String()
+}
+
+// AnalyzePluginOrdering computes the order in which Kong executes plugins
+// during the access phase, combining their static priorities, highest
+// first, with the Before and After constraints of their Ordering.
+// plugins are expected to be the plugins executed for a route, at most one
+// per plugin name, like the effective plugins of a PluginResolution.
+// priorities holds the static priorities by plugin name, like the ones
+// returned by PluginPriorities or BundledPluginPriorities.
+// An error is returned when a plugin has no known priority, when constraints
+// reference unknown plugins or phases other than access, contradict each
+// other, or form a cycle, in which case the error is a
+// *PluginOrderingCycleError.
+func AnalyzePluginOrdering(plugins []*Plugin, priorities map[string]int) (*PluginExecutionOrder, error) {
+	order := &PluginExecutionOrder{Priorities: map[string]int{}}
+	byName := map[string]*Plugin{}
+	var names []string
+	var errs []error
+	for _, p := range plugins {
+		if p == nil || p.Name == nil {
+			continue
+		}
+		name := *p.Name
+		if _, ok := byName[name]; ok {
+			errs = append(errs, fmt.Errorf("plugin %q is executed more than once", name))
+			continue
+		}
+		priority, ok := priorities[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("priority of plugin %q is unknown", name))
+			continue
+		}
+		byName[name] = p
+		names = append(names, name)
+		order.Priorities[name] = priority
+	}
+
+	// runsBefore holds the edges of the constraints graph, from the plugins
+	// to the ones they must run before.
+	runsBefore := map[string]map[string]bool{}
+	addEdge := func(from, to string) {
+		if runsBefore[from] == nil {
+			runsBefore[from] = map[string]bool{}
+		}
+		runsBefore[from][to] = true
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ordering := byName[name].Ordering
+		if ordering == nil {
+			continue
+		}
+		constraints := []struct {
+			relation string
+			phases   PluginOrderingPhase
+		}{
+			{"before", ordering.Before},
+			{"after", ordering.After},
+		}
+		related := map[string]string{}
+		for _, c := range constraints {
+			for _, phase := range sortedPhases(c.phases) {
+				if phase != PluginOrderingPhaseAccess {
+					errs = append(errs, fmt.Errorf("plugin %q: ordering is not supported in the %s phase",
+						name, phase))
+					continue
+				}
+				for _, other := range c.phases[phase] {
+					switch previous, seen := related[other]; {
+					case other == name:
+						errs = append(errs, fmt.Errorf("plugin %q cannot run %s itself", name, c.relation))
+						continue
+					case seen && previous != c.relation:
+						errs = append(errs, fmt.Errorf("plugin %q is set to run both before and after %q",
+							name, other))
+						continue
+					}
+					related[other] = c.relation
+					if _, ok := byName[other]; !ok {
+						if _, known := priorities[other]; known {
+							order.Warnings = append(order.Warnings, fmt.Sprintf(
+								"plugin %q: %q is not executed, the %s constraint has no effect",
+								name, other, c.relation))
+						} else {
+							errs = append(errs, fmt.Errorf("plugin %q: unknown plugin %q", name, other))
+						}
+						continue
+					}
+					if c.relation == "before" {
+						addEdge(name, other)
+					} else {
+						addEdge(other, name)
+					}
+				}
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	byPriority := func(names []string) {
+		sort.Slice(names, func(i, j int) bool {
+			pi, pj := order.Priorities[names[i]], order.Priorities[names[j]]
+			if pi != pj {
+				return pi > pj
+			}
+			return names[i] < names[j]
+		})
+	}
+
+	// Kahn's algorithm, running the plugin with the highest priority among
+	// the ones whose constraints are satisfied.
+	inDegree := map[string]int{}
+	for _, targets := range runsBefore {
+		for to := range targets {
+			inDegree[to]++
+		}
+	}
+	var ready []string
+	for _, name := range names {
+		if inDegree[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+	var sorted []string
+	for len(ready) > 0 {
+		byPriority(ready)
+		name := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, name)
+		for to := range runsBefore[name] {
+			inDegree[to]--
+			if inDegree[to] == 0 {
+				ready = append(ready, to)
+			}
+		}
+	}
+	if len(sorted) < len(names) {
+		return nil, &PluginOrderingCycleError{Cycle: findPluginOrderingCycle(names, runsBefore, inDegree)}
+	}
+
+	static := append([]string(nil), names...)
+	byPriority(static)
+	for i, name := range sorted {
+		order.Plugins = append(order.Plugins, byName[name])
+		if static[i] != name && byName[name].Ordering != nil {
+			order.Reordered = append(order.Reordered, name)
+		}
+	}
+	return order, nil
+}
+
+func sortedPhases(phases PluginOrderingPhase) []string {
+	names := make([]string, 0, len(phases))
+	for phase := range phases {
+		names = append(names, phase)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// findPluginOrderingCycle returns a cycle among the plugins left unsorted by
+// Kahn's algorithm, which are the ones with a remaining in-degree.
+func findPluginOrderingCycle(names []string, runsBefore map[string]map[string]bool,
+	inDegree map[string]int,
+) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+	var cycle []string
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		state[name] = visiting
+		path = append(path, name)
+		next := make([]string, 0, len(runsBefore[name]))
+		for to := range runsBefore[name] {
+			next = append(next, to)
+		}
+		sort.Strings(next)
+		for _, to := range next {
+			switch state[to] {
+			case visiting:
+				for i, n := range path {
+					if n == to {
+						cycle = append(append([]string(nil), path[i:]...), to)
+						return true
+					}
+				}
+			case unvisited:
+				if visit(to) {
+					return true
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return false
+	}
+	for _, name := range names {
+		if inDegree[name] > 0 && state[name] == unvisited && visit(name) {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// PreviewPluginOrder resolves the plugins Kong executes for requests
+// matching target, as ResolveEffectivePlugins does, and returns the order in
+// which Kong executes them during the access phase, using the priorities
+// reported by Kong.
+func PreviewPluginOrder(ctx context.Context, client *Client, target PluginTarget) (*PluginExecutionOrder, error) {
+	resolution, err := ResolveEffectivePlugins(ctx, client, target)
+	if err != nil {
+		return nil, err
+	}
+	priorities, err := PluginPriorities(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("fetching plugin priorities: %w", err)
+	}
+	plugins := make([]*Plugin, 0, len(resolution.Effective))
+	for _, name := range resolution.Names() {
+		plugins = append(plugins, resolution.Effective[name].Plugin)
+	}
+	return AnalyzePluginOrdering(plugins, priorities)
+}
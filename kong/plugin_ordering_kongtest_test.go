@@ -0,0 +1,53 @@
+package kong_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+func TestPreviewPluginOrder(t *testing.T) {
+	server := kongtest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(kong.String(server.URL), nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	service, err := client.Services.Create(ctx, &kong.Service{
+		Name: kong.String("svc"),
+		Host: kong.String("example.com"),
+	})
+	require.NoError(t, err)
+	_, err = client.Routes.Create(ctx, &kong.Route{
+		Name:    kong.String("route"),
+		Paths:   kong.StringSlice("/"),
+		Service: &kong.Service{ID: service.ID},
+	})
+	require.NoError(t, err)
+	_, err = client.Plugins.Create(ctx, &kong.Plugin{
+		Name:    kong.String("key-auth"),
+		Service: &kong.Service{ID: service.ID},
+	})
+	require.NoError(t, err)
+	_, err = client.Plugins.Create(ctx, &kong.Plugin{
+		Name:     kong.String("rate-limiting"),
+		Ordering: &kong.PluginOrdering{Before: kong.PluginOrderingPhase{kong.PluginOrderingPhaseAccess: {"key-auth"}}},
+	})
+	require.NoError(t, err)
+	_, err = client.Plugins.Create(ctx, &kong.Plugin{Name: kong.String("prometheus")})
+	require.NoError(t, err)
+
+	priorities, err := kong.PluginPriorities(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 910, priorities["rate-limiting"])
+	assert.Equal(t, -1000, priorities["post-function"])
+
+	order, err := kong.PreviewPluginOrder(ctx, client, kong.PluginTarget{Route: kong.String("route")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rate-limiting", "key-auth", "prometheus"}, order.Names())
+}
@@ -0,0 +1,124 @@
+package kong
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func orderedPlugin(name string, before, after []string) *Plugin {
+	p := &Plugin{Name: String(name)}
+	if before == nil && after == nil {
+		return p
+	}
+	p.Ordering = &PluginOrdering{}
+	if before != nil {
+		p.Ordering.Before = PluginOrderingPhase{PluginOrderingPhaseAccess: before}
+	}
+	if after != nil {
+		p.Ordering.After = PluginOrderingPhase{PluginOrderingPhaseAccess: after}
+	}
+	return p
+}
+
+func TestAnalyzePluginOrdering(t *testing.T) {
+	priorities := BundledPluginPriorities()
+
+	order, err := AnalyzePluginOrdering([]*Plugin{
+		orderedPlugin("rate-limiting", nil, nil),
+		orderedPlugin("key-auth", nil, nil),
+		orderedPlugin("cors", nil, nil),
+	}, priorities)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cors", "key-auth", "rate-limiting"}, order.Names())
+	assert.Empty(t, order.Reordered)
+
+	// Rate limiting before authentication, by IP.
+	order, err = AnalyzePluginOrdering([]*Plugin{
+		orderedPlugin("rate-limiting", []string{"key-auth"}, nil),
+		orderedPlugin("key-auth", nil, nil),
+		orderedPlugin("cors", nil, nil),
+		orderedPlugin("acl", nil, []string{"rate-limiting"}),
+		orderedPlugin("request-transformer", []string{"proxy-cache"}, nil),
+	}, priorities)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cors", "rate-limiting", "key-auth", "acl", "request-transformer"}, order.Names())
+	assert.Equal(t, []string{"rate-limiting", "acl"}, order.Reordered)
+	assert.Equal(t, []string{
+		`plugin "request-transformer": "proxy-cache" is not executed, the before constraint has no effect`,
+	}, order.Warnings)
+	assert.Equal(t, `1. cors (priority 2000)
+2. rate-limiting (priority 910, reordered)
+3. key-auth (priority 1250)
+4. acl (priority 950, reordered)
+5. request-transformer (priority 801)
+`, order.String())
+}
+
+func TestAnalyzePluginOrderingErrors(t *testing.T) {
+	priorities := BundledPluginPriorities()
+	priorities["custom"] = 500
+
+	_, err := AnalyzePluginOrdering([]*Plugin{
+		orderedPlugin("cors", []string{"rate-limiting"}, nil),
+		orderedPlugin("key-auth", []string{"cors"}, nil),
+		orderedPlugin("rate-limiting", []string{"key-auth"}, nil),
+		orderedPlugin("custom", nil, nil),
+	}, priorities)
+	var cycleErr *PluginOrderingCycleError
+	require.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []string{"cors", "rate-limiting", "key-auth", "cors"}, cycleErr.Cycle)
+	assert.EqualError(t, err, "plugin ordering cycle: cors -> rate-limiting -> key-auth -> cors")
+
+	tests := []struct {
+		name    string
+		plugins []*Plugin
+		wantErr string
+	}{
+		{
+			name:    "unknown priority",
+			plugins: []*Plugin{orderedPlugin("unknown", nil, nil)},
+			wantErr: `priority of plugin "unknown" is unknown`,
+		},
+		{
+			name:    "duplicate plugin",
+			plugins: []*Plugin{orderedPlugin("cors", nil, nil), orderedPlugin("cors", nil, nil)},
+			wantErr: `plugin "cors" is executed more than once`,
+		},
+		{
+			name:    "unknown reference",
+			plugins: []*Plugin{orderedPlugin("cors", []string{"unknown"}, nil)},
+			wantErr: `plugin "cors": unknown plugin "unknown"`,
+		},
+		{
+			name:    "self reference",
+			plugins: []*Plugin{orderedPlugin("cors", nil, []string{"cors"})},
+			wantErr: `plugin "cors" cannot run after itself`,
+		},
+		{
+			name: "contradiction",
+			plugins: []*Plugin{
+				orderedPlugin("cors", []string{"acl"}, []string{"acl"}),
+				orderedPlugin("acl", nil, nil),
+			},
+			wantErr: `plugin "cors" is set to run both before and after "acl"`,
+		},
+		{
+			name: "unsupported phase",
+			plugins: []*Plugin{{
+				Name:     String("cors"),
+				Ordering: &PluginOrdering{Before: PluginOrderingPhase{"rewrite": {"acl"}}},
+			}},
+			wantErr: `plugin "cors": ordering is not supported in the rewrite phase`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := AnalyzePluginOrdering(tt.plugins, priorities)
+			assert.Nil(t, order)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package kong
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PluginTarget identifies the entities a request is matched to by Kong: the
+// route and service it is proxied through, the authenticated consumer and
+// the consumer group of the consumer. Any of them may be nil.
+// ResolveEffectivePlugins accepts names or IDs, EffectivePlugins compares
+// them to both the IDs and the names of the entities plugins are scoped to.
+type PluginTarget struct {
+	Route         *string
+	Service       *string
+	Consumer      *string
+	ConsumerGroup *string
+}
+
+// pluginScopeSet is the set of entities a plugin is scoped to.
+type pluginScopeSet struct {
+	consumer, consumerGroup, route, service bool
+}
+
+// pluginPrecedences are the combinations of entities plugins can be scoped
+// to, from the most specific one, which Kong executes first, to the global
+// scope.
+// Read https://docs.konghq.com/gateway/latest/key-concepts/plugins/#precedence
+var pluginPrecedences = []pluginScopeSet{
+	{consumer: true, route: true, service: true},
+	{consumerGroup: true, route: true, service: true},
+	{consumer: true, route: true},
+	{consumer: true, service: true},
+	{consumerGroup: true, route: true},
+	{consumerGroup: true, service: true},
+	{route: true, service: true},
+	{consumer: true},
+	{consumerGroup: true},
+	{route: true},
+	{service: true},
+	{},
+}
+
+func pluginScopeSetOf(plugin *Plugin) pluginScopeSet {
+	return pluginScopeSet{
+		consumer:      plugin.Consumer != nil,
+		consumerGroup: plugin.ConsumerGroup != nil,
+		route:         plugin.Route != nil,
+		service:       plugin.Service != nil,
+	}
+}
+
+// precedence returns the rank of the scope in pluginPrecedences, or -1 if
+// Kong doesn't support the combination.
+func (s pluginScopeSet) precedence() int {
+	for i, p := range pluginPrecedences {
+		if p == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s pluginScopeSet) String() string {
+	var entities []string
+	if s.consumer {
+		entities = append(entities, "consumer")
+	}
+	if s.consumerGroup {
+		entities = append(entities, "consumer group")
+	}
+	if s.route {
+		entities = append(entities, "route")
+	}
+	if s.service {
+		entities = append(entities, "service")
+	}
+	switch len(entities) {
+	case 0:
+		return "global"
+	case 1:
+		return entities[0]
+	}
+	return strings.Join(entities[:len(entities)-1], ", ") + " and " + entities[len(entities)-1]
+}
+
+// EffectivePlugin is the plugin instance Kong executes for a plugin name,
+// together with the instances of the same plugin it takes precedence over.
+type EffectivePlugin struct {
+	Plugin *Plugin
+	// Scope describes the entities the plugin is scoped to,
+	// e.g. "consumer and route" or "global".
+	Scope string
+	// Shadowed holds the instances matching the target which Kong doesn't
+	// execute, from the most to the least specific one.
+	Shadowed []*ShadowedPlugin
+}
+
+// ShadowedPlugin is a plugin instance matching a PluginTarget which Kong
+// doesn't execute because a more specific instance takes precedence.
+type ShadowedPlugin struct {
+	Plugin *Plugin
+	// Scope describes the entities the plugin is scoped to.
+	Scope string
+	// By is the instance taking precedence.
+	By *Plugin
+}
+
+// PluginResolution is the result of resolving the plugins Kong executes for
+// a PluginTarget.
+type PluginResolution struct {
+	Target PluginTarget
+	// Effective holds the effective plugin instances by plugin name.
+	Effective map[string]*EffectivePlugin
+	// Disabled holds the disabled instances matching the target, which never
+	// shadow other instances.
+	Disabled []*Plugin
+}
+
+// Names returns the names of the effective plugins, sorted.
+func (r *PluginResolution) Names() []string {
+	names := make([]string, 0, len(r.Effective))
+	for name := range r.Effective {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Explain describes, for each plugin name, the instance Kong executes and
+// the instances it shadows.
+func (r *PluginResolution) Explain() string {
+	var b strings.Builder
+	for _, name := range r.Names() {
+		effective := r.Effective[name]
+		fmt.Fprintf(&b, "%s: %s (%s) applies\n", name, pluginLabel(effective.Plugin), effective.Scope)
+		for _, s := range effective.Shadowed {
+			fmt.Fprintf(&b, "  shadows %s (%s)\n", pluginLabel(s.Plugin), s.Scope)
+		}
+	}
+	for _, p := range r.Disabled {
+		fmt.Fprintf(&b, "%s: %s (%s) is disabled\n", p.FriendlyName(), pluginLabel(p),
+			pluginScopeSetOf(p))
+	}
+	return b.String()
+}
+
+// pluginLabel identifies a plugin instance by its instance name or ID.
+func pluginLabel(p *Plugin) string {
+	switch {
+	case p.InstanceName != nil:
+		return fmt.Sprintf("instance %q", *p.InstanceName)
+	case p.ID != nil:
+		return "plugin " + *p.ID
+	}
+	return "plugin " + p.FriendlyName()
+}
+
+// EffectivePlugins resolves, among plugins, the instances Kong executes for
+// requests matching target, applying Kong's precedence rules: for each
+// plugin name, the instance scoped to the most specific combination of
+// entities wins, down to global instances.
+// Plugins scoped to entities other than the ones of target don't apply and
+// are left out of the resolution.
+func EffectivePlugins(plugins []*Plugin, target PluginTarget) *PluginResolution {
+	resolution := &PluginResolution{
+		Target:    target,
+		Effective: map[string]*EffectivePlugin{},
+	}
+
+	type candidate struct {
+		plugin     *Plugin
+		scope      pluginScopeSet
+		precedence int
+	}
+	byName := map[string][]candidate{}
+	for _, p := range plugins {
+		if p == nil || p.Name == nil || !pluginMatchesTarget(p, target) {
+			continue
+		}
+		scope := pluginScopeSetOf(p)
+		precedence := scope.precedence()
+		if precedence < 0 {
+			continue
+		}
+		if p.Enabled != nil && !*p.Enabled {
+			resolution.Disabled = append(resolution.Disabled, p)
+			continue
+		}
+		byName[*p.Name] = append(byName[*p.Name], candidate{p, scope, precedence})
+	}
+
+	for name, candidates := range byName {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].precedence < candidates[j].precedence
+		})
+		winner := candidates[0]
+		effective := &EffectivePlugin{Plugin: winner.plugin, Scope: winner.scope.String()}
+		for _, c := range candidates[1:] {
+			effective.Shadowed = append(effective.Shadowed, &ShadowedPlugin{
+				Plugin: c.plugin,
+				Scope:  c.scope.String(),
+				By:     winner.plugin,
+			})
+		}
+		resolution.Effective[name] = effective
+	}
+	return resolution
+}
+
+// pluginMatchesTarget returns true if every entity the plugin is scoped to
+// is part of target.
+func pluginMatchesTarget(p *Plugin, target PluginTarget) bool {
+	if p.Route != nil && !referenceMatches(target.Route, p.Route.ID, p.Route.Name) {
+		return false
+	}
+	if p.Service != nil && !referenceMatches(target.Service, p.Service.ID, p.Service.Name) {
+		return false
+	}
+	if p.Consumer != nil &&
+		!referenceMatches(target.Consumer, p.Consumer.ID, p.Consumer.Username, p.Consumer.CustomID) {
+		return false
+	}
+	if p.ConsumerGroup != nil &&
+		!referenceMatches(target.ConsumerGroup, p.ConsumerGroup.ID, p.ConsumerGroup.Name) {
+		return false
+	}
+	return true
+}
+
+func referenceMatches(target *string, keys ...*string) bool {
+	if isEmptyString(target) {
+		return false
+	}
+	for _, key := range keys {
+		if key != nil && *key == *target {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveEffectivePlugins fetches the plugins of the workspace of client and
+// resolves the instances Kong executes for requests matching target, as
+// EffectivePlugins does.
+// The entities of target are looked up in Kong, so they can be given by name
+// or ID. When target has a route but no service, the service of the route is
+// used. The consumer group isn't derived from the consumer, since consumers
+// can belong to several groups.
+func ResolveEffectivePlugins(ctx context.Context, client *Client, target PluginTarget) (*PluginResolution, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+
+	resolved := PluginTarget{}
+	if !isEmptyString(target.Route) {
+		route, err := client.Routes.Get(ctx, target.Route)
+		if err != nil {
+			return nil, fmt.Errorf("fetching route %q: %w", *target.Route, err)
+		}
+		resolved.Route = route.ID
+		if isEmptyString(target.Service) && route.Service != nil {
+			resolved.Service = route.Service.ID
+		}
+	}
+	if !isEmptyString(target.Service) {
+		service, err := client.Services.Get(ctx, target.Service)
+		if err != nil {
+			return nil, fmt.Errorf("fetching service %q: %w", *target.Service, err)
+		}
+		resolved.Service = service.ID
+	}
+	if !isEmptyString(target.Consumer) {
+		consumer, err := client.Consumers.Get(ctx, target.Consumer)
+		if err != nil {
+			return nil, fmt.Errorf("fetching consumer %q: %w", *target.Consumer, err)
+		}
+		resolved.Consumer = consumer.ID
+	}
+	if !isEmptyString(target.ConsumerGroup) {
+		group, err := client.ConsumerGroups.Get(ctx, target.ConsumerGroup)
+		if err != nil {
+			return nil, fmt.Errorf("fetching consumer group %q: %w", *target.ConsumerGroup, err)
+		}
+		if group.ConsumerGroup != nil {
+			resolved.ConsumerGroup = group.ConsumerGroup.ID
+		}
+	}
+
+	plugins, err := client.Plugins.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing plugins: %w", err)
+	}
+	return EffectivePlugins(plugins, resolved), nil
+}
@@ -0,0 +1,65 @@
+package kong_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/kongtest"
+)
+
+func TestResolveEffectivePlugins(t *testing.T) {
+	server := kongtest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(kong.String(server.URL), nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	service, err := client.Services.Create(ctx, &kong.Service{
+		Name: kong.String("svc"),
+		Host: kong.String("example.com"),
+	})
+	require.NoError(t, err)
+	route, err := client.Routes.Create(ctx, &kong.Route{
+		Name:    kong.String("route"),
+		Paths:   kong.StringSlice("/"),
+		Service: &kong.Service{ID: service.ID},
+	})
+	require.NoError(t, err)
+	consumer, err := client.Consumers.Create(ctx, &kong.Consumer{Username: kong.String("alice")})
+	require.NoError(t, err)
+
+	onService, err := client.Plugins.Create(ctx, &kong.Plugin{
+		Name:    kong.String("rate-limiting"),
+		Service: &kong.Service{ID: service.ID},
+	})
+	require.NoError(t, err)
+	onConsumer, err := client.Plugins.Create(ctx, &kong.Plugin{
+		Name:     kong.String("rate-limiting"),
+		Consumer: &kong.Consumer{ID: consumer.ID},
+	})
+	require.NoError(t, err)
+
+	// The service is derived from the route.
+	resolution, err := kong.ResolveEffectivePlugins(ctx, client, kong.PluginTarget{Route: kong.String("route")})
+	require.NoError(t, err)
+	assert.Equal(t, route.ID, resolution.Target.Route)
+	assert.Equal(t, service.ID, resolution.Target.Service)
+	assert.Equal(t, onService.ID, resolution.Effective["rate-limiting"].Plugin.ID)
+
+	resolution, err = kong.ResolveEffectivePlugins(ctx, client, kong.PluginTarget{
+		Route:    kong.String("route"),
+		Consumer: kong.String("alice"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, onConsumer.ID, resolution.Effective["rate-limiting"].Plugin.ID)
+	require.Len(t, resolution.Effective["rate-limiting"].Shadowed, 1)
+	assert.Equal(t, onService.ID, resolution.Effective["rate-limiting"].Shadowed[0].Plugin.ID)
+
+	_, err = kong.ResolveEffectivePlugins(ctx, client, kong.PluginTarget{Consumer: kong.String("bob")})
+	assert.ErrorContains(t, err, `fetching consumer "bob"`)
+	assert.True(t, kong.IsNotFoundErr(err))
+}
@@ -0,0 +1,127 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectivePlugins(t *testing.T) {
+	route := &Route{ID: String("route-id")}
+	service := &Service{ID: String("service-id")}
+	consumer := &Consumer{ID: String("consumer-id")}
+	group := &ConsumerGroup{ID: String("group-id")}
+
+	global := &Plugin{ID: String("global"), Name: String("rate-limiting")}
+	onService := &Plugin{ID: String("service"), Name: String("rate-limiting"), Service: service}
+	onConsumer := &Plugin{ID: String("consumer"), Name: String("rate-limiting"), Consumer: consumer}
+	onConsumerRoute := &Plugin{
+		ID:       String("consumer-route"),
+		Name:     String("rate-limiting"),
+		Consumer: consumer,
+		Route:    route,
+	}
+	onGroupService := &Plugin{
+		ID:            String("group-service"),
+		Name:          String("rate-limiting"),
+		ConsumerGroup: group,
+		Service:       service,
+	}
+	onOtherRoute := &Plugin{ID: String("other-route"), Name: String("rate-limiting"), Route: &Route{ID: String("x")}}
+	corsOnRoute := &Plugin{ID: String("cors"), Name: String("cors"), Route: route}
+	disabled := &Plugin{
+		ID:      String("disabled"),
+		Name:    String("cors"),
+		Service: service,
+		Route:   route,
+		Enabled: Bool(false),
+	}
+	plugins := []*Plugin{
+		global, onService, onConsumer, onConsumerRoute, onGroupService, onOtherRoute, corsOnRoute, disabled,
+	}
+
+	resolution := EffectivePlugins(plugins, PluginTarget{
+		Route:         String("route-id"),
+		Service:       String("service-id"),
+		Consumer:      String("consumer-id"),
+		ConsumerGroup: String("group-id"),
+	})
+	assert.Equal(t, []string{"cors", "rate-limiting"}, resolution.Names())
+
+	rateLimiting := resolution.Effective["rate-limiting"]
+	assert.Same(t, onConsumerRoute, rateLimiting.Plugin)
+	assert.Equal(t, "consumer and route", rateLimiting.Scope)
+	var shadowed []*Plugin
+	for _, s := range rateLimiting.Shadowed {
+		shadowed = append(shadowed, s.Plugin)
+		assert.Same(t, onConsumerRoute, s.By)
+	}
+	assert.Equal(t, []*Plugin{onGroupService, onConsumer, onService, global}, shadowed)
+
+	assert.Same(t, corsOnRoute, resolution.Effective["cors"].Plugin)
+	assert.Empty(t, resolution.Effective["cors"].Shadowed)
+	assert.Equal(t, []*Plugin{disabled}, resolution.Disabled)
+
+	assert.Equal(t, `cors: plugin cors (route) applies
+rate-limiting: plugin consumer-route (consumer and route) applies
+  shadows plugin group-service (consumer group and service)
+  shadows plugin consumer (consumer)
+  shadows plugin service (service)
+  shadows plugin global (global)
+cors: plugin disabled (route and service) is disabled
+`, resolution.Explain())
+
+	// Without a consumer, consumer groups take precedence over services.
+	resolution = EffectivePlugins(plugins, PluginTarget{
+		Service:       String("service-id"),
+		ConsumerGroup: String("group-id"),
+	})
+	assert.Same(t, onGroupService, resolution.Effective["rate-limiting"].Plugin)
+	assert.NotContains(t, resolution.Effective, "cors")
+
+	// Unauthenticated requests only get global plugins.
+	resolution = EffectivePlugins(plugins, PluginTarget{})
+	assert.Same(t, global, resolution.Effective["rate-limiting"].Plugin)
+}
+
+func TestEffectivePluginsPrecedence(t *testing.T) {
+	target := PluginTarget{
+		Route:         String("route"),
+		Service:       String("service"),
+		Consumer:      String("consumer"),
+		ConsumerGroup: String("group"),
+	}
+	// Each combination shadows all the ones after it.
+	var plugins []*Plugin
+	for i, scope := range pluginPrecedences {
+		p := &Plugin{ID: String(scope.String()), Name: String("acl")}
+		if scope.consumer {
+			p.Consumer = &Consumer{Username: target.Consumer}
+		}
+		if scope.consumerGroup {
+			p.ConsumerGroup = &ConsumerGroup{Name: target.ConsumerGroup}
+		}
+		if scope.route {
+			p.Route = &Route{Name: target.Route}
+		}
+		if scope.service {
+			p.Service = &Service{Name: target.Service}
+		}
+		plugins = append([]*Plugin{p}, plugins...)
+
+		resolution := EffectivePlugins(plugins, target)
+		require.Contains(t, resolution.Effective, "acl")
+		assert.Equal(t, *plugins[len(plugins)-1].ID, *resolution.Effective["acl"].Plugin.ID)
+		assert.Len(t, resolution.Effective["acl"].Shadowed, i)
+	}
+
+	// Kong doesn't support scoping plugins to both a consumer and a
+	// consumer group.
+	resolution := EffectivePlugins([]*Plugin{{
+		Name:          String("acl"),
+		Consumer:      &Consumer{ID: target.Consumer},
+		ConsumerGroup: &ConsumerGroup{ID: target.ConsumerGroup},
+	}}, target)
+	assert.Empty(t, resolution.Effective)
+}
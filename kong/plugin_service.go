@@ -9,6 +9,10 @@ import (
 )
 
 // AbstractPluginService handles Plugins in Kong.
+//
+// The scoped create and update methods, such as CreateForConsumer, fetch the
+// full schema of the plugin and fail without writing it when the plugin
+// can't be applied to the entities it is scoped to, see ValidatePluginScope.
 type AbstractPluginService interface {
 	// Create creates a Plugin in Kong.
 	Create(ctx context.Context, plugin *Plugin) (*Plugin, error)
@@ -16,6 +20,8 @@ type AbstractPluginService interface {
 	CreateForService(ctx context.Context, serviceIDorName *string, plugin *Plugin) (*Plugin, error)
 	// CreateForRoute creates a Plugin in Kong.
 	CreateForRoute(ctx context.Context, routeIDorName *string, plugin *Plugin) (*Plugin, error)
+	// CreateForConsumer creates a Plugin in Kong.
+	CreateForConsumer(ctx context.Context, consumerUsernameOrID *string, plugin *Plugin) (*Plugin, error)
 	// CreateForConsumerGroup creates a Plugin in Kong.
 	CreateForConsumerGroup(ctx context.Context, cgIDorName *string, plugin *Plugin) (*Plugin, error)
 	// Get fetches a Plugin in Kong.
@@ -26,6 +32,8 @@ type AbstractPluginService interface {
 	UpdateForService(ctx context.Context, serviceIDorName *string, plugin *Plugin) (*Plugin, error)
 	// UpdateForRoute updates a Plugin in Kong for a service
 	UpdateForRoute(ctx context.Context, routeIDorName *string, plugin *Plugin) (*Plugin, error)
+	// UpdateForConsumer updates a Plugin in Kong for a consumer
+	UpdateForConsumer(ctx context.Context, consumerUsernameOrID *string, plugin *Plugin) (*Plugin, error)
 	// UpdateForConsumerGrou updates a Plugin in Kong for a consumer-group
 	UpdateForConsumerGroup(ctx context.Context, cgIDorName *string, plugin *Plugin) (*Plugin, error)
 	// Delete deletes a Plugin in Kong
@@ -34,6 +42,10 @@ type AbstractPluginService interface {
 	DeleteForService(ctx context.Context, serviceIDorName *string, pluginID *string) error
 	// DeleteForRoute deletes a Plugin in Kong
 	DeleteForRoute(ctx context.Context, routeIDorName *string, pluginID *string) error
+	// DeleteForConsumer deletes a Plugin in Kong
+	DeleteForConsumer(ctx context.Context, consumerUsernameOrID *string, pluginID *string) error
+	// DeleteForConsumerGroup deletes a Plugin in Kong
+	DeleteForConsumerGroup(ctx context.Context, cgIDorName *string, pluginID *string) error
 	// List fetches a list of Plugins in Kong.
 	List(ctx context.Context, opt *ListOpt) ([]*Plugin, *ListOpt, error)
 	// ListAll fetches all Plugins in Kong.
@@ -122,9 +134,15 @@ func (s *PluginService) Create(ctx context.Context,
 // If an ID is specified, it will be used to
 // create a plugin in Kong, otherwise an ID
 // is auto-generated.
+// The plugin can be scoped to a consumer or consumer group as well by setting
+// its Consumer or ConsumerGroup.
 func (s *PluginService) CreateForService(ctx context.Context,
 	serviceIDorName *string, plugin *Plugin,
 ) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+
 	queryPath := "/plugins"
 	method := "POST"
 	if plugin.ID != nil {
@@ -135,6 +153,11 @@ func (s *PluginService) CreateForService(ctx context.Context,
 		return nil, fmt.Errorf("serviceIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.Service = &Service{ID: serviceIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
 	return s.sendRequest(ctx, plugin, fmt.Sprintf("/services/%v"+queryPath, *serviceIDorName), method)
 }
 
@@ -142,9 +165,15 @@ func (s *PluginService) CreateForService(ctx context.Context,
 // If an ID is specified, it will be used to
 // create a plugin in Kong, otherwise an ID
 // is auto-generated.
+// The plugin can be scoped to a consumer or consumer group as well by setting
+// its Consumer or ConsumerGroup.
 func (s *PluginService) CreateForRoute(ctx context.Context,
 	routeIDorName *string, plugin *Plugin,
 ) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+
 	queryPath := "/plugins"
 	method := "POST"
 
@@ -156,13 +185,52 @@ func (s *PluginService) CreateForRoute(ctx context.Context,
 		return nil, fmt.Errorf("routeIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.Route = &Route{ID: routeIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
 	return s.sendRequest(ctx, plugin, fmt.Sprintf("/routes/%v"+queryPath, *routeIDorName), method)
 }
 
+// CreateForConsumer creates a Plugin in Kong at Consumer level.
+// If an ID is specified, it will be used to
+// create a plugin in Kong, otherwise an ID
+// is auto-generated.
+// The plugin can be scoped to a route or service as well by setting
+// its Route or Service.
+func (s *PluginService) CreateForConsumer(ctx context.Context,
+	consumerUsernameOrID *string, plugin *Plugin,
+) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+
+	queryPath := "/plugins"
+	method := "POST"
+
+	if plugin.ID != nil {
+		queryPath = queryPath + "/" + *plugin.ID
+		method = "PUT"
+	}
+	if isEmptyString(consumerUsernameOrID) {
+		return nil, fmt.Errorf("consumerUsernameOrID cannot be nil")
+	}
+
+	scoped := *plugin
+	scoped.Consumer = &Consumer{ID: consumerUsernameOrID}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
+	return s.sendRequest(ctx, plugin, fmt.Sprintf("/consumers/%v"+queryPath, *consumerUsernameOrID), method)
+}
+
 // CreateForConsumerGroup creates a Plugin in Kong at ConsumerGroup level.
 // If an ID is specified, it will be used to
 // create a plugin in Kong, otherwise an ID
 // is auto-generated.
+// The plugin can be scoped to a route or service as well by setting
+// its Route or Service.
 func (s *PluginService) CreateForConsumerGroup(ctx context.Context,
 	cgIDorName *string, plugin *Plugin,
 ) (*Plugin, error) {
@@ -181,6 +249,11 @@ func (s *PluginService) CreateForConsumerGroup(ctx context.Context,
 		return nil, fmt.Errorf("cgIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.ConsumerGroup = &ConsumerGroup{ID: cgIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
 	return s.sendRequest(ctx, plugin, fmt.Sprintf("/consumer_groups/%v"+queryPath, *cgIDorName), method)
 }
 
@@ -222,6 +295,9 @@ func (s *PluginService) Update(ctx context.Context,
 func (s *PluginService) UpdateForService(ctx context.Context,
 	serviceIDorName *string, plugin *Plugin,
 ) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
 	if isEmptyString(plugin.ID) {
 		return nil, fmt.Errorf("ID cannot be nil for Update operation")
 	}
@@ -229,6 +305,12 @@ func (s *PluginService) UpdateForService(ctx context.Context,
 		return nil, fmt.Errorf("serviceIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.Service = &Service{ID: serviceIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf("/services/%v/plugins/%v", *serviceIDorName, *plugin.ID)
 	return s.sendRequest(ctx, plugin, endpoint, "PATCH")
 }
@@ -237,6 +319,9 @@ func (s *PluginService) UpdateForService(ctx context.Context,
 func (s *PluginService) UpdateForRoute(ctx context.Context,
 	routeIDorName *string, plugin *Plugin,
 ) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
 	if isEmptyString(plugin.ID) {
 		return nil, fmt.Errorf("ID cannot be nil for Update operation")
 	}
@@ -244,10 +329,40 @@ func (s *PluginService) UpdateForRoute(ctx context.Context,
 		return nil, fmt.Errorf("routeIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.Route = &Route{ID: routeIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf("/routes/%v/plugins/%v", *routeIDorName, *plugin.ID)
 	return s.sendRequest(ctx, plugin, endpoint, "PATCH")
 }
 
+// UpdateForConsumer updates a Plugin in Kong at Consumer level.
+func (s *PluginService) UpdateForConsumer(ctx context.Context,
+	consumerUsernameOrID *string, plugin *Plugin,
+) (*Plugin, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("plugin cannot be nil")
+	}
+	if isEmptyString(plugin.ID) {
+		return nil, fmt.Errorf("ID cannot be nil for Update operation")
+	}
+	if isEmptyString(consumerUsernameOrID) {
+		return nil, fmt.Errorf("consumerUsernameOrID cannot be nil")
+	}
+
+	scoped := *plugin
+	scoped.Consumer = &Consumer{ID: consumerUsernameOrID}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/consumers/%v/plugins/%v", *consumerUsernameOrID, *plugin.ID)
+	return s.sendRequest(ctx, plugin, endpoint, "PATCH")
+}
+
 // UpdateForConsumerGroup updates a Plugin in Kong at Consumer Group level.
 func (s *PluginService) UpdateForConsumerGroup(ctx context.Context,
 	cgIDorName *string, plugin *Plugin,
@@ -262,6 +377,12 @@ func (s *PluginService) UpdateForConsumerGroup(ctx context.Context,
 		return nil, fmt.Errorf("cgIDorName cannot be nil")
 	}
 
+	scoped := *plugin
+	scoped.ConsumerGroup = &ConsumerGroup{ID: cgIDorName}
+	if err := s.validateScope(ctx, &scoped); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf("/consumer_groups/%v/plugins/%v", *cgIDorName, *plugin.ID)
 	return s.sendRequest(ctx, plugin, endpoint, "PATCH")
 }
@@ -320,6 +441,44 @@ func (s *PluginService) DeleteForRoute(ctx context.Context,
 	return nil
 }
 
+// DeleteForConsumer deletes a Plugin in Kong at Consumer level.
+func (s *PluginService) DeleteForConsumer(ctx context.Context,
+	consumerUsernameOrID *string, pluginID *string,
+) error {
+	if isEmptyString(pluginID) {
+		return fmt.Errorf("plugin ID cannot be nil for Delete operation")
+	}
+	if isEmptyString(consumerUsernameOrID) {
+		return fmt.Errorf("consumerUsernameOrID cannot be nil")
+	}
+
+	endpoint := fmt.Sprintf("/consumers/%v/plugins/%v", *consumerUsernameOrID, *pluginID)
+	_, err := s.sendRequest(ctx, nil, endpoint, "DELETE")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteForConsumerGroup deletes a Plugin in Kong at Consumer Group level.
+func (s *PluginService) DeleteForConsumerGroup(ctx context.Context,
+	cgIDorName *string, pluginID *string,
+) error {
+	if isEmptyString(pluginID) {
+		return fmt.Errorf("plugin ID cannot be nil for Delete operation")
+	}
+	if isEmptyString(cgIDorName) {
+		return fmt.Errorf("cgIDorName cannot be nil")
+	}
+
+	endpoint := fmt.Sprintf("/consumer_groups/%v/plugins/%v", *cgIDorName, *pluginID)
+	_, err := s.sendRequest(ctx, nil, endpoint, "DELETE")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates a Plugin against its schema
 func (s *PluginService) Validate(ctx context.Context, plugin *Plugin) (bool, string, error) {
 	endpoint := "/schemas/plugins/validate"
@@ -457,6 +616,24 @@ func (s *PluginService) ListAllForConsumerGroups(ctx context.Context,
 	return s.listAllByPath(ctx, "/consumer_groups/"+*cgID+"/plugins")
 }
 
+// validateScope checks that plugin can be applied to the entities it is
+// scoped to, according to the full schema of the plugin fetched from Kong.
+// Plugins without a name, as in partial updates, and plugins whose schema
+// isn't found are left to Kong to reject.
+func (s *PluginService) validateScope(ctx context.Context, plugin *Plugin) error {
+	if isEmptyString(plugin.Name) {
+		return nil
+	}
+	schema, err := s.GetFullSchema(ctx, plugin.Name)
+	if IsNotFoundErr(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("fetching schema of plugin %q: %w", *plugin.Name, err)
+	}
+	return ValidatePluginScope(plugin, schema)
+}
+
 func (s *PluginService) sendRequest(ctx context.Context, plugin *Plugin, endpoint, method string) (*Plugin, error) {
 	var req *http.Request
 	var err error
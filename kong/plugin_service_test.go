@@ -1,7 +1,12 @@
 package kong
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -1007,6 +1012,136 @@ func TestPluginsWithConditionalExpressions(T *testing.T) {
 	})
 }
 
+func TestPluginsWithConsumer(T *testing.T) {
+	RunWhenDBMode(T, "postgres")
+	RunWhenKong(T, ">=2.3.0")
+
+	assert := assert.New(T)
+	require := require.New(T)
+
+	client, err := NewTestClient(nil, nil)
+	require.NoError(err)
+	require.NotNil(client)
+
+	consumer, err := client.Consumers.Create(defaultCtx, &Consumer{
+		Username: String("plugin-consumer"),
+	})
+	require.NoError(err)
+	require.NotNil(consumer)
+	T.Cleanup(func() {
+		require.NoError(client.Consumers.Delete(defaultCtx, consumer.ID))
+	})
+
+	_, err = client.Plugins.CreateForConsumer(defaultCtx, consumer.Username, &Plugin{Name: String("key-auth")})
+	require.EqualError(err, `plugin "key-auth" cannot be applied to a consumer`)
+
+	plugin := &Plugin{
+		Name: String("rate-limiting"),
+		Config: Configuration{
+			"minute": 10,
+		},
+	}
+	createdPlugin, err := client.Plugins.CreateForConsumer(defaultCtx, consumer.Username, plugin)
+	require.NoError(err)
+	require.NotNil(createdPlugin)
+	assert.Equal(consumer.ID, createdPlugin.Consumer.ID)
+
+	createdPlugin.Config["minute"] = 20
+	updatedPlugin, err := client.Plugins.UpdateForConsumer(defaultCtx, consumer.ID, createdPlugin)
+	require.NoError(err)
+	assert.Equal(float64(20), updatedPlugin.Config["minute"])
+
+	plugins, err := client.Plugins.ListAllForConsumer(defaultCtx, consumer.ID)
+	require.NoError(err)
+	require.Len(plugins, 1)
+
+	require.NoError(client.Plugins.DeleteForConsumer(defaultCtx, consumer.Username, createdPlugin.ID))
+	_, err = client.Plugins.Get(defaultCtx, createdPlugin.ID)
+	assert.True(IsNotFoundErr(err))
+}
+
+func TestPluginsScopedEndpoints(t *testing.T) {
+	type request struct {
+		method, path string
+	}
+	var (
+		lock     sync.Mutex
+		requests []request
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, request{r.Method, r.URL.Path})
+		lock.Unlock()
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if name, ok := strings.CutPrefix(r.URL.Path, "/schemas/plugins/"); ok {
+			schema, err := BundledPluginSchema(MustNewVersion("3.10.0"), name)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			b, err := json.Marshal(schema)
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "plugin-id", "name": "rate-limiting"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+	plugins := client.Plugins
+
+	_, err = plugins.CreateForConsumer(defaultCtx, String("alice"), &Plugin{Name: String("rate-limiting")})
+	require.NoError(t, err)
+	_, err = plugins.CreateForConsumer(defaultCtx, String("alice"),
+		&Plugin{ID: String("plugin-id"), Name: String("rate-limiting")})
+	require.NoError(t, err)
+	_, err = plugins.UpdateForConsumer(defaultCtx, String("alice"), &Plugin{ID: String("plugin-id")})
+	require.NoError(t, err)
+	require.NoError(t, plugins.DeleteForConsumer(defaultCtx, String("alice"), String("plugin-id")))
+	require.NoError(t, plugins.DeleteForConsumerGroup(defaultCtx, String("gold"), String("plugin-id")))
+
+	// Plugins are checked against their schema before being written.
+	_, err = plugins.CreateForConsumer(defaultCtx, String("alice"), &Plugin{Name: String("key-auth")})
+	assert.EqualError(t, err, `plugin "key-auth" cannot be applied to a consumer`)
+	_, err = plugins.UpdateForRoute(defaultCtx, String("route"),
+		&Plugin{ID: String("plugin-id"), Name: String("key-auth"), Consumer: &Consumer{ID: String("alice")}})
+	assert.EqualError(t, err, `plugin "key-auth" cannot be applied to a consumer`)
+	// Without a schema, the plugin is left to Kong to reject.
+	_, err = plugins.CreateForConsumer(defaultCtx, String("alice"), &Plugin{Name: String("custom")})
+	require.NoError(t, err)
+
+	assert.Equal(t, []request{
+		{http.MethodGet, "/schemas/plugins/rate-limiting"},
+		{http.MethodPost, "/consumers/alice/plugins"},
+		{http.MethodGet, "/schemas/plugins/rate-limiting"},
+		{http.MethodPut, "/consumers/alice/plugins/plugin-id"},
+		{http.MethodPatch, "/consumers/alice/plugins/plugin-id"},
+		{http.MethodDelete, "/consumers/alice/plugins/plugin-id"},
+		{http.MethodDelete, "/consumer_groups/gold/plugins/plugin-id"},
+		{http.MethodGet, "/schemas/plugins/key-auth"},
+		{http.MethodGet, "/schemas/plugins/key-auth"},
+		{http.MethodGet, "/schemas/plugins/custom"},
+		{http.MethodPost, "/consumers/alice/plugins"},
+	}, requests)
+
+	_, err = plugins.CreateForConsumer(defaultCtx, nil, &Plugin{Name: String("rate-limiting")})
+	assert.EqualError(t, err, "consumerUsernameOrID cannot be nil")
+	_, err = plugins.CreateForConsumer(defaultCtx, String("alice"), nil)
+	assert.EqualError(t, err, "plugin cannot be nil")
+	_, err = plugins.UpdateForConsumer(defaultCtx, String("alice"), &Plugin{})
+	assert.EqualError(t, err, "ID cannot be nil for Update operation")
+	err = plugins.DeleteForConsumer(defaultCtx, nil, String("plugin-id"))
+	assert.EqualError(t, err, "consumerUsernameOrID cannot be nil")
+	err = plugins.DeleteForConsumerGroup(defaultCtx, String("gold"), nil)
+	assert.EqualError(t, err, "plugin ID cannot be nil for Delete operation")
+	assert.Len(t, requests, 11)
+}
+
 func comparePlugins(T *testing.T, expected, actual []*Plugin) bool {
 	var expectedNames, actualNames []string
 	for _, plugin := range expected {
@@ -0,0 +1,81 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePluginScope(t *testing.T) {
+	keyAuth := mustBundledPluginSchema(t, "3.10.0", "key-auth")
+	rateLimiting := mustBundledPluginSchema(t, "3.10.0", "rate-limiting")
+
+	tests := []struct {
+		name    string
+		plugin  *Plugin
+		schema  Schema
+		wantErr string
+	}{
+		{
+			name:   "global plugin",
+			plugin: &Plugin{Name: String("key-auth")},
+			schema: keyAuth,
+		},
+		{
+			name:   "route and service",
+			plugin: &Plugin{Name: String("key-auth"), Route: &Route{ID: String("r")}, Service: &Service{ID: String("s")}},
+			schema: keyAuth,
+		},
+		{
+			name:    "no_consumer plugin on a consumer",
+			plugin:  &Plugin{Name: String("key-auth"), Consumer: &Consumer{ID: String("c")}},
+			schema:  keyAuth,
+			wantErr: `plugin "key-auth" cannot be applied to a consumer`,
+		},
+		{
+			name: "route and consumer",
+			plugin: &Plugin{
+				Name:     String("rate-limiting"),
+				Route:    &Route{ID: String("r")},
+				Consumer: &Consumer{ID: String("c")},
+			},
+			schema: rateLimiting,
+		},
+		{
+			name: "service and consumer group",
+			plugin: &Plugin{
+				Name:          String("rate-limiting"),
+				Service:       &Service{ID: String("s")},
+				ConsumerGroup: &ConsumerGroup{ID: String("cg")},
+			},
+			schema: rateLimiting,
+		},
+		{
+			name:    "nil plugin",
+			schema:  keyAuth,
+			wantErr: "plugin cannot be nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePluginScope(tt.plugin, tt.schema)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	schema := Schema{"fields": []interface{}{
+		map[string]interface{}{"service": map[string]interface{}{"type": "foreign", "eq": nil}},
+		map[string]interface{}{"route": map[string]interface{}{"type": "foreign", "eq": nil}},
+	}}
+	err := ValidatePluginScope(&Plugin{
+		Name:    String("foo"),
+		Service: &Service{ID: String("s")},
+		Route:   &Route{ID: String("r")},
+	}, schema)
+	assert.EqualError(t, err, `plugin "foo" cannot be applied to a service or route`)
+}
@@ -0,0 +1,467 @@
+// Command plugingen generates typed configuration structs from plugin
+// schemas, as returned by kong.PluginService.GetFullSchema, or from vault
+// backend schemas, as returned by kong.VaultService.GetFullSchema.
+//
+// Usage:
+//
+//	plugingen -package name [-kind plugin|vault] [-output file] [-registry var] schema.json|dir...
+//
+// The name of each plugin or vault backend is the name of its schema file,
+// without the .json extension. Directories are expanded to the schema files
+// they hold. For every plugin, plugingen generates a <Plugin>Config struct,
+// typed constants for the values of enumerated fields, and conversions from
+// and to kong.Configuration. Vault backends get the same, their names being
+// returned by VaultName instead of PluginName. With -registry, it also
+// generates a map from names to constructors of their configuration, typed
+// with the Config interface the package must declare.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/tidwall/gjson"
+)
+
+// initialisms are the words written upper case in Go names.
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "CA": true, "CORS": true, "DNS": true, "GRPC": true,
+	"HMAC": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true,
+	"JWT": true, "JWKS": true, "OIDC": true, "SNI": true, "SQL": true, "SSL": true,
+	"TCP": true, "TLS": true, "TTL": true, "UDP": true, "URI": true, "URL": true,
+	"ARN": true, "AWS": true, "GCP": true, "HCV": true, "KV": true,
+}
+
+// kind is the kind of entity configured by the generated types.
+type kind struct {
+	// name is used in doc comments, e.g. "plugin".
+	name string
+	// title is used in Go names, e.g. PluginName.
+	title string
+}
+
+var kinds = map[string]kind{
+	"plugin": {name: "plugin", title: "Plugin"},
+	"vault":  {name: "vault", title: "Vault"},
+}
+
+func main() {
+	pkg := flag.String("package", "", "package of the generated code")
+	kindName := flag.String("kind", "plugin", "kind of the schemas: plugin or vault")
+	output := flag.String("output", "", "file to write the generated code to, zz_generated.<package>.go if empty")
+	registry := flag.String("registry", "", "name of the map of configuration constructors to generate, if any")
+	flag.Parse()
+	k, ok := kinds[*kindName]
+	if *pkg == "" || !ok || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *output == "" {
+		*output = "zz_generated." + *pkg + ".go"
+	}
+
+	files, err := schemaFiles(flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(*pkg, *registry, k, files)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// schemaFiles returns the schema files in paths, expanding directories.
+func schemaFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return pluginName(files[i]) < pluginName(files[j])
+	})
+	return files, nil
+}
+
+func pluginName(file string) string {
+	return strings.TrimSuffix(filepath.Base(file), ".json")
+}
+
+type generator struct {
+	b     bytes.Buffer
+	kind  kind
+	types map[string]bool
+}
+
+// generate returns the source of the configuration types of the plugins or
+// vault backends whose schemas are in files.
+func generate(pkg, registry string, k kind, files []string) ([]byte, error) {
+	g := &generator{kind: k, types: map[string]bool{}}
+	var roots []string
+	var names []string
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !gjson.ValidBytes(content) {
+			return nil, fmt.Errorf("%s: invalid JSON", file)
+		}
+		name := pluginName(file)
+		config, ok := recordField(gjson.ParseBytes(content), "config")
+		if !ok {
+			return nil, fmt.Errorf("%s: no config field", file)
+		}
+		root, err := g.entity(name, config)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		roots = append(roots, root)
+		names = append(names, name)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by plugingen. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintln(&b, "import (")
+	fmt.Fprintln(&b, `"encoding/json"`)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, `"github.com/kong/go-kong/kong"`)
+	fmt.Fprintln(&b, ")")
+	fmt.Fprintln(&b)
+	b.Write(g.b.Bytes())
+	if registry != "" {
+		fmt.Fprintf(&b, "// %s maps the names of the %ss to constructors of their configuration.\n", registry, g.kind.name)
+		fmt.Fprintf(&b, "var %s = map[string]func() Config{\n", registry)
+		for i, root := range roots {
+			fmt.Fprintf(&b, "%s%sName: func() Config { return &%s{} },\n", goName(names[i]), g.kind.title, root)
+		}
+		fmt.Fprintln(&b, "}")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.String())
+	}
+	return src, nil
+}
+
+// entity generates the configuration types of the plugin or vault backend
+// and returns the name of its root type.
+func (g *generator) entity(name string, config gjson.Result) (string, error) {
+	prefix := goName(name)
+	root := prefix + "Config"
+	owner := name + " " + g.kind.name
+	nameFunc := g.kind.title + "Name"
+	nameConst := prefix + nameFunc
+	if err := g.declare(nameConst); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(&g.b, "// %s is the name of the %s.\n", nameConst, owner)
+	fmt.Fprintf(&g.b, "const %s = %q\n\n", nameConst, name)
+
+	if err := g.record(root, fmt.Sprintf("%s is the configuration of the %s.", root, owner),
+		owner, "config", config); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(&g.b, "// %s returns the name of the %s.\n", nameFunc, owner)
+	fmt.Fprintf(&g.b, "func (c *%s) %s() string {\nreturn %s\n}\n\n", root, nameFunc, nameConst)
+	fmt.Fprintf(&g.b, "// ToConfiguration converts c to the configuration of a kong.%s.\n", g.kind.title)
+	fmt.Fprintf(&g.b, "func (c *%s) ToConfiguration() (kong.Configuration, error) {\n", root)
+	fmt.Fprintln(&g.b, `b, err := json.Marshal(c)
+if err != nil {
+	return nil, err
+}
+var config kong.Configuration
+if err := json.Unmarshal(b, &config); err != nil {
+	return nil, err
+}
+return config, nil
+}`)
+	fmt.Fprintln(&g.b)
+	fromFunc := root + "FromConfiguration"
+	if err := g.declare(fromFunc); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(&g.b, "// %s converts a configuration of the %s to its typed form.\n", fromFunc, owner)
+	fmt.Fprintln(&g.b, "// Fields unknown to the schema it was generated from are ignored.")
+	fmt.Fprintf(&g.b, "func %s(config kong.Configuration) (*%s, error) {\n", fromFunc, root)
+	fmt.Fprintf(&g.b, `b, err := json.Marshal(config)
+if err != nil {
+	return nil, err
+}
+c := &%s{}
+if err := json.Unmarshal(b, c); err != nil {
+	return nil, err
+}
+return c, nil
+}
+`, root)
+	fmt.Fprintln(&g.b)
+	return root, nil
+}
+
+// field is a field of a record being generated.
+type field struct {
+	name, goName, typ string
+	comment           []string
+}
+
+// record generates the struct typeName for the record schema at path of the
+// owner, e.g. "acl plugin", along with the types of its fields.
+func (g *generator) record(typeName, doc, owner, path string, schema gjson.Result) error {
+	if err := g.declare(typeName); err != nil {
+		return err
+	}
+	var fields []field
+	var pending []func() error
+	seen := map[string]bool{}
+
+	add := func(name string, def gjson.Result, deprecated bool) error {
+		goField := goName(name)
+		if seen[goField] {
+			return fmt.Errorf("%s.%s: duplicate field %s", path, name, goField)
+		}
+		seen[goField] = true
+		typ, more, err := g.fieldType(typeName+goField, owner, path+"."+name, def)
+		if err != nil {
+			return err
+		}
+		if more != nil {
+			pending = append(pending, more)
+		}
+		fields = append(fields, field{
+			name:    name,
+			goName:  goField,
+			typ:     typ,
+			comment: fieldComment(def, deprecated),
+		})
+		return nil
+	}
+	for _, f := range schema.Get("fields").Array() {
+		name, def := singleKey(f)
+		if err := add(name, def, false); err != nil {
+			return err
+		}
+	}
+	for _, f := range schema.Get("shorthand_fields").Array() {
+		name, def := singleKey(f)
+		if err := add(name, def, true); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(&g.b, "// %s\n", doc)
+	fmt.Fprintf(&g.b, "type %s struct {\n", typeName)
+	for _, f := range fields {
+		for _, line := range f.comment {
+			fmt.Fprintf(&g.b, "// %s\n", line)
+		}
+		fmt.Fprintf(&g.b, "%s %s `json:\"%s,omitempty\" yaml:\"%s,omitempty\"`\n", f.goName, f.typ, f.name, f.name)
+	}
+	fmt.Fprintln(&g.b, "}")
+	fmt.Fprintln(&g.b)
+
+	for _, more := range pending {
+		if err := more(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldType returns the Go type of the field at path, and a function
+// generating the types it depends on, if any.
+func (g *generator) fieldType(typeName, owner, path string, def gjson.Result) (string, func() error, error) {
+	switch typ := def.Get("type").String(); typ {
+	case "string":
+		if oneOf := def.Get("one_of"); oneOf.Exists() {
+			return "*" + typeName, func() error { return g.enum(typeName, owner, path, oneOf) }, nil
+		}
+		return "*string", nil, nil
+	case "integer":
+		return "*int", nil, nil
+	case "number":
+		return "*float64", nil, nil
+	case "boolean":
+		return "*bool", nil, nil
+	case "record":
+		doc := fmt.Sprintf("%s is the %s record of the %s.", typeName, path, owner)
+		return "*" + typeName, func() error { return g.record(typeName, doc, owner, path, def) }, nil
+	case "array", "set":
+		elem, more, err := g.elementType(typeName, owner, path, def.Get("elements"))
+		if err != nil {
+			return "", nil, err
+		}
+		return "[]" + elem, more, nil
+	case "map":
+		elem, more, err := g.elementType(typeName+"Value", owner, path, def.Get("values"))
+		if err != nil {
+			return "", nil, err
+		}
+		return "map[string]" + elem, more, nil
+	case "", "json", "foreign", "function":
+		return "interface{}", nil, nil
+	default:
+		return "", nil, fmt.Errorf("%s: unsupported type %q", path, typ)
+	}
+}
+
+// elementType returns the Go type of the elements of an array, set or map.
+func (g *generator) elementType(typeName, owner, path string, def gjson.Result) (string, func() error, error) {
+	typ, more, err := g.fieldType(typeName, owner, path, def)
+	if err != nil {
+		return "", nil, err
+	}
+	return strings.TrimPrefix(typ, "*"), more, nil
+}
+
+// enum generates the string type typeName and a constant for each of the
+// values of the enumerated field at path.
+func (g *generator) enum(typeName, owner, path string, values gjson.Result) error {
+	if err := g.declare(typeName); err != nil {
+		return err
+	}
+	fmt.Fprintf(&g.b, "// %s is a value of %s of the %s.\n", typeName, path, owner)
+	fmt.Fprintf(&g.b, "type %s string\n\n", typeName)
+	fmt.Fprintf(&g.b, "// Values of %s.\n", typeName)
+	fmt.Fprintln(&g.b, "const (")
+	for _, v := range values.Array() {
+		name := typeName + goName(v.String())
+		if err := g.declare(name); err != nil {
+			return err
+		}
+		fmt.Fprintf(&g.b, "%s %s = %q\n", name, typeName, v.String())
+	}
+	fmt.Fprintln(&g.b, ")")
+	fmt.Fprintln(&g.b)
+	fmt.Fprintln(&g.b, "// Ptr returns a pointer to v.")
+	fmt.Fprintf(&g.b, "func (v %s) Ptr() *%s {\nreturn &v\n}\n\n", typeName, typeName)
+	return nil
+}
+
+// declare reserves a name in the generated package.
+func (g *generator) declare(name string) error {
+	if g.types[name] {
+		return fmt.Errorf("name %s generated twice", name)
+	}
+	g.types[name] = true
+	return nil
+}
+
+// fieldComment returns the doc comment of a field.
+func fieldComment(def gjson.Result, deprecated bool) []string {
+	var lines []string
+	if d := def.Get("description"); d.Exists() {
+		lines = append(lines, strings.Join(strings.Fields(d.String()), " "))
+	}
+	var attrs []string
+	if def.Get("required").Bool() {
+		attrs = append(attrs, "Required.")
+	}
+	if d := def.Get("default"); d.Exists() && d.Type != gjson.Null {
+		attrs = append(attrs, "Defaults to "+compactJSON(d.Raw)+".")
+	}
+	if len(attrs) > 0 {
+		lines = append(lines, strings.Join(attrs, " "))
+	}
+	if deprecated {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		msg := "Deprecated: shorthand field."
+		var replacements []string
+		for _, p := range def.Get("deprecation.replaced_with.#.path").Array() {
+			var parts []string
+			for _, part := range p.Array() {
+				parts = append(parts, part.String())
+			}
+			replacements = append(replacements, strings.Join(parts, "."))
+		}
+		if len(replacements) > 0 {
+			msg = "Deprecated: use " + strings.Join(replacements, " and ") + " instead."
+		}
+		lines = append(lines, msg)
+	}
+	return lines
+}
+
+func compactJSON(raw string) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(raw)); err != nil {
+		return raw
+	}
+	return b.String()
+}
+
+// recordField returns the definition of the field called name of record.
+func recordField(record gjson.Result, name string) (gjson.Result, bool) {
+	for _, f := range record.Get("fields").Array() {
+		if n, def := singleKey(f); n == name {
+			return def, true
+		}
+	}
+	return gjson.Result{}, false
+}
+
+// singleKey returns the key and value of a single-key object, the way
+// fields are listed in Lua schemas.
+func singleKey(obj gjson.Result) (string, gjson.Result) {
+	var key string
+	var value gjson.Result
+	obj.ForEach(func(k, v gjson.Result) bool {
+		key, value = k.String(), v
+		return false
+	})
+	return key, value
+}
+
+// goName converts a schema name, such as rate-limiting, redis_host or
+// consumer-group, to an exported Go name.
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, part := range parts {
+		upper := strings.ToUpper(part)
+		if initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit([]rune(s)[0]) {
+		s = "X" + s
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedConfigsAreUpToDate(t *testing.T) {
+	files, err := schemaFiles([]string{"../../../schemas/3.10/plugins"})
+	require.NoError(t, err)
+	src, err := generate("pluginconfig", "configs", kinds["plugin"], files)
+	require.NoError(t, err)
+	current, err := os.ReadFile("../../zz_generated.pluginconfig.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(current), string(src),
+		"plugin configurations are out of date, run go generate ./kong/pluginconfig")
+}
+
+func TestGeneratedVaultConfigsAreUpToDate(t *testing.T) {
+	files, err := schemaFiles([]string{"../../../schemas/3.10/vaults"})
+	require.NoError(t, err)
+	src, err := generate("vaultconfig", "configs", kinds["vault"], files)
+	require.NoError(t, err)
+	current, err := os.ReadFile("../../../vaultconfig/zz_generated.vaultconfig.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(current), string(src),
+		"vault configurations are out of date, run go generate ./kong/vaultconfig")
+}
+
+func TestGenerate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "my-plugin.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"fields": [
+		{"protocols": {"type": "set"}},
+		{"config": {"type": "record", "fields": [
+			{"mode": {"type": "string", "one_of": ["on", "off"], "default": "on", "required": true}},
+			{"upstream_url": {"type": "string", "description": "Where to   send requests."}},
+			{"headers": {"type": "map", "keys": {"type": "string"}, "values": {"type": "string"}}},
+			{"rules": {"type": "array", "elements": {"type": "record", "fields": [
+				{"status": {"type": "integer"}},
+				{"weight": {"type": "number"}}
+			]}}},
+			{"extra": {"type": "json"}}
+		], "shorthand_fields": [
+			{"url": {"type": "string", "deprecation": {"replaced_with": [{"path": ["upstream_url"]}]}}}
+		]}}
+	]}`), 0o600))
+
+	b, err := generate("myplugins", "", kinds["plugin"], []string{file})
+	require.NoError(t, err)
+	// Ignore the alignment of fields.
+	src := strings.Join(strings.Fields(string(b)), " ")
+	for _, want := range []string{
+		"package myplugins",
+		`const MyPluginPluginName = "my-plugin"`,
+		"type MyPluginConfig struct {",
+		"// Required. Defaults to \"on\".\n\tMode *MyPluginConfigMode `json:\"mode,omitempty\"",
+		"// Where to send requests.\n\tUpstreamURL *string",
+		"Headers map[string]string",
+		"Rules []MyPluginConfigRules",
+		"Extra interface{}",
+		"// Deprecated: use upstream_url instead.\n\tURL *string",
+		`MyPluginConfigModeOff MyPluginConfigMode = "off"`,
+		"type MyPluginConfigRules struct {",
+		"Status *int",
+		"Weight *float64",
+		"func MyPluginConfigFromConfiguration(config kong.Configuration) (*MyPluginConfig, error) {",
+	} {
+		assert.Contains(t, src, strings.Join(strings.Fields(want), " "))
+	}
+	assert.NotContains(t, src, "var configs")
+
+	_, err = generate("myplugins", "", kinds["plugin"], []string{file, file})
+	assert.ErrorContains(t, err, "generated twice")
+}
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"rate-limiting":    "RateLimiting",
+		"redis_ssl_verify": "RedisSSLVerify",
+		"consumer-group":   "ConsumerGroup",
+		"uri_param_names":  "URIParamNames",
+		"GET":              "GET",
+		"2xx":              "X2xx",
+	} {
+		assert.Equal(t, want, goName(name), name)
+	}
+}
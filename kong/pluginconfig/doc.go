@@ -0,0 +1,24 @@
+// Package pluginconfig provides typed configurations for the bundled OSS
+// plugins, generated from their schemas in Kong 3.10 by the plugingen
+// command.
+//
+// The configurations convert to and from the untyped kong.Configuration of
+// kong.Plugin, so that misspelled fields are caught at compile time.
+// Enumerated fields have their own types with a constant per value, which
+// don't prevent invalid values: kong.ValidatePlugin catches them at run time.
+//
+//	plugin, err := pluginconfig.NewPlugin(&pluginconfig.RateLimitingConfig{
+//		Minute: kong.Float64(10),
+//		Policy: pluginconfig.RateLimitingConfigPolicyLocal.Ptr(),
+//	})
+//
+// Fields left nil, and empty arrays, are omitted from the converted
+// configurations, Kong filling them with their defaults.
+//
+// Configurations of other plugins, including custom ones, can be generated
+// the same way from the schemas returned by kong.PluginService.GetFullSchema:
+//
+//	go run github.com/kong/go-kong/kong/pluginconfig/cmd/plugingen -package myplugins my-plugin.json
+package pluginconfig
+
+//go:generate go run ./cmd/plugingen -package pluginconfig -registry configs ../schemas/3.10/plugins
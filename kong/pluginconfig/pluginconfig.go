@@ -0,0 +1,67 @@
+package pluginconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kong/go-kong/kong"
+)
+
+// Config is the typed configuration of a plugin.
+type Config interface {
+	// PluginName returns the name of the plugin.
+	PluginName() string
+	// ToConfiguration converts the configuration to the one of a kong.Plugin.
+	ToConfiguration() (kong.Configuration, error)
+}
+
+// NewPlugin returns a plugin named after config and configured with it.
+func NewPlugin(config Config) (*kong.Plugin, error) {
+	c, err := config.ToConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("converting %s configuration: %w", config.PluginName(), err)
+	}
+	return &kong.Plugin{
+		Name:   kong.String(config.PluginName()),
+		Config: c,
+	}, nil
+}
+
+// FromPlugin returns the typed configuration of plugin.
+// The second value is false if plugin isn't one of the bundled OSS plugins
+// the package has configurations for.
+func FromPlugin(plugin *kong.Plugin) (Config, bool, error) {
+	if plugin == nil || plugin.Name == nil {
+		return nil, false, nil
+	}
+	newConfig, ok := configs[*plugin.Name]
+	if !ok {
+		return nil, false, nil
+	}
+	config := newConfig()
+	if err := fromConfiguration(plugin.Config, config); err != nil {
+		return nil, true, fmt.Errorf("converting %s configuration: %w", *plugin.Name, err)
+	}
+	return config, true, nil
+}
+
+// Plugins returns the names of the plugins the package has configurations
+// for.
+func Plugins() []string {
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fromConfiguration decodes config into the typed configuration v.
+func fromConfiguration(config kong.Configuration, v Config) error {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
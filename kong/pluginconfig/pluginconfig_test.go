@@ -0,0 +1,99 @@
+package pluginconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/pluginconfig"
+)
+
+func TestNewPlugin(t *testing.T) {
+	plugin, err := pluginconfig.NewPlugin(&pluginconfig.RateLimitingConfig{
+		Minute: kong.Float64(10),
+		Policy: pluginconfig.RateLimitingConfigPolicyRedis.Ptr(),
+		Redis: &pluginconfig.RateLimitingConfigRedis{
+			Host: kong.String("redis.example.com"),
+			Port: kong.Int(6380),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &kong.Plugin{
+		Name: kong.String("rate-limiting"),
+		Config: kong.Configuration{
+			"minute": float64(10),
+			"policy": "redis",
+			"redis": map[string]interface{}{
+				"host": "redis.example.com",
+				"port": float64(6380),
+			},
+		},
+	}, plugin)
+
+	version := kong.MustNewVersion("3.10.0")
+	entitySchema, err := kong.BundledSchema(version, "plugins")
+	require.NoError(t, err)
+	pluginSchema, err := kong.BundledPluginSchema(version, "rate-limiting")
+	require.NoError(t, err)
+	require.NoError(t, kong.FillPluginsDefaults(plugin, pluginSchema))
+	assert.NoError(t, kong.ValidatePlugin(plugin, entitySchema, pluginSchema))
+}
+
+func TestFromPlugin(t *testing.T) {
+	config, ok, err := pluginconfig.FromPlugin(&kong.Plugin{
+		Name: kong.String("cors"),
+		Config: kong.Configuration{
+			"origins": []interface{}{"https://example.com"},
+			"methods": []interface{}{"GET", "POST"},
+			"max_age": float64(3600),
+			"unknown": true,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, &pluginconfig.CORSConfig{
+		Origins: []string{"https://example.com"},
+		Methods: []pluginconfig.CORSConfigMethods{
+			pluginconfig.CORSConfigMethodsGET,
+			pluginconfig.CORSConfigMethodsPOST,
+		},
+		MaxAge: kong.Float64(3600),
+	}, config)
+
+	_, ok, err = pluginconfig.FromPlugin(&kong.Plugin{Name: kong.String("my-plugin")})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = pluginconfig.FromPlugin(&kong.Plugin{
+		Name:   kong.String("key-auth"),
+		Config: kong.Configuration{"key_names": "apikey"},
+	})
+	assert.True(t, ok)
+	assert.Error(t, err)
+}
+
+func TestConfigsRoundTrip(t *testing.T) {
+	version := kong.MustNewVersion("3.10.0")
+	bundled, err := kong.BundledSchemaPlugins(version)
+	require.NoError(t, err)
+	assert.Equal(t, bundled, pluginconfig.Plugins())
+
+	// The defaults of every plugin convert to its typed configuration and
+	// back, empty arrays being omitted and filled again.
+	for _, name := range pluginconfig.Plugins() {
+		schema, err := kong.BundledPluginSchema(version, name)
+		require.NoError(t, err)
+		plugin := &kong.Plugin{Name: kong.String(name)}
+		require.NoError(t, kong.FillPluginsDefaults(plugin, schema))
+
+		config, ok, err := pluginconfig.FromPlugin(plugin)
+		require.NoError(t, err, name)
+		require.True(t, ok)
+		roundTripped, err := pluginconfig.NewPlugin(config)
+		require.NoError(t, err, name)
+		require.NoError(t, kong.FillPluginsDefaults(roundTripped, schema))
+		assert.Equal(t, plugin.Config, roundTripped.Config, name)
+	}
+}
@@ -0,0 +1,602 @@
+// Code generated by plugingen. DO NOT EDIT.
+
+package pluginconfig
+
+import (
+	"encoding/json"
+
+	"github.com/kong/go-kong/kong"
+)
+
+// ACLPluginName is the name of the acl plugin.
+const ACLPluginName = "acl"
+
+// ACLConfig is the configuration of the acl plugin.
+type ACLConfig struct {
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+	// Required. Defaults to false.
+	HideGroupsHeader *bool `json:"hide_groups_header,omitempty" yaml:"hide_groups_header,omitempty"`
+	// Required. Defaults to false.
+	AlwaysUseAuthenticatedGroups *bool `json:"always_use_authenticated_groups,omitempty" yaml:"always_use_authenticated_groups,omitempty"`
+}
+
+// PluginName returns the name of the acl plugin.
+func (c *ACLConfig) PluginName() string {
+	return ACLPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *ACLConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ACLConfigFromConfiguration converts a configuration of the acl plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func ACLConfigFromConfiguration(config kong.Configuration) (*ACLConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &ACLConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CORSPluginName is the name of the cors plugin.
+const CORSPluginName = "cors"
+
+// CORSConfig is the configuration of the cors plugin.
+type CORSConfig struct {
+	Origins        []string `json:"origins,omitempty" yaml:"origins,omitempty"`
+	Headers        []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	ExposedHeaders []string `json:"exposed_headers,omitempty" yaml:"exposed_headers,omitempty"`
+	// Defaults to ["GET","HEAD","PUT","PATCH","POST","DELETE","OPTIONS","TRACE","CONNECT"].
+	Methods []CORSConfigMethods `json:"methods,omitempty" yaml:"methods,omitempty"`
+	MaxAge  *float64            `json:"max_age,omitempty" yaml:"max_age,omitempty"`
+	// Required. Defaults to false.
+	Credentials *bool `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	// Required. Defaults to false.
+	PrivateNetwork *bool `json:"private_network,omitempty" yaml:"private_network,omitempty"`
+	// Required. Defaults to false.
+	PreflightContinue *bool `json:"preflight_continue,omitempty" yaml:"preflight_continue,omitempty"`
+	// Required. Defaults to true.
+	AllowOriginAbsent *bool `json:"allow_origin_absent,omitempty" yaml:"allow_origin_absent,omitempty"`
+}
+
+// CORSConfigMethods is a value of config.methods of the cors plugin.
+type CORSConfigMethods string
+
+// Values of CORSConfigMethods.
+const (
+	CORSConfigMethodsGET     CORSConfigMethods = "GET"
+	CORSConfigMethodsHEAD    CORSConfigMethods = "HEAD"
+	CORSConfigMethodsPUT     CORSConfigMethods = "PUT"
+	CORSConfigMethodsPATCH   CORSConfigMethods = "PATCH"
+	CORSConfigMethodsPOST    CORSConfigMethods = "POST"
+	CORSConfigMethodsDELETE  CORSConfigMethods = "DELETE"
+	CORSConfigMethodsOPTIONS CORSConfigMethods = "OPTIONS"
+	CORSConfigMethodsTRACE   CORSConfigMethods = "TRACE"
+	CORSConfigMethodsCONNECT CORSConfigMethods = "CONNECT"
+)
+
+// Ptr returns a pointer to v.
+func (v CORSConfigMethods) Ptr() *CORSConfigMethods {
+	return &v
+}
+
+// PluginName returns the name of the cors plugin.
+func (c *CORSConfig) PluginName() string {
+	return CORSPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *CORSConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// CORSConfigFromConfiguration converts a configuration of the cors plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func CORSConfigFromConfiguration(config kong.Configuration) (*CORSConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &CORSConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// JWTPluginName is the name of the jwt plugin.
+const JWTPluginName = "jwt"
+
+// JWTConfig is the configuration of the jwt plugin.
+type JWTConfig struct {
+	// Defaults to ["jwt"].
+	URIParamNames []string `json:"uri_param_names,omitempty" yaml:"uri_param_names,omitempty"`
+	// Defaults to [].
+	CookieNames []string `json:"cookie_names,omitempty" yaml:"cookie_names,omitempty"`
+	// Defaults to "iss".
+	KeyClaimName *string `json:"key_claim_name,omitempty" yaml:"key_claim_name,omitempty"`
+	// Required. Defaults to false.
+	SecretIsBase64 *bool                     `json:"secret_is_base64,omitempty" yaml:"secret_is_base64,omitempty"`
+	ClaimsToVerify []JWTConfigClaimsToVerify `json:"claims_to_verify,omitempty" yaml:"claims_to_verify,omitempty"`
+	Anonymous      *string                   `json:"anonymous,omitempty" yaml:"anonymous,omitempty"`
+	// Required. Defaults to true.
+	RunOnPreflight *bool `json:"run_on_preflight,omitempty" yaml:"run_on_preflight,omitempty"`
+	// Defaults to 0.
+	MaximumExpiration *float64 `json:"maximum_expiration,omitempty" yaml:"maximum_expiration,omitempty"`
+	// Defaults to ["authorization"].
+	HeaderNames []string `json:"header_names,omitempty" yaml:"header_names,omitempty"`
+	Realm       *string  `json:"realm,omitempty" yaml:"realm,omitempty"`
+}
+
+// JWTConfigClaimsToVerify is a value of config.claims_to_verify of the jwt plugin.
+type JWTConfigClaimsToVerify string
+
+// Values of JWTConfigClaimsToVerify.
+const (
+	JWTConfigClaimsToVerifyExp JWTConfigClaimsToVerify = "exp"
+	JWTConfigClaimsToVerifyNbf JWTConfigClaimsToVerify = "nbf"
+)
+
+// Ptr returns a pointer to v.
+func (v JWTConfigClaimsToVerify) Ptr() *JWTConfigClaimsToVerify {
+	return &v
+}
+
+// PluginName returns the name of the jwt plugin.
+func (c *JWTConfig) PluginName() string {
+	return JWTPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *JWTConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// JWTConfigFromConfiguration converts a configuration of the jwt plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func JWTConfigFromConfiguration(config kong.Configuration) (*JWTConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &JWTConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// KeyAuthPluginName is the name of the key-auth plugin.
+const KeyAuthPluginName = "key-auth"
+
+// KeyAuthConfig is the configuration of the key-auth plugin.
+type KeyAuthConfig struct {
+	// Required. Defaults to ["apikey"].
+	KeyNames []string `json:"key_names,omitempty" yaml:"key_names,omitempty"`
+	// Required. Defaults to false.
+	HideCredentials *bool   `json:"hide_credentials,omitempty" yaml:"hide_credentials,omitempty"`
+	Anonymous       *string `json:"anonymous,omitempty" yaml:"anonymous,omitempty"`
+	// Required. Defaults to true.
+	KeyInHeader *bool `json:"key_in_header,omitempty" yaml:"key_in_header,omitempty"`
+	// Required. Defaults to true.
+	KeyInQuery *bool `json:"key_in_query,omitempty" yaml:"key_in_query,omitempty"`
+	// Required. Defaults to false.
+	KeyInBody *bool `json:"key_in_body,omitempty" yaml:"key_in_body,omitempty"`
+	// Required. Defaults to true.
+	RunOnPreflight *bool   `json:"run_on_preflight,omitempty" yaml:"run_on_preflight,omitempty"`
+	Realm          *string `json:"realm,omitempty" yaml:"realm,omitempty"`
+}
+
+// PluginName returns the name of the key-auth plugin.
+func (c *KeyAuthConfig) PluginName() string {
+	return KeyAuthPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *KeyAuthConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// KeyAuthConfigFromConfiguration converts a configuration of the key-auth plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func KeyAuthConfigFromConfiguration(config kong.Configuration) (*KeyAuthConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &KeyAuthConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ProxyCachePluginName is the name of the proxy-cache plugin.
+const ProxyCachePluginName = "proxy-cache"
+
+// ProxyCacheConfig is the configuration of the proxy-cache plugin.
+type ProxyCacheConfig struct {
+	// Required. Defaults to [200,301,404].
+	ResponseCode []int `json:"response_code,omitempty" yaml:"response_code,omitempty"`
+	// Required. Defaults to ["GET","HEAD"].
+	RequestMethod []ProxyCacheConfigRequestMethod `json:"request_method,omitempty" yaml:"request_method,omitempty"`
+	// Required. Defaults to ["text/plain","application/json"].
+	ContentType []string `json:"content_type,omitempty" yaml:"content_type,omitempty"`
+	// Defaults to 300.
+	CacheTTL *int `json:"cache_ttl,omitempty" yaml:"cache_ttl,omitempty"`
+	// Required.
+	Strategy *ProxyCacheConfigStrategy `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	// Required. Defaults to false.
+	CacheControl *bool `json:"cache_control,omitempty" yaml:"cache_control,omitempty"`
+	// Defaults to false.
+	IgnoreURICase *bool `json:"ignore_uri_case,omitempty" yaml:"ignore_uri_case,omitempty"`
+	StorageTTL    *int  `json:"storage_ttl,omitempty" yaml:"storage_ttl,omitempty"`
+	// Required.
+	Memory          *ProxyCacheConfigMemory `json:"memory,omitempty" yaml:"memory,omitempty"`
+	VaryQueryParams []string                `json:"vary_query_params,omitempty" yaml:"vary_query_params,omitempty"`
+	VaryHeaders     []string                `json:"vary_headers,omitempty" yaml:"vary_headers,omitempty"`
+	// Required.
+	ResponseHeaders *ProxyCacheConfigResponseHeaders `json:"response_headers,omitempty" yaml:"response_headers,omitempty"`
+}
+
+// ProxyCacheConfigRequestMethod is a value of config.request_method of the proxy-cache plugin.
+type ProxyCacheConfigRequestMethod string
+
+// Values of ProxyCacheConfigRequestMethod.
+const (
+	ProxyCacheConfigRequestMethodHEAD  ProxyCacheConfigRequestMethod = "HEAD"
+	ProxyCacheConfigRequestMethodGET   ProxyCacheConfigRequestMethod = "GET"
+	ProxyCacheConfigRequestMethodPOST  ProxyCacheConfigRequestMethod = "POST"
+	ProxyCacheConfigRequestMethodPATCH ProxyCacheConfigRequestMethod = "PATCH"
+	ProxyCacheConfigRequestMethodPUT   ProxyCacheConfigRequestMethod = "PUT"
+)
+
+// Ptr returns a pointer to v.
+func (v ProxyCacheConfigRequestMethod) Ptr() *ProxyCacheConfigRequestMethod {
+	return &v
+}
+
+// ProxyCacheConfigStrategy is a value of config.strategy of the proxy-cache plugin.
+type ProxyCacheConfigStrategy string
+
+// Values of ProxyCacheConfigStrategy.
+const (
+	ProxyCacheConfigStrategyMemory ProxyCacheConfigStrategy = "memory"
+)
+
+// Ptr returns a pointer to v.
+func (v ProxyCacheConfigStrategy) Ptr() *ProxyCacheConfigStrategy {
+	return &v
+}
+
+// ProxyCacheConfigMemory is the config.memory record of the proxy-cache plugin.
+type ProxyCacheConfigMemory struct {
+	// Required. Defaults to "kong_db_cache".
+	DictionaryName *string `json:"dictionary_name,omitempty" yaml:"dictionary_name,omitempty"`
+}
+
+// ProxyCacheConfigResponseHeaders is the config.response_headers record of the proxy-cache plugin.
+type ProxyCacheConfigResponseHeaders struct {
+	// Defaults to true.
+	Age *bool `json:"age,omitempty" yaml:"age,omitempty"`
+	// Defaults to true.
+	XCacheStatus *bool `json:"X-Cache-Status,omitempty" yaml:"X-Cache-Status,omitempty"`
+	// Defaults to true.
+	XCacheKey *bool `json:"X-Cache-Key,omitempty" yaml:"X-Cache-Key,omitempty"`
+}
+
+// PluginName returns the name of the proxy-cache plugin.
+func (c *ProxyCacheConfig) PluginName() string {
+	return ProxyCachePluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *ProxyCacheConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ProxyCacheConfigFromConfiguration converts a configuration of the proxy-cache plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func ProxyCacheConfigFromConfiguration(config kong.Configuration) (*ProxyCacheConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &ProxyCacheConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RateLimitingPluginName is the name of the rate-limiting plugin.
+const RateLimitingPluginName = "rate-limiting"
+
+// RateLimitingConfig is the configuration of the rate-limiting plugin.
+type RateLimitingConfig struct {
+	Second *float64 `json:"second,omitempty" yaml:"second,omitempty"`
+	Minute *float64 `json:"minute,omitempty" yaml:"minute,omitempty"`
+	Hour   *float64 `json:"hour,omitempty" yaml:"hour,omitempty"`
+	Day    *float64 `json:"day,omitempty" yaml:"day,omitempty"`
+	Month  *float64 `json:"month,omitempty" yaml:"month,omitempty"`
+	Year   *float64 `json:"year,omitempty" yaml:"year,omitempty"`
+	// Defaults to "consumer".
+	LimitBy    *RateLimitingConfigLimitBy `json:"limit_by,omitempty" yaml:"limit_by,omitempty"`
+	HeaderName *string                    `json:"header_name,omitempty" yaml:"header_name,omitempty"`
+	Path       *string                    `json:"path,omitempty" yaml:"path,omitempty"`
+	// Defaults to "local".
+	Policy *RateLimitingConfigPolicy `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// Required. Defaults to true.
+	FaultTolerant *bool `json:"fault_tolerant,omitempty" yaml:"fault_tolerant,omitempty"`
+	// Required.
+	Redis *RateLimitingConfigRedis `json:"redis,omitempty" yaml:"redis,omitempty"`
+	// Required. Defaults to false.
+	HideClientHeaders *bool `json:"hide_client_headers,omitempty" yaml:"hide_client_headers,omitempty"`
+	// Defaults to 429.
+	ErrorCode *float64 `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+	// Defaults to "API rate limit exceeded".
+	ErrorMessage *string `json:"error_message,omitempty" yaml:"error_message,omitempty"`
+	// Required. Defaults to -1.
+	SyncRate *float64 `json:"sync_rate,omitempty" yaml:"sync_rate,omitempty"`
+	// Deprecated: use redis.host instead.
+	RedisHost *string `json:"redis_host,omitempty" yaml:"redis_host,omitempty"`
+	// Deprecated: use redis.port instead.
+	RedisPort *int `json:"redis_port,omitempty" yaml:"redis_port,omitempty"`
+	// Deprecated: use redis.password instead.
+	RedisPassword *string `json:"redis_password,omitempty" yaml:"redis_password,omitempty"`
+	// Deprecated: use redis.username instead.
+	RedisUsername *string `json:"redis_username,omitempty" yaml:"redis_username,omitempty"`
+	// Deprecated: use redis.ssl instead.
+	RedisSSL *bool `json:"redis_ssl,omitempty" yaml:"redis_ssl,omitempty"`
+	// Deprecated: use redis.ssl_verify instead.
+	RedisSSLVerify *bool `json:"redis_ssl_verify,omitempty" yaml:"redis_ssl_verify,omitempty"`
+	// Deprecated: use redis.server_name instead.
+	RedisServerName *string `json:"redis_server_name,omitempty" yaml:"redis_server_name,omitempty"`
+	// Deprecated: use redis.timeout instead.
+	RedisTimeout *int `json:"redis_timeout,omitempty" yaml:"redis_timeout,omitempty"`
+	// Deprecated: use redis.database instead.
+	RedisDatabase *int `json:"redis_database,omitempty" yaml:"redis_database,omitempty"`
+}
+
+// RateLimitingConfigLimitBy is a value of config.limit_by of the rate-limiting plugin.
+type RateLimitingConfigLimitBy string
+
+// Values of RateLimitingConfigLimitBy.
+const (
+	RateLimitingConfigLimitByConsumer      RateLimitingConfigLimitBy = "consumer"
+	RateLimitingConfigLimitByCredential    RateLimitingConfigLimitBy = "credential"
+	RateLimitingConfigLimitByIP            RateLimitingConfigLimitBy = "ip"
+	RateLimitingConfigLimitByService       RateLimitingConfigLimitBy = "service"
+	RateLimitingConfigLimitByHeader        RateLimitingConfigLimitBy = "header"
+	RateLimitingConfigLimitByPath          RateLimitingConfigLimitBy = "path"
+	RateLimitingConfigLimitByConsumerGroup RateLimitingConfigLimitBy = "consumer-group"
+)
+
+// Ptr returns a pointer to v.
+func (v RateLimitingConfigLimitBy) Ptr() *RateLimitingConfigLimitBy {
+	return &v
+}
+
+// RateLimitingConfigPolicy is a value of config.policy of the rate-limiting plugin.
+type RateLimitingConfigPolicy string
+
+// Values of RateLimitingConfigPolicy.
+const (
+	RateLimitingConfigPolicyLocal   RateLimitingConfigPolicy = "local"
+	RateLimitingConfigPolicyCluster RateLimitingConfigPolicy = "cluster"
+	RateLimitingConfigPolicyRedis   RateLimitingConfigPolicy = "redis"
+)
+
+// Ptr returns a pointer to v.
+func (v RateLimitingConfigPolicy) Ptr() *RateLimitingConfigPolicy {
+	return &v
+}
+
+// RateLimitingConfigRedis is the config.redis record of the rate-limiting plugin.
+type RateLimitingConfigRedis struct {
+	Host *string `json:"host,omitempty" yaml:"host,omitempty"`
+	// Defaults to 6379.
+	Port *int `json:"port,omitempty" yaml:"port,omitempty"`
+	// Defaults to 2000.
+	Timeout  *int    `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	// Defaults to 0.
+	Database *int `json:"database,omitempty" yaml:"database,omitempty"`
+	// Defaults to false.
+	SSL *bool `json:"ssl,omitempty" yaml:"ssl,omitempty"`
+	// Defaults to false.
+	SSLVerify  *bool   `json:"ssl_verify,omitempty" yaml:"ssl_verify,omitempty"`
+	ServerName *string `json:"server_name,omitempty" yaml:"server_name,omitempty"`
+}
+
+// PluginName returns the name of the rate-limiting plugin.
+func (c *RateLimitingConfig) PluginName() string {
+	return RateLimitingPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *RateLimitingConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// RateLimitingConfigFromConfiguration converts a configuration of the rate-limiting plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func RateLimitingConfigFromConfiguration(config kong.Configuration) (*RateLimitingConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &RateLimitingConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RequestTransformerPluginName is the name of the request-transformer plugin.
+const RequestTransformerPluginName = "request-transformer"
+
+// RequestTransformerConfig is the configuration of the request-transformer plugin.
+type RequestTransformerConfig struct {
+	HTTPMethod *string `json:"http_method,omitempty" yaml:"http_method,omitempty"`
+	// Required.
+	Remove *RequestTransformerConfigRemove `json:"remove,omitempty" yaml:"remove,omitempty"`
+	// Required.
+	Rename *RequestTransformerConfigRename `json:"rename,omitempty" yaml:"rename,omitempty"`
+	// Required.
+	Replace *RequestTransformerConfigReplace `json:"replace,omitempty" yaml:"replace,omitempty"`
+	// Required.
+	Add *RequestTransformerConfigAdd `json:"add,omitempty" yaml:"add,omitempty"`
+	// Required.
+	Append *RequestTransformerConfigAppend `json:"append,omitempty" yaml:"append,omitempty"`
+}
+
+// RequestTransformerConfigRemove is the config.remove record of the request-transformer plugin.
+type RequestTransformerConfigRemove struct {
+	// Required. Defaults to [].
+	Body []string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Required. Defaults to [].
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Required. Defaults to [].
+	Querystring []string `json:"querystring,omitempty" yaml:"querystring,omitempty"`
+}
+
+// RequestTransformerConfigRename is the config.rename record of the request-transformer plugin.
+type RequestTransformerConfigRename struct {
+	// Required. Defaults to [].
+	Body []string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Required. Defaults to [].
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Required. Defaults to [].
+	Querystring []string `json:"querystring,omitempty" yaml:"querystring,omitempty"`
+}
+
+// RequestTransformerConfigReplace is the config.replace record of the request-transformer plugin.
+type RequestTransformerConfigReplace struct {
+	// Required. Defaults to [].
+	Body []string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Required. Defaults to [].
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Required. Defaults to [].
+	Querystring []string `json:"querystring,omitempty" yaml:"querystring,omitempty"`
+	URI         *string  `json:"uri,omitempty" yaml:"uri,omitempty"`
+}
+
+// RequestTransformerConfigAdd is the config.add record of the request-transformer plugin.
+type RequestTransformerConfigAdd struct {
+	// Required. Defaults to [].
+	Body []string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Required. Defaults to [].
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Required. Defaults to [].
+	Querystring []string `json:"querystring,omitempty" yaml:"querystring,omitempty"`
+}
+
+// RequestTransformerConfigAppend is the config.append record of the request-transformer plugin.
+type RequestTransformerConfigAppend struct {
+	// Required. Defaults to [].
+	Body []string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Required. Defaults to [].
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Required. Defaults to [].
+	Querystring []string `json:"querystring,omitempty" yaml:"querystring,omitempty"`
+}
+
+// PluginName returns the name of the request-transformer plugin.
+func (c *RequestTransformerConfig) PluginName() string {
+	return RequestTransformerPluginName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Plugin.
+func (c *RequestTransformerConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// RequestTransformerConfigFromConfiguration converts a configuration of the request-transformer plugin to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func RequestTransformerConfigFromConfiguration(config kong.Configuration) (*RequestTransformerConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &RequestTransformerConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// configs maps the names of the plugins to constructors of their configuration.
+var configs = map[string]func() Config{
+	ACLPluginName:                func() Config { return &ACLConfig{} },
+	CORSPluginName:               func() Config { return &CORSConfig{} },
+	JWTPluginName:                func() Config { return &JWTConfig{} },
+	KeyAuthPluginName:            func() Config { return &KeyAuthConfig{} },
+	ProxyCachePluginName:         func() Config { return &ProxyCacheConfig{} },
+	RateLimitingPluginName:       func() Config { return &RateLimitingConfig{} },
+	RequestTransformerPluginName: func() Config { return &RequestTransformerConfig{} },
+}
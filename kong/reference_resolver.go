@@ -0,0 +1,894 @@
+package kong
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ReferenceMode selects the form foreign references are normalized to by
+// ReferenceResolver.
+type ReferenceMode int
+
+const (
+	// ReferenceByID normalizes references to the ID of the referenced entity.
+	ReferenceByID ReferenceMode = iota
+	// ReferenceByName normalizes references to the endpoint key of the
+	// referenced entity (the name, or the username for consumers).
+	// Entities without an endpoint key, like certificates, are referenced by ID.
+	ReferenceByName
+)
+
+// ResolvedReference identifies an entity a reference was resolved to.
+type ResolvedReference struct {
+	Type EntityType
+	ID   *string
+	Name *string
+}
+
+// DanglingReferenceError is returned when a reference points to an entity
+// which exists neither in the local entity set nor in Kong.
+type DanglingReferenceError struct {
+	// Entity identifies the entity holding the reference, e.g. "routes/my-route".
+	Entity string
+	// Field is the field holding the reference, e.g. "service".
+	Field string
+	// Type is the type of the referenced entity.
+	Type EntityType
+	// Reference is the name or ID used in the reference.
+	Reference string
+}
+
+func (e *DanglingReferenceError) Error() string {
+	return fmt.Sprintf("%s: %s: %s %q not found", e.Entity, e.Field, e.Type, e.Reference)
+}
+
+// AmbiguousReferenceError is returned when a reference matches more than one
+// entity, e.g. when two entities share a name or when the ID and the name of
+// a reference point to different entities.
+type AmbiguousReferenceError struct {
+	// Entity identifies the entity holding the reference, e.g. "routes/my-route".
+	Entity string
+	// Field is the field holding the reference, e.g. "service".
+	Field string
+	// Type is the type of the referenced entity.
+	Type EntityType
+	// Reference is the name or ID used in the reference.
+	Reference string
+	// Candidates identifies the entities matching the reference.
+	Candidates []string
+}
+
+func (e *AmbiguousReferenceError) Error() string {
+	return fmt.Sprintf("%s: %s: %s %q is ambiguous, it matches %s",
+		e.Entity, e.Field, e.Type, e.Reference, strings.Join(e.Candidates, ", "))
+}
+
+// ReferenceCycleError is returned when entities reference each other in a cycle.
+type ReferenceCycleError struct {
+	// Cycle holds the entities forming the cycle, the first entity being
+	// repeated at the end.
+	Cycle []string
+}
+
+func (e *ReferenceCycleError) Error() string {
+	return "reference cycle: " + strings.Join(e.Cycle, " -> ")
+}
+
+// ReferenceResolver resolves foreign references between entities, like
+// Route.Service or Plugin.Consumer, which may hold either the name or the ID
+// of the referenced entity.
+// References are looked up in a local entity set first and then, when a
+// Client is configured, in Kong.
+type ReferenceResolver struct {
+	client *Client
+
+	entities []*indexedEntity
+	byID     map[EntityType]map[string][]*indexedEntity
+	byName   map[EntityType]map[string][]*indexedEntity
+	byValue  map[interface{}]*indexedEntity
+
+	remote map[string]*ResolvedReference
+}
+
+type indexedEntity struct {
+	typ   EntityType
+	id    *string
+	name  *string
+	label string
+}
+
+// matches returns false if id or name is set and differs from the ID or the
+// name of the entity.
+func (e *indexedEntity) matches(id, name *string) bool {
+	if id != nil && (e.id == nil || *e.id != *id) {
+		return false
+	}
+	if name != nil && (e.name == nil || *e.name != *name) {
+		return false
+	}
+	return true
+}
+
+func (e *indexedEntity) reference() *ResolvedReference {
+	return &ResolvedReference{Type: e.typ, ID: e.id, Name: e.name}
+}
+
+// NewReferenceResolver returns a ReferenceResolver looking up references in
+// entities and in Kong using client. Both are optional: a nil entities only
+// uses Kong, a nil client only uses the local entity set.
+// Entities nested in entities, like routes nested under services, are part
+// of the local entity set too.
+func NewReferenceResolver(client *Client, entities *DeclarativeContent) *ReferenceResolver {
+	r := &ReferenceResolver{
+		client:  client,
+		byID:    map[EntityType]map[string][]*indexedEntity{},
+		byName:  map[EntityType]map[string][]*indexedEntity{},
+		byValue: map[interface{}]*indexedEntity{},
+		remote:  map[string]*ResolvedReference{},
+	}
+	if entities == nil {
+		return r
+	}
+
+	for _, s := range entities.Services {
+		if s == nil || s.Service == nil {
+			continue
+		}
+		r.index(s.Service, EntityTypeServices, s.ID, s.Name)
+		for _, route := range s.Routes {
+			if route != nil && route.Route != nil {
+				r.index(route.Route, EntityTypeRoutes, route.ID, route.Name)
+			}
+		}
+	}
+	for _, route := range entities.Routes {
+		if route != nil && route.Route != nil {
+			r.index(route.Route, EntityTypeRoutes, route.ID, route.Name)
+		}
+	}
+	for _, c := range entities.Consumers {
+		if c != nil && c.Consumer != nil {
+			r.index(c.Consumer, EntityTypeConsumers, c.ID, c.Username)
+		}
+	}
+	for _, cg := range entities.ConsumerGroups {
+		if cg != nil && cg.ConsumerGroup != nil {
+			r.index(cg.ConsumerGroup, EntityTypeConsumerGroups, cg.ID, cg.Name)
+		}
+	}
+	for _, u := range entities.Upstreams {
+		if u != nil && u.Upstream != nil {
+			r.index(u.Upstream, EntityTypeUpstreams, u.ID, u.Name)
+		}
+	}
+	for _, c := range entities.Certificates {
+		if c != nil && c.Certificate != nil {
+			r.index(c.Certificate, EntityTypeCertificates, c.ID, nil)
+		}
+	}
+	for _, c := range entities.CACertificates {
+		if c != nil {
+			r.index(c, EntityTypeCACertificates, c.ID, nil)
+		}
+	}
+	for _, ks := range entities.KeySets {
+		if ks != nil {
+			r.index(ks, EntityTypeKeySets, ks.ID, ks.Name)
+		}
+	}
+	for _, p := range entities.Partials {
+		if p != nil {
+			r.index(p, EntityTypePartials, p.ID, p.Name)
+		}
+	}
+	return r
+}
+
+func (r *ReferenceResolver) index(value interface{}, typ EntityType, id, name *string) {
+	e := &indexedEntity{typ: typ, id: nonEmpty(id), name: nonEmpty(name)}
+	switch {
+	case e.name != nil:
+		e.label = string(typ) + "/" + *e.name
+	case e.id != nil:
+		e.label = string(typ) + "/" + *e.id
+	default:
+		e.label = fmt.Sprintf("%s/#%d", typ, len(r.entities))
+	}
+	r.entities = append(r.entities, e)
+	r.byValue[value] = e
+
+	if e.id != nil {
+		if r.byID[typ] == nil {
+			r.byID[typ] = map[string][]*indexedEntity{}
+		}
+		r.byID[typ][*e.id] = append(r.byID[typ][*e.id], e)
+	}
+	if e.name != nil {
+		if r.byName[typ] == nil {
+			r.byName[typ] = map[string][]*indexedEntity{}
+		}
+		r.byName[typ][*e.name] = append(r.byName[typ][*e.name], e)
+	}
+}
+
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+// Resolve looks up the entity of type typ whose ID or endpoint key is
+// nameOrID. It returns a *DanglingReferenceError when no such entity exists
+// and an *AmbiguousReferenceError when nameOrID matches the ID of an entity
+// and the endpoint key of another one.
+func (r *ReferenceResolver) Resolve(ctx context.Context, typ EntityType, nameOrID string) (*ResolvedReference, error) {
+	ref := &foreignRef{field: string(typ), typ: typ, id: &nameOrID, name: &nameOrID}
+	return r.resolve(ctx, string(typ), ref, true)
+}
+
+// ResolveReferences normalizes in place the foreign references of entity,
+// which must be a pointer to a Kong entity like *Route or *Plugin, to the
+// form selected by mode. The referenced entities are replaced by stubs only
+// holding their ID or their endpoint key.
+func (r *ReferenceResolver) ResolveReferences(ctx context.Context, entity interface{}, mode ReferenceMode) error {
+	label, refs, err := entityReferences(entity)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, ref := range refs {
+		if _, err := r.normalize(ctx, label, ref, mode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ResolveContent normalizes in place the foreign references of all entities
+// of content, including nested ones, to the form selected by mode.
+// All dangling and ambiguous references are reported, joined in the returned
+// error. When all references can be resolved, a *ReferenceCycleError is
+// returned if entities of the local entity set reference each other in a cycle.
+func (r *ReferenceResolver) ResolveContent(
+	ctx context.Context, content *DeclarativeContent, mode ReferenceMode,
+) error {
+	if content == nil {
+		return fmt.Errorf("declarative content is nil")
+	}
+
+	edges := map[*indexedEntity][]*indexedEntity{}
+	var errs []error
+	resolve := func(entity interface{}) {
+		label, refs, err := entityReferences(entity)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		from := r.byValue[entity]
+		for _, ref := range refs {
+			to, err := r.normalize(ctx, label, ref, mode)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if from != nil && to != nil {
+				edges[from] = append(edges[from], to)
+			}
+		}
+	}
+
+	for _, entity := range declarativeEntities(content) {
+		resolve(entity)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return r.checkCycles(edges)
+}
+
+// normalize resolves ref and rewrites it according to mode. It returns the
+// local entity the reference was resolved to, if any.
+func (r *ReferenceResolver) normalize(
+	ctx context.Context, label string, ref *foreignRef, mode ReferenceMode,
+) (*indexedEntity, error) {
+	resolved, err := r.resolve(ctx, label, ref, false)
+	if err != nil {
+		return nil, err
+	}
+
+	id, name := nonEmpty(resolved.ID), nonEmpty(resolved.Name)
+	if mode == ReferenceByName && name != nil && ref.hasName {
+		ref.set(nil, String(*name))
+	} else {
+		if id == nil {
+			return nil, fmt.Errorf("%s: %s: %s %q has no ID, IDs can be filled with FillIDs",
+				label, ref.field, ref.typ, ref.String())
+		}
+		ref.set(String(*id), nil)
+	}
+	return r.localEntity(resolved), nil
+}
+
+func (r *ReferenceResolver) localEntity(resolved *ResolvedReference) *indexedEntity {
+	if resolved.ID != nil {
+		if matches := r.byID[resolved.Type][*resolved.ID]; len(matches) == 1 {
+			return matches[0]
+		}
+	}
+	if resolved.Name != nil {
+		if matches := r.byName[resolved.Type][*resolved.Name]; len(matches) == 1 {
+			return matches[0]
+		}
+	}
+	return nil
+}
+
+// resolve looks ref up in the local entity set and then in Kong.
+// When either is true, ref.id and ref.name hold the same value, which may be
+// a name or an ID.
+func (r *ReferenceResolver) resolve(
+	ctx context.Context, label string, ref *foreignRef, either bool,
+) (*ResolvedReference, error) {
+	id, name := nonEmpty(ref.id), nonEmpty(ref.name)
+	if id == nil && name == nil {
+		return nil, fmt.Errorf("%s: %s: reference has neither an ID nor a name", label, ref.field)
+	}
+
+	var matches []*indexedEntity
+	seen := map[*indexedEntity]bool{}
+	add := func(candidates []*indexedEntity) {
+		for _, c := range candidates {
+			if !seen[c] {
+				seen[c] = true
+				matches = append(matches, c)
+			}
+		}
+	}
+	if id != nil {
+		add(r.byID[ref.typ][*id])
+	}
+	if name != nil {
+		add(r.byName[ref.typ][*name])
+	}
+
+	switch {
+	case len(matches) == 1 && (either || matches[0].matches(id, name)):
+		return matches[0].reference(), nil
+	case len(matches) > 0:
+		// Either several entities match, or the ID and the name of the
+		// reference don't belong to the same entity.
+		candidates := make([]string, 0, len(matches))
+		for _, m := range matches {
+			candidates = append(candidates, m.label)
+		}
+		return nil, &AmbiguousReferenceError{
+			Entity:     label,
+			Field:      ref.field,
+			Type:       ref.typ,
+			Reference:  ref.String(),
+			Candidates: candidates,
+		}
+	}
+
+	if r.client != nil {
+		key := name
+		if id != nil {
+			key = id
+		}
+		resolved, err := r.fetch(ctx, ref.typ, *key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", label, ref.field, err)
+		}
+		if resolved != nil {
+			if !either && id != nil && name != nil && (resolved.Name == nil || *resolved.Name != *name) {
+				return nil, &AmbiguousReferenceError{
+					Entity:     label,
+					Field:      ref.field,
+					Type:       ref.typ,
+					Reference:  ref.String(),
+					Candidates: []string{string(ref.typ) + "/" + *resolved.ID},
+				}
+			}
+			return resolved, nil
+		}
+	}
+
+	return nil, &DanglingReferenceError{
+		Entity:    label,
+		Field:     ref.field,
+		Type:      ref.typ,
+		Reference: ref.String(),
+	}
+}
+
+// fetch looks up an entity in Kong. It returns nil when the entity doesn't exist.
+func (r *ReferenceResolver) fetch(ctx context.Context, typ EntityType, key string) (*ResolvedReference, error) {
+	cacheKey := string(typ) + "/" + key
+	if resolved, ok := r.remote[cacheKey]; ok {
+		return resolved, nil
+	}
+
+	var id, name *string
+	var err error
+	switch typ {
+	case EntityTypeServices:
+		var s *Service
+		if s, err = r.client.Services.Get(ctx, &key); err == nil {
+			id, name = s.ID, s.Name
+		}
+	case EntityTypeRoutes:
+		var route *Route
+		if route, err = r.client.Routes.Get(ctx, &key); err == nil {
+			id, name = route.ID, route.Name
+		}
+	case EntityTypeConsumers:
+		var c *Consumer
+		if c, err = r.client.Consumers.Get(ctx, &key); err == nil {
+			id, name = c.ID, c.Username
+		}
+	case EntityTypeConsumerGroups:
+		var cg *ConsumerGroupObject
+		if cg, err = r.client.ConsumerGroups.Get(ctx, &key); err == nil && cg.ConsumerGroup != nil {
+			id, name = cg.ConsumerGroup.ID, cg.ConsumerGroup.Name
+		}
+	case EntityTypeUpstreams:
+		var u *Upstream
+		if u, err = r.client.Upstreams.Get(ctx, &key); err == nil {
+			id, name = u.ID, u.Name
+		}
+	case EntityTypeCertificates:
+		var c *Certificate
+		if c, err = r.client.Certificates.Get(ctx, &key); err == nil {
+			id = c.ID
+		}
+	case EntityTypeCACertificates:
+		var c *CACertificate
+		if c, err = r.client.CACertificates.Get(ctx, &key); err == nil {
+			id = c.ID
+		}
+	case EntityTypeKeySets:
+		var ks *KeySet
+		if ks, err = r.client.KeySets.Get(ctx, &key); err == nil {
+			id, name = ks.ID, ks.Name
+		}
+	case EntityTypePartials:
+		var p *Partial
+		if p, err = r.client.Partials.Get(ctx, &key); err == nil {
+			id, name = p.ID, p.Name
+		}
+	default:
+		return nil, fmt.Errorf("unsupported reference type %q", typ)
+	}
+	if err != nil {
+		if IsNotFoundErr(err) {
+			r.remote[cacheKey] = nil
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching %s %q: %w", typ, key, err)
+	}
+
+	var resolved *ResolvedReference
+	if id != nil {
+		resolved = &ResolvedReference{Type: typ, ID: id, Name: nonEmpty(name)}
+	}
+	r.remote[cacheKey] = resolved
+	return resolved, nil
+}
+
+// checkCycles returns a *ReferenceCycleError if edges contain a cycle.
+func (r *ReferenceResolver) checkCycles(edges map[*indexedEntity][]*indexedEntity) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[*indexedEntity]int{}
+	var stack []*indexedEntity
+
+	var visit func(e *indexedEntity) error
+	visit = func(e *indexedEntity) error {
+		state[e] = visiting
+		stack = append(stack, e)
+		for _, next := range edges[e] {
+			switch state[next] {
+			case visiting:
+				var cycle []string
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						for _, s := range stack[i:] {
+							cycle = append(cycle, s.label)
+						}
+						break
+					}
+				}
+				return &ReferenceCycleError{Cycle: append(cycle, next.label)}
+			case 0:
+				if err := visit(next); err != nil {
+					return err
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[e] = visited
+		return nil
+	}
+
+	for _, e := range r.entities {
+		if state[e] == 0 {
+			if err := visit(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// foreignRef is a reference held by an entity to another entity.
+type foreignRef struct {
+	field string
+	typ   EntityType
+	id    *string
+	name  *string
+	// hasName is true when the referenced entity type has an endpoint key.
+	hasName bool
+	// set replaces the reference with a stub holding only id or name.
+	set func(id, name *string)
+}
+
+func (ref *foreignRef) String() string {
+	id, name := nonEmpty(ref.id), nonEmpty(ref.name)
+	switch {
+	case id != nil && name != nil && *id != *name:
+		return *id + " / " + *name
+	case id != nil:
+		return *id
+	case name != nil:
+		return *name
+	}
+	return ""
+}
+
+func serviceRef(field string, s **Service) *foreignRef {
+	if *s == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeServices, id: (*s).ID, name: (*s).Name, hasName: true,
+		set: func(id, name *string) { *s = &Service{ID: id, Name: name} },
+	}
+}
+
+func routeRef(field string, r **Route) *foreignRef {
+	if *r == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeRoutes, id: (*r).ID, name: (*r).Name, hasName: true,
+		set: func(id, name *string) { *r = &Route{ID: id, Name: name} },
+	}
+}
+
+func consumerRef(field string, c **Consumer) *foreignRef {
+	if *c == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeConsumers, id: (*c).ID, name: (*c).Username, hasName: true,
+		set: func(id, name *string) { *c = &Consumer{ID: id, Username: name} },
+	}
+}
+
+func consumerGroupRef(field string, cg **ConsumerGroup) *foreignRef {
+	if *cg == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeConsumerGroups, id: (*cg).ID, name: (*cg).Name, hasName: true,
+		set: func(id, name *string) { *cg = &ConsumerGroup{ID: id, Name: name} },
+	}
+}
+
+func upstreamRef(field string, u **Upstream) *foreignRef {
+	if *u == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeUpstreams, id: (*u).ID, name: (*u).Name, hasName: true,
+		set: func(id, name *string) { *u = &Upstream{ID: id, Name: name} },
+	}
+}
+
+func certificateRef(field string, c **Certificate) *foreignRef {
+	if *c == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeCertificates, id: (*c).ID,
+		set: func(id, _ *string) { *c = &Certificate{ID: id} },
+	}
+}
+
+func caCertificateRef(field string, c **CACertificate) *foreignRef {
+	if *c == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeCACertificates, id: (*c).ID,
+		set: func(id, _ *string) { *c = &CACertificate{ID: id} },
+	}
+}
+
+func keySetRef(field string, ks **KeySet) *foreignRef {
+	if *ks == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypeKeySets, id: (*ks).ID, name: (*ks).Name, hasName: true,
+		set: func(id, name *string) { *ks = &KeySet{ID: id, Name: name} },
+	}
+}
+
+func partialRef(field string, p **Partial) *foreignRef {
+	if *p == nil {
+		return nil
+	}
+	return &foreignRef{
+		field: field, typ: EntityTypePartials, id: (*p).ID, name: (*p).Name, hasName: true,
+		set: func(id, name *string) { *p = &Partial{ID: id, Name: name} },
+	}
+}
+
+// entityReferences returns a label identifying entity and the foreign
+// references it holds.
+func entityReferences(entity interface{}) (string, []*foreignRef, error) {
+	if v := reflect.ValueOf(entity); v.Kind() != reflect.Ptr || v.IsNil() {
+		return "", nil, fmt.Errorf("entity must be a non-nil pointer to a Kong entity, got '%T'", entity)
+	}
+
+	var (
+		label string
+		refs  []*foreignRef
+	)
+	switch e := entity.(type) {
+	case *Service:
+		label = "services/" + e.FriendlyName()
+		refs = append(refs, certificateRef("client_certificate", &e.ClientCertificate))
+	case *Route:
+		label = "routes/" + e.FriendlyName()
+		refs = append(refs, serviceRef("service", &e.Service))
+	case *Consumer:
+		label = "consumers/" + e.FriendlyName()
+	case *ConsumerGroup:
+		label = "consumer_groups/" + e.FriendlyName()
+	case *Upstream:
+		label = "upstreams/" + e.FriendlyName()
+		refs = append(refs, certificateRef("client_certificate", &e.ClientCertificate))
+	case *Target:
+		label = "targets/" + e.FriendlyName()
+		refs = append(refs, upstreamRef("upstream", &e.Upstream))
+	case *Certificate:
+		label = "certificates/" + stringOrEmpty(e.ID)
+	case *CACertificate:
+		label = "ca_certificates/" + stringOrEmpty(e.ID)
+	case *SNI:
+		label = "snis/" + e.FriendlyName()
+		refs = append(refs, certificateRef("certificate", &e.Certificate))
+	case *Vault:
+		label = "vaults/" + e.FriendlyName()
+	case *Key:
+		label = "keys/" + stringOrEmpty(e.KID)
+		refs = append(refs, keySetRef("set", &e.Set))
+	case *KeySet:
+		label = "key_sets/" + stringOrEmpty(e.Name)
+	case *Partial:
+		label = "partials/" + e.FriendlyName()
+	case *FilterChain:
+		label = "filter_chains/" + e.FriendlyName()
+		refs = append(refs, serviceRef("service", &e.Service), routeRef("route", &e.Route))
+	case *Plugin:
+		label = "plugins/" + e.FriendlyName()
+		refs = append(refs,
+			serviceRef("service", &e.Service),
+			routeRef("route", &e.Route),
+			consumerRef("consumer", &e.Consumer),
+			consumerGroupRef("consumer_group", &e.ConsumerGroup),
+		)
+		for i, link := range e.Partials {
+			if link != nil {
+				refs = append(refs, partialRef(fmt.Sprintf("partials[%d]", i), &link.Partial))
+			}
+		}
+	case *KeyAuth:
+		label = "keyauth_credentials/" + stringOrEmpty(e.ID)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	case *BasicAuth:
+		label = "basicauth_credentials/" + stringOrEmpty(e.Username)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	case *HMACAuth:
+		label = "hmacauth_credentials/" + stringOrEmpty(e.Username)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	case *JWTAuth:
+		label = "jwt_secrets/" + stringOrEmpty(e.Key)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	case *MTLSAuth:
+		label = "mtls_auth_credentials/" + stringOrEmpty(e.ID)
+		refs = append(refs,
+			consumerRef("consumer", &e.Consumer),
+			caCertificateRef("ca_certificate", &e.CACertificate),
+		)
+	case *ACLGroup:
+		label = "acls/" + stringOrEmpty(e.Group)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	case *Oauth2Credential:
+		label = "oauth2_credentials/" + stringOrEmpty(e.ClientID)
+		refs = append(refs, consumerRef("consumer", &e.Consumer))
+	default:
+		return "", nil, fmt.Errorf("unsupported entity type: '%T'", entity)
+	}
+
+	nonNil := refs[:0]
+	for _, ref := range refs {
+		if ref != nil {
+			nonNil = append(nonNil, ref)
+		}
+	}
+	return label, nonNil, nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// declarativeEntities returns all entities of content, including nested ones.
+func declarativeEntities(content *DeclarativeContent) []interface{} {
+	var entities []interface{}
+	add := func(e interface{}) {
+		if !reflect.ValueOf(e).IsNil() {
+			entities = append(entities, e)
+		}
+	}
+	addRoute := func(r *DeclarativeRoute) {
+		if r == nil || r.Route == nil {
+			return
+		}
+		add(r.Route)
+		for _, p := range r.Plugins {
+			add(p)
+		}
+		for _, f := range r.FilterChains {
+			add(f)
+		}
+	}
+
+	for _, s := range content.Services {
+		if s == nil || s.Service == nil {
+			continue
+		}
+		add(s.Service)
+		for _, r := range s.Routes {
+			addRoute(r)
+		}
+		for _, p := range s.Plugins {
+			add(p)
+		}
+		for _, f := range s.FilterChains {
+			add(f)
+		}
+	}
+	for _, r := range content.Routes {
+		addRoute(r)
+	}
+	for _, c := range content.Consumers {
+		if c == nil || c.Consumer == nil {
+			continue
+		}
+		add(c.Consumer)
+		for _, p := range c.Plugins {
+			add(p)
+		}
+		for _, cred := range c.KeyAuths {
+			add(cred)
+		}
+		for _, cred := range c.BasicAuths {
+			add(cred)
+		}
+		for _, cred := range c.HMACAuths {
+			add(cred)
+		}
+		for _, cred := range c.JWTAuths {
+			add(cred)
+		}
+		for _, cred := range c.MTLSAuths {
+			add(cred)
+		}
+		for _, cred := range c.ACLGroups {
+			add(cred)
+		}
+		for _, cred := range c.Oauth2Credentials {
+			add(cred)
+		}
+	}
+	for _, cg := range content.ConsumerGroups {
+		if cg == nil || cg.ConsumerGroup == nil {
+			continue
+		}
+		add(cg.ConsumerGroup)
+		for _, p := range cg.Plugins {
+			add(p)
+		}
+	}
+	for _, u := range content.Upstreams {
+		if u == nil || u.Upstream == nil {
+			continue
+		}
+		add(u.Upstream)
+		for _, t := range u.Targets {
+			add(t)
+		}
+	}
+	for _, c := range content.Certificates {
+		if c == nil || c.Certificate == nil {
+			continue
+		}
+		add(c.Certificate)
+		for _, sni := range c.SNIs {
+			add(sni)
+		}
+	}
+	for _, e := range content.Plugins {
+		add(e)
+	}
+	for _, e := range content.Targets {
+		add(e)
+	}
+	for _, e := range content.SNIs {
+		add(e)
+	}
+	for _, e := range content.CACertificates {
+		add(e)
+	}
+	for _, e := range content.Vaults {
+		add(e)
+	}
+	for _, e := range content.Keys {
+		add(e)
+	}
+	for _, e := range content.KeySets {
+		add(e)
+	}
+	for _, e := range content.Partials {
+		add(e)
+	}
+	for _, e := range content.FilterChains {
+		add(e)
+	}
+	for _, e := range content.KeyAuths {
+		add(e)
+	}
+	for _, e := range content.BasicAuths {
+		add(e)
+	}
+	for _, e := range content.HMACAuths {
+		add(e)
+	}
+	for _, e := range content.JWTAuths {
+		add(e)
+	}
+	for _, e := range content.MTLSAuths {
+		add(e)
+	}
+	for _, e := range content.ACLGroups {
+		add(e)
+	}
+	for _, e := range content.Oauth2Credentials {
+		add(e)
+	}
+
+	return entities
+}
@@ -0,0 +1,244 @@
+package kong
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func referenceResolverTestContent() *DeclarativeContent {
+	return &DeclarativeContent{
+		Services: []*DeclarativeService{
+			{
+				Service: &Service{ID: String("6b9bc8c6-0000-4000-8000-000000000001"), Name: String("svc")},
+				Routes: []*DeclarativeRoute{
+					{Route: &Route{ID: String("6b9bc8c6-0000-4000-8000-000000000002"), Name: String("route")}},
+				},
+			},
+		},
+		Consumers: []*DeclarativeConsumer{
+			{Consumer: &Consumer{ID: String("6b9bc8c6-0000-4000-8000-000000000003"), Username: String("alice")}},
+		},
+		Upstreams: []*DeclarativeUpstream{
+			{Upstream: &Upstream{ID: String("6b9bc8c6-0000-4000-8000-000000000004"), Name: String("upstream")}},
+		},
+		Partials: []*Partial{
+			{ID: String("6b9bc8c6-0000-4000-8000-000000000005"), Name: String("redis")},
+		},
+	}
+}
+
+func TestReferenceResolver_ResolveReferences(t *testing.T) {
+	ctx := context.Background()
+	r := NewReferenceResolver(nil, referenceResolverTestContent())
+
+	plugin := &Plugin{
+		Name:     String("rate-limiting"),
+		Service:  &Service{Name: String("svc"), Host: String("example.com")},
+		Route:    &Route{ID: String("6b9bc8c6-0000-4000-8000-000000000002")},
+		Consumer: &Consumer{Username: String("alice")},
+		Partials: []*PartialLink{{Partial: &Partial{Name: String("redis")}, Path: String("config.redis")}},
+	}
+	require.NoError(t, r.ResolveReferences(ctx, plugin, ReferenceByID))
+	assert.Equal(t, &Service{ID: String("6b9bc8c6-0000-4000-8000-000000000001")}, plugin.Service)
+	assert.Equal(t, &Route{ID: String("6b9bc8c6-0000-4000-8000-000000000002")}, plugin.Route)
+	assert.Equal(t, &Consumer{ID: String("6b9bc8c6-0000-4000-8000-000000000003")}, plugin.Consumer)
+	assert.Equal(t, &PartialLink{
+		Partial: &Partial{ID: String("6b9bc8c6-0000-4000-8000-000000000005")},
+		Path:    String("config.redis"),
+	}, plugin.Partials[0])
+
+	require.NoError(t, r.ResolveReferences(ctx, plugin, ReferenceByName))
+	assert.Equal(t, &Service{Name: String("svc")}, plugin.Service)
+	assert.Equal(t, &Route{Name: String("route")}, plugin.Route)
+	assert.Equal(t, &Consumer{Username: String("alice")}, plugin.Consumer)
+	assert.Equal(t, &Partial{Name: String("redis")}, plugin.Partials[0].Partial)
+
+	target := &Target{Target: String("10.0.0.1:80"), Upstream: &Upstream{Name: String("upstream")}}
+	require.NoError(t, r.ResolveReferences(ctx, target, ReferenceByID))
+	assert.Equal(t, &Upstream{ID: String("6b9bc8c6-0000-4000-8000-000000000004")}, target.Upstream)
+
+	_, err := (&ReferenceResolver{}).resolve(ctx, "x", &foreignRef{field: "service", typ: EntityTypeServices}, false)
+	require.Error(t, err)
+	require.Error(t, r.ResolveReferences(ctx, (*Route)(nil), ReferenceByID))
+	require.Error(t, r.ResolveReferences(ctx, "not an entity", ReferenceByID))
+}
+
+func TestReferenceResolver_Errors(t *testing.T) {
+	ctx := context.Background()
+	content := referenceResolverTestContent()
+	content.Routes = []*DeclarativeRoute{
+		{Route: &Route{ID: String("6b9bc8c6-0000-4000-8000-000000000006"), Name: String("route")}},
+	}
+	content.KeySets = []*KeySet{{Name: String("unidentified")}}
+	r := NewReferenceResolver(nil, content)
+
+	t.Run("dangling reference", func(t *testing.T) {
+		route := &Route{Name: String("r"), Service: &Service{Name: String("missing")}}
+		err := r.ResolveReferences(ctx, route, ReferenceByID)
+		var dangling *DanglingReferenceError
+		require.ErrorAs(t, err, &dangling)
+		assert.Equal(t, &DanglingReferenceError{
+			Entity:    "routes/r",
+			Field:     "service",
+			Type:      EntityTypeServices,
+			Reference: "missing",
+		}, dangling)
+		assert.EqualError(t, err, `routes/r: service: services "missing" not found`)
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		plugin := &Plugin{Name: String("cors"), Route: &Route{Name: String("route")}}
+		err := r.ResolveReferences(ctx, plugin, ReferenceByID)
+		var ambiguous *AmbiguousReferenceError
+		require.ErrorAs(t, err, &ambiguous)
+		assert.Equal(t, []string{"routes/route", "routes/route"}, ambiguous.Candidates)
+	})
+
+	t.Run("id and name of different entities", func(t *testing.T) {
+		route := &Route{
+			Name:    String("r"),
+			Service: &Service{ID: String("6b9bc8c6-0000-4000-8000-000000000001"), Name: String("other")},
+		}
+		err := r.ResolveReferences(ctx, route, ReferenceByID)
+		var ambiguous *AmbiguousReferenceError
+		require.ErrorAs(t, err, &ambiguous)
+		assert.Equal(t, "6b9bc8c6-0000-4000-8000-000000000001 / other", ambiguous.Reference)
+	})
+
+	t.Run("referenced entity without ID", func(t *testing.T) {
+		key := &Key{KID: String("kid"), Set: &KeySet{Name: String("unidentified")}}
+		require.ErrorContains(t, r.ResolveReferences(ctx, key, ReferenceByID), "has no ID")
+		require.NoError(t, r.ResolveReferences(ctx, key, ReferenceByName))
+	})
+
+	t.Run("all errors are reported", func(t *testing.T) {
+		err := r.ResolveContent(ctx, &DeclarativeContent{
+			Plugins: []*Plugin{
+				{Name: String("cors"), Service: &Service{Name: String("missing-1")}},
+				{Name: String("cors"), Consumer: &Consumer{Username: String("missing-2")}},
+			},
+		}, ReferenceByID)
+		require.ErrorContains(t, err, "missing-1")
+		require.ErrorContains(t, err, "missing-2")
+	})
+}
+
+func TestReferenceResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+	content := referenceResolverTestContent()
+	r := NewReferenceResolver(nil, content)
+
+	resolved, err := r.Resolve(ctx, EntityTypeServices, "svc")
+	require.NoError(t, err)
+	assert.Equal(t, "6b9bc8c6-0000-4000-8000-000000000001", *resolved.ID)
+
+	resolved, err = r.Resolve(ctx, EntityTypeServices, "6b9bc8c6-0000-4000-8000-000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, "svc", *resolved.Name)
+
+	// A name equal to the ID of another entity is ambiguous.
+	content.Services = append(content.Services, &DeclarativeService{
+		Service: &Service{ID: String("6b9bc8c6-0000-4000-8000-000000000007"), Name: String("svc")},
+	})
+	content.Consumers = append(content.Consumers, &DeclarativeConsumer{
+		Consumer: &Consumer{Username: String("6b9bc8c6-0000-4000-8000-000000000003")},
+	})
+	r = NewReferenceResolver(nil, content)
+	_, err = r.Resolve(ctx, EntityTypeConsumers, "6b9bc8c6-0000-4000-8000-000000000003")
+	var ambiguous *AmbiguousReferenceError
+	require.ErrorAs(t, err, &ambiguous)
+	_, err = r.Resolve(ctx, EntityTypeServices, "svc")
+	require.ErrorAs(t, err, &ambiguous)
+}
+
+func TestReferenceResolver_ResolveContent(t *testing.T) {
+	ctx := context.Background()
+	content := referenceResolverTestContent()
+	content.Services[0].Routes[0].Plugins = []*Plugin{
+		{Name: String("cors"), Consumer: &Consumer{Username: String("alice")}},
+	}
+	content.Routes = []*DeclarativeRoute{
+		{Route: &Route{Name: String("top-level"), Service: &Service{Name: String("svc")}}},
+	}
+	content.Consumers[0].ACLGroups = []*ACLGroup{
+		{Group: String("admins"), Consumer: &Consumer{ID: String("6b9bc8c6-0000-4000-8000-000000000003")}},
+	}
+
+	r := NewReferenceResolver(nil, content)
+	require.NoError(t, r.ResolveContent(ctx, content, ReferenceByName))
+	assert.Equal(t, &Consumer{Username: String("alice")}, content.Services[0].Routes[0].Plugins[0].Consumer)
+	assert.Equal(t, &Service{Name: String("svc")}, content.Routes[0].Service)
+	assert.Equal(t, &Consumer{Username: String("alice")}, content.Consumers[0].ACLGroups[0].Consumer)
+
+	require.NoError(t, r.ResolveContent(ctx, content, ReferenceByID))
+	assert.Equal(t, &Service{ID: String("6b9bc8c6-0000-4000-8000-000000000001")}, content.Routes[0].Service)
+}
+
+func TestReferenceResolver_Client(t *testing.T) {
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services/remote-svc", func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"id": "6b9bc8c6-0000-4000-8000-0000000000aa", "name": "remote-svc"}`))
+	})
+	mux.HandleFunc("/consumer_groups/gold", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"consumer_group": {"id": "6b9bc8c6-0000-4000-8000-0000000000bb", "name": "gold"}}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	r := NewReferenceResolver(client, referenceResolverTestContent())
+
+	routes := []*Route{
+		{Name: String("a"), Service: &Service{Name: String("remote-svc")}},
+		{Name: String("b"), Service: &Service{Name: String("remote-svc")}},
+		{Name: String("c"), Service: &Service{Name: String("svc")}},
+	}
+	for _, route := range routes {
+		require.NoError(t, r.ResolveReferences(ctx, route, ReferenceByID))
+	}
+	assert.Equal(t, "6b9bc8c6-0000-4000-8000-0000000000aa", *routes[0].Service.ID)
+	assert.Equal(t, "6b9bc8c6-0000-4000-8000-0000000000aa", *routes[1].Service.ID)
+	assert.Equal(t, "6b9bc8c6-0000-4000-8000-000000000001", *routes[2].Service.ID)
+	assert.Equal(t, 1, requests, "remote lookups must be cached")
+
+	plugin := &Plugin{Name: String("cors"), ConsumerGroup: &ConsumerGroup{Name: String("gold")}}
+	require.NoError(t, r.ResolveReferences(ctx, plugin, ReferenceByID))
+	assert.Equal(t, "6b9bc8c6-0000-4000-8000-0000000000bb", *plugin.ConsumerGroup.ID)
+
+	var dangling *DanglingReferenceError
+	err = r.ResolveReferences(ctx, &Route{Name: String("d"), Service: &Service{Name: String("nope")}}, ReferenceByID)
+	require.ErrorAs(t, err, &dangling)
+}
+
+func TestReferenceResolver_Cycles(t *testing.T) {
+	r := NewReferenceResolver(nil, referenceResolverTestContent())
+	svc, route, consumer := r.entities[0], r.entities[1], r.entities[2]
+
+	require.NoError(t, r.checkCycles(map[*indexedEntity][]*indexedEntity{
+		route:    {svc},
+		consumer: {svc, route},
+	}))
+
+	err := r.checkCycles(map[*indexedEntity][]*indexedEntity{
+		consumer: {route},
+		route:    {svc},
+		svc:      {route},
+	})
+	var cycle *ReferenceCycleError
+	require.ErrorAs(t, err, &cycle)
+	assert.Equal(t, []string{"services/svc", "routes/route", "services/svc"}, cycle.Cycle)
+}
@@ -0,0 +1,39 @@
+package kong
+
+import "fmt"
+
+// routeExpressionFields holds the fields available to the expressions of
+// routes, with the expressions router.
+var routeExpressionFields = exprFields{
+	"http.method":            exprFieldString,
+	"http.host":              exprFieldString,
+	"http.path":              exprFieldString,
+	"http.path.segments.*":   exprFieldString,
+	"http.path.segments.len": exprFieldInt,
+	"http.headers.*":         exprFieldString,
+	"http.queries.*":         exprFieldString,
+	"net.protocol":           exprFieldString,
+	"net.src.ip":             exprFieldIPAddr,
+	"net.src.port":           exprFieldInt,
+	"net.dst.ip":             exprFieldIPAddr,
+	"net.dst.port":           exprFieldInt,
+	"tls.sni":                exprFieldString,
+}
+
+// ParseRouteExpression parses the expression of a route, e.g.
+// `http.path ^= "/api" && http.host == "example.com"`, and checks that it
+// only uses the fields, operators and values the expressions router
+// supports.
+// Errors are *ExpressionError, giving the position of the error.
+func ParseRouteExpression(expression string) (Expr, error) {
+	return parseExpr(expression, routeExpressionFields)
+}
+
+// FormatRouteExpression validates expression and returns it in Kong's syntax,
+// as expected by Route.Expression.
+func FormatRouteExpression(expression Expr) (string, error) {
+	if err := validateExpr(expression, routeExpressionFields); err != nil {
+		return "", fmt.Errorf("invalid route expression: %w", err)
+	}
+	return expression.String(), nil
+}
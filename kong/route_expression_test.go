@@ -0,0 +1,98 @@
+package kong
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRouteExpression(t *testing.T) {
+	e, err := ParseRouteExpression(`(http.path ^= "/api" || http.path ~ r#"^/v\d+/"#) && ` +
+		`http.host == "example.com" && net.protocol == "https" && net.src.ip in 10.0.0.0/8`)
+	require.NoError(t, err)
+	assert.Equal(t, ExprAnd(
+		ExprOr(
+			ExprField("http.path").HasPrefix("/api"),
+			ExprField("http.path").Matches(`^/v\d+/`),
+		),
+		ExprField("http.host").Equals("example.com"),
+		ExprField("net.protocol").Equals("https"),
+		ExprField("net.src.ip").In(netip.MustParsePrefix("10.0.0.0/8")),
+	), e)
+
+	tests := []struct {
+		expression string
+		formatted  string
+	}{
+		{
+			expression: `tls.sni=="example.com"||http.headers.x_tenant=="eu"`,
+			formatted:  `tls.sni == "example.com" || http.headers.x_tenant == "eu"`,
+		},
+		{
+			expression: `lower(http.host) =^ ".example.com" && http.path.segments.len > 2`,
+			formatted:  `lower(http.host) =^ ".example.com" && http.path.segments.len > 2`,
+		},
+		{
+			expression: `(http.method == "GET" || http.method == "HEAD") && http.path.segments.0 == "users"`,
+			formatted:  `(http.method == "GET" || http.method == "HEAD") && http.path.segments.0 == "users"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			e, err := ParseRouteExpression(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.formatted, e.String())
+
+			reparsed, err := ParseRouteExpression(e.String())
+			require.NoError(t, err)
+			assert.Equal(t, e, reparsed)
+		})
+	}
+}
+
+func TestParseRouteExpressionErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    string
+		wantPos    int
+	}{
+		{`http.path ^= "/api" ||`, "expected a field, got end of expression", 22},
+		{`http.path ^ "/api"`, `unexpected character '^'`, 10},
+		{`route.name == "health"`, `unknown field "route.name"`, 0},
+		{`http.path.segments.len == "2"`, "http.path.segments.len == expects a value of type integer, got string", 26},
+		{`net.src.ip == 10.0.0.0/8`, "net.src.ip == expects a value of type IP address, got CIDR", 14},
+		{`http.host ~ "*.example.com"`, "invalid regex \"*.example.com\": error parsing regexp: " +
+			"missing argument to repetition operator: `*`", 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := ParseRouteExpression(tt.expression)
+			var exprErr *ExpressionError
+			require.True(t, errors.As(err, &exprErr), err)
+			assert.Equal(t, tt.wantErr, exprErr.Msg)
+			assert.Equal(t, tt.wantPos, exprErr.Pos)
+		})
+	}
+}
+
+func TestFormatRouteExpression(t *testing.T) {
+	expression, err := FormatRouteExpression(ExprAnd(
+		ExprField("http.path").HasPrefix("/api"),
+		ExprOr(
+			ExprField("http.headers.x_version").Equals("2"),
+			ExprField("http.queries.version").Equals("2"),
+		),
+		ExprField("net.dst.port").Compare(ExprEquals, ExprInt(8443)),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, `http.path ^= "/api" && (http.headers.x_version == "2" || http.queries.version == "2") && `+
+		`net.dst.port == 8443`, expression)
+
+	_, err = FormatRouteExpression(ExprField("consumer.id").Equals("id"))
+	assert.EqualError(t, err, `invalid route expression: unknown field "consumer.id"`)
+	_, err = FormatRouteExpression(nil)
+	assert.EqualError(t, err, "invalid route expression: expression cannot be nil")
+}
@@ -1,6 +1,7 @@
 package kong
 
 import (
+	"net/netip"
 	"strings"
 	"testing"
 
@@ -136,6 +137,16 @@ func TestCreateExpressionRoutes(T *testing.T) {
 	require.NoError(T, err)
 	require.NotNil(T, client)
 
+	builtExpression, err := FormatRouteExpression(ExprAnd(
+		ExprField("http.path").HasPrefix("/api"),
+		ExprOr(
+			ExprField("http.host").Equals("example.com"),
+			ExprField("tls.sni").Equals("example.com"),
+		),
+		ExprField("net.src.ip").In(netip.MustParsePrefix("10.0.0.0/8")),
+	))
+	require.NoError(T, err)
+
 	for _, tc := range []struct {
 		name   string
 		route  *Route
@@ -166,6 +177,16 @@ func TestCreateExpressionRoutes(T *testing.T) {
 				assert.Equal(t, uint64(1), *route.Priority)
 			},
 		},
+		{
+			name: "route with built expression",
+			route: &Route{
+				Expression: String(builtExpression),
+			},
+			valid: true,
+			assert: func(t *testing.T, route *Route) {
+				assert.Equal(t, builtExpression, *route.Expression)
+			},
+		},
 		// TODO: this fails now because Gateway returns priority in scientific notation:
 		// failed decoding response body: json: cannot unmarshal number 3.3820977671045e+15 into Go struct field Route.priority of type int64
 		// Ref: https://konghq.atlassian.net/browse/FTI-5515
@@ -0,0 +1,229 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// contractEntities maps the entities with bundled schema snapshots to the
+// structs representing them.
+var contractEntities = map[string]interface{}{
+	"acls":                  ACLGroup{},
+	"basicauth_credentials": BasicAuth{},
+	"ca_certificates":       CACertificate{},
+	"certificates":          Certificate{},
+	"consumer_groups":       ConsumerGroup{},
+	"consumers":             Consumer{},
+	"hmacauth_credentials":  HMACAuth{},
+	"jwt_secrets":           JWTAuth{},
+	"key_sets":              KeySet{},
+	"keyauth_credentials":   KeyAuth{},
+	"keys":                  Key{},
+	"plugins":               Plugin{},
+	"routes":                Route{},
+	"services":              Service{},
+	"snis":                  SNI{},
+	"targets":               Target{},
+	"upstreams":             Upstream{},
+	"vaults":                Vault{},
+}
+
+// knownSchemaDrift lists, per entity, the fields on which go-kong knowingly
+// differs from the bundled schemas, with the reason why.
+var knownSchemaDrift = map[string]map[string]string{
+	"ca_certificates": {
+		"updated_at": "not exposed by go-kong",
+	},
+	"certificates": {
+		"snis":       "handled by the Admin API, not part of the schema",
+		"updated_at": "not exposed by go-kong",
+	},
+	"consumer_groups": {
+		"updated_at": "not exposed by go-kong",
+	},
+	"consumers": {
+		"updated_at": "not exposed by go-kong",
+	},
+	"plugins": {
+		"condition":  "Kong Enterprise only",
+		"partials":   "Kong Enterprise only",
+		"run_on":     "removed in Kong 3.0, kept for older releases",
+		"updated_at": "not exposed by go-kong",
+	},
+	"routes": {
+		"expression": "only in the schema of the expressions router",
+		"priority":   "only in the schema of the expressions router",
+	},
+	"services": {
+		"tls_sans": "added after the bundled releases",
+	},
+	"snis": {
+		"updated_at": "not exposed by go-kong",
+	},
+	"targets": {
+		"failover":   "Kong Enterprise only",
+		"updated_at": "not exposed by go-kong",
+	},
+	"upstreams": {
+		"healthchecks.passive.healthy.interval":   "Healthy is shared by active and passive health checks",
+		"healthchecks.passive.unhealthy.interval": "Unhealthy is shared by active and passive health checks",
+		"sticky_sessions_cookie":                  "added after the bundled releases",
+		"sticky_sessions_cookie_path":             "added after the bundled releases",
+		"updated_at":                              "not exposed by go-kong",
+	},
+}
+
+// schemaFieldDrift compares the fields of the Lua record schema to the json
+// tags of typ, recursing in records represented by structs, and returns the
+// fields missing from typ and the fields of typ unknown to the schema.
+func schemaFieldDrift(record gjson.Result, typ reflect.Type, prefix string) (missing, extra []string) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	tags := jsonFields(typ)
+
+	seen := map[string]bool{}
+	for _, field := range record.Get("fields").Array() {
+		name := luaFieldName(field)
+		def := field.Get(name)
+		seen[name] = true
+		goField, ok := tags[name]
+		if !ok {
+			missing = append(missing, prefix+name)
+			continue
+		}
+		if def.Get("type").String() != "record" || !def.Get("fields").Exists() {
+			continue
+		}
+		elem := goField.Type
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			continue
+		}
+		m, e := schemaFieldDrift(def, elem, prefix+name+".")
+		missing = append(missing, m...)
+		extra = append(extra, e...)
+	}
+	for _, field := range record.Get("shorthand_fields").Array() {
+		seen[luaFieldName(field)] = true
+	}
+	for name := range tags {
+		if !seen[name] {
+			extra = append(extra, prefix+name)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
+
+// jsonFields returns the fields of typ by json name.
+func jsonFields(typ reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+func TestSchemaContract(t *testing.T) {
+	versions := BundledSchemaVersions()
+	require.NotEmpty(t, versions)
+
+	for entity, value := range contractEntities {
+		t.Run(entity, func(t *testing.T) {
+			typ := reflect.TypeOf(value)
+			known := knownSchemaDrift[entity]
+			// Fields of the struct unknown to a release may have been added
+			// by another one, so they are only reported when none has them.
+			extraIn := map[string]int{}
+			drifted := map[string]bool{}
+			for _, version := range versions {
+				schema, err := BundledSchema(version, entity)
+				require.NoError(t, err)
+				b, err := json.Marshal(schema)
+				require.NoError(t, err)
+
+				missing, extra := schemaFieldDrift(gjson.ParseBytes(b), typ, "")
+				for _, field := range missing {
+					drifted[field] = true
+					if _, ok := known[field]; ok {
+						continue
+					}
+					assert.Failf(t, "field missing from go-kong",
+						"Kong %s has %s.%s which %s lacks", version, entity, field, typ.Name())
+				}
+				for _, field := range extra {
+					drifted[field] = true
+					extraIn[field]++
+				}
+			}
+			for field := range known {
+				assert.Truef(t, drifted[field],
+					"%s.%s is listed as known drift but matches every bundled schema", entity, field)
+			}
+			for field, count := range extraIn {
+				if _, ok := known[field]; ok || count < len(versions) {
+					continue
+				}
+				assert.Failf(t, "field unknown to Kong",
+					"%s has %s which no bundled release of %s has", typ.Name(), field, entity)
+			}
+		})
+	}
+}
+
+func TestSchemaContractEntitiesAreBundled(t *testing.T) {
+	for _, version := range BundledSchemaVersions() {
+		entities, err := BundledSchemaEntities(version)
+		require.NoError(t, err)
+		for _, entity := range entities {
+			assert.Contains(t, contractEntities, entity,
+				fmt.Sprintf("no struct checked against the %s schema of Kong %s", entity, version))
+		}
+	}
+	for entity := range knownSchemaDrift {
+		assert.Contains(t, contractEntities, entity)
+	}
+}
+
+func TestSchemaFieldDrift(t *testing.T) {
+	type inner struct {
+		A *string `json:"a,omitempty"`
+		C *string `json:"c,omitempty"`
+	}
+	type entity struct {
+		ID     *string `json:"id,omitempty"`
+		Record *inner  `json:"record,omitempty"`
+		Extra  *string `json:"extra,omitempty"`
+		Other  *string `json:"other,omitempty"`
+		Hidden *string `json:"-"`
+	}
+	record := gjson.Parse(`{"fields": [
+		{"id": {"type": "string"}},
+		{"name": {"type": "string"}},
+		{"record": {"type": "record", "fields": [{"a": {"type": "string"}}, {"b": {"type": "string"}}]}}
+	], "shorthand_fields": [{"extra": {"type": "string"}}]}`)
+
+	missing, extra := schemaFieldDrift(record, reflect.TypeOf(&entity{}), "")
+	assert.Equal(t, []string{"name", "record.b"}, missing)
+	assert.Equal(t, []string{"other", "record.c"}, extra)
+}
@@ -0,0 +1,268 @@
+package kong
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SchemaModel is a typed view of a schema returned by SchemaService.Get,
+// PluginService.GetFullSchema or PartialService.GetFullSchema.
+type SchemaModel struct {
+	Fields []*SchemaField
+	// ShorthandFields holds the top-level shorthand fields of the schema,
+	// e.g. "url" for services.
+	ShorthandFields []*SchemaField
+	// SupportedPartials holds, for plugin schemas, the paths at which the
+	// plugin accepts partials, by partial type.
+	SupportedPartials map[string][]string
+}
+
+// SchemaField describes a field of a schema.
+type SchemaField struct {
+	Name string
+	// Path is the dotted path of the field from the root of the schema, e.g.
+	// "config.redis.host". The elements of arrays and sets have the path of
+	// their container followed by "[]", the keys and values of maps the path
+	// of their container.
+	Path string
+	// Type is the type of the field: "string", "integer", "number",
+	// "boolean", "array", "set", "map", "record", "foreign" or "json".
+	Type     string
+	Required bool
+	// Default is the default value of the field, if HasDefault is true.
+	// It may be nil, for fields defaulting to null.
+	Default       interface{}
+	HasDefault    bool
+	Auto          bool
+	Unique        bool
+	OneOf         []interface{}
+	Reference     string
+	Referenceable bool
+	Encrypted     bool
+	Description   string
+	Deprecation   *SchemaFieldDeprecation
+	// Elements describes the elements of arrays and sets.
+	Elements *SchemaField
+	// Keys and Values describe the keys and values of maps.
+	Keys   *SchemaField
+	Values *SchemaField
+	// Fields holds the fields of records.
+	Fields []*SchemaField
+	// ShorthandFields holds the shorthand fields of records, which Kong
+	// accepts as input and translates into other fields, usually to keep
+	// deprecated fields working.
+	ShorthandFields []*SchemaField
+	// Shorthand is true for the fields listed in the shorthand fields of
+	// their record.
+	Shorthand bool
+	// Definition holds the definition of the field as found in the schema,
+	// including the validators which aren't modeled, e.g. "between".
+	Definition map[string]interface{}
+}
+
+// SchemaFieldDeprecation describes the deprecation of a field.
+type SchemaFieldDeprecation struct {
+	Message          string
+	RemovalInVersion string
+	// ReplacedWith holds the dotted paths of the fields replacing the
+	// deprecated field, relative to the record holding it.
+	ReplacedWith []string
+}
+
+// ParseSchema parses schema into a SchemaModel.
+func ParseSchema(schema Schema) (*SchemaModel, error) {
+	fields, err := parseSchemaFields(schema["fields"], "", "fields")
+	if err != nil {
+		return nil, err
+	}
+	model := &SchemaModel{Fields: fields}
+	if model.ShorthandFields, err = parseSchemaFields(schema["shorthand_fields"], "", "shorthand_fields"); err != nil {
+		return nil, err
+	}
+	for _, shorthand := range model.ShorthandFields {
+		shorthand.Shorthand = true
+	}
+	if partials, ok := schema["supported_partials"].(map[string]interface{}); ok {
+		model.SupportedPartials = map[string][]string{}
+		for partialType, paths := range partials {
+			list, _ := paths.([]interface{})
+			for _, path := range list {
+				if s, ok := path.(string); ok {
+					model.SupportedPartials[partialType] = append(model.SupportedPartials[partialType], s)
+				}
+			}
+		}
+	}
+	return model, nil
+}
+
+// parseSchemaFields parses the fields of a record at path. key is the name of
+// the array holding them, for error messages.
+func parseSchemaFields(value interface{}, path, key string) ([]*SchemaField, error) {
+	if value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema: %s of %q must be an array", key, path)
+	}
+	fields := make([]*SchemaField, 0, len(list))
+	for _, item := range list {
+		entry, ok := item.(map[string]interface{})
+		if !ok || len(entry) != 1 {
+			return nil, fmt.Errorf("schema: %s of %q must hold objects with a single key", key, path)
+		}
+		for name, def := range entry {
+			definition, ok := def.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("schema: definition of %q must be an object", joinFieldPath(path, name))
+			}
+			field, err := parseSchemaField(name, joinFieldPath(path, name), definition)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+func parseSchemaField(name, path string, def map[string]interface{}) (*SchemaField, error) {
+	field := &SchemaField{Name: name, Path: path, Definition: def}
+	field.Type, _ = def["type"].(string)
+	field.Required, _ = def["required"].(bool)
+	field.Default, field.HasDefault = def["default"]
+	field.Auto, _ = def["auto"].(bool)
+	field.Unique, _ = def["unique"].(bool)
+	field.OneOf, _ = def["one_of"].([]interface{})
+	field.Reference, _ = def["reference"].(string)
+	field.Referenceable, _ = def["referenceable"].(bool)
+	field.Encrypted, _ = def["encrypted"].(bool)
+	field.Description, _ = def["description"].(string)
+	if deprecation, ok := def["deprecation"].(map[string]interface{}); ok {
+		field.Deprecation = parseSchemaFieldDeprecation(deprecation)
+	}
+
+	var err error
+	if elements, ok := def["elements"].(map[string]interface{}); ok {
+		if field.Elements, err = parseSchemaField(name, path+"[]", elements); err != nil {
+			return nil, err
+		}
+	}
+	if keys, ok := def["keys"].(map[string]interface{}); ok {
+		if field.Keys, err = parseSchemaField(name, path, keys); err != nil {
+			return nil, err
+		}
+	}
+	if values, ok := def["values"].(map[string]interface{}); ok {
+		if field.Values, err = parseSchemaField(name, path, values); err != nil {
+			return nil, err
+		}
+	}
+	if field.Fields, err = parseSchemaFields(def["fields"], path, "fields"); err != nil {
+		return nil, err
+	}
+	if field.ShorthandFields, err = parseSchemaFields(def["shorthand_fields"], path, "shorthand_fields"); err != nil {
+		return nil, err
+	}
+	for _, shorthand := range field.ShorthandFields {
+		shorthand.Shorthand = true
+	}
+	return field, nil
+}
+
+func parseSchemaFieldDeprecation(def map[string]interface{}) *SchemaFieldDeprecation {
+	deprecation := &SchemaFieldDeprecation{}
+	deprecation.Message, _ = def["message"].(string)
+	deprecation.RemovalInVersion, _ = def["removal_in_version"].(string)
+	replacements, _ := def["replaced_with"].([]interface{})
+	for _, replacement := range replacements {
+		r, _ := replacement.(map[string]interface{})
+		segments, _ := r["path"].([]interface{})
+		parts := make([]string, 0, len(segments))
+		for _, segment := range segments {
+			parts = append(parts, fmt.Sprint(segment))
+		}
+		if len(parts) > 0 {
+			deprecation.ReplacedWith = append(deprecation.ReplacedWith, strings.Join(parts, "."))
+		}
+	}
+	return deprecation
+}
+
+// Lookup returns the field at the dotted path, e.g. "config.redis.host".
+// The elements of arrays and sets are looked up by suffixing the name of
+// their container with "[]", e.g. "config.rules[].limit". Shorthand fields
+// are found too.
+func (m *SchemaModel) Lookup(path string) (*SchemaField, bool) {
+	return lookupSchemaField(m.Fields, m.ShorthandFields, path)
+}
+
+// Lookup returns the field at the dotted path, relative to f, as
+// SchemaModel.Lookup does.
+func (f *SchemaField) Lookup(path string) (*SchemaField, bool) {
+	return lookupSchemaField(f.Fields, f.ShorthandFields, path)
+}
+
+func lookupSchemaField(fields, shorthands []*SchemaField, path string) (*SchemaField, bool) {
+	name, rest, nested := strings.Cut(path, ".")
+	name, elements := strings.CutSuffix(name, "[]")
+	var field *SchemaField
+	for _, candidate := range slices.Concat(fields, shorthands) {
+		if candidate.Name == name {
+			field = candidate
+			break
+		}
+	}
+	if field == nil {
+		return nil, false
+	}
+	if elements {
+		if field.Elements == nil {
+			return nil, false
+		}
+		field = field.Elements
+	}
+	if !nested {
+		return field, true
+	}
+	return field.Lookup(rest)
+}
+
+// Walk calls fn for all the fields of the schema, depth-first and in the
+// order of the schema, including elements, keys, values and shorthand
+// fields. Walk stops at the first error returned by fn.
+func (m *SchemaModel) Walk(fn func(field *SchemaField) error) error {
+	for _, fields := range [][]*SchemaField{m.Fields, m.ShorthandFields} {
+		for _, field := range fields {
+			if err := field.Walk(fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Walk calls fn for f and the fields it holds, as SchemaModel.Walk does.
+func (f *SchemaField) Walk(fn func(field *SchemaField) error) error {
+	if err := fn(f); err != nil {
+		return err
+	}
+	for _, nested := range []*SchemaField{f.Elements, f.Keys, f.Values} {
+		if nested == nil {
+			continue
+		}
+		if err := nested.Walk(fn); err != nil {
+			return err
+		}
+	}
+	for _, fields := range [][]*SchemaField{f.Fields, f.ShorthandFields} {
+		for _, field := range fields {
+			if err := field.Walk(fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package kong
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchema(t *testing.T) {
+	schema, err := BundledSchema(MustNewVersion("3.10.0"), "services")
+	require.NoError(t, err)
+	model, err := ParseSchema(schema)
+	require.NoError(t, err)
+	require.Len(t, model.Fields, 18)
+	assert.Equal(t, "id", model.Fields[0].Name)
+
+	protocol, ok := model.Lookup("protocol")
+	require.True(t, ok)
+	assert.Equal(t, "string", protocol.Type)
+	assert.True(t, protocol.Required)
+	assert.True(t, protocol.HasDefault)
+	assert.Equal(t, "http", protocol.Default)
+	assert.Contains(t, protocol.OneOf, "grpcs")
+	assert.Equal(t, []interface{}{float64(0), float64(32767)}, model.Fields[4].Definition["between"])
+
+	certificate, ok := model.Lookup("client_certificate")
+	require.True(t, ok)
+	assert.Equal(t, "foreign", certificate.Type)
+	assert.Equal(t, "certificates", certificate.Reference)
+
+	tag, ok := model.Lookup("tags[]")
+	require.True(t, ok)
+	assert.Equal(t, "tags[]", tag.Path)
+	assert.Equal(t, "string", tag.Type)
+	assert.True(t, tag.Required)
+
+	require.Len(t, model.ShorthandFields, 1)
+	url, ok := model.Lookup("url")
+	require.True(t, ok)
+	assert.Equal(t, "url", url.Path)
+	assert.Equal(t, "string", url.Type)
+	assert.True(t, url.Shorthand)
+
+	var walked []string
+	require.NoError(t, model.Walk(func(field *SchemaField) error {
+		if field.Shorthand {
+			walked = append(walked, field.Path)
+		}
+		return nil
+	}))
+	assert.Equal(t, []string{"url"}, walked)
+
+	for _, path := range []string{"unknown", "host.name", "host[]", "tags[].name", "url.host"} {
+		_, ok := model.Lookup(path)
+		assert.False(t, ok, path)
+	}
+}
+
+func TestParseSchemaPlugin(t *testing.T) {
+	model, err := ParseSchema(mustBundledPluginSchema(t, "3.10.0", "rate-limiting"))
+	require.NoError(t, err)
+
+	host, ok := model.Lookup("config.redis.host")
+	require.True(t, ok)
+	assert.Equal(t, "config.redis.host", host.Path)
+	assert.False(t, host.HasDefault)
+
+	password, ok := model.Lookup("config.redis.password")
+	require.True(t, ok)
+	assert.True(t, password.Referenceable)
+	assert.True(t, password.Encrypted)
+
+	shorthand, ok := model.Lookup("config.redis_host")
+	require.True(t, ok)
+	assert.True(t, shorthand.Shorthand)
+	assert.Equal(t, &SchemaFieldDeprecation{
+		Message:          "rate-limiting: config.redis_host is deprecated, please use config.redis.host instead",
+		RemovalInVersion: "4.0",
+		ReplacedWith:     []string{"redis.host"},
+	}, shorthand.Deprecation)
+
+	config, ok := model.Lookup("config")
+	require.True(t, ok)
+	port, ok := config.Lookup("redis.port")
+	require.True(t, ok)
+	assert.Equal(t, "integer", port.Type)
+	assert.Equal(t, float64(6379), port.Default)
+
+	var deprecated []string
+	require.NoError(t, model.Walk(func(field *SchemaField) error {
+		if field.Deprecation != nil {
+			deprecated = append(deprecated, field.Path)
+		}
+		return nil
+	}))
+	assert.Contains(t, deprecated, "config.redis_database")
+
+	stop := errors.New("stop")
+	var visited int
+	assert.Equal(t, stop, model.Walk(func(*SchemaField) error {
+		visited++
+		return stop
+	}))
+	assert.Equal(t, 1, visited)
+}
+
+func TestParseSchemaPartials(t *testing.T) {
+	model, err := ParseSchema(mustPartialPluginSchema(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"redis-ee":   {"config.redis"},
+		"vault-auth": {"config.vaults[]", "config.other_vaults[]"},
+	}, model.SupportedPartials)
+
+	vault, ok := model.Lookup("config.vaults[]")
+	require.True(t, ok)
+	assert.Equal(t, "string", vault.Type)
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	_, err := ParseSchema(Schema{"fields": "invalid"})
+	assert.EqualError(t, err, `schema: fields of "" must be an array`)
+
+	_, err = ParseSchema(Schema{"fields": []interface{}{
+		map[string]interface{}{"config": map[string]interface{}{
+			"type":   "record",
+			"fields": []interface{}{map[string]interface{}{"a": 1, "b": 2}},
+		}},
+	}})
+	assert.EqualError(t, err, `schema: fields of "config" must hold objects with a single key`)
+
+	_, err = ParseSchema(Schema{"fields": []interface{}{map[string]interface{}{"name": "string"}}})
+	assert.EqualError(t, err, `schema: definition of "name" must be an object`)
+
+	_, err = ParseSchema(Schema{"fields": []interface{}{}, "shorthand_fields": "invalid"})
+	assert.EqualError(t, err, `schema: shorthand_fields of "" must be an array`)
+}
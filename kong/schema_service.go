@@ -22,7 +22,21 @@ const (
 	EntityTypeSNIs           EntityType = "snis"
 	EntityTypeCertificates   EntityType = "certificates"
 	EntityTypeCACertificates EntityType = "ca_certificates"
-	EntityTypeTags           EntityType = "tags"
+	EntityTypeKeySets        EntityType = "key_sets"
+	EntityTypeKeys           EntityType = "keys"
+	EntityTypePartials       EntityType = "partials"
+	EntityTypeVaults         EntityType = "vaults"
+	EntityTypeFilterChains   EntityType = "filter_chains"
+
+	EntityTypeConsumerGroupConsumers EntityType = "consumer_group_consumers"
+	EntityTypeKeyAuths               EntityType = "keyauth_credentials"
+	EntityTypeBasicAuths             EntityType = "basicauth_credentials"
+	EntityTypeHMACAuths              EntityType = "hmacauth_credentials"
+	EntityTypeJWTAuths               EntityType = "jwt_secrets"
+	EntityTypeMTLSAuths              EntityType = "mtls_auth_credentials"
+	EntityTypeACLs                   EntityType = "acls"
+	EntityTypeOauth2Credentials      EntityType = "oauth2_credentials"
+	EntityTypeTags                   EntityType = "tags"
 )
 
 // AbstractSchemaService handles schemas in Kong.
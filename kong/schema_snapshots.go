@@ -0,0 +1,174 @@
+package kong
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// bundledSchemas holds snapshots of the Lua schemas returned by the Admin API
+// (GET /schemas/{entity}, GET /schemas/plugins/{name} and
+// GET /schemas/vaults/{name}) for a selection of Kong releases. The layout is
+// schemas/{major.minor}/{entity}.json, schemas/{major.minor}/plugins/{name}.json
+// and schemas/{major.minor}/vaults/{name}.json.
+//
+//go:embed schemas
+var bundledSchemas embed.FS
+
+const bundledSchemasRoot = "schemas"
+
+// bundledVaultSchemasRange is the range of Kong releases for which the
+// schemas of the vault backends are bundled. The older snapshots lack them.
+const bundledVaultSchemasRange = ">=3.10.0"
+
+// BundledSchemaVersions returns the Kong releases for which go-kong ships
+// schema snapshots, oldest first.
+func BundledSchemaVersions() []Version {
+	entries, err := fs.ReadDir(bundledSchemas, bundledSchemasRoot)
+	if err != nil {
+		// The directory is embedded at build time, this can't happen.
+		panic(err)
+	}
+	versions := make([]Version, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		versions = append(versions, MustNewVersion(e.Name()+".0"))
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].version.LT(versions[j].version)
+	})
+	return versions
+}
+
+// bundledSchemaDir returns the snapshot directory matching the given version:
+// the newest bundled release whose major.minor is not newer than version.
+func bundledSchemaDir(version Version) (string, error) {
+	var dir string
+	for _, v := range BundledSchemaVersions() {
+		if v.Major() > version.Major() ||
+			(v.Major() == version.Major() && v.Minor() > version.Minor()) {
+			break
+		}
+		dir = fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	}
+	if dir == "" {
+		return "", fmt.Errorf("no bundled schemas for Kong version %s", version)
+	}
+	return path.Join(bundledSchemasRoot, dir), nil
+}
+
+func readBundledSchema(file string) (Schema, error) {
+	b, err := bundledSchemas.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var schema Schema
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return nil, fmt.Errorf("decoding bundled schema %s: %w", file, err)
+	}
+	return schema, nil
+}
+
+// BundledSchema returns the bundled snapshot of the schema for entity
+// (e.g. "services", "routes") as served by the given Kong version.
+// The snapshot of the closest bundled release not newer than version is used.
+func BundledSchema(version Version, entity string) (Schema, error) {
+	dir, err := bundledSchemaDir(version)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := readBundledSchema(path.Join(dir, entity+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("no bundled schema for entity %q in Kong %s", entity, version)
+	}
+	return schema, nil
+}
+
+// BundledPluginSchema returns the bundled snapshot of the full schema of the
+// plugin called name, as returned by PluginService.GetFullSchema.
+// The snapshot of the closest bundled release not newer than version is used.
+func BundledPluginSchema(version Version, name string) (Schema, error) {
+	dir, err := bundledSchemaDir(version)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := readBundledSchema(path.Join(dir, "plugins", name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("no bundled schema for plugin %q in Kong %s", name, version)
+	}
+	return schema, nil
+}
+
+// BundledVaultSchema returns the bundled snapshot of the schema of the vault
+// backend called name (e.g. "env", "hcv"), as returned by
+// VaultService.GetFullSchema.
+// The snapshot of the closest bundled release not newer than version is used.
+// Vault schemas are only bundled for Kong 3.10 and later, an error is
+// returned for older versions.
+func BundledVaultSchema(version Version, name string) (Schema, error) {
+	dir, err := bundledVaultSchemaDir(version)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := readBundledSchema(path.Join(dir, "vaults", name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("no bundled schema for vault %q in Kong %s", name, version)
+	}
+	return schema, nil
+}
+
+// BundledSchemaEntities returns the names of the entities with a bundled
+// schema for the given Kong version.
+func BundledSchemaEntities(version Version) ([]string, error) {
+	return listBundledSchemas(version, "")
+}
+
+// BundledSchemaPlugins returns the names of the plugins with a bundled
+// schema for the given Kong version.
+func BundledSchemaPlugins(version Version) ([]string, error) {
+	return listBundledSchemas(version, "plugins")
+}
+
+// BundledSchemaVaults returns the names of the vault backends with a bundled
+// schema for the given Kong version, which must be 3.10 or later.
+func BundledSchemaVaults(version Version) ([]string, error) {
+	if _, err := bundledVaultSchemaDir(version); err != nil {
+		return nil, err
+	}
+	return listBundledSchemas(version, "vaults")
+}
+
+// bundledVaultSchemaDir returns the snapshot directory holding the vault
+// schemas matching the given version.
+func bundledVaultSchemaDir(version Version) (string, error) {
+	if !MustNewRange(bundledVaultSchemasRange)(version) {
+		return "", fmt.Errorf("no bundled vault schemas for Kong %s (requires %s)", version, bundledVaultSchemasRange)
+	}
+	return bundledSchemaDir(version)
+}
+
+func listBundledSchemas(version Version, subdir string) ([]string, error) {
+	dir, err := bundledSchemaDir(version)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := fs.ReadDir(bundledSchemas, path.Join(dir, subdir))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,1199 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// SchemaValidationError is returned by ValidateEntity when an entity does not
+// satisfy its schema. Its layout mirrors the "fields" and "@entity" sections
+// of the schema violation errors returned by Kong.
+type SchemaValidationError struct {
+	// Fields maps the path of every invalid field to the violation found.
+	// Nested fields are separated with dots and array elements are
+	// addressed with their index, e.g. "config.redis.host" or "paths[1]".
+	Fields map[string]string
+	// Entity holds violations of entity-level checks such as
+	// at_least_one_of or mutually_exclusive.
+	Entity []string
+}
+
+func (e *SchemaValidationError) Error() string {
+	paths := make([]string, 0, len(e.Fields))
+	for p := range e.Fields {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	parts := make([]string, 0, len(paths)+len(e.Entity))
+	for _, p := range paths {
+		parts = append(parts, p+": "+e.Fields[p])
+	}
+	for _, msg := range e.Entity {
+		parts = append(parts, "@entity: "+msg)
+	}
+	return fmt.Sprintf("schema violation (%s)", strings.Join(parts, "; "))
+}
+
+func (e *SchemaValidationError) addField(path, msg string) {
+	if path == "" {
+		e.Entity = append(e.Entity, msg)
+		return
+	}
+	if _, ok := e.Fields[path]; ok {
+		// Keep the first violation found on a field, as Kong does.
+		return
+	}
+	e.Fields[path] = msg
+}
+
+func (e *SchemaValidationError) empty() bool {
+	return len(e.Fields) == 0 && len(e.Entity) == 0
+}
+
+func newSchemaValidationError() *SchemaValidationError {
+	return &SchemaValidationError{Fields: map[string]string{}}
+}
+
+// ValidateEntity validates entity against schema without contacting Kong.
+//
+// The entity can be any value that marshals to a JSON object, typically one
+// of the entity structs of this package or a map. The schema can either be a
+// Lua schema, as returned by SchemaService.Get, PluginService.GetFullSchema or
+// BundledSchema, or a JSON schema such as the ones served by Konnect.
+//
+// Kong fills default and auto-generated values before validating an entity.
+// Required fields are therefore only reported as missing when the schema
+// defines neither a default nor an auto-generated value for them.
+//
+// It returns a *SchemaValidationError when the entity violates the schema.
+func ValidateEntity(entity interface{}, schema Schema) error {
+	if schema == nil {
+		return fmt.Errorf("validating '%T': provided schema is nil", entity)
+	}
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("marshal entity: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Errorf("entity '%T' is not a JSON object: %w", entity, err)
+	}
+	jsonSchema, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("marshal schema: %w", err)
+	}
+	gjsonSchema := gjson.ParseBytes(jsonSchema)
+
+	verr := newSchemaValidationError()
+	switch {
+	case gjsonSchema.Get("fields").Exists():
+		obj = translateLuaShorthands(luaShorthandEntity(entity), gjsonSchema, obj, verr)
+		validateLuaRecord(gjsonSchema, obj, "", verr)
+	case gjsonSchema.Get("properties").Exists():
+		validateJSONSchemaValue(gjsonSchema, obj, "", verr)
+	default:
+		return fmt.Errorf("unsupported schema format: neither 'fields' nor 'properties' found")
+	}
+	if verr.empty() {
+		return nil
+	}
+	return verr
+}
+
+// ValidatePlugin validates plugin against the schema of the plugin entity,
+// as returned by SchemaService.Get("plugins") or BundledSchema, combined with
+// the plugin specific schema returned by PluginService.GetFullSchema or
+// BundledPluginSchema, the same way Kong combines them.
+//
+// It returns a *SchemaValidationError when the plugin violates the schema.
+func ValidatePlugin(plugin *Plugin, entitySchema Schema, pluginSchema Schema) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin is nil")
+	}
+	if entitySchema == nil || pluginSchema == nil {
+		return fmt.Errorf("validating plugin: provided schema is nil")
+	}
+	merged, err := mergePluginSchema(entitySchema, pluginSchema)
+	if err != nil {
+		return err
+	}
+	return ValidateEntity(plugin, merged)
+}
+
+// ValidateVault validates vault against the schema of the vault entity, as
+// returned by SchemaService.Get("vaults") or BundledSchema, combined with the
+// schema of its backend returned by VaultService.GetFullSchema or
+// BundledVaultSchema, the same way Kong combines them.
+//
+// It returns a *SchemaValidationError when the vault violates the schema.
+func ValidateVault(vault *Vault, entitySchema Schema, vaultSchema Schema) error {
+	if vault == nil {
+		return fmt.Errorf("vault is nil")
+	}
+	if entitySchema == nil || vaultSchema == nil {
+		return fmt.Errorf("validating vault: provided schema is nil")
+	}
+	merged, err := mergePluginSchema(entitySchema, vaultSchema)
+	if err != nil {
+		return err
+	}
+	return ValidateEntity(vault, merged)
+}
+
+// mergePluginSchema overrides the fields of the plugin entity schema with the
+// ones defined by a plugin schema, like Kong does with subschemas. Vault
+// backend schemas are merged into the vault entity schema the same way.
+func mergePluginSchema(entitySchema Schema, pluginSchema Schema) (Schema, error) {
+	var merged Schema
+	b, err := json.Marshal(entitySchema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+	if err := json.Unmarshal(b, &merged); err != nil {
+		return nil, fmt.Errorf("unmarshal schema: %w", err)
+	}
+	b, err = json.Marshal(pluginSchema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+	var sub Schema
+	if err := json.Unmarshal(b, &sub); err != nil {
+		return nil, fmt.Errorf("unmarshal schema: %w", err)
+	}
+
+	fields, _ := merged["fields"].([]interface{})
+	subFields, _ := sub["fields"].([]interface{})
+	for _, subField := range subFields {
+		sf, ok := subField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		replaced := false
+		for name := range sf {
+			for i, field := range fields {
+				if f, ok := field.(map[string]interface{}); ok && f[name] != nil {
+					fields[i] = sf
+					replaced = true
+				}
+			}
+		}
+		if !replaced {
+			fields = append(fields, sf)
+		}
+	}
+	merged["fields"] = fields
+	if checks, ok := sub["entity_checks"].([]interface{}); ok {
+		existing, _ := merged["entity_checks"].([]interface{})
+		merged["entity_checks"] = append(existing, checks...)
+	}
+	return merged, nil
+}
+
+func joinFieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// luaFieldName returns the name of a field in a Lua schema "fields" array,
+// where each field is a single-key object.
+func luaFieldName(field gjson.Result) string {
+	for k := range field.Map() {
+		return k
+	}
+	return ""
+}
+
+// luaRecordField returns the definition of the field called name in a Lua
+// record schema.
+func luaRecordField(record gjson.Result, name string) (gjson.Result, bool) {
+	for _, field := range record.Get("fields").Array() {
+		if def, ok := field.Map()[name]; ok {
+			return def, true
+		}
+	}
+	return gjson.Result{}, false
+}
+
+// luaFieldAtPath resolves a dotted path like "redis.host" inside a Lua record
+// schema.
+func luaFieldAtPath(record gjson.Result, path string) (gjson.Result, bool) {
+	parts := strings.Split(path, ".")
+	current := record
+	for i, part := range parts {
+		def, ok := luaRecordField(current, part)
+		if !ok {
+			return gjson.Result{}, false
+		}
+		if i == len(parts)-1 {
+			return def, true
+		}
+		current = def
+	}
+	return gjson.Result{}, false
+}
+
+// effectiveValue returns the value at path in obj, falling back to the default
+// declared in the schema, to mimic the view Kong has of an entity when running
+// entity checks.
+func effectiveValue(record gjson.Result, obj map[string]interface{}, path string) interface{} {
+	if v := lookupValue(obj, strings.Split(path, ".")); v != nil {
+		return v
+	}
+	def, ok := luaFieldAtPath(record, path)
+	if !ok {
+		return nil
+	}
+	if d := def.Get("default"); d.Exists() {
+		return d.Value()
+	}
+	return nil
+}
+
+// lookupValue returns the value found at the end of path in obj, or nil.
+func lookupValue(obj map[string]interface{}, path []string) interface{} {
+	var current interface{} = obj
+	for _, part := range path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[part]
+	}
+	return current
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func validateLuaRecord(record gjson.Result, obj map[string]interface{}, path string, verr *SchemaValidationError) {
+	known := map[string]bool{}
+	record.Get("shorthand_fields").ForEach(func(_, value gjson.Result) bool {
+		known[luaFieldName(value)] = true
+		return true
+	})
+	obj = expandLuaShorthands(record, obj)
+	for _, field := range record.Get("fields").Array() {
+		name := luaFieldName(field)
+		known[name] = true
+		validateLuaField(field.Get(gjson.Escape(name)), obj[name], joinFieldPath(path, name), verr)
+	}
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !known[name] {
+			verr.addField(joinFieldPath(path, name), "unknown field")
+		}
+	}
+	record.Get("entity_checks").ForEach(func(_, check gjson.Result) bool {
+		runLuaEntityCheck(record, check, obj, path, verr)
+		return true
+	})
+}
+
+// luaShorthand identifies a shorthand field of an entity.
+type luaShorthand struct {
+	entity, field string
+}
+
+// luaShorthandTranslations holds the translations of the shorthand fields
+// whose translation is a Lua function, which doesn't appear in the schemas
+// served by the Admin API.
+var luaShorthandTranslations = map[luaShorthand]func(value interface{}) (map[string]interface{}, error){
+	{entity: "services", field: "url"}: translateServiceURLShorthand,
+}
+
+// luaShorthandEntity returns the name of the entity type of entity, for the
+// entities with translated shorthand fields.
+func luaShorthandEntity(entity interface{}) string {
+	switch entity.(type) {
+	case *Service, Service:
+		return "services"
+	}
+	return ""
+}
+
+// translateServiceURLShorthand translates the url shorthand of services into
+// the fields it sets, as Kong does: the host, port and path missing from the
+// URL are unset.
+func translateServiceURLShorthand(value interface{}) (map[string]interface{}, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string")
+	}
+	service, err := serviceURLFields(s)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{"host": nil, "port": nil, "path": nil}
+	if service.Protocol != nil {
+		fields["protocol"] = *service.Protocol
+	}
+	if service.Host != nil {
+		fields["host"] = *service.Host
+	}
+	if service.Port != nil {
+		fields["port"] = float64(*service.Port)
+	}
+	if service.Path != nil {
+		fields["path"] = *service.Path
+	}
+	return fields, nil
+}
+
+// translateLuaShorthands returns obj with the values of the shorthand fields
+// of entity having a translation translated to the fields they set.
+func translateLuaShorthands(entity string, record gjson.Result, obj map[string]interface{},
+	verr *SchemaValidationError,
+) map[string]interface{} {
+	translated := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		translated[k] = v
+	}
+	record.Get("shorthand_fields").ForEach(func(_, shorthand gjson.Result) bool {
+		name := luaFieldName(shorthand)
+		translate, ok := luaShorthandTranslations[luaShorthand{entity: entity, field: name}]
+		if !ok || obj[name] == nil {
+			return true
+		}
+		fields, err := translate(obj[name])
+		if err != nil {
+			verr.addField(name, err.Error())
+			return true
+		}
+		for k, v := range fields {
+			translated[k] = v
+		}
+		return true
+	})
+	return translated
+}
+
+// expandLuaShorthands returns obj with the values of the deprecated shorthand
+// fields of record copied to the fields replacing them, the way Kong does
+// before validating entities. They don't override the replacing fields when
+// these are set.
+func expandLuaShorthands(record gjson.Result, obj map[string]interface{}) map[string]interface{} {
+	shorthands := record.Get("shorthand_fields").Array()
+	if len(shorthands) == 0 {
+		return obj
+	}
+	expanded := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		expanded[k] = v
+	}
+	for _, shorthand := range shorthands {
+		name := luaFieldName(shorthand)
+		value := obj[name]
+		if value == nil {
+			continue
+		}
+		for _, replacement := range parseReplacedWithPaths(shorthand.Get(gjson.Escape(name))) {
+			setUnsetValue(expanded, replacement, value)
+		}
+	}
+	return expanded
+}
+
+// setUnsetValue sets the value at path in obj unless it is already set,
+// copying the maps along path instead of modifying them.
+func setUnsetValue(obj map[string]interface{}, path []string, value interface{}) {
+	if len(path) == 0 {
+		return
+	}
+	if len(path) == 1 {
+		if obj[path[0]] == nil {
+			obj[path[0]] = value
+		}
+		return
+	}
+	nested := map[string]interface{}{}
+	if current, ok := obj[path[0]].(map[string]interface{}); ok {
+		for k, v := range current {
+			nested[k] = v
+		}
+	} else if obj[path[0]] != nil {
+		return
+	}
+	setUnsetValue(nested, path[1:], value)
+	obj[path[0]] = nested
+}
+
+func validateLuaField(def gjson.Result, value interface{}, path string, verr *SchemaValidationError) {
+	if value == nil {
+		if eq := def.Get("eq"); eq.Exists() && eq.Type != gjson.Null {
+			verr.addField(path, fmt.Sprintf("value must be %s", eq.Raw))
+			return
+		}
+		if def.Get("type").String() == "record" && def.Get("required").Bool() && !def.Get("default").Exists() {
+			// Kong builds missing required records from the defaults of
+			// their fields, so validate them as empty records.
+			value = map[string]interface{}{}
+		} else if def.Get("required").Bool() && !def.Get("default").Exists() && !def.Get("auto").Bool() {
+			verr.addField(path, "required field missing")
+			return
+		} else {
+			return
+		}
+	}
+	if eq := def.Get("eq"); eq.Exists() {
+		if eq.Type == gjson.Null {
+			verr.addField(path, "value must be null")
+			return
+		}
+		if !reflect.DeepEqual(eq.Value(), value) {
+			verr.addField(path, fmt.Sprintf("value must be %s", eq.Raw))
+			return
+		}
+	}
+
+	switch def.Get("type").String() {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			verr.addField(path, "expected a string")
+			return
+		}
+		validateLuaString(def, s, path, verr)
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			verr.addField(path, "expected an integer")
+			return
+		}
+		validateLuaNumber(def, n, path, verr)
+	case "number":
+		n, ok := value.(float64)
+		if !ok {
+			verr.addField(path, "expected a number")
+			return
+		}
+		validateLuaNumber(def, n, path, verr)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			verr.addField(path, "expected a boolean")
+		}
+	case "array", "set":
+		arr, ok := value.([]interface{})
+		if !ok {
+			verr.addField(path, "expected a"+articleSuffix(def.Get("type").String()))
+			return
+		}
+		if !validateLuaLength(def, len(arr), path, verr) {
+			return
+		}
+		if contains := def.Get("contains"); contains.Exists() && !containsValue(arr, contains.Value()) {
+			verr.addField(path, fmt.Sprintf("expected to contain: %v", contains.Value()))
+			return
+		}
+		elements := def.Get("elements")
+		for i, el := range arr {
+			validateLuaField(elements, el, fmt.Sprintf("%s[%d]", path, i), verr)
+		}
+	case "map":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			verr.addField(path, "expected a map")
+			return
+		}
+		if !validateLuaLength(def, len(m), path, verr) {
+			return
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			validateLuaField(def.Get("keys"), k, joinFieldPath(path, k), verr)
+			validateLuaField(def.Get("values"), m[k], joinFieldPath(path, k), verr)
+		}
+	case "record":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			verr.addField(path, "expected a record")
+			return
+		}
+		if def.Get("abstract").Bool() {
+			// Abstract records (e.g. a generic plugin config) are defined
+			// by a subschema which is not known here.
+			return
+		}
+		validateLuaRecord(def, m, path, verr)
+	case "foreign":
+		if _, ok := value.(map[string]interface{}); !ok {
+			verr.addField(path, "expected a foreign key reference")
+		}
+	}
+}
+
+func articleSuffix(typ string) string {
+	switch typ {
+	case "array":
+		return "n array"
+	default:
+		return " " + typ
+	}
+}
+
+func containsValue(arr []interface{}, v interface{}) bool {
+	for _, el := range arr {
+		if reflect.DeepEqual(el, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatOneOf(values []gjson.Result) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func validateLuaLength(def gjson.Result, l int, path string, verr *SchemaValidationError) bool {
+	if lenEq := def.Get("len_eq"); lenEq.Exists() && l != int(lenEq.Int()) {
+		verr.addField(path, fmt.Sprintf("length must be %d", lenEq.Int()))
+		return false
+	}
+	if lenMin := def.Get("len_min"); lenMin.Exists() && l < int(lenMin.Int()) {
+		verr.addField(path, fmt.Sprintf("length must be at least %d", lenMin.Int()))
+		return false
+	}
+	if lenMax := def.Get("len_max"); lenMax.Exists() && l > int(lenMax.Int()) {
+		verr.addField(path, fmt.Sprintf("length must be at most %d", lenMax.Int()))
+		return false
+	}
+	return true
+}
+
+func validateLuaNumber(def gjson.Result, n float64, path string, verr *SchemaValidationError) {
+	if between := def.Get("between").Array(); len(between) == 2 {
+		if n < between[0].Float() || n > between[1].Float() {
+			verr.addField(path, fmt.Sprintf("value should be between %s and %s", between[0].Raw, between[1].Raw))
+			return
+		}
+	}
+	if gt := def.Get("gt"); gt.Exists() && n <= gt.Float() {
+		verr.addField(path, fmt.Sprintf("value must be greater than %s", gt.Raw))
+		return
+	}
+	if oneOf := def.Get("one_of"); oneOf.Exists() {
+		for _, v := range oneOf.Array() {
+			if v.Float() == n {
+				return
+			}
+		}
+		verr.addField(path, "expected one of: "+formatOneOf(oneOf.Array()))
+	}
+}
+
+func validateLuaString(def gjson.Result, s string, path string, verr *SchemaValidationError) {
+	if !validateLuaLength(def, len(s), path, verr) {
+		return
+	}
+	if oneOf := def.Get("one_of"); oneOf.Exists() {
+		found := false
+		for _, v := range oneOf.Array() {
+			if v.String() == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			verr.addField(path, "expected one of: "+formatOneOf(oneOf.Array()))
+			return
+		}
+	}
+	if notOneOf := def.Get("not_one_of"); notOneOf.Exists() {
+		for _, v := range notOneOf.Array() {
+			if v.String() == s {
+				verr.addField(path, "must not be one of: "+formatOneOf(notOneOf.Array()))
+				return
+			}
+		}
+	}
+	if def.Get("uuid").Bool() {
+		if _, err := uuid.Parse(s); err != nil {
+			verr.addField(path, "expected a valid UUID")
+			return
+		}
+	}
+	if prefix := def.Get("starts_with"); prefix.Exists() && !strings.HasPrefix(s, prefix.String()) {
+		verr.addField(path, "should start with: "+prefix.String())
+		return
+	}
+	if match := def.Get("match"); match.Exists() && luaPatternMatches(match.String(), s) == matchFailed {
+		verr.addField(path, "invalid value: "+s)
+		return
+	}
+	if notMatch := def.Get("not_match"); notMatch.Exists() && luaPatternMatches(notMatch.String(), s) == matchOK {
+		verr.addField(path, "invalid value: "+s)
+		return
+	}
+	for _, m := range def.Get("match_all").Array() {
+		if luaPatternMatches(m.Get("pattern").String(), s) == matchFailed {
+			verr.addField(path, luaMatchErr(m, s))
+			return
+		}
+	}
+	for _, m := range def.Get("match_none").Array() {
+		if luaPatternMatches(m.Get("pattern").String(), s) == matchOK {
+			verr.addField(path, luaMatchErr(m, s))
+			return
+		}
+	}
+	if matchAny := def.Get("match_any"); matchAny.Exists() {
+		for _, p := range matchAny.Get("patterns").Array() {
+			if luaPatternMatches(p.String(), s) != matchFailed {
+				return
+			}
+		}
+		verr.addField(path, luaMatchErr(matchAny, s))
+	}
+}
+
+func luaMatchErr(m gjson.Result, s string) string {
+	if e := m.Get("err"); e.Exists() {
+		return e.String()
+	}
+	return "invalid value: " + s
+}
+
+func runLuaEntityCheck(
+	record gjson.Result, check gjson.Result, obj map[string]interface{}, path string, verr *SchemaValidationError,
+) {
+	value := func(field string) interface{} {
+		return effectiveValue(record, obj, field)
+	}
+	quoted := func(fields []gjson.Result) string {
+		s := make([]string, len(fields))
+		for i, f := range fields {
+			s[i] = "'" + f.String() + "'"
+		}
+		return strings.Join(s, ", ")
+	}
+	countSet := func(fields []gjson.Result) int {
+		n := 0
+		for _, f := range fields {
+			if !isEmptyValue(value(f.String())) {
+				n++
+			}
+		}
+		return n
+	}
+	entityErr := func(msg string) {
+		if path == "" {
+			verr.addField("", msg)
+			return
+		}
+		verr.addField(path, msg)
+	}
+
+	name := luaFieldName(check)
+	args := check.Get(gjson.Escape(name))
+	switch name {
+	case "at_least_one_of":
+		if countSet(args.Array()) == 0 {
+			entityErr("at least one of these fields must be non-empty: " + quoted(args.Array()))
+		}
+	case "only_one_of":
+		if countSet(args.Array()) != 1 {
+			entityErr("exactly one of these fields must be non-empty: " + quoted(args.Array()))
+		}
+	case "mutually_exclusive":
+		if countSet(args.Array()) > 1 {
+			entityErr("only one or none of these fields must be set: " + quoted(args.Array()))
+		}
+	case "mutually_required":
+		if n := countSet(args.Array()); n != 0 && n != len(args.Array()) {
+			entityErr("all or none of these fields must be set: " + quoted(args.Array()))
+		}
+	case "mutually_exclusive_sets":
+		set1, set2 := args.Get("set1").Array(), args.Get("set2").Array()
+		if countSet(set1) > 0 && countSet(set2) > 0 {
+			entityErr(fmt.Sprintf("these sets are mutually exclusive: (%s), (%s)", quoted(set1), quoted(set2)))
+		}
+	case "distinct":
+		seen := map[string]bool{}
+		for _, f := range args.Array() {
+			v := value(f.String())
+			if v == nil {
+				continue
+			}
+			key := fmt.Sprint(v)
+			if seen[key] {
+				entityErr("values of these fields must be distinct: " + quoted(args.Array()))
+				return
+			}
+			seen[key] = true
+		}
+	case "conditional":
+		ifField := args.Get("if_field").String()
+		if !luaValueMatches(args.Get("if_match"), value(ifField)) {
+			return
+		}
+		thenField := args.Get("then_field").String()
+		if luaValueMatches(args.Get("then_match"), value(thenField)) {
+			return
+		}
+		msg := "invalid value"
+		thenMatch := args.Get("then_match")
+		switch {
+		case args.Get("then_err").Exists():
+			msg = args.Get("then_err").String()
+		case thenMatch.Get("required").Bool():
+			msg = "required field missing"
+		}
+		verr.addField(joinFieldPath(path, thenField), msg)
+	case "conditional_at_least_one_of":
+		ifField := args.Get("if_field").String()
+		fields, errTmpl := args.Get("then_at_least_one_of"), args.Get("then_err")
+		if !luaValueMatches(args.Get("if_match"), value(ifField)) {
+			if !args.Get("else_then_at_least_one_of").Exists() {
+				return
+			}
+			if args.Get("else_match").Exists() && !luaValueMatches(args.Get("else_match"), value(ifField)) {
+				return
+			}
+			fields, errTmpl = args.Get("else_then_at_least_one_of"), args.Get("else_then_err")
+		}
+		if countSet(fields.Array()) > 0 {
+			return
+		}
+		list := quoted(fields.Array())
+		msg := "at least one of these fields must be non-empty: " + list
+		if errTmpl.Exists() {
+			msg = strings.Replace(errTmpl.String(), "%s", list, 1)
+		}
+		entityErr(msg)
+	}
+}
+
+// luaValueMatches reports whether value satisfies the partial field
+// definition match, as used by the if_match/then_match arguments of
+// conditional entity checks. Like in Kong, an absent value matches unless
+// match requires a value.
+func luaValueMatches(match gjson.Result, value interface{}) bool {
+	if value == nil {
+		if match.Get("required").Bool() {
+			return false
+		}
+		if eq := match.Get("eq"); eq.Exists() && eq.Type != gjson.Null {
+			return false
+		}
+		return true
+	}
+	if contains := match.Get("contains"); contains.Exists() {
+		arr, ok := value.([]interface{})
+		if !ok || !containsValue(arr, contains.Value()) {
+			return false
+		}
+	}
+	def := match
+	if !def.Get("type").Exists() {
+		// Without an explicit type, derive it from the value so that the
+		// generic constraint checks can run.
+		var typ string
+		switch value.(type) {
+		case string:
+			typ = "string"
+		case float64:
+			typ = "number"
+		case bool:
+			typ = "boolean"
+		case []interface{}:
+			typ = "array"
+		case map[string]interface{}:
+			typ = "map"
+		}
+		raw, _ := json.Marshal(match.Value())
+		patched := map[string]interface{}{}
+		_ = json.Unmarshal(raw, &patched)
+		patched["type"] = typ
+		delete(patched, "required")
+		delete(patched, "contains")
+		raw, _ = json.Marshal(patched)
+		def = gjson.ParseBytes(raw)
+	}
+	verr := newSchemaValidationError()
+	validateLuaField(def, value, "v", verr)
+	return verr.empty()
+}
+
+type luaMatchResult int
+
+const (
+	matchOK luaMatchResult = iota
+	matchFailed
+	// matchUnsupported is returned for patterns using Lua features that
+	// have no equivalent in Go regular expressions, such as %b or %f.
+	matchUnsupported
+)
+
+func luaPatternMatches(pattern, s string) luaMatchResult {
+	re, err := luaPatternToRegexp(pattern)
+	if err != nil {
+		return matchUnsupported
+	}
+	if re.MatchString(s) {
+		return matchOK
+	}
+	return matchFailed
+}
+
+// luaClass returns the regular expression character class equivalent to the
+// Lua character class %c, without the enclosing brackets.
+func luaClass(c byte) (string, bool) {
+	classes := map[byte]string{
+		'a': `A-Za-z`,
+		'd': `0-9`,
+		'l': `a-z`,
+		'u': `A-Z`,
+		's': `\s`,
+		'w': `A-Za-z0-9`,
+		'x': `A-Fa-f0-9`,
+		'p': `[:punct:]`,
+		'c': `[:cntrl:]`,
+	}
+	lower := c | 0x20
+	class, ok := classes[lower]
+	if !ok {
+		return "", false
+	}
+	if c != lower {
+		return "^" + class, true
+	}
+	return class, true
+}
+
+// luaPatternToRegexp translates a Lua pattern, as used by the match family of
+// validators in Kong schemas, into a Go regular expression.
+func luaPatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	inSet := false
+	// quantifiable tracks whether the previous item is a single character
+	// class, as Lua only treats *, +, - and ? as quantifiers after one.
+	quantifiable := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '%':
+			if i+1 >= len(pattern) {
+				return nil, fmt.Errorf("malformed pattern (ends with '%%')")
+			}
+			i++
+			next := pattern[i]
+			if next == 'b' || next == 'f' {
+				return nil, fmt.Errorf("unsupported pattern item: %%%c", next)
+			}
+			class, isClass := luaClass(next)
+			switch {
+			case isClass && inSet:
+				if strings.HasPrefix(class, "^") {
+					return nil, fmt.Errorf("unsupported negated class in set: %%%c", next)
+				}
+				b.WriteString(class)
+			case isClass:
+				b.WriteString("[" + class + "]")
+			case inSet:
+				b.WriteString(`\` + string(next))
+			default:
+				b.WriteString(regexp.QuoteMeta(string(next)))
+			}
+			quantifiable = !inSet
+		case inSet:
+			switch {
+			case c == ']':
+				inSet = false
+				quantifiable = true
+				b.WriteByte(c)
+			case c == '\\' || c == '[':
+				b.WriteString(`\` + string(c))
+			default:
+				b.WriteByte(c)
+			}
+		case c == '[':
+			inSet = true
+			b.WriteByte(c)
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				b.WriteByte('^')
+				i++
+			}
+		case strings.IndexByte("*+?-", c) >= 0 && quantifiable:
+			if c == '-' {
+				b.WriteString("*?")
+			} else {
+				b.WriteByte(c)
+			}
+			quantifiable = false
+		case c == '.':
+			b.WriteByte(c)
+			quantifiable = true
+		case (c == '^' && i == 0) || (c == '$' && i == len(pattern)-1) || c == '(' || c == ')':
+			b.WriteByte(c)
+			quantifiable = false
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+			quantifiable = true
+		}
+	}
+	if inSet {
+		return nil, fmt.Errorf("malformed pattern (missing ']')")
+	}
+	return regexp.Compile(b.String())
+}
+
+func jsonSchemaTypeMatches(typ string, value interface{}) bool {
+	switch typ {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+func jsonSchemaMatches(def gjson.Result, value interface{}) bool {
+	verr := newSchemaValidationError()
+	validateJSONSchemaValue(def, value, "", verr)
+	return verr.empty()
+}
+
+func jsonSchemaErr(def gjson.Result, fallback string) string {
+	if d := def.Get("description"); d.Exists() {
+		return d.String()
+	}
+	return fallback
+}
+
+func validateJSONSchemaValue(def gjson.Result, value interface{}, path string, verr *SchemaValidationError) {
+	if typ := def.Get("type"); typ.Exists() {
+		types := []string{typ.String()}
+		if typ.IsArray() {
+			types = readStringArray(typ)
+		}
+		matched := false
+		for _, t := range types {
+			if jsonSchemaTypeMatches(t, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			verr.addField(path, "expected a value of type "+strings.Join(types, " or "))
+			return
+		}
+	}
+	if c := def.Get("const"); c.Exists() && !reflect.DeepEqual(c.Value(), value) {
+		verr.addField(path, "value must be "+c.Raw)
+		return
+	}
+	if enum := def.Get("enum"); enum.Exists() {
+		found := false
+		for _, v := range enum.Array() {
+			if reflect.DeepEqual(v.Value(), value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			verr.addField(path, "expected one of: "+formatOneOf(enum.Array()))
+			return
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if minLen := def.Get("minLength"); minLen.Exists() && len(v) < int(minLen.Int()) {
+			verr.addField(path, fmt.Sprintf("length must be at least %d", minLen.Int()))
+			return
+		}
+		if maxLen := def.Get("maxLength"); maxLen.Exists() && len(v) > int(maxLen.Int()) {
+			verr.addField(path, fmt.Sprintf("length must be at most %d", maxLen.Int()))
+			return
+		}
+		if pattern := def.Get("pattern"); pattern.Exists() {
+			// Patterns which can't be compiled by Go (e.g. lookarounds) are skipped.
+			if re, err := regexp.Compile(pattern.String()); err == nil && !re.MatchString(v) {
+				verr.addField(path, jsonSchemaErr(def, "invalid value: "+v))
+				return
+			}
+		}
+	case float64:
+		if m := def.Get("minimum"); m.Exists() && v < m.Float() {
+			verr.addField(path, fmt.Sprintf("value must be at least %s", m.Raw))
+			return
+		}
+		if m := def.Get("maximum"); m.Exists() && v > m.Float() {
+			verr.addField(path, fmt.Sprintf("value must be at most %s", m.Raw))
+			return
+		}
+		if m := def.Get("exclusiveMinimum"); m.Exists() && v <= m.Float() {
+			verr.addField(path, fmt.Sprintf("value must be greater than %s", m.Raw))
+			return
+		}
+		if m := def.Get("exclusiveMaximum"); m.Exists() && v >= m.Float() {
+			verr.addField(path, fmt.Sprintf("value must be less than %s", m.Raw))
+			return
+		}
+	case []interface{}:
+		if m := def.Get("minItems"); m.Exists() && len(v) < int(m.Int()) {
+			verr.addField(path, fmt.Sprintf("length must be at least %d", m.Int()))
+			return
+		}
+		if m := def.Get("maxItems"); m.Exists() && len(v) > int(m.Int()) {
+			verr.addField(path, fmt.Sprintf("length must be at most %d", m.Int()))
+			return
+		}
+		if def.Get("uniqueItems").Bool() {
+			for i := range v {
+				for j := i + 1; j < len(v); j++ {
+					if reflect.DeepEqual(v[i], v[j]) {
+						verr.addField(path, "items must be unique")
+						return
+					}
+				}
+			}
+		}
+		if contains := def.Get("contains"); contains.Exists() {
+			found := false
+			for _, el := range v {
+				if jsonSchemaMatches(contains, el) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				verr.addField(path, jsonSchemaErr(contains, "no item matches the expected schema"))
+				return
+			}
+		}
+		if items := def.Get("items"); items.Exists() {
+			for i, el := range v {
+				validateJSONSchemaValue(items, el, fmt.Sprintf("%s[%d]", path, i), verr)
+			}
+		}
+	case map[string]interface{}:
+		validateJSONSchemaObject(def, v, path, verr)
+	}
+
+	for _, sub := range def.Get("allOf").Array() {
+		if ifSchema := sub.Get("if"); ifSchema.Exists() {
+			branch := sub.Get("else")
+			if jsonSchemaMatches(ifSchema, value) {
+				branch = sub.Get("then")
+			}
+			if branch.Exists() && !jsonSchemaMatches(branch, value) {
+				verr.addField(path, jsonSchemaErr(sub, "conditional schema check failed"))
+			}
+			continue
+		}
+		validateJSONSchemaValue(sub, value, path, verr)
+	}
+	if anyOf := def.Get("anyOf"); anyOf.Exists() {
+		matched := false
+		for _, sub := range anyOf.Array() {
+			if jsonSchemaMatches(sub, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			verr.addField(path, jsonSchemaErr(def, "value does not match any of the allowed schemas"))
+		}
+	}
+	if oneOf := def.Get("oneOf"); oneOf.Exists() {
+		n := 0
+		for _, sub := range oneOf.Array() {
+			if jsonSchemaMatches(sub, value) {
+				n++
+			}
+		}
+		if n != 1 {
+			verr.addField(path, jsonSchemaErr(def, "value must match exactly one of the allowed schemas"))
+		}
+	}
+	if not := def.Get("not"); not.Exists() && jsonSchemaMatches(not, value) {
+		verr.addField(path, jsonSchemaErr(not, "value is not allowed"))
+	}
+}
+
+func validateJSONSchemaObject(def gjson.Result, obj map[string]interface{}, path string, verr *SchemaValidationError) {
+	properties := def.Get("properties")
+	for _, name := range readStringArray(def.Get("required")) {
+		if obj[name] != nil {
+			continue
+		}
+		if properties.Get(gjson.Escape(name) + ".default").Exists() {
+			continue
+		}
+		verr.addField(joinFieldPath(path, name), "required field missing")
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	additional := def.Get("additionalProperties")
+	for _, name := range names {
+		fieldPath := joinFieldPath(path, name)
+		prop := properties.Get(gjson.Escape(name))
+		if !prop.Exists() {
+			switch {
+			case additional.IsObject():
+				validateJSONSchemaValue(additional, obj[name], fieldPath, verr)
+			case additional.Exists() && !additional.Bool():
+				verr.addField(fieldPath, "unknown field")
+			}
+			continue
+		}
+		if obj[name] == nil {
+			continue
+		}
+		validateJSONSchemaValue(prop, obj[name], fieldPath, verr)
+	}
+}
@@ -0,0 +1,430 @@
+package kong
+
+import (
+	"encoding/json"
+	"io/fs"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func mustBundledSchema(t *testing.T, version, entity string) Schema {
+	t.Helper()
+	schema, err := BundledSchema(MustNewVersion(version), entity)
+	require.NoError(t, err)
+	return schema
+}
+
+func mustBundledPluginSchema(t *testing.T, version, name string) Schema {
+	t.Helper()
+	schema, err := BundledPluginSchema(MustNewVersion(version), name)
+	require.NoError(t, err)
+	return schema
+}
+
+func requireSchemaViolation(t *testing.T, err error) *SchemaValidationError {
+	t.Helper()
+	require.Error(t, err)
+	var verr *SchemaValidationError
+	require.ErrorAs(t, err, &verr)
+	return verr
+}
+
+func TestBundledSchema(t *testing.T) {
+	versions := BundledSchemaVersions()
+	require.NotEmpty(t, versions)
+	for i := 1; i < len(versions); i++ {
+		assert.True(t, versions[i-1].version.LT(versions[i].version), "versions must be sorted")
+	}
+
+	s34, err := BundledSchema(MustNewVersion("3.5.0"), "plugins")
+	require.NoError(t, err)
+	s310, err := BundledSchema(MustNewVersion("3.12.1"), "plugins")
+	require.NoError(t, err)
+	assert.NotEqual(t, s34, s310, "3.5 must resolve to the 3.4 snapshot and 3.12 to the 3.10 one")
+
+	_, err = BundledSchema(MustNewVersion("2.8.0"), "services")
+	assert.Error(t, err)
+	_, err = BundledSchema(MustNewVersion("3.10.0"), "unknown_entities")
+	assert.Error(t, err)
+	_, err = BundledPluginSchema(MustNewVersion("3.10.0"), "rate-limiting")
+	assert.NoError(t, err)
+
+	entities, err := BundledSchemaEntities(MustNewVersion("3.10.0"))
+	require.NoError(t, err)
+	assert.Contains(t, entities, "services")
+	assert.Contains(t, entities, "routes")
+	plugins, err := BundledSchemaPlugins(MustNewVersion("3.10.0"))
+	require.NoError(t, err)
+	assert.Contains(t, plugins, "rate-limiting")
+}
+
+func TestValidateEntity_LuaSchema(t *testing.T) {
+	for _, version := range []string{"3.4.0", "3.10.0"} {
+		t.Run(version, func(t *testing.T) {
+			services := mustBundledSchema(t, version, "services")
+			routes := mustBundledSchema(t, version, "routes")
+
+			require.NoError(t, ValidateEntity(&Service{
+				Name: String("svc"),
+				Host: String("example.com"),
+				Port: Int(8080),
+				Path: String("/foo"),
+				Tags: StringSlice("a", "b"),
+			}, services))
+
+			verr := requireSchemaViolation(t, ValidateEntity(&Service{Name: String("svc")}, services))
+			assert.Equal(t, map[string]string{"host": "required field missing"}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Service{
+				Host:     String("example.com"),
+				Port:     Int(70000),
+				Protocol: String("ftp"),
+				Path:     String("foo"),
+				Tags:     StringSlice(" leading"),
+			}, services))
+			assert.Equal(t, map[string]string{
+				"port":     "value should be between 0 and 65535",
+				"protocol": "expected one of: grpc, grpcs, http, https, tcp, tls, tls_passthrough, udp, ws, wss",
+				"path":     "should start with: /",
+				"tags[0]":  "must not begin with whitespace",
+			}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Service{
+				Host:      String("example.com"),
+				TLSVerify: Bool(true),
+			}, services))
+			assert.Equal(t, map[string]string{
+				"tls_verify": "tls_verify can be set only when protocol is `https`",
+			}, verr.Fields)
+
+			require.NoError(t, ValidateEntity(&Route{
+				Name:    String("r"),
+				Paths:   StringSlice("/foo", "~/bar$"),
+				Service: &Service{ID: String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab")},
+			}, routes))
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Route{
+				Name:  String("r"),
+				Paths: StringSlice("foo", "/a//b"),
+			}, routes))
+			assert.Equal(t, map[string]string{
+				"paths[0]": "should start with: / (fixed path) or ~/ (regex path)",
+				"paths[1]": "must not have empty segments",
+			}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Route{Name: String("r")}, routes))
+			assert.Equal(t, []string{
+				"must set one of 'methods', 'hosts', 'headers', 'paths' when 'protocols' is 'http'",
+				"must set one of 'methods', 'hosts', 'headers', 'paths', 'snis' when 'protocols' is 'https'",
+			}, verr.Entity)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Route{
+				Protocols: StringSlice("http"),
+				Paths:     StringSlice("/"),
+				SNIs:      StringSlice("example.com"),
+			}, routes))
+			assert.Equal(t, map[string]string{
+				"snis": "'snis' can be set only when protocols has one of 'https', 'grpcs', 'tls' or 'tls_passthrough'",
+			}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(map[string]interface{}{
+				"username": "u",
+				"nickname": "n",
+			}, mustBundledSchema(t, version, "consumers")))
+			assert.Equal(t, map[string]string{"nickname": "unknown field"}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Consumer{}, mustBundledSchema(t, version, "consumers")))
+			assert.Equal(t, []string{
+				"at least one of these fields must be non-empty: 'custom_id', 'username'",
+			}, verr.Entity)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Key{
+				KID: String("kid"),
+				JWK: String("{}"),
+				PEM: &PEM{PublicKey: String("pub")},
+			}, mustBundledSchema(t, version, "keys")))
+			assert.Equal(t, []string{
+				"only one or none of these fields must be set: 'jwk', 'pem'",
+			}, verr.Entity)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Certificate{
+				Cert:    String("cert"),
+				Key:     String("key"),
+				CertAlt: String("cert"),
+			}, mustBundledSchema(t, version, "certificates")))
+			assert.Equal(t, []string{
+				"all or none of these fields must be set: 'cert_alt', 'key_alt'",
+			}, verr.Entity)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Upstream{
+				Name:   String("u"),
+				HashOn: String("header"),
+				Healthchecks: &Healthcheck{
+					Active: &ActiveHealthcheck{Concurrency: Int(0)},
+				},
+			}, mustBundledSchema(t, version, "upstreams")))
+			assert.Equal(t, map[string]string{
+				"hash_on_header":                  "required field missing",
+				"healthchecks.active.concurrency": "value should be between 1 and 2147483648",
+			}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Target{
+				Target: String("10.0.0.1:80"),
+				Weight: Int(-1),
+			}, mustBundledSchema(t, version, "targets")))
+			assert.Equal(t, map[string]string{
+				"upstream": "required field missing",
+				"weight":   "value should be between 0 and 65535",
+			}, verr.Fields)
+		})
+	}
+}
+
+func TestValidateEntity_ServiceURL(t *testing.T) {
+	for _, version := range []string{"3.4.0", "3.10.0"} {
+		t.Run(version, func(t *testing.T) {
+			services := mustBundledSchema(t, version, "services")
+
+			require.NoError(t, ValidateEntity(&Service{Name: String("a"), URL: String("http://x")}, services))
+			require.NoError(t, ValidateEntity(&Service{
+				Name: String("a"),
+				URL:  String("https://example.com:8443/api"),
+			}, services))
+
+			verr := requireSchemaViolation(t, ValidateEntity(&Service{
+				Name: String("a"),
+				URL:  String("ftp://example.com:70000//api"),
+			}, services))
+			assert.Equal(t, map[string]string{
+				"protocol": "expected one of: grpc, grpcs, http, https, tcp, tls, tls_passthrough, udp, ws, wss",
+				"port":     "value should be between 0 and 65535",
+				"path":     "must not have empty segments",
+			}, verr.Fields)
+
+			verr = requireSchemaViolation(t, ValidateEntity(&Service{Name: String("a"), URL: String("/api")}, services))
+			assert.Equal(t, map[string]string{"host": "required field missing"}, verr.Fields)
+
+			// As in Kong, the url overrides the fields it sets, even with
+			// the values it lacks.
+			verr = requireSchemaViolation(t, ValidateEntity(&Service{
+				Name: String("a"),
+				Host: String("example.com"),
+				URL:  String("http:///api"),
+			}, services))
+			assert.Equal(t, map[string]string{"host": "required field missing"}, verr.Fields)
+		})
+	}
+
+	b, err := json.Marshal(mustBundledSchema(t, "3.10.0", "services"))
+	require.NoError(t, err)
+	record := gjson.ParseBytes(b)
+	verr := newSchemaValidationError()
+	obj := map[string]interface{}{"url": "wss://example.com"}
+	assert.Equal(t, map[string]interface{}{
+		"url":      "wss://example.com",
+		"protocol": "wss",
+		"host":     "example.com",
+		"port":     float64(443),
+		"path":     nil,
+	}, translateLuaShorthands("services", record, obj, verr))
+	// Only the url shorthand of services is translated.
+	assert.Equal(t, obj, translateLuaShorthands("upstreams", record, obj, verr))
+	assert.True(t, verr.empty())
+}
+
+func TestValidatePlugin(t *testing.T) {
+	entity := mustBundledSchema(t, "3.10.0", "plugins")
+	rateLimiting := mustBundledPluginSchema(t, "3.10.0", "rate-limiting")
+
+	require.NoError(t, ValidatePlugin(&Plugin{
+		Name:    String("rate-limiting"),
+		Enabled: Bool(true),
+		Config: Configuration{
+			"minute": 10,
+			"policy": "redis",
+			"redis": map[string]interface{}{
+				"host": "localhost",
+				"port": 6379,
+			},
+		},
+	}, entity, rateLimiting))
+
+	// Deprecated shorthand fields are accepted.
+	require.NoError(t, ValidatePlugin(&Plugin{
+		Name:   String("rate-limiting"),
+		Config: Configuration{"minute": 10, "redis_host": "localhost"},
+	}, entity, rateLimiting))
+
+	verr := requireSchemaViolation(t, ValidatePlugin(&Plugin{
+		Name:   String("rate-limiting"),
+		Config: Configuration{"policy": "redis", "limit_by": "path", "minutes": 1},
+	}, entity, rateLimiting))
+	assert.Equal(t, map[string]string{
+		"config": "at least one of these fields must be non-empty: " +
+			"'second', 'minute', 'hour', 'day', 'month', 'year'",
+		"config.minutes":    "unknown field",
+		"config.path":       "required field missing",
+		"config.redis.host": "required field missing",
+	}, verr.Fields)
+
+	verr = requireSchemaViolation(t, ValidatePlugin(&Plugin{
+		Name:     String("cors"),
+		Consumer: &Consumer{ID: String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab")},
+		Config:   Configuration{"methods": []interface{}{"GET", "FETCH"}},
+	}, entity, mustBundledPluginSchema(t, "3.10.0", "cors")))
+	assert.Equal(t, map[string]string{
+		"consumer":          "value must be null",
+		"config.methods[1]": "expected one of: GET, HEAD, PUT, PATCH, POST, DELETE, OPTIONS, TRACE, CONNECT",
+	}, verr.Fields)
+
+	verr = requireSchemaViolation(t, ValidatePlugin(&Plugin{
+		Name:   String("acl"),
+		Config: Configuration{"allow": []interface{}{"a"}, "deny": []interface{}{"b"}},
+	}, entity, mustBundledPluginSchema(t, "3.10.0", "acl")))
+	assert.Equal(t, map[string]string{
+		"config": "exactly one of these fields must be non-empty: 'allow', 'deny'",
+	}, verr.Fields)
+
+	assert.Error(t, ValidatePlugin(nil, entity, rateLimiting))
+	assert.Error(t, ValidatePlugin(&Plugin{}, entity, nil))
+}
+
+func TestValidateEntity_JSONSchema(t *testing.T) {
+	services := getJSONSchemaFromFile(t, "testdata/serviceJSONSchema.json")
+	routes := getJSONSchemaFromFile(t, "testdata/routeJSONSchema.json")
+
+	require.NoError(t, ValidateEntity(&Service{
+		ID:   String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab"),
+		Host: String("example.com"),
+	}, services))
+
+	verr := requireSchemaViolation(t, ValidateEntity(&Service{
+		ID:       String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab"),
+		Protocol: String("ftp"),
+		Port:     Int(70000),
+	}, services))
+	assert.Equal(t, "required field missing", verr.Fields["host"])
+	assert.Contains(t, verr.Fields, "protocol")
+	assert.Contains(t, verr.Fields, "port")
+
+	verr = requireSchemaViolation(t, ValidateEntity(&Service{
+		ID:        String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab"),
+		Host:      String("example.com"),
+		TLSVerify: Bool(true),
+	}, services))
+	assert.Equal(t, []string{"tls_verify can be set only when protocol is `https`"}, verr.Entity)
+
+	require.NoError(t, ValidateEntity(&Route{
+		ID:        String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab"),
+		Protocols: StringSlice("http", "https"),
+		Paths:     StringSlice("/foo"),
+	}, routes))
+
+	verr = requireSchemaViolation(t, ValidateEntity(&Route{
+		ID:        String("5d5b6b1e-1a7b-4a8e-9a4e-0bd0a4b9a1ab"),
+		Protocols: StringSlice("http"),
+		Paths:     StringSlice("/a//b"),
+		SNIs:      StringSlice("example.com"),
+	}, routes))
+	assert.Contains(t, verr.Fields, "paths[0]")
+	assert.Contains(t, verr.Entity,
+		"'snis' can be set only when protocols has one of 'https', 'grpcs', 'tls' or 'tls_passthrough'")
+}
+
+func TestValidateEntity_Errors(t *testing.T) {
+	assert.Error(t, ValidateEntity(&Service{}, nil))
+	assert.Error(t, ValidateEntity(&Service{}, Schema{"type": "object"}))
+	assert.Error(t, ValidateEntity([]string{"not", "an", "object"}, Schema{"fields": []interface{}{}}))
+
+	err := ValidateEntity(map[string]interface{}{"a": 1}, Schema{"fields": []interface{}{}})
+	require.Error(t, err)
+	assert.Equal(t, "schema violation (a: unknown field)", err.Error())
+}
+
+func TestLuaPatternToRegexp(t *testing.T) {
+	tests := []struct {
+		pattern string
+		matches []string
+		fails   []string
+	}{
+		{pattern: "^%u+$", matches: []string{"GET"}, fails: []string{"get", "GET1", ""}},
+		{pattern: "^[%w%.%-%_]+$", matches: []string{"a.b-c_d"}, fails: []string{"a b", "a/b"}},
+		{pattern: "^[^:]+:?%d*$", matches: []string{"example.com", "example.com:80"}, fails: []string{"example.com:http"}},
+		{pattern: "^[a-z][a-z%d-]-[a-z%d]+$", matches: []string{"my-vault", "ab"}, fails: []string{"My-vault", "-a"}},
+		{pattern: "%s$", matches: []string{"a "}, fails: []string{"a"}},
+		{pattern: "a.-b", matches: []string{"axxb", "ab"}, fails: []string{"ba"}},
+		{pattern: "1+1", matches: []string{"11"}, fails: []string{"2"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := luaPatternToRegexp(tc.pattern)
+			require.NoError(t, err)
+			for _, s := range tc.matches {
+				assert.True(t, re.MatchString(s), "%q should match %q", tc.pattern, s)
+			}
+			for _, s := range tc.fails {
+				assert.False(t, re.MatchString(s), "%q should not match %q", tc.pattern, s)
+			}
+		})
+	}
+
+	for _, p := range []string{"%bxy", "%f[%w]", "[abc", "abc%"} {
+		_, err := luaPatternToRegexp(p)
+		assert.Error(t, err, p)
+	}
+}
+
+func TestValidateVault(t *testing.T) {
+	entity := mustBundledSchema(t, "3.10.0", "vaults")
+	hcv, err := BundledVaultSchema(MustNewVersion("3.10.0"), "hcv")
+	require.NoError(t, err)
+
+	require.NoError(t, ValidateVault(&Vault{
+		Name:   String("hcv"),
+		Prefix: String("my-vault"),
+		Config: Configuration{
+			"host":  "vault.example.com",
+			"kv":    "v2",
+			"token": "{vault://env/hcv-token}",
+		},
+	}, entity, hcv))
+
+	verr := requireSchemaViolation(t, ValidateVault(&Vault{
+		Name:   String("hcv"),
+		Prefix: String("env"),
+		Config: Configuration{"kv": "v3", "port": 70000, "unknown": true},
+	}, entity, hcv))
+	assert.Equal(t, map[string]string{
+		"prefix":         "must not be one of: env, aws, gcp, hcv, azure, conjur",
+		"config.kv":      "expected one of: v1, v2",
+		"config.port":    "value should be between 0 and 65535",
+		"config.unknown": "unknown field",
+	}, verr.Fields)
+
+	vaults, err := BundledSchemaVaults(MustNewVersion("3.10.0"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aws", "azure", "conjur", "env", "gcp", "hcv"}, vaults)
+	_, err = BundledVaultSchema(MustNewVersion("3.9.1"), "hcv")
+	assert.EqualError(t, err, `no bundled vault schemas for Kong 3.9.1 (requires >=3.10.0)`)
+	_, err = BundledSchemaVaults(MustNewVersion("3.4.0"))
+	assert.EqualError(t, err, `no bundled vault schemas for Kong 3.4.0 (requires >=3.10.0)`)
+}
+
+func TestBundledVaultSchemasRange(t *testing.T) {
+	inRange := MustNewRange(bundledVaultSchemasRange)
+	for _, version := range BundledSchemaVersions() {
+		dir, err := bundledSchemaDir(version)
+		require.NoError(t, err)
+		entries, err := fs.ReadDir(bundledSchemas, path.Join(dir, "vaults"))
+		if inRange(version) {
+			require.NoError(t, err, "Kong %s is in %s but has no vault schemas", version, bundledVaultSchemasRange)
+			assert.NotEmpty(t, entries)
+		} else {
+			assert.Error(t, err, "Kong %s has vault schemas, update bundledVaultSchemasRange", version)
+		}
+	}
+}
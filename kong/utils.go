@@ -833,26 +833,17 @@ func getDefaultPartialPath(partialLinks []*PartialLink, pluginSchema gjson.Resul
 		return fmt.Errorf("schema does not contain supported_partials")
 	}
 
-	// internal function to find a partial by its ID
-	// this is useful to get partial's type for finding
-	// its default path in the config
-	findPartialByID := func(partialID string) *Partial {
-		for _, p := range partials {
-			if *p.ID == partialID {
-				return p
-			}
-		}
-		return nil
-	}
-
 	for _, p := range partialLinks {
 		if p.Path != nil {
 			continue
 		}
 
-		partialNeeded := findPartialByID(*p.ID)
-		if partialNeeded == nil {
-			return fmt.Errorf("partial with ID %s not found", *p.ID)
+		partialNeeded, err := findLinkedPartial(p, partials)
+		if err != nil {
+			return err
+		}
+		if partialNeeded.Type == nil {
+			return fmt.Errorf("partial %s has no type to find its default path", partialLinkRef(p))
 		}
 
 		defaultPartialPathInSchema := supportedPartials.Get(*partialNeeded.Type)
@@ -954,25 +945,49 @@ func setConfigValue(config Configuration, path string, configValue interface{})
 	return nil
 }
 
-func fillPartialConfigsInPlugin(plugin *Plugin, partials []*Partial) error {
-	// internal function to find a partial by its ID
-	// this is useful to get partial's type for finding
-	// its default path in the config
-	findPartialByID := func(partialID string) *Partial {
-		for _, p := range partials {
-			if *p.ID == partialID {
-				return p
+// findLinkedPartial returns the partial of partials that link references, by
+// ID or, for links without one, by name.
+func findLinkedPartial(link *PartialLink, partials []*Partial) (*Partial, error) {
+	if link == nil || link.Partial == nil || (link.ID == nil && link.Name == nil) {
+		return nil, fmt.Errorf("partial link has neither an ID nor a name")
+	}
+	for _, p := range partials {
+		if p == nil {
+			continue
+		}
+		if link.ID != nil {
+			if p.ID != nil && *p.ID == *link.ID {
+				return p, nil
 			}
+		} else if p.Name != nil && *p.Name == *link.Name {
+			return p, nil
 		}
-		return nil
 	}
+	if link.ID != nil {
+		return nil, fmt.Errorf("partial with ID %s not found", *link.ID)
+	}
+	return nil, fmt.Errorf("partial with name %s not found", *link.Name)
+}
+
+// partialLinkRef returns the ID, or else the name, link references a partial
+// by, to describe it in errors.
+func partialLinkRef(link *PartialLink) string {
+	if link.ID != nil {
+		return *link.ID
+	}
+	return *link.Name
+}
 
+func fillPartialConfigsInPlugin(plugin *Plugin, partials []*Partial) error {
 	const configString string = "config."
 
 	for _, partialLink := range plugin.Partials {
-		partialNeeded := findPartialByID(*partialLink.ID)
-		if partialNeeded == nil {
-			return fmt.Errorf("partial with ID %s not found", *partialLink.ID)
+		partialNeeded, err := findLinkedPartial(partialLink, partials)
+		if err != nil {
+			return err
+		}
+		if partialLink.Path == nil {
+			return fmt.Errorf("partial %s has no path", partialLinkRef(partialLink))
 		}
 		sanitisedPath := strings.ReplaceAll(*partialLink.Path, configString, "")
 		partialAppendsRequired := strings.HasSuffix(sanitisedPath, "[]")
@@ -1366,6 +1381,222 @@ func FillPartialDefaults(partial *Partial, schema Schema) error {
 	return nil
 }
 
+// StripEntityDefaults removes from entity the fields equal to their default
+// in its schema. It is the inverse of FillEntityDefaults, and supports the
+// same entities and schemas.
+func StripEntityDefaults(entity interface{}, schema Schema) error {
+	if schema == nil {
+		return fmt.Errorf("stripping defaults for '%T': provided schema is nil", entity)
+	}
+	switch entity.(type) {
+	case *Target, *Service, *Route, *Upstream, *ConsumerGroupPlugin:
+	default:
+		return fmt.Errorf("unsupported entity: '%T'", entity)
+	}
+	defaults, err := getDefaultsObj(schema)
+	if err != nil {
+		return fmt.Errorf("parse schema for defaults: %w", err)
+	}
+	var defaultsMap map[string]interface{}
+	if err := json.Unmarshal(defaults, &defaultsMap); err != nil {
+		return fmt.Errorf("unmarshal defaults: %w", err)
+	}
+	jsonb, err := json.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("marshal entity: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(jsonb, &obj); err != nil {
+		return fmt.Errorf("unmarshal entity: %w", err)
+	}
+	stripDefaultsObj(obj, defaultsMap)
+	if jsonb, err = json.Marshal(obj); err != nil {
+		return fmt.Errorf("marshal entity without defaults: %w", err)
+	}
+	// Decode into a zero value, so that the stripped fields are unset.
+	stripped := reflect.New(reflect.TypeOf(entity).Elem())
+	if err := json.Unmarshal(jsonb, stripped.Interface()); err != nil {
+		return fmt.Errorf("unmarshal entity without defaults: %w", err)
+	}
+	reflect.ValueOf(entity).Elem().Set(stripped.Elem())
+	return nil
+}
+
+// stripDefaultsObj removes from obj the fields equal to their value in
+// defaults, as returned by flattenDefaultsSchema. Records left empty are
+// removed too.
+func stripDefaultsObj(obj map[string]interface{}, defaults map[string]interface{}) {
+	for name, value := range obj {
+		defaultValue, ok := defaults[name]
+		if !ok {
+			continue
+		}
+		if record, ok := defaultValue.(map[string]interface{}); ok {
+			if sub, ok := value.(map[string]interface{}); ok {
+				stripDefaultsObj(sub, record)
+				if len(sub) == 0 {
+					delete(obj, name)
+				}
+			} else if value == nil {
+				delete(obj, name)
+			}
+			continue
+		}
+		if reflect.DeepEqual(value, defaultValue) {
+			delete(obj, name)
+		}
+	}
+}
+
+// stripConfigRecord removes from config the fields of the record described
+// by schema equal to their default, recursively. It is the inverse of
+// fillConfigRecord.
+func stripConfigRecord(schema gjson.Result, config map[string]interface{}) {
+	defaultRecordValue := schema.Get("default")
+	schema.Get("fields").ForEach(func(_, field gjson.Result) bool {
+		for fname, def := range field.Map() {
+			value, ok := config[fname]
+			if !ok {
+				continue
+			}
+			// Fields without a default are filled with an explicit nil.
+			defaultValue := def.Get("default")
+			if defaultRecordValue.Exists() && defaultRecordValue.Get(fname).Exists() {
+				defaultValue = defaultRecordValue.Get(fname)
+			}
+			if reflect.DeepEqual(value, defaultValue.Value()) {
+				delete(config, fname)
+				continue
+			}
+
+			switch def.Get("type").String() {
+			case "record":
+				if subConfig, ok := value.(map[string]interface{}); ok {
+					stripConfigRecord(def, subConfig)
+					if len(subConfig) == 0 {
+						delete(config, fname)
+					}
+				}
+			case "array", "set":
+				if def.Get("elements.type").String() != "record" {
+					continue
+				}
+				records, _ := value.([]interface{})
+				for _, record := range records {
+					if recordMap, ok := record.(map[string]interface{}); ok {
+						stripConfigRecord(def.Get("elements"), recordMap)
+					}
+				}
+			}
+		}
+		return true
+	})
+}
+
+// stripPartialConfigsInPlugin removes from the configuration of plugin the
+// configurations provided by partials. It is the inverse of
+// fillPartialConfigsInPlugin.
+func stripPartialConfigsInPlugin(plugin *Plugin, partials []*Partial) error {
+	for _, partialLink := range plugin.Partials {
+		partial, err := findLinkedPartial(partialLink, partials)
+		if err != nil {
+			return err
+		}
+		if partialLink.Path == nil {
+			return fmt.Errorf("partial %s has no path", partialLinkRef(partialLink))
+		}
+		path := strings.TrimSuffix(strings.ReplaceAll(*partialLink.Path, "config.", ""), "[]")
+		pathParts := strings.Split(path, ".")
+		if !strings.HasSuffix(*partialLink.Path, "[]") {
+			// Gateway does not support overrides yet, the whole value comes
+			// from the partial.
+			deleteAndCollapseMap(plugin.Config, pathParts)
+			continue
+		}
+
+		array, ok := configValue(plugin.Config, path).([]interface{})
+		if !ok {
+			continue
+		}
+		partialConfig := partial.Config.DeepCopy()
+		remaining := make([]interface{}, 0, len(array))
+		for _, item := range array {
+			if !reflect.DeepEqual(item, map[string]interface{}(partialConfig)) {
+				remaining = append(remaining, item)
+			}
+		}
+		if len(remaining) == 0 {
+			deleteAndCollapseMap(plugin.Config, pathParts)
+			continue
+		}
+		if err := setConfigValue(plugin.Config, path, remaining); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stripConfigRecordDefaults(plugin *Plugin, schema Schema, partials []*Partial) error {
+	jsonb, err := json.Marshal(&schema)
+	if err != nil {
+		return err
+	}
+	gjsonSchema := gjson.ParseBytes(jsonb)
+	configSchema, err := getConfigSchema(gjsonSchema)
+	if err != nil {
+		return err
+	}
+
+	// Values are compared to the defaults in their JSON representation.
+	plugin.Config = plugin.Config.DeepCopy()
+	if len(plugin.Partials) > 0 && len(partials) > 0 {
+		// Resolve the default paths on a copy, to leave the links untouched.
+		links := make([]*PartialLink, len(plugin.Partials))
+		for i, link := range plugin.Partials {
+			links[i] = link.DeepCopy()
+		}
+		if err := getDefaultPartialPath(links, gjsonSchema, partials); err != nil {
+			return err
+		}
+		withPaths := &Plugin{Config: plugin.Config, Partials: links}
+		if err := stripPartialConfigsInPlugin(withPaths, partials); err != nil {
+			return err
+		}
+	}
+	if plugin.Config != nil {
+		stripConfigRecord(configSchema, plugin.Config)
+		if len(plugin.Config) == 0 {
+			plugin.Config = nil
+		}
+	}
+
+	if plugin.Protocols != nil &&
+		stringArrayToString(plugin.Protocols) == stringArrayToString(getDefaultProtocols(gjsonSchema)) {
+		plugin.Protocols = nil
+	}
+	if plugin.Enabled != nil && *plugin.Enabled {
+		plugin.Enabled = nil
+	}
+	return nil
+}
+
+// StripPluginDefaults removes from plugin the values equal to their default
+// in its schema, recursively, along with the default protocols and enabled
+// flag. It is the inverse of FillPluginsDefaults.
+// Takes in a plugin struct and mutates it in place.
+func StripPluginDefaults(plugin *Plugin, schema Schema) error {
+	return stripConfigRecordDefaults(plugin, schema, nil)
+}
+
+// StripPluginDefaultsWithPartials removes from plugin the values equal to
+// their default in its schema, as StripPluginDefaults does, and the
+// configuration provided by the partials linked to the plugin.
+// It is the inverse of FillPluginsDefaultsWithPartials.
+// Takes in a plugin struct and mutates it in place.
+func StripPluginDefaultsWithPartials(plugin *Plugin, schema Schema, partials []*Partial) error {
+	return stripConfigRecordDefaults(plugin, schema, partials)
+}
+
 func StringValue(s *string) string {
 	if s == nil {
 		return ""
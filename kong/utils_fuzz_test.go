@@ -0,0 +1,361 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// pluginFixture is a bundled plugin schema the properties are checked on.
+type pluginFixture struct {
+	name   string
+	schema Schema
+	config gjson.Result
+}
+
+var (
+	pluginFixturesOnce sync.Once
+	pluginFixtures     []pluginFixture
+	pluginFixturesErr  error
+)
+
+// loadPluginFixtures returns the plugin schemas bundled for every version.
+func loadPluginFixtures(t testing.TB) []pluginFixture {
+	t.Helper()
+	pluginFixturesOnce.Do(func() {
+		for _, version := range BundledSchemaVersions() {
+			names, err := BundledSchemaPlugins(version)
+			if err != nil {
+				pluginFixturesErr = err
+				return
+			}
+			for _, name := range names {
+				schema, err := BundledPluginSchema(version, name)
+				if err != nil {
+					pluginFixturesErr = err
+					return
+				}
+				b, err := json.Marshal(schema)
+				if err != nil {
+					pluginFixturesErr = err
+					return
+				}
+				config, err := getConfigSchema(gjson.ParseBytes(b))
+				if err != nil {
+					pluginFixturesErr = fmt.Errorf("%s %s: %w", version, name, err)
+					return
+				}
+				pluginFixtures = append(pluginFixtures, pluginFixture{
+					name:   fmt.Sprintf("%d.%d/%s", version.Major(), version.Minor(), name),
+					schema: schema,
+					config: config,
+				})
+			}
+		}
+	})
+	require.NoError(t, pluginFixturesErr)
+	require.NotEmpty(t, pluginFixtures)
+	return pluginFixtures
+}
+
+// configGenerator generates configurations matching a record schema, its
+// choices being driven by a byte stream so that fuzzed inputs map to
+// configurations deterministically.
+type configGenerator struct {
+	data []byte
+}
+
+func (g *configGenerator) next() byte {
+	if len(g.data) == 0 {
+		return 0
+	}
+	b := g.data[0]
+	g.data = g.data[1:]
+	return b
+}
+
+// record generates a configuration for the record schema, setting some of
+// its fields and shorthand fields.
+func (g *configGenerator) record(schema gjson.Result, depth int) Configuration {
+	config := Configuration{}
+	for _, field := range schema.Get("fields").Array() {
+		name := luaFieldName(field)
+		if g.next()%3 != 0 {
+			continue
+		}
+		if v, ok := g.value(field.Get(name), depth); ok {
+			config[name] = v
+		}
+	}
+	for _, field := range schema.Get("shorthand_fields").Array() {
+		name := luaFieldName(field)
+		if g.next()%4 != 0 {
+			continue
+		}
+		if v, ok := g.value(field.Get(name), depth); ok {
+			config[name] = v
+		}
+	}
+	return config
+}
+
+func (g *configGenerator) value(def gjson.Result, depth int) (interface{}, bool) {
+	b := g.next()
+	if b%11 == 0 {
+		return nil, true
+	}
+	switch def.Get("type").String() {
+	case "string":
+		if oneOf := def.Get("one_of").Array(); len(oneOf) > 0 {
+			return oneOf[int(b)%len(oneOf)].String(), true
+		}
+		return fmt.Sprintf("value-%d", b), true
+	case "integer":
+		return float64(b), true
+	case "number":
+		return float64(b) / 2, true
+	case "boolean":
+		return b%2 == 0, true
+	case "array", "set":
+		elements := def.Get("elements")
+		if elements.Get("type").String() == "record" {
+			if depth > 3 {
+				return nil, false
+			}
+			values := []interface{}{}
+			for i := 0; i < int(b%3); i++ {
+				values = append(values, map[string]interface{}(g.record(elements, depth+1)))
+			}
+			return values, true
+		}
+		values := []interface{}{}
+		for i := 0; i < int(b%3); i++ {
+			v, ok := g.value(elements, depth+1)
+			if !ok || v == nil {
+				continue
+			}
+			values = append(values, v)
+		}
+		return values, true
+	case "record":
+		if depth > 3 {
+			return nil, false
+		}
+		return map[string]interface{}(g.record(def, depth+1)), true
+	case "map":
+		return map[string]interface{}{fmt.Sprintf("key-%d", b): fmt.Sprintf("value-%d", b)}, true
+	default:
+		return nil, false
+	}
+}
+
+// fillOptions derives the options to fill configurations with from b.
+func fillOptions(b byte) FillRecordOptions {
+	return FillRecordOptions{
+		FillDefaults: b&1 == 0,
+		FillAuto:     b&2 == 0,
+	}
+}
+
+// requirePreserved checks that every value set in input is left unchanged in
+// output, records and arrays of records being compared element by element.
+func requirePreserved(t testing.TB, input, output interface{}, path string) {
+	t.Helper()
+	switch input := input.(type) {
+	case map[string]interface{}:
+		out, ok := output.(map[string]interface{})
+		require.Truef(t, ok, "%s: record replaced with %#v", path, output)
+		for k, v := range input {
+			got, ok := out[k]
+			require.Truef(t, ok, "%s.%s: removed", path, k)
+			requirePreserved(t, v, got, path+"."+k)
+		}
+	case Configuration:
+		requirePreserved(t, map[string]interface{}(input), configurationMap(output), path)
+	case []interface{}:
+		out, ok := output.([]interface{})
+		require.Truef(t, ok, "%s: array replaced with %#v", path, output)
+		require.Lenf(t, out, len(input), "%s: array length changed", path)
+		for i := range input {
+			requirePreserved(t, input[i], out[i], fmt.Sprintf("%s[%d]", path, i))
+		}
+	default:
+		require.Truef(t, reflect.DeepEqual(input, output), "%s: %#v overwritten with %#v", path, input, output)
+	}
+}
+
+func configurationMap(v interface{}) interface{} {
+	if c, ok := v.(Configuration); ok {
+		return map[string]interface{}(c)
+	}
+	return v
+}
+
+// requireNoShorthandConflict checks, record by record, that filling didn't
+// set the shorthand fields absent from input, nor the fields replacing the
+// shorthand fields set in input.
+func requireNoShorthandConflict(
+	t testing.TB, schema gjson.Result, input, output map[string]interface{}, path string,
+) {
+	t.Helper()
+	for deprecated, replacements := range buildDeprecatedFieldWithReplacementsMap(schema.Get("shorthand_fields")) {
+		_, inInput := input[deprecated]
+		_, inOutput := output[deprecated]
+		require.Equalf(t, inInput, inOutput, "%s.%s: shorthand field set by filling", path, deprecated)
+		if !inInput {
+			continue
+		}
+		for _, replacement := range replacements {
+			if _, ok := traverseConfigMap(input, replacement); ok {
+				continue
+			}
+			_, ok := traverseConfigMap(output, replacement)
+			require.Falsef(t, ok, "%s.%s: set by filling along with its shorthand field %s",
+				path, strings.Join(replacement, "."), deprecated)
+		}
+	}
+	for _, field := range schema.Get("fields").Array() {
+		name := luaFieldName(field)
+		def := field.Get(name)
+		if def.Get("type").String() != "record" {
+			continue
+		}
+		in, _ := input[name].(map[string]interface{})
+		out, _ := output[name].(map[string]interface{})
+		if in != nil && out != nil {
+			requireNoShorthandConflict(t, def, in, out, path+"."+name)
+		}
+	}
+}
+
+// checkFillProperties checks the properties of filling the configuration
+// generated from data with the bundled plugin schema it selects.
+func checkFillProperties(t testing.TB, fixtures []pluginFixture, data []byte) {
+	t.Helper()
+	g := &configGenerator{data: data}
+	fixture := fixtures[int(g.next())%len(fixtures)]
+	opts := fillOptions(g.next())
+	input := g.record(fixture.config, 0)
+	original := input.DeepCopy()
+
+	once := fillConfigRecord(fixture.config, input, nil, opts)
+	twice := fillConfigRecord(fixture.config, once, nil, opts)
+
+	require.Equalf(t, original, input, "%s: input mutated", fixture.name)
+	require.Equalf(t, once, twice, "%s: filling is not idempotent", fixture.name)
+	requirePreserved(t, map[string]interface{}(input), map[string]interface{}(once), fixture.name)
+	requireNoShorthandConflict(t, fixture.config, input, once, fixture.name)
+
+	plugin := &Plugin{Name: String(fixture.name), Config: input.DeepCopy()}
+	require.NoError(t, FillPluginsDefaultsWithOpts(plugin, fixture.schema, opts))
+	require.Equalf(t, once, plugin.Config, "%s: FillPluginsDefaultsWithOpts differs from fillConfigRecord",
+		fixture.name)
+	require.NoError(t, FillPluginsDefaultsWithOpts(plugin, fixture.schema, opts))
+	require.Equalf(t, once, plugin.Config, "%s: filling the plugin is not idempotent", fixture.name)
+}
+
+// requireOnlyRemoved checks that output only differs from input by removed
+// fields.
+func requireOnlyRemoved(t testing.TB, input, output map[string]interface{}, path string) {
+	t.Helper()
+	for k, v := range output {
+		in, ok := input[k]
+		require.Truef(t, ok, "%s.%s: added", path, k)
+		inMap, inIsMap := in.(map[string]interface{})
+		outMap, outIsMap := v.(map[string]interface{})
+		if inIsMap && outIsMap {
+			requireOnlyRemoved(t, inMap, outMap, path+"."+k)
+			continue
+		}
+		require.Truef(t, reflect.DeepEqual(in, v), "%s.%s: %#v changed to %#v", path, k, in, v)
+	}
+}
+
+// checkClearUnmatchingDeprecationsProperties checks the properties of
+// ClearUnmatchingDeprecations on the configurations generated from data with
+// the bundled plugin schema it selects.
+func checkClearUnmatchingDeprecationsProperties(t testing.TB, fixtures []pluginFixture, data []byte) {
+	t.Helper()
+	g := &configGenerator{data: data}
+	fixture := fixtures[int(g.next())%len(fixtures)]
+	newConfig := g.record(fixture.config, 0)
+	oldConfig := g.record(fixture.config, 0)
+	newPlugin := &Plugin{Config: newConfig.DeepCopy()}
+	oldPlugin := &Plugin{Config: oldConfig.DeepCopy()}
+
+	require.NoError(t, ClearUnmatchingDeprecations(newPlugin, oldPlugin, fixture.schema))
+	requireOnlyRemoved(t, newConfig, newPlugin.Config, fixture.name+" new")
+	requireOnlyRemoved(t, oldConfig, oldPlugin.Config, fixture.name+" old")
+
+	// Kong sends shorthand fields back for compatibility, they must not
+	// show in the old configuration unless the new one has them too.
+	for deprecated := range buildDeprecatedFieldWithReplacementsMap(fixture.config.Get("shorthand_fields")) {
+		if _, ok := newPlugin.Config[deprecated]; !ok {
+			require.NotContainsf(t, oldPlugin.Config, deprecated, "%s: shorthand field left in the old config",
+				fixture.name)
+		}
+	}
+
+	clearedNew := newPlugin.Config.DeepCopy()
+	clearedOld := oldPlugin.Config.DeepCopy()
+	require.NoError(t, ClearUnmatchingDeprecations(newPlugin, oldPlugin, fixture.schema))
+	require.Equalf(t, clearedNew, newPlugin.Config, "%s: clearing the new config is not idempotent", fixture.name)
+	require.Equalf(t, clearedOld, oldPlugin.Config, "%s: clearing the old config is not idempotent", fixture.name)
+}
+
+// propertySeeds returns pseudo-random inputs for the property tests,
+// deterministic across runs.
+func propertySeeds(fixtures int) [][]byte {
+	r := rand.New(rand.NewSource(1)) //nolint:gosec
+	var seeds [][]byte
+	for i := 0; i < fixtures*16; i++ {
+		seed := make([]byte, 256)
+		r.Read(seed)
+		seed[0] = byte(i % fixtures)
+		seeds = append(seeds, seed)
+	}
+	return seeds
+}
+
+func TestFillPluginsDefaultsProperties(t *testing.T) {
+	fixtures := loadPluginFixtures(t)
+	for _, seed := range propertySeeds(len(fixtures)) {
+		checkFillProperties(t, fixtures, seed)
+	}
+}
+
+func TestClearUnmatchingDeprecationsProperties(t *testing.T) {
+	fixtures := loadPluginFixtures(t)
+	for _, seed := range propertySeeds(len(fixtures)) {
+		checkClearUnmatchingDeprecationsProperties(t, fixtures, seed)
+	}
+}
+
+func FuzzFillConfigRecord(f *testing.F) {
+	fixtures := loadPluginFixtures(f)
+	for i := range fixtures {
+		f.Add([]byte{byte(i), 0, 0, 0, 0})
+		f.Add([]byte{byte(i), 3, 0, 3, 0, 6, 0, 9})
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkFillProperties(t, fixtures, data)
+	})
+}
+
+func FuzzClearUnmatchingDeprecations(f *testing.F) {
+	fixtures := loadPluginFixtures(f)
+	for i := range fixtures {
+		f.Add([]byte{byte(i), 0, 0, 0, 0})
+		f.Add([]byte{byte(i), 1, 0, 4, 8, 0, 0, 4, 0})
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkClearUnmatchingDeprecationsProperties(t, fixtures, data)
+	})
+}
@@ -5309,3 +5309,145 @@ func Test_fillPartialConfigsInPlugin(t *testing.T) {
 		})
 	}
 }
+
+func TestStripEntityDefaults(t *testing.T) {
+	version := MustNewVersion("3.10.0")
+	serviceSchema, err := BundledSchema(version, "services")
+	require.NoError(t, err)
+
+	service := &Service{
+		Name: String("svc"),
+		Host: String("example.com"),
+		Port: Int(8080),
+		Tags: StringSlice("team:a"),
+	}
+	filled := service.DeepCopy()
+	require.NoError(t, FillEntityDefaults(filled, serviceSchema))
+	assert.Equal(t, "http", *filled.Protocol)
+	require.NoError(t, StripEntityDefaults(filled, serviceSchema))
+	assert.Equal(t, service, filled)
+
+	explicit := &Service{Host: String("example.com"), Port: Int(80), Retries: Int(5), Enabled: Bool(false)}
+	require.NoError(t, StripEntityDefaults(explicit, serviceSchema))
+	assert.Equal(t, &Service{Host: String("example.com"), Enabled: Bool(false)}, explicit)
+
+	upstreamSchema, err := BundledSchema(version, "upstreams")
+	require.NoError(t, err)
+	upstream := &Upstream{
+		Name: String("upstream"),
+		Healthchecks: &Healthcheck{Active: &ActiveHealthcheck{
+			Healthy: &Healthy{Interval: Int(5)},
+		}},
+	}
+	filledUpstream := upstream.DeepCopy()
+	require.NoError(t, FillEntityDefaults(filledUpstream, upstreamSchema))
+	assert.Equal(t, "round-robin", *filledUpstream.Algorithm)
+	require.NoError(t, StripEntityDefaults(filledUpstream, upstreamSchema))
+	assert.Equal(t, upstream, filledUpstream)
+
+	err = StripEntityDefaults(&Consumer{}, serviceSchema)
+	assert.EqualError(t, err, "unsupported entity: '*kong.Consumer'")
+	err = StripEntityDefaults(service, nil)
+	assert.EqualError(t, err, "stripping defaults for '*kong.Service': provided schema is nil")
+}
+
+func Test_StripPluginDefaults(t *testing.T) {
+	schema := mustBundledPluginSchema(t, "3.10.0", "rate-limiting")
+	plugin := &Plugin{
+		Name: String("rate-limiting"),
+		Config: Configuration{
+			"minute": 10,
+			"policy": "redis",
+			"redis":  map[string]interface{}{"host": "redis.example.com", "port": 6379},
+		},
+		Protocols: StringSlice("http", "https"),
+	}
+	require.NoError(t, FillPluginsDefaults(plugin, schema))
+	assert.Equal(t, "consumer", plugin.Config["limit_by"])
+
+	require.NoError(t, StripPluginDefaults(plugin, schema))
+	assert.Equal(t, &Plugin{
+		Name: String("rate-limiting"),
+		Config: Configuration{
+			"minute": float64(10),
+			"policy": "redis",
+			"redis":  map[string]interface{}{"host": "redis.example.com"},
+		},
+		Protocols: StringSlice("http", "https"),
+	}, plugin)
+
+	require.NoError(t, FillPluginsDefaults(plugin, schema))
+	plugin.Config["minute"] = nil
+	require.NoError(t, StripPluginDefaults(plugin, schema))
+	assert.Equal(t, Configuration{
+		"policy": "redis",
+		"redis":  map[string]interface{}{"host": "redis.example.com"},
+	}, plugin.Config)
+}
+
+func Test_StripPluginDefaults_ArrayOfRecords(t *testing.T) {
+	var schema Schema
+	require.NoError(t, json.Unmarshal([]byte(`{"fields": [{"config": {"type": "record", "fields": [
+		{"rules": {"type": "array", "elements": {"type": "record", "fields": [
+			{"limit": {"type": "integer", "default": 10}},
+			{"window": {"type": "integer", "default": 60}}
+		]}}},
+		{"limits": {"type": "record", "default": {"second": 1}, "fields": [
+			{"second": {"type": "integer"}}
+		]}}
+	]}}]}`), &schema))
+
+	plugin := &Plugin{
+		Name: String("custom"),
+		Config: Configuration{
+			"rules": []interface{}{
+				map[string]interface{}{"limit": 10, "window": 30},
+				map[string]interface{}{"limit": 5},
+			},
+			"limits": map[string]interface{}{"second": 1},
+		},
+	}
+	require.NoError(t, StripPluginDefaults(plugin, schema))
+	assert.Equal(t, Configuration{
+		"rules": []interface{}{
+			map[string]interface{}{"window": float64(30)},
+			map[string]interface{}{"limit": float64(5)},
+		},
+	}, plugin.Config)
+}
+
+func Test_StripPluginDefaultsWithPartials(t *testing.T) {
+	schema := mustPartialPluginSchema(t)
+	partials := []*Partial{
+		{ID: String("redis-id"), Type: String("redis-ee"), Config: Configuration{"host": "redis.example.com"}},
+		{ID: String("vault-id"), Type: String("vault-auth"), Config: Configuration{"name": "v"}},
+	}
+	plugin := &Plugin{
+		Name:   String("rate-limiting-advanced"),
+		Config: Configuration{"limit": 5, "vaults": []interface{}{"own"}},
+		Partials: []*PartialLink{
+			{Partial: &Partial{ID: String("redis-id")}},
+			{Partial: &Partial{ID: String("vault-id")}, Path: String("config.vaults[]")},
+		},
+	}
+	require.NoError(t, FillPluginsDefaultsWithPartials(plugin, schema, partials))
+	assert.Len(t, plugin.Config["vaults"], 2)
+	plugin.Partials[0].Path = nil
+
+	require.NoError(t, StripPluginDefaultsWithPartials(plugin, schema, partials))
+	assert.Equal(t, Configuration{"limit": float64(5), "vaults": []interface{}{"own"}}, plugin.Config)
+	assert.Nil(t, plugin.Partials[0].Path)
+
+	// Links without an ID reference their partial by name.
+	partials[0].Name = String("redis")
+	plugin.Config["redis"] = map[string]interface{}{"host": "redis.example.com"}
+	plugin.Partials[0].Partial = &Partial{Name: String("redis")}
+	require.NoError(t, StripPluginDefaultsWithPartials(plugin, schema, partials))
+	assert.Equal(t, Configuration{"limit": float64(5), "vaults": []interface{}{"own"}}, plugin.Config)
+
+	err := stripPartialConfigsInPlugin(&Plugin{Partials: []*PartialLink{{Path: String("config.redis")}}}, partials)
+	assert.EqualError(t, err, "partial link has neither an ID nor a name")
+	err = stripPartialConfigsInPlugin(&Plugin{Partials: []*PartialLink{{Partial: &Partial{Name: String("redis")}}}},
+		partials)
+	assert.EqualError(t, err, "partial redis has no path")
+}
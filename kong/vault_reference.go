@@ -0,0 +1,394 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	vaultReferenceStart = "{vault://"
+	vaultReferenceEnd   = "}"
+)
+
+// builtinVaultBackends holds the names of the vault backends shipped with
+// Kong, which can be referenced by name without creating a Vault.
+var builtinVaultBackends = []string{"env", "aws", "gcp", "hcv", "azure", "conjur"}
+
+// vaultPrefixRegex matches the prefixes of vaults.
+var vaultPrefixRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// VaultReference is a reference to a secret stored in a vault, as written in
+// the fields of entities:
+//
+//	{vault://<prefix>/<resource>[/<key>][?<query>][#<version>]}
+//
+// When the path after the prefix has several segments, the last one is the
+// key of the secret in the resource, which holds a JSON object. A trailing
+// slash marks paths without a key, e.g. {vault://hcv/path/to/secret/}.
+type VaultReference struct {
+	// Prefix is the prefix of a Vault, or the name of a built-in vault
+	// backend, e.g. "env".
+	Prefix   string
+	Resource string
+	Key      string
+	// Query overrides the configuration of the vault, e.g. region=eu-west-1.
+	Query url.Values
+	// Version is the version of the secret, 0 for the latest one.
+	Version int
+}
+
+// IsVaultReference returns true if s is written as a vault reference. It
+// doesn't check that the reference is valid.
+func IsVaultReference(s string) bool {
+	return strings.HasPrefix(s, vaultReferenceStart) && strings.HasSuffix(s, vaultReferenceEnd)
+}
+
+// ParseVaultReference parses a vault reference, e.g.
+// {vault://aws/database/password?region=eu-west-1}.
+func ParseVaultReference(reference string) (*VaultReference, error) {
+	if !IsVaultReference(reference) {
+		return nil, fmt.Errorf("invalid vault reference %q: references are written {vault://<prefix>/<resource>}",
+			reference)
+	}
+	rest := strings.TrimSuffix(strings.TrimPrefix(reference, vaultReferenceStart), vaultReferenceEnd)
+	ref := &VaultReference{}
+
+	if i := strings.LastIndexByte(rest, '#'); i >= 0 {
+		version, err := strconv.Atoi(rest[i+1:])
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("invalid vault reference %q: version must be a positive integer", reference)
+		}
+		ref.Version = version
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		query, err := url.ParseQuery(rest[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid vault reference %q: invalid query: %w", reference, err)
+		}
+		ref.Query = query
+		rest = rest[:i]
+	}
+
+	prefix, path, _ := strings.Cut(rest, "/")
+	if !vaultPrefixRegex.MatchString(prefix) {
+		return nil, fmt.Errorf("invalid vault reference %q: invalid prefix %q", reference, prefix)
+	}
+	ref.Prefix = prefix
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		ref.Resource, ref.Key = path[:i], path[i+1:]
+	} else {
+		ref.Resource = path
+	}
+	if ref.Resource == "" {
+		return nil, fmt.Errorf("invalid vault reference %q: missing resource", reference)
+	}
+	return ref, nil
+}
+
+// String returns the reference in Kong's syntax.
+func (r *VaultReference) String() string {
+	var b strings.Builder
+	b.WriteString(vaultReferenceStart)
+	b.WriteString(r.Prefix)
+	b.WriteByte('/')
+	b.WriteString(r.Resource)
+	if r.Key != "" || strings.Contains(r.Resource, "/") {
+		b.WriteByte('/')
+		b.WriteString(r.Key)
+	}
+	if len(r.Query) > 0 {
+		b.WriteByte('?')
+		b.WriteString(r.Query.Encode())
+	}
+	if r.Version > 0 {
+		fmt.Fprintf(&b, "#%d", r.Version)
+	}
+	b.WriteString(vaultReferenceEnd)
+	return b.String()
+}
+
+// ValidateVaultReferences checks the vault references found in entity
+// against schema: references must be valid and only be set on the fields
+// marked as referenceable.
+// The entity can be any value that marshals to a JSON object, typically one
+// of the entity structs of this package. For plugins, schema must be the
+// full schema of the plugin, as returned by PluginService.GetFullSchema.
+//
+// It returns a *SchemaValidationError listing the invalid fields.
+func ValidateVaultReferences(entity interface{}, schema Schema) error {
+	model, err := ParseSchema(schema)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("marshal entity: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Errorf("entity '%T' is not a JSON object: %w", entity, err)
+	}
+	verr := newSchemaValidationError()
+	validateRecordVaultReferences(model.Fields, model.ShorthandFields, obj, "", verr)
+	if verr.empty() {
+		return nil
+	}
+	return verr
+}
+
+func validateRecordVaultReferences(fields, shorthands []*SchemaField, obj map[string]interface{}, path string,
+	verr *SchemaValidationError,
+) {
+	for name, value := range obj {
+		var field *SchemaField
+		for _, candidate := range slices.Concat(fields, shorthands) {
+			if candidate.Name == name {
+				field = candidate
+			}
+		}
+		if field != nil {
+			validateFieldVaultReferences(field, value, joinFieldPath(path, name), verr)
+		}
+	}
+}
+
+func validateFieldVaultReferences(field *SchemaField, value interface{}, path string, verr *SchemaValidationError) {
+	switch v := value.(type) {
+	case string:
+		if !strings.HasPrefix(v, vaultReferenceStart) {
+			return
+		}
+		if _, err := ParseVaultReference(v); err != nil {
+			verr.addField(path, err.Error())
+			return
+		}
+		if !field.Referenceable {
+			verr.addField(path, "vault references are not allowed on this field")
+		}
+	case []interface{}:
+		if field.Elements == nil {
+			return
+		}
+		for i, element := range v {
+			validateFieldVaultReferences(field.Elements, element, fmt.Sprintf("%s[%d]", path, i), verr)
+		}
+	case map[string]interface{}:
+		if field.Values != nil {
+			for key, element := range v {
+				validateFieldVaultReferences(field.Values, element, joinFieldPath(path, key), verr)
+			}
+			return
+		}
+		validateRecordVaultReferences(field.Fields, field.ShorthandFields, v, path, verr)
+	}
+}
+
+// vaultQueryConfig converts the query arguments of a reference to the
+// configuration fields they override, typed after schema, the full schema of
+// the vault backend.
+func vaultQueryConfig(schema Schema, query url.Values) (Configuration, error) {
+	model, err := ParseSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	config := Configuration{}
+	for key := range query {
+		field, ok := model.Lookup("config." + key)
+		if !ok || len(field.Fields) > 0 {
+			return nil, fmt.Errorf("unknown configuration field %q", key)
+		}
+		value := query.Get(key)
+		var converted interface{}
+		switch field.Type {
+		case "integer":
+			converted, err = strconv.Atoi(value)
+		case "number":
+			converted, err = strconv.ParseFloat(value, 64)
+		case "boolean":
+			converted, err = strconv.ParseBool(value)
+		default:
+			converted = value
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for configuration field %q", value, key)
+		}
+		config[key] = converted
+	}
+	return config, nil
+}
+
+// VaultResolver resolves vault references locally, to use configurations
+// holding references without Kong, e.g. in tests.
+type VaultResolver interface {
+	// Resolve returns the secret ref references.
+	Resolve(ref *VaultReference) (string, error)
+}
+
+// LocalVaults resolves vault references with the VaultResolver registered
+// for their prefix:
+//
+//	vaults := LocalVaults{
+//		"env":     &EnvVaultResolver{},
+//		"secrets": &FileVaultResolver{FS: os.DirFS("testdata/secrets")},
+//	}
+//	config, err := vaults.ResolveConfig(plugin.Config)
+type LocalVaults map[string]VaultResolver
+
+// Resolve returns the secret reference refers to.
+func (v LocalVaults) Resolve(reference string) (string, error) {
+	ref, err := ParseVaultReference(reference)
+	if err != nil {
+		return "", err
+	}
+	resolver, ok := v[ref.Prefix]
+	if !ok {
+		return "", fmt.Errorf("resolving %s: no resolver for vault %q", reference, ref.Prefix)
+	}
+	secret, err := resolver.Resolve(ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", reference, err)
+	}
+	return secret, nil
+}
+
+// ResolveConfig returns a copy of config in which the vault references are
+// replaced by the secrets they refer to.
+func (v LocalVaults) ResolveConfig(config Configuration) (Configuration, error) {
+	resolved := config.DeepCopy()
+	if err := v.resolveValue(map[string]interface{}(resolved)); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// resolveValue replaces the references found in the maps and arrays held by
+// value.
+func (v LocalVaults) resolveValue(value interface{}) error {
+	resolve := func(element interface{}, set func(interface{})) error {
+		s, ok := element.(string)
+		if !ok {
+			return v.resolveValue(element)
+		}
+		if !IsVaultReference(s) {
+			return nil
+		}
+		secret, err := v.Resolve(s)
+		if err != nil {
+			return err
+		}
+		set(secret)
+		return nil
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		// Resolve in order, for deterministic errors.
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := resolve(value[key], func(secret interface{}) { value[key] = secret }); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i := range value {
+			if err := resolve(value[i], func(secret interface{}) { value[i] = secret }); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EnvVaultResolver resolves references to the env vault, which reads secrets
+// from environment variables as Kong does: the resource is uppercased and its
+// dashes replaced by underscores, e.g. {vault://env/db-password} reads
+// DB_PASSWORD.
+type EnvVaultResolver struct {
+	// Prefix is prepended to the names of the variables, as the prefix
+	// setting of the env vault. The prefix query parameter of references
+	// overrides it.
+	Prefix string
+	// LookupEnv looks up variables, os.LookupEnv if nil.
+	LookupEnv func(key string) (string, bool)
+}
+
+// Resolve returns the secret ref references.
+func (r *EnvVaultResolver) Resolve(ref *VaultReference) (string, error) {
+	prefix := r.Prefix
+	if ref.Query.Has("prefix") {
+		prefix = ref.Query.Get("prefix")
+	}
+	name := strings.ToUpper(strings.ReplaceAll(prefix+ref.Resource, "-", "_"))
+	lookupEnv := r.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+	value, ok := lookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return vaultSecretKey(value, ref.Key)
+}
+
+// FileVaultResolver resolves references to secrets stored in files, e.g. for
+// tests: {vault://<prefix>/db/password} reads the file db/password of FS or,
+// if there is none, the key password of the JSON object in the file db.
+type FileVaultResolver struct {
+	FS fs.FS
+}
+
+// Resolve returns the secret ref references, without the trailing newline of
+// the file.
+func (r *FileVaultResolver) Resolve(ref *VaultReference) (string, error) {
+	if !fs.ValidPath(ref.Resource) {
+		return "", fmt.Errorf("invalid secret path %q", ref.Resource)
+	}
+	if ref.Key != "" {
+		// The key is looked up in the resource if the path isn't a file.
+		if b, err := fs.ReadFile(r.FS, path.Join(ref.Resource, ref.Key)); err == nil {
+			return strings.TrimRight(string(b), "\r\n"), nil
+		}
+	}
+	b, err := fs.ReadFile(r.FS, ref.Resource)
+	if err != nil {
+		return "", err
+	}
+	return vaultSecretKey(strings.TrimRight(string(b), "\r\n"), ref.Key)
+}
+
+// vaultSecretKey returns the value of key in secret, a JSON object, or secret
+// itself if key is empty.
+func vaultSecretKey(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, cannot read key %q", key)
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret", key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
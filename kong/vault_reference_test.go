@@ -0,0 +1,160 @@
+package kong
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVaultReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      *VaultReference
+	}{
+		{
+			reference: "{vault://env/db-password}",
+			want:      &VaultReference{Prefix: "env", Resource: "db-password"},
+		},
+		{
+			reference: "{vault://aws/database/password?region=eu-west-1#2}",
+			want: &VaultReference{
+				Prefix:   "aws",
+				Resource: "database",
+				Key:      "password",
+				Query:    url.Values{"region": {"eu-west-1"}},
+				Version:  2,
+			},
+		},
+		{
+			reference: "{vault://my-hcv/path/to/secret/}",
+			want:      &VaultReference{Prefix: "my-hcv", Resource: "path/to/secret"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			ref, err := ParseVaultReference(tt.reference)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ref)
+			assert.Equal(t, tt.reference, ref.String())
+		})
+	}
+
+	for reference, wantErr := range map[string]string{
+		"vault://env/a":        "references are written {vault://<prefix>/<resource>}",
+		"{vault://env}":        "missing resource",
+		"{vault://env/}":       "missing resource",
+		"{vault://Env/a}":      `invalid prefix "Env"`,
+		"{vault://env/a#0}":    "version must be a positive integer",
+		"{vault://env/a?%zz=}": `invalid query: invalid URL escape "%zz"`,
+	} {
+		_, err := ParseVaultReference(reference)
+		assert.EqualError(t, err, "invalid vault reference "+`"`+reference+`": `+wantErr)
+	}
+	assert.True(t, IsVaultReference("{vault://env/a}"))
+	assert.False(t, IsVaultReference("vault://env/a"))
+}
+
+func TestValidateVaultReferences(t *testing.T) {
+	schema := mustBundledPluginSchema(t, "3.10.0", "rate-limiting")
+	plugin := &Plugin{
+		Name: String("rate-limiting"),
+		Config: Configuration{
+			"policy": "redis",
+			"redis": map[string]interface{}{
+				"password": "{vault://env/redis-password}",
+				"username": "{vault://aws/redis/username}",
+			},
+		},
+	}
+	require.NoError(t, ValidateVaultReferences(plugin, schema))
+
+	plugin.Config["redis"].(map[string]interface{})["host"] = "{vault://env/redis-host}"
+	plugin.Config["redis"].(map[string]interface{})["password"] = "{vault://env}"
+	plugin.Config["redis_host"] = "{vault://env/redis-host}"
+	err := ValidateVaultReferences(plugin, schema)
+	var verr *SchemaValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, map[string]string{
+		"config.redis.host":     "vault references are not allowed on this field",
+		"config.redis.password": `invalid vault reference "{vault://env}": missing resource`,
+		"config.redis_host":     "vault references are not allowed on this field",
+	}, verr.Fields)
+}
+
+func TestValidateVaultReferences_TopLevelShorthand(t *testing.T) {
+	schema := mustBundledSchema(t, "3.10.0", "services")
+	service := &Service{Name: String("svc"), URL: String("{vault://env/upstream-url}")}
+	err := ValidateVaultReferences(service, schema)
+	var verr *SchemaValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, map[string]string{
+		"url": "vault references are not allowed on this field",
+	}, verr.Fields)
+}
+
+func TestLocalVaults(t *testing.T) {
+	env := map[string]string{
+		"DB_PASSWORD":     "s3cr3t",
+		"APP_DB_PASSWORD": "prefixed",
+		"CREDENTIALS":     `{"user": "admin", "port": 5432}`,
+	}
+	vaults := LocalVaults{
+		"env": &EnvVaultResolver{LookupEnv: func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}},
+		"files": &FileVaultResolver{FS: fstest.MapFS{
+			"redis/password": {Data: []byte("from-file\n")},
+			"db":             {Data: []byte(`{"user": "db-user"}`)},
+		}},
+	}
+
+	secret, err := vaults.Resolve("{vault://env/db-password}")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", secret)
+	secret, err = vaults.Resolve("{vault://env/db-password?prefix=app_}")
+	require.NoError(t, err)
+	assert.Equal(t, "prefixed", secret)
+	secret, err = vaults.Resolve("{vault://env/credentials/port}")
+	require.NoError(t, err)
+	assert.Equal(t, "5432", secret)
+	// Without a file at the path of the reference, the last segment is the
+	// key of a JSON file.
+	secret, err = vaults.Resolve("{vault://files/db/user}")
+	require.NoError(t, err)
+	assert.Equal(t, "db-user", secret)
+
+	config, err := vaults.ResolveConfig(Configuration{
+		"user": "{vault://env/credentials/user}",
+		"redis": map[string]interface{}{
+			"password": "{vault://files/redis/password}",
+			"hosts":    []interface{}{"plain", "{vault://env/db-password}"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Configuration{
+		"user": "admin",
+		"redis": map[string]interface{}{
+			"password": "from-file",
+			"hosts":    []interface{}{"plain", "s3cr3t"},
+		},
+	}, config)
+
+	_, err = vaults.Resolve("{vault://aws/secret}")
+	assert.EqualError(t, err, `resolving {vault://aws/secret}: no resolver for vault "aws"`)
+	_, err = vaults.Resolve("{vault://env/unknown}")
+	assert.EqualError(t, err, "resolving {vault://env/unknown}: environment variable UNKNOWN is not set")
+	_, err = vaults.Resolve("{vault://env/credentials/unknown}")
+	assert.EqualError(t, err, `resolving {vault://env/credentials/unknown}: key "unknown" not found in secret`)
+	_, err = vaults.Resolve("{vault://env/db-password/key}")
+	assert.EqualError(t, err,
+		`resolving {vault://env/db-password/key}: secret is not a JSON object, cannot read key "key"`)
+	_, err = vaults.Resolve("{vault://files/../secret/}")
+	assert.EqualError(t, err, `resolving {vault://files/../secret/}: invalid secret path "../secret"`)
+	_, err = vaults.ResolveConfig(Configuration{"a": []interface{}{"{vault://files/missing/}"}})
+	assert.ErrorContains(t, err, "resolving {vault://files/missing/}: open missing")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 )
 
 // AbstractVaultService handles Vaults in Kong.
@@ -24,6 +25,12 @@ type AbstractVaultService interface {
 	ListAll(ctx context.Context) ([]*Vault, error)
 	// Validate validates a Vault against its schema.
 	Validate(ctx context.Context, vault *Vault) (bool, string, error)
+	// ValidateReference checks that reference is valid and refers to an existing Vault.
+	ValidateReference(ctx context.Context, reference string) error
+	// TestReference checks reference against the configuration of the vault it refers to.
+	TestReference(ctx context.Context, reference string) error
+	// GetFullSchema retrieves the full schema of a vault backend.
+	GetFullSchema(ctx context.Context, name *string) (Schema, error)
 }
 
 // VaultService handles Vaults in Kong.
@@ -176,3 +183,100 @@ func (s *VaultService) Validate(ctx context.Context, vault *Vault) (bool, string
 	}
 	return true, "", nil
 }
+
+// ValidateReference checks that reference is a valid vault reference, e.g.
+// {vault://aws/database/password}, whose prefix is the prefix of a Vault in
+// Kong or the name of a vault backend shipped with Kong.
+// The secret itself isn't read.
+func (s *VaultService) ValidateReference(ctx context.Context, reference string) error {
+	ref, err := ParseVaultReference(reference)
+	if err != nil {
+		return err
+	}
+	_, err = s.referencedVault(ctx, reference, ref)
+	return err
+}
+
+// TestReference checks reference against the vault it refers to: on top of
+// the checks of ValidateReference, the query arguments of the reference must
+// be fields of the configuration of the vault backend, and the configuration
+// of the Vault they override must pass Kong's vault validation endpoint.
+// The configuration of the vault backends referenced by name lives in
+// kong.conf, only their query arguments are checked.
+// The Admin API doesn't expose secrets, the secret itself isn't read.
+func (s *VaultService) TestReference(ctx context.Context, reference string) error {
+	ref, err := ParseVaultReference(reference)
+	if err != nil {
+		return err
+	}
+	vault, err := s.referencedVault(ctx, reference, ref)
+	if err != nil {
+		return err
+	}
+	backend := ref.Prefix
+	if vault != nil && vault.Name != nil {
+		backend = *vault.Name
+	}
+	schema, err := s.GetFullSchema(ctx, &backend)
+	if err != nil {
+		return err
+	}
+	overrides, err := vaultQueryConfig(schema, ref.Query)
+	if err != nil {
+		return fmt.Errorf("invalid vault reference %q: %w", reference, err)
+	}
+	if vault == nil {
+		return nil
+	}
+
+	config := vault.Config.DeepCopy()
+	if config == nil {
+		config = Configuration{}
+	}
+	for key, value := range overrides {
+		config[key] = value
+	}
+	valid, msg, err := s.Validate(ctx, &Vault{Name: vault.Name, Prefix: vault.Prefix, Config: config})
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("invalid vault reference %q: %s", reference, msg)
+	}
+	return nil
+}
+
+// referencedVault returns the Vault whose prefix ref refers to, or nil if it
+// refers to a vault backend shipped with Kong by name.
+func (s *VaultService) referencedVault(ctx context.Context, reference string, ref *VaultReference) (*Vault, error) {
+	vault, err := s.Get(ctx, &ref.Prefix)
+	if err == nil {
+		return vault, nil
+	}
+	if !IsNotFoundErr(err) {
+		return nil, err
+	}
+	if slices.Contains(builtinVaultBackends, ref.Prefix) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("invalid vault reference %q: vault %q not found: %w", reference, ref.Prefix, err)
+}
+
+// GetFullSchema retrieves the full schema of the vault backend called name,
+// e.g. "hcv".
+func (s *VaultService) GetFullSchema(ctx context.Context, name *string) (Schema, error) {
+	if isEmptyString(name) {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+	endpoint := fmt.Sprintf("/schemas/vaults/%v", *name)
+	req, err := s.client.NewRequest("GET", endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var schema Schema
+	_, err = s.client.Do(ctx, req, &schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
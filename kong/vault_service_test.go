@@ -1,9 +1,14 @@
 package kong
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -219,3 +224,97 @@ func compareVaults(t *testing.T, expected, actual []*Vault) bool {
 
 	return compareSlices(expectedPrefixes, actualPrefixes)
 }
+
+func TestVaultGetFullSchema(t *testing.T) {
+	RunWhenEnterprise(t, ">=3.0.0", RequiredFeatures{})
+
+	client, err := NewTestClient(nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	schema, err := client.Vaults.GetFullSchema(defaultCtx, String("env"))
+	require.NoError(t, err)
+	_, ok := schema["fields"]
+	assert.True(t, ok)
+
+	schema, err = client.Vaults.GetFullSchema(defaultCtx, String("noexist"))
+	assert.Nil(t, schema)
+	require.Error(t, err)
+	assert.True(t, IsNotFoundErr(err))
+}
+
+func TestVaultServiceValidateReference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/vaults/my-vault" {
+			_, _ = w.Write([]byte(`{"id": "vault-id", "name": "env", "prefix": "my-vault"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	}))
+	defer server.Close()
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Vaults.ValidateReference(defaultCtx, "{vault://my-vault/secret}"))
+	require.NoError(t, client.Vaults.ValidateReference(defaultCtx, "{vault://env/secret}"))
+
+	err = client.Vaults.ValidateReference(defaultCtx, "{vault://unknown/secret}")
+	assert.ErrorContains(t, err, `invalid vault reference "{vault://unknown/secret}": vault "unknown" not found`)
+	assert.True(t, IsNotFoundErr(err))
+
+	err = client.Vaults.ValidateReference(defaultCtx, "{vault://my-vault}")
+	assert.EqualError(t, err, `invalid vault reference "{vault://my-vault}": missing resource`)
+}
+
+func TestVaultServiceTestReference(t *testing.T) {
+	var validated []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vaults/my-hcv":
+			_, _ = w.Write([]byte(`{"id": "vault-id", "name": "hcv", "prefix": "my-hcv", "config": {"host": "vault"}}`))
+		case "/schemas/vaults/hcv", "/schemas/vaults/env":
+			schema, err := BundledVaultSchema(MustNewVersion("3.10.0"), path.Base(r.URL.Path))
+			require.NoError(t, err)
+			require.NoError(t, json.NewEncoder(w).Encode(schema))
+		case "/schemas/vaults/validate":
+			var vault map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&vault))
+			validated = append(validated, vault)
+			if vault["config"].(map[string]interface{})["port"] == float64(70000) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message": "schema violation (config.port: value should be between 0 and 65535)"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"message": "schema validation successful"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not found"}`))
+		}
+	}))
+	defer server.Close()
+	client, err := NewClient(String(server.URL), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Vaults.TestReference(defaultCtx, "{vault://my-hcv/secret/key?port=8300&kv=v2}"))
+	assert.Equal(t, []map[string]interface{}{{
+		"name":   "hcv",
+		"prefix": "my-hcv",
+		"config": map[string]interface{}{"host": "vault", "port": float64(8300), "kv": "v2"},
+	}}, validated)
+
+	err = client.Vaults.TestReference(defaultCtx, "{vault://my-hcv/secret/key?port=70000}")
+	assert.EqualError(t, err, `invalid vault reference "{vault://my-hcv/secret/key?port=70000}": `+
+		"schema violation (config.port: value should be between 0 and 65535)")
+	err = client.Vaults.TestReference(defaultCtx, "{vault://my-hcv/secret?port=http}")
+	assert.EqualError(t, err, `invalid vault reference "{vault://my-hcv/secret?port=http}": `+
+		`invalid value "http" for configuration field "port"`)
+
+	require.NoError(t, client.Vaults.TestReference(defaultCtx, "{vault://env/secret?prefix=kong_}"))
+	err = client.Vaults.TestReference(defaultCtx, "{vault://env/secret?region=eu}")
+	assert.EqualError(t, err, `invalid vault reference "{vault://env/secret?region=eu}": `+
+		`unknown configuration field "region"`)
+	err = client.Vaults.TestReference(defaultCtx, "{vault://unknown/secret}")
+	assert.True(t, IsNotFoundErr(err))
+	assert.Len(t, validated, 2)
+}
@@ -0,0 +1,22 @@
+// Package vaultconfig provides typed configurations for the vault backends
+// shipped with Kong, generated from their schemas in Kong 3.10 by the
+// plugingen command.
+//
+// The configurations convert to and from the untyped kong.Configuration of
+// kong.Vault, so that misspelled fields are caught at compile time.
+// Enumerated fields have their own types with a constant per value, which
+// don't prevent invalid values: Validate catches them at run time.
+//
+//	vault, err := vaultconfig.NewVault("my-hcv", &vaultconfig.HCVConfig{
+//		Host:  kong.String("vault.example.com"),
+//		KV:    vaultconfig.HCVConfigKVV2.Ptr(),
+//		Token: kong.String("{vault://env/hcv-token}"),
+//	})
+//
+// Fields left nil are omitted from the converted configurations, Kong
+// filling them with their defaults. Validate checks vaults against the
+// bundled schemas without contacting Kong, which requires Kong 3.10 or later
+// as older releases have no bundled vault schemas.
+package vaultconfig
+
+//go:generate go run ../pluginconfig/cmd/plugingen -package vaultconfig -kind vault -registry configs ../schemas/3.10/vaults
@@ -0,0 +1,89 @@
+package vaultconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kong/go-kong/kong"
+)
+
+// Config is the typed configuration of a vault backend.
+type Config interface {
+	// VaultName returns the name of the vault backend.
+	VaultName() string
+	// ToConfiguration converts the configuration to the one of a kong.Vault.
+	ToConfiguration() (kong.Configuration, error)
+}
+
+// NewVault returns a vault with the given prefix, using the backend of
+// config and configured with it.
+func NewVault(prefix string, config Config) (*kong.Vault, error) {
+	c, err := config.ToConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("converting %s configuration: %w", config.VaultName(), err)
+	}
+	return &kong.Vault{
+		Name:   kong.String(config.VaultName()),
+		Prefix: kong.String(prefix),
+		Config: c,
+	}, nil
+}
+
+// FromVault returns the typed configuration of vault.
+// The second value is false if vault doesn't use one of the vault backends
+// the package has configurations for.
+func FromVault(vault *kong.Vault) (Config, bool, error) {
+	if vault == nil || vault.Name == nil {
+		return nil, false, nil
+	}
+	newConfig, ok := configs[*vault.Name]
+	if !ok {
+		return nil, false, nil
+	}
+	config := newConfig()
+	if err := fromConfiguration(vault.Config, config); err != nil {
+		return nil, true, fmt.Errorf("converting %s configuration: %w", *vault.Name, err)
+	}
+	return config, true, nil
+}
+
+// Vaults returns the names of the vault backends the package has
+// configurations for.
+func Vaults() []string {
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate validates vault against the bundled schemas of the given Kong
+// version, without contacting Kong. Vault schemas are only bundled for Kong
+// 3.10 and later, an error is returned for older versions.
+// It returns a *kong.SchemaValidationError when the vault violates the
+// schemas.
+func Validate(vault *kong.Vault, version kong.Version) error {
+	if vault == nil || vault.Name == nil {
+		return fmt.Errorf("vault name is required")
+	}
+	entitySchema, err := kong.BundledSchema(version, "vaults")
+	if err != nil {
+		return err
+	}
+	vaultSchema, err := kong.BundledVaultSchema(version, *vault.Name)
+	if err != nil {
+		return err
+	}
+	return kong.ValidateVault(vault, entitySchema, vaultSchema)
+}
+
+// fromConfiguration decodes config into the typed configuration v.
+func fromConfiguration(config kong.Configuration, v Config) error {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,110 @@
+package vaultconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/vaultconfig"
+)
+
+func TestNewVault(t *testing.T) {
+	vault, err := vaultconfig.NewVault("my-hcv", &vaultconfig.HCVConfig{
+		Host:       kong.String("vault.example.com"),
+		KV:         vaultconfig.HCVConfigKVV2.Ptr(),
+		AuthMethod: vaultconfig.HCVConfigAuthMethodKubernetes.Ptr(),
+		KubeRole:   kong.String("kong"),
+		TTL:        kong.Int(60),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &kong.Vault{
+		Name:   kong.String("hcv"),
+		Prefix: kong.String("my-hcv"),
+		Config: kong.Configuration{
+			"host":        "vault.example.com",
+			"kv":          "v2",
+			"auth_method": "kubernetes",
+			"kube_role":   "kong",
+			"ttl":         float64(60),
+		},
+	}, vault)
+	require.NoError(t, vaultconfig.Validate(vault, kong.MustNewVersion("3.10.0")))
+
+	vault, err = vaultconfig.NewVault("my-gcp", &vaultconfig.GCPConfig{TTL: kong.Int(-1)})
+	require.NoError(t, err)
+	err = vaultconfig.Validate(vault, kong.MustNewVersion("3.10.0"))
+	var verr *kong.SchemaValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, map[string]string{
+		"config.project_id": "required field missing",
+		"config.ttl":        "value should be between 0 and 100000000",
+	}, verr.Fields)
+
+	err = vaultconfig.Validate(&kong.Vault{Name: kong.String("my-backend")}, kong.MustNewVersion("3.10.0"))
+	assert.EqualError(t, err, `no bundled schema for vault "my-backend" in Kong 3.10.0`)
+
+	err = vaultconfig.Validate(vault, kong.MustNewVersion("3.4.0"))
+	assert.EqualError(t, err, `no bundled vault schemas for Kong 3.4.0 (requires >=3.10.0)`)
+}
+
+func TestFromVault(t *testing.T) {
+	config, ok, err := vaultconfig.FromVault(&kong.Vault{
+		Name:   kong.String("aws"),
+		Prefix: kong.String("my-aws"),
+		Config: kong.Configuration{
+			"region":          "eu-west-1",
+			"assume_role_arn": "{vault://env/role-arn}",
+			"unknown":         true,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, &vaultconfig.AWSConfig{
+		Region:        kong.String("eu-west-1"),
+		AssumeRoleARN: kong.String("{vault://env/role-arn}"),
+	}, config)
+
+	_, ok, err = vaultconfig.FromVault(&kong.Vault{Name: kong.String("my-backend")})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = vaultconfig.FromVault(&kong.Vault{
+		Name:   kong.String("hcv"),
+		Config: kong.Configuration{"port": "8200"},
+	})
+	assert.True(t, ok)
+	assert.Error(t, err)
+}
+
+func TestConfigsRoundTrip(t *testing.T) {
+	version := kong.MustNewVersion("3.10.0")
+	bundled, err := kong.BundledSchemaVaults(version)
+	require.NoError(t, err)
+	assert.Equal(t, bundled, vaultconfig.Vaults())
+
+	// The defaults of every vault backend convert to its typed configuration
+	// and back.
+	for _, name := range vaultconfig.Vaults() {
+		schema, err := kong.BundledVaultSchema(version, name)
+		require.NoError(t, err)
+		model, err := kong.ParseSchema(schema)
+		require.NoError(t, err)
+		config, ok := model.Lookup("config")
+		require.True(t, ok)
+		vault := &kong.Vault{Name: kong.String(name), Prefix: kong.String("my-vault"), Config: kong.Configuration{}}
+		for _, field := range config.Fields {
+			if field.HasDefault {
+				vault.Config[field.Name] = field.Default
+			}
+		}
+
+		typed, ok, err := vaultconfig.FromVault(vault)
+		require.NoError(t, err, name)
+		require.True(t, ok)
+		roundTripped, err := vaultconfig.NewVault("my-vault", typed)
+		require.NoError(t, err, name)
+		assert.Equal(t, vault, roundTripped, name)
+	}
+}
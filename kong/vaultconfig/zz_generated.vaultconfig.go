@@ -0,0 +1,469 @@
+// Code generated by plugingen. DO NOT EDIT.
+
+package vaultconfig
+
+import (
+	"encoding/json"
+
+	"github.com/kong/go-kong/kong"
+)
+
+// AWSVaultName is the name of the aws vault.
+const AWSVaultName = "aws"
+
+// AWSConfig is the configuration of the aws vault.
+type AWSConfig struct {
+	// The AWS region your vault is located in.
+	Region *string `json:"region,omitempty" yaml:"region,omitempty"`
+	// The AWS Secrets Manager service endpoint URL. If not specified, the value used by vault will be the official AWS Secrets Manager service url which is `https://secretsmanager.<region>.amazonaws.com`. You can specify a complete URL (including the "http/https" scheme) to override the endpoint that vault will connect to.
+	EndpointURL *string `json:"endpoint_url,omitempty" yaml:"endpoint_url,omitempty"`
+	// The target AWS IAM role ARN that will be assumed. Typically this is used for operating between multiple roles (cross-account or within the same account).
+	AssumeRoleARN *string `json:"assume_role_arn,omitempty" yaml:"assume_role_arn,omitempty"`
+	// The role session name used for role assuming.
+	// Required. Defaults to "KongVault".
+	RoleSessionName *string `json:"role_session_name,omitempty" yaml:"role_session_name,omitempty"`
+	// The custom STS endpoint URL used for role assuming in the AWS IAM role.
+	StsEndpointURL *string `json:"sts_endpoint_url,omitempty" yaml:"sts_endpoint_url,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// VaultName returns the name of the aws vault.
+func (c *AWSConfig) VaultName() string {
+	return AWSVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *AWSConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// AWSConfigFromConfiguration converts a configuration of the aws vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func AWSConfigFromConfiguration(config kong.Configuration) (*AWSConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &AWSConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AzureVaultName is the name of the azure vault.
+const AzureVaultName = "azure"
+
+// AzureConfig is the configuration of the azure vault.
+type AzureConfig struct {
+	// The URI the vault is reachable from.
+	// Required.
+	VaultURI *string `json:"vault_uri,omitempty" yaml:"vault_uri,omitempty"`
+	// The prefix for the environment variables that hold the credentials of the vault.
+	// Required. Defaults to "AZURE".
+	CredentialsPrefix *string `json:"credentials_prefix,omitempty" yaml:"credentials_prefix,omitempty"`
+	// Azure Key Vault enables storing and managing secrets, keys and certificates. Only secrets are supported.
+	// Defaults to "secrets".
+	Type *AzureConfigType `json:"type,omitempty" yaml:"type,omitempty"`
+	// The location of the vault, e.g. eastus.
+	Location *string `json:"location,omitempty" yaml:"location,omitempty"`
+	// The client ID from your registered application.
+	ClientID *string `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+	// The DirectoryId and TenantId of the registered application.
+	TenantID *string `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// AzureConfigType is a value of config.type of the azure vault.
+type AzureConfigType string
+
+// Values of AzureConfigType.
+const (
+	AzureConfigTypeSecrets AzureConfigType = "secrets"
+)
+
+// Ptr returns a pointer to v.
+func (v AzureConfigType) Ptr() *AzureConfigType {
+	return &v
+}
+
+// VaultName returns the name of the azure vault.
+func (c *AzureConfig) VaultName() string {
+	return AzureVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *AzureConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// AzureConfigFromConfiguration converts a configuration of the azure vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func AzureConfigFromConfiguration(config kong.Configuration) (*AzureConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &AzureConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ConjurVaultName is the name of the conjur vault.
+const ConjurVaultName = "conjur"
+
+// ConjurConfig is the configuration of the conjur vault.
+type ConjurConfig struct {
+	// The URL of the Conjur server.
+	// Required.
+	EndpointURL *string `json:"endpoint_url,omitempty" yaml:"endpoint_url,omitempty"`
+	// The method to authenticate to Conjur with.
+	// Required. Defaults to "api_key".
+	AuthMethod *ConjurConfigAuthMethod `json:"auth_method,omitempty" yaml:"auth_method,omitempty"`
+	// The login name of the workload identity.
+	// Required.
+	Login *string `json:"login,omitempty" yaml:"login,omitempty"`
+	// The API key of the workload identity.
+	// Required.
+	APIKey *string `json:"api_key,omitempty" yaml:"api_key,omitempty"`
+	// The Conjur organization account name.
+	// Required.
+	Account *string `json:"account,omitempty" yaml:"account,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// ConjurConfigAuthMethod is a value of config.auth_method of the conjur vault.
+type ConjurConfigAuthMethod string
+
+// Values of ConjurConfigAuthMethod.
+const (
+	ConjurConfigAuthMethodAPIKey ConjurConfigAuthMethod = "api_key"
+)
+
+// Ptr returns a pointer to v.
+func (v ConjurConfigAuthMethod) Ptr() *ConjurConfigAuthMethod {
+	return &v
+}
+
+// VaultName returns the name of the conjur vault.
+func (c *ConjurConfig) VaultName() string {
+	return ConjurVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *ConjurConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ConjurConfigFromConfiguration converts a configuration of the conjur vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func ConjurConfigFromConfiguration(config kong.Configuration) (*ConjurConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &ConjurConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// EnvVaultName is the name of the env vault.
+const EnvVaultName = "env"
+
+// EnvConfig is the configuration of the env vault.
+type EnvConfig struct {
+	// The prefix of the environment variables that hold the secrets.
+	Prefix *string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// VaultName returns the name of the env vault.
+func (c *EnvConfig) VaultName() string {
+	return EnvVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *EnvConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// EnvConfigFromConfiguration converts a configuration of the env vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func EnvConfigFromConfiguration(config kong.Configuration) (*EnvConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &EnvConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GCPVaultName is the name of the gcp vault.
+const GCPVaultName = "gcp"
+
+// GCPConfig is the configuration of the gcp vault.
+type GCPConfig struct {
+	// The project ID from your Google API Console. Visit your Google API Console and select Manage all projects in the projects list to find your project ID.
+	// Required.
+	ProjectID *string `json:"project_id,omitempty" yaml:"project_id,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// VaultName returns the name of the gcp vault.
+func (c *GCPConfig) VaultName() string {
+	return GCPVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *GCPConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// GCPConfigFromConfiguration converts a configuration of the gcp vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func GCPConfigFromConfiguration(config kong.Configuration) (*GCPConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &GCPConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// HCVVaultName is the name of the hcv vault.
+const HCVVaultName = "hcv"
+
+// HCVConfig is the configuration of the hcv vault.
+type HCVConfig struct {
+	// The protocol to connect with.
+	// Defaults to "http".
+	Protocol *HCVConfigProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	// The hostname of your HashiCorp vault.
+	// Defaults to "127.0.0.1".
+	Host *string `json:"host,omitempty" yaml:"host,omitempty"`
+	// The port number of your HashiCorp vault.
+	// Defaults to 8200.
+	Port *int `json:"port,omitempty" yaml:"port,omitempty"`
+	// Namespace for the Vault. Vault Enterprise requires a namespace to successfully connect to it.
+	Namespace *string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// The mount point.
+	// Defaults to "secret".
+	Mount *string `json:"mount,omitempty" yaml:"mount,omitempty"`
+	// The secrets engine version you are using.
+	// Defaults to "v1".
+	KV *HCVConfigKV `json:"kv,omitempty" yaml:"kv,omitempty"`
+	// A token string.
+	Token *string `json:"token,omitempty" yaml:"token,omitempty"`
+	// The auth method to use when connecting to the vault.
+	// Defaults to "token".
+	AuthMethod *HCVConfigAuthMethod `json:"auth_method,omitempty" yaml:"auth_method,omitempty"`
+	// The Role assigned to the Kubernetes service account.
+	KubeRole *string `json:"kube_role,omitempty" yaml:"kube_role,omitempty"`
+	// The path to the Kubernetes service account token file.
+	KubeAPITokenFile *string `json:"kube_api_token_file,omitempty" yaml:"kube_api_token_file,omitempty"`
+	// The path of the Kubernetes auth method, kubernetes if not set.
+	KubeAuthPath *string `json:"kube_auth_path,omitempty" yaml:"kube_auth_path,omitempty"`
+	// The path of the AppRole auth method.
+	// Defaults to "approle".
+	ApproleAuthPath *string `json:"approle_auth_path,omitempty" yaml:"approle_auth_path,omitempty"`
+	// The Role ID of the AppRole.
+	ApproleRoleID *string `json:"approle_role_id,omitempty" yaml:"approle_role_id,omitempty"`
+	// The Secret ID of the AppRole.
+	ApproleSecretID *string `json:"approle_secret_id,omitempty" yaml:"approle_secret_id,omitempty"`
+	// The path to the file holding the Secret ID of the AppRole.
+	ApproleSecretIDFile *string `json:"approle_secret_id_file,omitempty" yaml:"approle_secret_id_file,omitempty"`
+	// Whether the Secret ID of the AppRole is response wrapped.
+	// Defaults to false.
+	ApproleResponseWrapping *bool `json:"approle_response_wrapping,omitempty" yaml:"approle_response_wrapping,omitempty"`
+	// The name of the role to authenticate with the cert auth method.
+	CertAuthRoleName *string `json:"cert_auth_role_name,omitempty" yaml:"cert_auth_role_name,omitempty"`
+	// The client certificate of the cert auth method, in PEM format.
+	CertAuthCert *string `json:"cert_auth_cert,omitempty" yaml:"cert_auth_cert,omitempty"`
+	// The private key of the client certificate of the cert auth method, in PEM format.
+	CertAuthCertKey *string `json:"cert_auth_cert_key,omitempty" yaml:"cert_auth_cert_key,omitempty"`
+	// The time-to-live (in seconds) of a secret from the vault when it's stored in the cache.
+	TTL *int `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// The time-to-live (in seconds) of a vault miss (no secret) when it's stored in the cache.
+	NegTTL *int `json:"neg_ttl,omitempty" yaml:"neg_ttl,omitempty"`
+	// The time (in seconds) for which stale secrets from the vault should be resurrected for when they cannot be refreshed (e.g., the vault is unreachable).
+	ResurrectTTL *int `json:"resurrect_ttl,omitempty" yaml:"resurrect_ttl,omitempty"`
+	// Decode all secrets in this vault as base64, useful for binary data. If some of the secrets in the vault are not base64 encoded, an error will occur when using them.
+	// Defaults to false.
+	Base64Decode *bool `json:"base64_decode,omitempty" yaml:"base64_decode,omitempty"`
+}
+
+// HCVConfigProtocol is a value of config.protocol of the hcv vault.
+type HCVConfigProtocol string
+
+// Values of HCVConfigProtocol.
+const (
+	HCVConfigProtocolHTTP  HCVConfigProtocol = "http"
+	HCVConfigProtocolHTTPS HCVConfigProtocol = "https"
+)
+
+// Ptr returns a pointer to v.
+func (v HCVConfigProtocol) Ptr() *HCVConfigProtocol {
+	return &v
+}
+
+// HCVConfigKV is a value of config.kv of the hcv vault.
+type HCVConfigKV string
+
+// Values of HCVConfigKV.
+const (
+	HCVConfigKVV1 HCVConfigKV = "v1"
+	HCVConfigKVV2 HCVConfigKV = "v2"
+)
+
+// Ptr returns a pointer to v.
+func (v HCVConfigKV) Ptr() *HCVConfigKV {
+	return &v
+}
+
+// HCVConfigAuthMethod is a value of config.auth_method of the hcv vault.
+type HCVConfigAuthMethod string
+
+// Values of HCVConfigAuthMethod.
+const (
+	HCVConfigAuthMethodToken      HCVConfigAuthMethod = "token"
+	HCVConfigAuthMethodKubernetes HCVConfigAuthMethod = "kubernetes"
+	HCVConfigAuthMethodApprole    HCVConfigAuthMethod = "approle"
+	HCVConfigAuthMethodCert       HCVConfigAuthMethod = "cert"
+)
+
+// Ptr returns a pointer to v.
+func (v HCVConfigAuthMethod) Ptr() *HCVConfigAuthMethod {
+	return &v
+}
+
+// VaultName returns the name of the hcv vault.
+func (c *HCVConfig) VaultName() string {
+	return HCVVaultName
+}
+
+// ToConfiguration converts c to the configuration of a kong.Vault.
+func (c *HCVConfig) ToConfiguration() (kong.Configuration, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// HCVConfigFromConfiguration converts a configuration of the hcv vault to its typed form.
+// Fields unknown to the schema it was generated from are ignored.
+func HCVConfigFromConfiguration(config kong.Configuration) (*HCVConfig, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	c := &HCVConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// configs maps the names of the vaults to constructors of their configuration.
+var configs = map[string]func() Config{
+	AWSVaultName:    func() Config { return &AWSConfig{} },
+	AzureVaultName:  func() Config { return &AzureConfig{} },
+	ConjurVaultName: func() Config { return &ConjurConfig{} },
+	EnvVaultName:    func() Config { return &EnvConfig{} },
+	GCPVaultName:    func() Config { return &GCPConfig{} },
+	HCVVaultName:    func() Config { return &HCVConfig{} },
+}
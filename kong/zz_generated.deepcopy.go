@@ -828,6 +828,582 @@ func (in *CustomPluginDefinition) DeepCopy() *CustomPluginDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeCertificate) DeepCopyInto(out *DeclarativeCertificate) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(Certificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNIs != nil {
+		in, out := &in.SNIs, &out.SNIs
+		*out = make([]*SNI, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SNI)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeCertificate.
+func (in *DeclarativeCertificate) DeepCopy() *DeclarativeCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeConsumer) DeepCopyInto(out *DeclarativeConsumer) {
+	*out = *in
+	if in.Consumer != nil {
+		in, out := &in.Consumer, &out.Consumer
+		*out = new(Consumer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*ConsumerGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConsumerGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]*Plugin, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Plugin)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.KeyAuths != nil {
+		in, out := &in.KeyAuths, &out.KeyAuths
+		*out = make([]*KeyAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KeyAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BasicAuths != nil {
+		in, out := &in.BasicAuths, &out.BasicAuths
+		*out = make([]*BasicAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BasicAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.HMACAuths != nil {
+		in, out := &in.HMACAuths, &out.HMACAuths
+		*out = make([]*HMACAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HMACAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.JWTAuths != nil {
+		in, out := &in.JWTAuths, &out.JWTAuths
+		*out = make([]*JWTAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(JWTAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MTLSAuths != nil {
+		in, out := &in.MTLSAuths, &out.MTLSAuths
+		*out = make([]*MTLSAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MTLSAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ACLGroups != nil {
+		in, out := &in.ACLGroups, &out.ACLGroups
+		*out = make([]*ACLGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ACLGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Oauth2Credentials != nil {
+		in, out := &in.Oauth2Credentials, &out.Oauth2Credentials
+		*out = make([]*Oauth2Credential, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Oauth2Credential)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeConsumer.
+func (in *DeclarativeConsumer) DeepCopy() *DeclarativeConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeConsumerGroup) DeepCopyInto(out *DeclarativeConsumerGroup) {
+	*out = *in
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(ConsumerGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]*Plugin, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Plugin)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeConsumerGroup.
+func (in *DeclarativeConsumerGroup) DeepCopy() *DeclarativeConsumerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeConsumerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeContent) DeepCopyInto(out *DeclarativeContent) {
+	*out = *in
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]*DeclarativeService, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeService)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*DeclarativeRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]*DeclarativeConsumer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeConsumer)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ConsumerGroups != nil {
+		in, out := &in.ConsumerGroups, &out.ConsumerGroups
+		*out = make([]*DeclarativeConsumerGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeConsumerGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]*Plugin, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Plugin)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]*DeclarativeUpstream, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeUpstream)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]*Target, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Target)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]*DeclarativeCertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeCertificate)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SNIs != nil {
+		in, out := &in.SNIs, &out.SNIs
+		*out = make([]*SNI, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SNI)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CACertificates != nil {
+		in, out := &in.CACertificates, &out.CACertificates
+		*out = make([]*CACertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CACertificate)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Vaults != nil {
+		in, out := &in.Vaults, &out.Vaults
+		*out = make([]*Vault, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Vault)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]*Key, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Key)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.KeySets != nil {
+		in, out := &in.KeySets, &out.KeySets
+		*out = make([]*KeySet, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KeySet)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Partials != nil {
+		in, out := &in.Partials, &out.Partials
+		*out = make([]*Partial, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Partial)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FilterChains != nil {
+		in, out := &in.FilterChains, &out.FilterChains
+		*out = make([]*FilterChain, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FilterChain)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.KeyAuths != nil {
+		in, out := &in.KeyAuths, &out.KeyAuths
+		*out = make([]*KeyAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KeyAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BasicAuths != nil {
+		in, out := &in.BasicAuths, &out.BasicAuths
+		*out = make([]*BasicAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BasicAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.HMACAuths != nil {
+		in, out := &in.HMACAuths, &out.HMACAuths
+		*out = make([]*HMACAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HMACAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.JWTAuths != nil {
+		in, out := &in.JWTAuths, &out.JWTAuths
+		*out = make([]*JWTAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(JWTAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MTLSAuths != nil {
+		in, out := &in.MTLSAuths, &out.MTLSAuths
+		*out = make([]*MTLSAuth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MTLSAuth)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ACLGroups != nil {
+		in, out := &in.ACLGroups, &out.ACLGroups
+		*out = make([]*ACLGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ACLGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Oauth2Credentials != nil {
+		in, out := &in.Oauth2Credentials, &out.Oauth2Credentials
+		*out = make([]*Oauth2Credential, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Oauth2Credential)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeContent.
+func (in *DeclarativeContent) DeepCopy() *DeclarativeContent {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeRoute) DeepCopyInto(out *DeclarativeRoute) {
+	*out = *in
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(Route)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]*Plugin, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Plugin)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FilterChains != nil {
+		in, out := &in.FilterChains, &out.FilterChains
+		*out = make([]*FilterChain, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FilterChain)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeRoute.
+func (in *DeclarativeRoute) DeepCopy() *DeclarativeRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeService) DeepCopyInto(out *DeclarativeService) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Service)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*DeclarativeRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeclarativeRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]*Plugin, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Plugin)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FilterChains != nil {
+		in, out := &in.FilterChains, &out.FilterChains
+		*out = make([]*FilterChain, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FilterChain)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeService.
+func (in *DeclarativeService) DeepCopy() *DeclarativeService {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeUpstream) DeepCopyInto(out *DeclarativeUpstream) {
+	*out = *in
+	if in.Upstream != nil {
+		in, out := &in.Upstream, &out.Upstream
+		*out = new(Upstream)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]*Target, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Target)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeUpstream.
+func (in *DeclarativeUpstream) DeepCopy() *DeclarativeUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DegraphqlRoute) DeepCopyInto(out *DegraphqlRoute) {
 	*out = *in